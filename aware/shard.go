@@ -31,6 +31,9 @@ type ShardStateAware interface {
 	BecomeFollower(bhmetapb.Shard)
 	// SnapshotApplied snapshot applied
 	SnapshotApplied(bhmetapb.Shard)
+	// Merged the source shard was merged into the target shard on the current store,
+	// the source shard is destoryed before.
+	Merged(target bhmetapb.Shard, source bhmetapb.Shard)
 }
 
 // TestShardStateAware just for test
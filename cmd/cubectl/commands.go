@@ -0,0 +1,736 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+)
+
+type clusterView struct {
+	Leader     string         `json:"leader"`
+	Containers map[string]int `json:"containers"`
+	Shards     int            `json:"shards"`
+	Schedulers []string       `json:"schedulers"`
+}
+
+type containerView struct {
+	ID             uint64        `json:"id"`
+	RaftAddr       string        `json:"raftAddr"`
+	ClientAddr     string        `json:"clientAddr"`
+	State          string        `json:"state"`
+	Labels         []metapb.Pair `json:"labels"`
+	Version        string        `json:"version"`
+	LeaderWeight   float64       `json:"leaderWeight"`
+	ResourceWeight float64       `json:"resourceWeight"`
+	Capacity       uint64        `json:"capacity"`
+	Available      uint64        `json:"available"`
+	ShardCount     uint64        `json:"shardCount"`
+	LastHeartbeat  string        `json:"lastHeartbeat"`
+}
+
+type peerView struct {
+	ID          uint64 `json:"id"`
+	ContainerID uint64 `json:"containerID"`
+	Role        string `json:"role"`
+}
+
+type shardView struct {
+	ID              uint64     `json:"id"`
+	Group           uint64     `json:"group"`
+	Start           string     `json:"start"`
+	End             string     `json:"end"`
+	State           string     `json:"state"`
+	ConfVer         uint64     `json:"confVer"`
+	Version         uint64     `json:"version"`
+	Leader          uint64     `json:"leader"`
+	Peers           []peerView `json:"peers"`
+	ApproximateSize int64      `json:"approximateSize"`
+	ApproximateKeys int64      `json:"approximateKeys"`
+}
+
+type jobView struct {
+	ID        uint64 `json:"id"`
+	Type      string `json:"type"`
+	State     string `json:"state"`
+	Progress  uint64 `json:"progress"`
+	Retries   uint64 `json:"retries"`
+	Error     string `json:"error"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type operatorView struct {
+	Shard  uint64 `json:"shard"`
+	Found  bool   `json:"found"`
+	Status string `json:"status"`
+	Desc   string `json:"desc"`
+}
+
+func (c *ctl) showCluster(args []string) error {
+	containers, err := c.containers()
+	if err != nil {
+		return err
+	}
+	shards, err := c.shards()
+	if err != nil {
+		return err
+	}
+	schedulers, err := c.client.ListSchedulers()
+	if err != nil {
+		return err
+	}
+	sort.Strings(schedulers)
+
+	view := clusterView{
+		Leader:     c.leader,
+		Containers: make(map[string]int),
+		Shards:     len(shards),
+		Schedulers: schedulers,
+	}
+	for _, v := range containers {
+		view.Containers[v.State]++
+	}
+
+	var states []string
+	for state, n := range view.Containers {
+		states = append(states, fmt.Sprintf("%s:%d", state, n))
+	}
+	sort.Strings(states)
+	return c.p.print(view, []string{"LEADER", "CONTAINERS", "SHARDS", "SCHEDULERS"},
+		[][]string{{view.Leader, strings.Join(states, ","), strconv.Itoa(view.Shards), strings.Join(schedulers, ",")}})
+}
+
+func (c *ctl) listContainers(args []string) error {
+	containers, err := c.containers()
+	if err != nil {
+		return err
+	}
+
+	var rows [][]string
+	for _, v := range containers {
+		rows = append(rows, []string{
+			format(v.ID),
+			v.RaftAddr,
+			v.ClientAddr,
+			v.State,
+			formatLabels(v.Labels),
+			format(v.LeaderWeight),
+			format(v.ResourceWeight),
+			format(v.Capacity),
+			format(v.Available),
+			format(v.ShardCount),
+			v.LastHeartbeat,
+		})
+	}
+	return c.p.print(containers, []string{"ID", "RAFT-ADDR", "CLIENT-ADDR", "STATE", "LABELS",
+		"LEADER-WEIGHT", "SHARD-WEIGHT", "CAPACITY", "AVAILABLE", "SHARDS", "LAST-HEARTBEAT"}, rows)
+}
+
+func (c *ctl) offlineContainer(args []string) error {
+	fs := flag.NewFlagSet("container offline", flag.ContinueOnError)
+	destroyed := fs.Bool("destroyed", false, "the container is physically destroyed and never come back")
+	id, err := parseIDWithFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	return c.client.RemoveContainer(id, *destroyed)
+}
+
+func (c *ctl) upContainer(args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	return c.client.UpContainer(ids[0])
+}
+
+func (c *ctl) setContainerWeight(args []string) error {
+	if len(args) != 3 {
+		return errUsage
+	}
+
+	ids, err := parseIDs(args[:1], 1)
+	if err != nil {
+		return err
+	}
+	leader, err := strconv.ParseFloat(args[1], 64)
+	if err != nil {
+		return err
+	}
+	resource, err := strconv.ParseFloat(args[2], 64)
+	if err != nil {
+		return err
+	}
+
+	return c.client.SetContainerWeight(ids[0], leader, resource)
+}
+
+func (c *ctl) listShards(args []string) error {
+	fs := flag.NewFlagSet("shard list", flag.ContinueOnError)
+	group := fs.Int64("group", -1, "only list the shards of the group")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	shards, err := c.shards()
+	if err != nil {
+		return err
+	}
+
+	var values []shardView
+	for _, v := range shards {
+		if *group < 0 || uint64(*group) == v.Group {
+			values = append(values, v)
+		}
+	}
+	return c.printShards(values)
+}
+
+func (c *ctl) showShard(args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	shards, err := c.shards()
+	if err != nil {
+		return err
+	}
+
+	for _, v := range shards {
+		if v.ID == ids[0] {
+			return c.printShards([]shardView{v})
+		}
+	}
+	return fmt.Errorf("shard %d not found", ids[0])
+}
+
+func (c *ctl) splitShard(args []string) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+
+	ids, err := parseIDs(args[:1], 1)
+	if err != nil {
+		return err
+	}
+
+	if args[1] == "-policy" {
+		if len(args) != 3 {
+			return errUsage
+		}
+
+		policy, ok := metapb.CheckPolicy_value[strings.ToUpper(args[2])]
+		if !ok || metapb.CheckPolicy(policy) == metapb.CheckPolicy_USEKEY {
+			return fmt.Errorf("invalid split policy %s", args[2])
+		}
+		return c.client.SplitResourceByPolicy(ids[0], metapb.CheckPolicy(policy))
+	}
+
+	var keys [][]byte
+	for _, arg := range args[1:] {
+		key, err := parseBytes(arg)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	return c.client.SplitResource(ids[0], keys...)
+}
+
+func (c *ctl) transferLeader(args []string) error {
+	ids, err := parseIDs(args, 2)
+	if err != nil {
+		return err
+	}
+
+	return c.client.TransferLeader(ids[0], ids[1])
+}
+
+func (c *ctl) movePeer(args []string) error {
+	ids, err := parseIDs(args, 3)
+	if err != nil {
+		return err
+	}
+
+	return c.client.MovePeer(ids[0], ids[1], ids[2])
+}
+
+func (c *ctl) showOperator(args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	rsp, err := c.client.GetOperatorStatus(ids[0])
+	if err != nil {
+		return err
+	}
+
+	view := operatorView{Shard: ids[0], Found: rsp.Found}
+	if rsp.Found {
+		view.Status = rsp.Status.String()
+		view.Desc = rsp.Desc
+	}
+	return c.p.print(view, []string{"SHARD", "STATUS", "OPERATOR"},
+		[][]string{{format(view.Shard), view.Status, view.Desc}})
+}
+
+func (c *ctl) cancelOperator(args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	return c.client.RemoveOperator(ids[0])
+}
+
+func (c *ctl) listSchedulers(args []string) error {
+	names, err := c.client.ListSchedulers()
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(names)
+	var rows [][]string
+	for _, name := range names {
+		rows = append(rows, []string{name})
+	}
+	return c.p.print(names, []string{"NAME"}, rows)
+}
+
+func (c *ctl) addScheduler(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	return c.client.AddScheduler(args[0], args[1:]...)
+}
+
+func (c *ctl) removeScheduler(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	return c.client.RemoveScheduler(args[0])
+}
+
+func (c *ctl) pauseScheduler(args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	seconds, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || seconds <= 0 {
+		return fmt.Errorf("invalid seconds %s", args[1])
+	}
+	return c.client.PauseScheduler(args[0], seconds)
+}
+
+func (c *ctl) resumeScheduler(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	return c.client.PauseScheduler(args[0], 0)
+}
+
+func (c *ctl) putRule(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	var data []byte
+	var err error
+	if args[0] == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	rule := rpcpb.PlacementRule{}
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return err
+	}
+	return c.client.PutPlacementRule(rule)
+}
+
+func (c *ctl) getRules(args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	rules, err := c.client.GetAppliedRules(ids[0])
+	if err != nil {
+		return err
+	}
+
+	var rows [][]string
+	for _, rule := range rules {
+		rows = append(rows, []string{
+			rule.GroupID,
+			rule.ID,
+			format(rule.Index),
+			format(rule.Override),
+			formatKey(rule.StartKey),
+			formatKey(rule.EndKey),
+			rule.Role.String(),
+			format(rule.Count),
+		})
+	}
+	return c.p.print(rules, []string{"GROUP", "ID", "INDEX", "OVERRIDE", "START", "END", "ROLE", "COUNT"}, rows)
+}
+
+func (c *ctl) createJob(args []string) error {
+	job, err := parseJob(args)
+	if err != nil {
+		return err
+	}
+
+	return c.doCreateJob(job)
+}
+
+func (c *ctl) doCreateJob(job metapb.Job) error {
+	job, err := c.client.CreateJob(job)
+	if err != nil {
+		return err
+	}
+	return c.printJobs(newJobView(job), []metapb.Job{job})
+}
+
+func (c *ctl) backup(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	return c.doCreateJob(metapb.Job{Type: metapb.JobType_Backup,
+		Content: protoc.MustMarshal(&metapb.BackupJob{Path: args[0]})})
+}
+
+func (c *ctl) restore(args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	return c.doCreateJob(metapb.Job{Type: metapb.JobType_Restore,
+		Content: protoc.MustMarshal(&metapb.RestoreJob{Path: args[0]})})
+}
+
+func (c *ctl) removeJob(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errUsage
+	}
+
+	job, err := parseJob(args[:1])
+	if err != nil {
+		return err
+	}
+	if len(args) == 2 {
+		ids, err := parseIDs(args[1:], 1)
+		if err != nil {
+			return err
+		}
+		job.ID = ids[0]
+	}
+	return c.client.RemoveJob(job)
+}
+
+func (c *ctl) listJobs(args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	jobs, err := c.client.ListJobs()
+	if err != nil {
+		return err
+	}
+
+	views := make([]jobView, 0, len(jobs))
+	for _, job := range jobs {
+		views = append(views, newJobView(job))
+	}
+	return c.printJobs(views, jobs)
+}
+
+func (c *ctl) showJob(args []string) error {
+	ids, err := parseIDs(args, 1)
+	if err != nil {
+		return err
+	}
+
+	job, err := c.client.GetJob(ids[0])
+	if err != nil {
+		return err
+	}
+	return c.printJobs(newJobView(job), []metapb.Job{job})
+}
+
+func (c *ctl) printJobs(value interface{}, jobs []metapb.Job) error {
+	var rows [][]string
+	for _, job := range jobs {
+		v := newJobView(job)
+		rows = append(rows, []string{
+			format(v.ID),
+			v.Type,
+			v.State,
+			format(v.Progress),
+			format(v.Retries),
+			v.Error,
+			v.CreatedAt,
+			v.UpdatedAt,
+		})
+	}
+	return c.p.print(value, []string{"ID", "TYPE", "STATE", "PROGRESS", "RETRIES", "ERROR",
+		"CREATED", "UPDATED"}, rows)
+}
+
+func (c *ctl) executeJob(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errUsage
+	}
+
+	job, err := parseJob(args[:1])
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if len(args) == 2 {
+		data, err = parseBytes(args[1])
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := c.client.ExecuteJob(job, data)
+	if err != nil {
+		return err
+	}
+	return c.p.print(formatKey(result), []string{"RESULT"}, [][]string{{formatKey(result)}})
+}
+
+func (c *ctl) containers() ([]containerView, error) {
+	infos, err := c.client.ListContainers()
+	if err != nil {
+		return nil, err
+	}
+
+	var values []containerView
+	for _, info := range infos {
+		meta := bhmetapb.Store{}
+		protoc.MustUnmarshal(&meta, info.Data)
+		v := containerView{
+			ID:             meta.ID,
+			RaftAddr:       meta.RaftAddr,
+			ClientAddr:     meta.ClientAddr,
+			State:          meta.State.String(),
+			Labels:         meta.Labels,
+			Version:        meta.Version,
+			LeaderWeight:   info.LeaderWeight,
+			ResourceWeight: info.ResourceWeight,
+		}
+		if meta.LastHeartbeatTime > 0 {
+			v.LastHeartbeat = time.Unix(0, meta.LastHeartbeatTime).Format(time.RFC3339)
+		}
+		if info.Stats != nil {
+			v.Capacity = info.Stats.Capacity
+			v.Available = info.Stats.Available
+			v.ShardCount = info.Stats.ResourceCount
+		}
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].ID < values[j].ID })
+	return values, nil
+}
+
+func (c *ctl) shards() ([]shardView, error) {
+	infos, err := c.client.ListResources()
+	if err != nil {
+		return nil, err
+	}
+
+	var values []shardView
+	for _, info := range infos {
+		meta := bhmetapb.Shard{}
+		protoc.MustUnmarshal(&meta, info.Data)
+		v := shardView{
+			ID:              meta.ID,
+			Group:           meta.Group,
+			Start:           formatKey(meta.Start),
+			End:             formatKey(meta.End),
+			State:           meta.State.String(),
+			ConfVer:         meta.Epoch.ConfVer,
+			Version:         meta.Epoch.Version,
+			Leader:          info.Leader,
+			ApproximateSize: info.ApproximateSize,
+			ApproximateKeys: info.ApproximateKeys,
+		}
+		for _, p := range meta.Peers {
+			v.Peers = append(v.Peers, peerView{ID: p.ID, ContainerID: p.ContainerID, Role: p.Role.String()})
+		}
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].ID < values[j].ID })
+	return values, nil
+}
+
+func (c *ctl) printShards(shards []shardView) error {
+	var rows [][]string
+	for _, v := range shards {
+		var peers []string
+		for _, p := range v.Peers {
+			peers = append(peers, fmt.Sprintf("%d@%d(%s)", p.ID, p.ContainerID, p.Role))
+		}
+		rows = append(rows, []string{
+			format(v.ID),
+			format(v.Group),
+			v.Start,
+			v.End,
+			v.State,
+			fmt.Sprintf("%d/%d", v.ConfVer, v.Version),
+			format(v.Leader),
+			strings.Join(peers, ","),
+			format(v.ApproximateSize),
+		})
+	}
+	return c.p.print(shards, []string{"ID", "GROUP", "START", "END", "STATE", "EPOCH(CONF/VER)",
+		"LEADER", "PEERS", "SIZE"}, rows)
+}
+
+func parseIDWithFlags(fs *flag.FlagSet, args []string) (uint64, error) {
+	// allow the flags after the id
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		args = append(args[1:], args[0])
+	}
+	if err := fs.Parse(args); err != nil {
+		return 0, errUsage
+	}
+
+	ids, err := parseIDs(fs.Args(), 1)
+	if err != nil {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+func parseIDs(args []string, n int) ([]uint64, error) {
+	if len(args) != n {
+		return nil, errUsage
+	}
+
+	ids := make([]uint64, 0, n)
+	for _, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %s", arg)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func parseJob(args []string) (metapb.Job, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return metapb.Job{}, errUsage
+	}
+
+	job := metapb.Job{}
+	if v, ok := metapb.JobType_value[args[0]]; ok {
+		job.Type = metapb.JobType(v)
+	} else {
+		v, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return job, fmt.Errorf("invalid job type %s", args[0])
+		}
+		job.Type = metapb.JobType(v)
+	}
+
+	if len(args) == 2 {
+		content, err := parseBytes(args[1])
+		if err != nil {
+			return job, err
+		}
+		job.Content = content
+	}
+	return job, nil
+}
+
+func newJobView(job metapb.Job) jobView {
+	v := jobView{
+		ID:       job.ID,
+		Type:     job.Type.String(),
+		State:    job.State.String(),
+		Progress: job.Progress,
+		Retries:  job.Retries,
+		Error:    job.Error,
+	}
+	if job.CreatedAt > 0 {
+		v.CreatedAt = time.Unix(job.CreatedAt, 0).Format(time.RFC3339)
+	}
+	if job.UpdatedAt > 0 {
+		v.UpdatedAt = time.Unix(job.UpdatedAt, 0).Format(time.RFC3339)
+	}
+	return v
+}
+
+// parseBytes parse the string as bytes, the string starts with "0x" is treated as hex
+func parseBytes(value string) ([]byte, error) {
+	if strings.HasPrefix(value, "0x") {
+		return hex.DecodeString(value[2:])
+	}
+	return []byte(value), nil
+}
+
+// formatKey returns the printable key as string, otherwise returns the hex with "0x" prefix
+func formatKey(key []byte) string {
+	for _, c := range string(key) {
+		if c > unicode.MaxASCII || !unicode.IsPrint(c) {
+			return "0x" + hex.EncodeToString(key)
+		}
+	}
+	return string(key)
+}
+
+func formatLabels(labels []metapb.Pair) string {
+	var values []string
+	for _, label := range labels {
+		values = append(values, fmt.Sprintf("%s=%s", label.Key, label.Value))
+	}
+	return strings.Join(values, ",")
+}
+
+func format(value interface{}) string {
+	return fmt.Sprintf("%v", value)
+}
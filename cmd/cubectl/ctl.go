@@ -0,0 +1,185 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/matrixorigin/matrixcube/components/prophet"
+	"github.com/matrixorigin/matrixcube/components/prophet/codec"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
+	"github.com/matrixorigin/matrixcube/raftstore"
+)
+
+var (
+	errUsage = errors.New("invalid usage")
+)
+
+type ctl struct {
+	leader string
+	client prophet.Client
+	p      *printer
+}
+
+func newCtl(addrs []string, timeout time.Duration, security tlsutil.Config, p *printer) (*ctl, error) {
+	leader, err := findLeader(addrs, timeout, security)
+	if err != nil {
+		return nil, err
+	}
+
+	member := &metapb.Member{Addr: leader}
+	return &ctl{
+		leader: leader,
+		client: prophet.NewClient(raftstore.NewProphetAdapter(),
+			prophet.WithRPCTimeout(timeout),
+			prophet.WithSecurity(security),
+			prophet.WithLeaderGetter(func() *metapb.Member { return member })),
+		p: p,
+	}, nil
+}
+
+func (c *ctl) close() {
+	c.client.Close()
+}
+
+// findLeader returns the address of the prophet leader. The prophet followers reply
+// the not leader error with the leader address.
+func findLeader(addrs []string, timeout time.Duration, security tlsutil.Config) (string, error) {
+	var lastErr error
+	for _, addr := range addrs {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+
+		leader, err := probeLeader(addr, timeout, security)
+		if err == nil {
+			return leader, nil
+		}
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("missing prophet address")
+	}
+	return "", lastErr
+}
+
+func probeLeader(addr string, timeout time.Duration, security tlsutil.Config) (string, error) {
+	encoder, decoder := codec.NewClientCodec(10 * buf.MB)
+	conn := tlsutil.NewIOSession(security, encoder, decoder)
+	defer conn.Close()
+
+	ok, err := conn.Connect(addr, timeout)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("connect to %s failed", addr)
+	}
+
+	req := &rpcpb.Request{}
+	req.ID = 1
+	req.Type = rpcpb.TypeListSchedulersReq
+	if err := conn.WriteAndFlush(req); err != nil {
+		return "", err
+	}
+
+	msg, err := conn.Read()
+	if err != nil {
+		return "", err
+	}
+
+	resp := msg.(*rpcpb.Response)
+	if resp.Error == "" {
+		return addr, nil
+	}
+	if util.IsNotLeaderError(resp.Error) && resp.Leader != "" {
+		return resp.Leader, nil
+	}
+	return "", fmt.Errorf("%s: %s", addr, resp.Error)
+}
+
+func (c *ctl) run(args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	var handlers map[string]func([]string) error
+	switch args[0] {
+	case "cluster":
+		return c.showCluster(args[1:])
+	case "container":
+		handlers = map[string]func([]string) error{
+			"list":    c.listContainers,
+			"offline": c.offlineContainer,
+			"up":      c.upContainer,
+			"weight":  c.setContainerWeight,
+		}
+	case "shard":
+		handlers = map[string]func([]string) error{
+			"list":            c.listShards,
+			"show":            c.showShard,
+			"split":           c.splitShard,
+			"transfer-leader": c.transferLeader,
+			"move-peer":       c.movePeer,
+		}
+	case "operator":
+		handlers = map[string]func([]string) error{
+			"show":   c.showOperator,
+			"cancel": c.cancelOperator,
+		}
+	case "scheduler":
+		handlers = map[string]func([]string) error{
+			"list":   c.listSchedulers,
+			"add":    c.addScheduler,
+			"remove": c.removeScheduler,
+			"pause":  c.pauseScheduler,
+			"resume": c.resumeScheduler,
+		}
+	case "rule":
+		handlers = map[string]func([]string) error{
+			"put": c.putRule,
+			"get": c.getRules,
+		}
+	case "job":
+		handlers = map[string]func([]string) error{
+			"list":    c.listJobs,
+			"show":    c.showJob,
+			"create":  c.createJob,
+			"remove":  c.removeJob,
+			"exec":    c.executeJob,
+			"backup":  c.backup,
+			"restore": c.restore,
+		}
+	default:
+		return errUsage
+	}
+
+	if len(args) < 2 {
+		return errUsage
+	}
+	fn, ok := handlers[args[1]]
+	if !ok {
+		return errUsage
+	}
+	return fn(args[2:])
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatAndParseKey(t *testing.T) {
+	assert.Equal(t, "key1", formatKey([]byte("key1")))
+	assert.Equal(t, "0x00ff", formatKey([]byte{0, 0xff}))
+
+	v, err := parseBytes("0x00ff")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0xff}, v)
+	v, err = parseBytes("key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("key1"), v)
+}
+
+func TestParseJob(t *testing.T) {
+	job, err := parseJob([]string{"CreateResourcePool", "0x01"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), int32(job.Type))
+	assert.Equal(t, []byte{1}, job.Content)
+
+	job, err = parseJob([]string{"100"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(100), int32(job.Type))
+
+	_, err = parseJob([]string{"invalid"})
+	assert.Error(t, err)
+}
+
+func TestPrinter(t *testing.T) {
+	_, err := newPrinter(nil, "xml")
+	assert.Error(t, err)
+
+	buf := bytes.NewBuffer(nil)
+	p, err := newPrinter(buf, formatTable)
+	assert.NoError(t, err)
+	assert.NoError(t, p.print(nil, []string{"ID", "NAME"}, [][]string{{"1", "a"}, {"100", "b"}}))
+	assert.Equal(t, "ID   NAME\n1    a\n100  b\n", buf.String())
+}
+
+func TestCtl(t *testing.T) {
+	c := raftstore.NewSingleTestClusterStore(t)
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	buf := bytes.NewBuffer(nil)
+	p, err := newPrinter(buf, formatJSON)
+	assert.NoError(t, err)
+	addr := c.GetProphet().GetConfig().RPCAddr
+	ctl, err := newCtl([]string{"127.0.0.1:1", addr}, time.Second*10, tlsutil.Config{}, p)
+	assert.NoError(t, err)
+	defer ctl.close()
+	assert.Equal(t, addr, ctl.leader)
+
+	assert.Equal(t, errUsage, ctl.run([]string{"shard"}))
+	assert.Equal(t, errUsage, ctl.run([]string{"shard", "unknown"}))
+
+	cluster := clusterView{}
+	mustRun(t, ctl, buf, &cluster, "cluster")
+	assert.Equal(t, addr, cluster.Leader)
+	assert.Equal(t, 1, cluster.Containers["UP"])
+	assert.Equal(t, 1, cluster.Shards)
+
+	var containers []containerView
+	mustRun(t, ctl, buf, &containers, "container", "list")
+	assert.Equal(t, 1, len(containers))
+
+	var shards []shardView
+	mustRun(t, ctl, buf, &shards, "shard", "list")
+	assert.Equal(t, 1, len(shards))
+	assert.Equal(t, containers[0].ID, shards[0].Leader)
+
+	assert.NoError(t, ctl.run([]string{"shard", "split", fmt.Sprintf("%d", shards[0].ID), "key5"}))
+	c.WaitShardByCount(t, 2, time.Second*10)
+
+	mustRun(t, ctl, buf, &shards, "shard", "list", "-group", "0")
+	assert.Equal(t, 2, len(shards))
+
+	var schedulers []string
+	mustRun(t, ctl, buf, &schedulers, "scheduler", "list")
+	assert.NotEmpty(t, schedulers)
+	assert.NoError(t, ctl.run([]string{"scheduler", "pause", "all", "10"}))
+	assert.NoError(t, ctl.run([]string{"scheduler", "resume", "all"}))
+
+	var jobs []jobView
+	mustRun(t, ctl, buf, &jobs, "job", "list")
+	assert.Empty(t, jobs)
+	assert.Error(t, ctl.run([]string{"job", "show", "100"}))
+	assert.Equal(t, errUsage, ctl.run([]string{"job", "backup"}))
+	assert.Equal(t, errUsage, ctl.run([]string{"job", "restore", "a", "b"}))
+}
+
+func mustRun(t *testing.T, ctl *ctl, buf *bytes.Buffer, value interface{}, args ...string) {
+	buf.Reset()
+	assert.NoError(t, ctl.run(args))
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), value))
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// cubectl is the command line tool to operate the matrixcube cluster. It talks to the
+// prophet leader over the prophet rpc.
+//
+// Usage:
+//
+//	cubectl [-prophet addrs] [-o table|json] [-timeout 10s] <command> [args...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/log"
+	putil "github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
+)
+
+var (
+	prophetAddrs = flag.String("prophet", "127.0.0.1:10001", "prophet rpc addresses, separated by commas")
+	output       = flag.String("o", "table", "output format, table or json")
+	timeout      = flag.Duration("timeout", time.Second*10, "rpc timeout")
+	caFile       = flag.String("ca", "", "the CA file to verify the prophet certificate")
+	certFile     = flag.String("cert", "", "the certificate file, TLS is used if set with the key")
+	keyFile      = flag.String("key", "", "the private key file of the certificate")
+)
+
+const usage = `Usage: cubectl [flags] <command> [args...]
+
+Commands:
+  cluster                                       show the prophet leader and the cluster summary
+  container list                                list all the containers
+  container offline <id> [-destroyed]           take the container offline
+  container up <id>                             bring the offline container back up
+  container weight <id> <leader> <resource>     set the leader and resource weight of the container
+  shard list [-group <group>]                   list all the shards with leaders and epochs
+  shard show <id>                               show the shard
+  shard split <id> <key>...                     split the shard by the keys
+  shard split <id> -policy scan|approximate     split the shard in the middle found by the policy
+  shard transfer-leader <id> <container>        transfer the leader of the shard to the container
+  shard move-peer <id> <from> <to>              move the peer of the shard between the containers
+  operator show <shard>                         show the latest operator of the shard
+  operator cancel <shard>                       cancel the running operator of the shard
+  scheduler list                                list the running schedulers
+  scheduler add <type> [args...]                add a scheduler
+  scheduler remove <name>                       remove the scheduler
+  scheduler pause <name> <seconds>              pause the scheduler, "all" means all schedulers
+  scheduler resume <name>                       resume the scheduler
+  rule put <file>                               put the placement rule in json, "-" means stdin
+  rule get <shard>                              show the placement rules applied to the shard
+  job list                                      list the active jobs and the history jobs
+  job show <id>                                 show the job
+  job create <type> [content]                   create a job
+  job remove <type> [id]                        remove the job, the id is required if many jobs of the type
+  job exec <type> [data]                        execute on the job and show the result
+  job backup <path>                             backup the data of all the shards to the path
+  job restore <path>                            restore the data of all the shards from the backup path
+
+The keys and the job data are treated as strings, or hex if they start with "0x".
+
+Flags:
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	// the log-level flag is registered by the log package, only the errors are printed by default
+	flag.Set("log-level", "error")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	log.SetLevelByString(flag.Lookup("log-level").Value.String())
+	putil.SetLogger(log.NewLoggerWithPrefix("[cubectl]"))
+
+	p, err := newPrinter(os.Stdout, *output)
+	if err != nil {
+		exit(err)
+	}
+
+	c, err := newCtl(strings.Split(*prophetAddrs, ","), *timeout,
+		tlsutil.Config{CAFile: *caFile, CertFile: *certFile, KeyFile: *keyFile}, p)
+	if err != nil {
+		exit(err)
+	}
+	defer c.close()
+
+	if err := c.run(flag.Args()); err != nil {
+		if err == errUsage {
+			flag.Usage()
+			os.Exit(2)
+		}
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "cubectl: %+v\n", err)
+	os.Exit(1)
+}
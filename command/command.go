@@ -16,6 +16,7 @@ package command
 import (
 	"github.com/fagongzi/goetty/buf"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/errorpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/util"
@@ -50,3 +51,24 @@ type WriteCommandFunc func(bhmetapb.Shard, *raftcmdpb.Request, Context) (writeBy
 
 // LocalCommandFunc directly exec on local func
 type LocalCommandFunc func(bhmetapb.Shard, *raftcmdpb.Request) (*raftcmdpb.Response, error)
+
+// Stage the stage of the request on the command path
+type Stage int
+
+const (
+	// ProposalStage the request is received by the shard and before it is proposed,
+	// the storage of the Context is read only, WriteBatch and LogIndex are not available
+	// in this stage
+	ProposalStage Stage = iota
+	// ApplyStage the write request is committed and before it is applied. The interceptor
+	// is called on all the replicas, so it must be deterministic in this stage.
+	ApplyStage
+	// ReadStage the read request is ready and before it is executed
+	ReadStage
+)
+
+// Interceptor intercepts the requests on the command path. The request can be modified by
+// the interceptor, returns a not nil error to reject the request, and the error will be
+// returned to the client. The Key of the request is always the user key in all the stages,
+// not the encoded key used by the storage.
+type Interceptor func(Stage, bhmetapb.Shard, *raftcmdpb.Request, Context) *errorpb.Error
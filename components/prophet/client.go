@@ -76,12 +76,54 @@ type Client interface {
 	// GetAppliedRules returns applied rules of the resource
 	GetAppliedRules(id uint64) ([]rpcpb.PlacementRule, error)
 
-	// CreateJob create job
-	CreateJob(metapb.Job) error
-	// RemoveJob remove job
+	// CreateJob create job and returns the created job with the allocated id. The active job is returned
+	// if the job processor is exclusive and an active job of the type exists.
+	CreateJob(metapb.Job) (metapb.Job, error)
+	// RemoveJob remove job by the id, the only active job of the type is removed if the id is not specified
 	RemoveJob(metapb.Job) error
-	// ExecuteJob execute on job and returns the execute result
+	// ExecuteJob execute on job and returns the execute result, the job is found as `RemoveJob`
 	ExecuteJob(metapb.Job, []byte) ([]byte, error)
+	// ListJobs returns the active jobs and the completed and failed jobs in the history
+	ListJobs() ([]metapb.Job, error)
+	// GetJob returns the job by the id
+	GetJob(id uint64) (metapb.Job, error)
+
+	// GetTimestamp allocates count monotonic timestamps from the prophet leader and returns
+	// the first one, the others are the following count-1 timestamps.
+	GetTimestamp(count uint32) (uint64, error)
+
+	// ListContainers returns all the containers, the container meta is encoded in ContainerInfo.Data
+	ListContainers() ([]rpcpb.ContainerInfo, error)
+	// ListResources returns all the resources, the resource meta is encoded in ResourceInfo.Data
+	ListResources() ([]rpcpb.ResourceInfo, error)
+	// RemoveContainer take the container offline, all the resource peers on the container will
+	// be moved to other containers, and the container become tombstone after that.
+	RemoveContainer(containerID uint64, physicallyDestroyed bool) error
+	// UpContainer bring the offline container back up
+	UpContainer(containerID uint64) error
+	// SetContainerWeight set the leader and resource weight of the container
+	SetContainerWeight(containerID uint64, leaderWeight, resourceWeight float64) error
+	// AddScheduler add a scheduler with the registered scheduler type and args
+	AddScheduler(typ string, args ...string) error
+	// RemoveScheduler remove the scheduler by name
+	RemoveScheduler(name string) error
+	// PauseScheduler pause the scheduler for seconds, 0 means resume. The name "all" means all schedulers.
+	PauseScheduler(name string, seconds int64) error
+	// ListSchedulers returns the names of the running schedulers
+	ListSchedulers() ([]string, error)
+	// TransferLeader add an operator to transfer the leader of the resource to the target container
+	TransferLeader(resourceID uint64, toContainerID uint64) error
+	// MovePeer add an operator to move the peer of the resource from one container to another
+	MovePeer(resourceID uint64, fromContainerID, toContainerID uint64) error
+	// SplitResource add an operator to split the resource by the split keys
+	SplitResource(resourceID uint64, splitKeys ...[]byte) error
+	// SplitResourceByPolicy add an operator to split the resource, the split key is found by the
+	// container with the SCAN or APPROXIMATE policy
+	SplitResourceByPolicy(resourceID uint64, policy metapb.CheckPolicy) error
+	// RemoveOperator cancel the running operator of the resource
+	RemoveOperator(resourceID uint64) error
+	// GetOperatorStatus returns the status of the latest operator of the resource
+	GetOperatorStatus(resourceID uint64) (rpcpb.GetOperatorStatusRsp, error)
 }
 
 type asyncClient struct {
@@ -109,7 +151,6 @@ func NewClient(adapter metadata.Adapter, opts ...Option) Client {
 	c := &asyncClient{
 		opts:                  &options{},
 		adapter:               adapter,
-		leaderConn:            createConn(),
 		resetReadC:            make(chan struct{}),
 		resetLeaderConnC:      make(chan struct{}),
 		writeC:                make(chan *ctx, 128),
@@ -121,6 +162,7 @@ func NewClient(adapter metadata.Adapter, opts ...Option) Client {
 		opt(c.opts)
 	}
 	c.opts.adjust()
+	c.leaderConn = createConn(c.opts.security)
 
 	c.ctx, c.cancel = context.WithCancel(context.Background())
 	c.start()
@@ -461,21 +503,21 @@ func (c *asyncClient) GetAppliedRules(id uint64) ([]rpcpb.PlacementRule, error)
 	return rsp.GetAppliedRules.Rules, nil
 }
 
-func (c *asyncClient) CreateJob(job metapb.Job) error {
+func (c *asyncClient) CreateJob(job metapb.Job) (metapb.Job, error) {
 	if !c.running() {
-		return ErrClosed
+		return metapb.Job{}, ErrClosed
 	}
 
 	req := &rpcpb.Request{}
 	req.Type = rpcpb.TypeCreateJobReq
 	req.CreateJob.Job = job
 
-	_, err := c.syncDo(req)
+	rsp, err := c.syncDo(req)
 	if err != nil {
-		return err
+		return metapb.Job{}, err
 	}
 
-	return nil
+	return rsp.CreateJob.Job, nil
 }
 
 func (c *asyncClient) RemoveJob(job metapb.Job) error {
@@ -513,6 +555,264 @@ func (c *asyncClient) ExecuteJob(job metapb.Job, data []byte) ([]byte, error) {
 	return rsp.ExecuteJob.Data, nil
 }
 
+func (c *asyncClient) ListJobs() ([]metapb.Job, error) {
+	if !c.running() {
+		return nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeListJobsReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.ListJobs.Jobs, nil
+}
+
+func (c *asyncClient) GetJob(id uint64) (metapb.Job, error) {
+	if !c.running() {
+		return metapb.Job{}, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetJobReq
+	req.GetJob.ID = id
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return metapb.Job{}, err
+	}
+
+	return rsp.GetJob.Job, nil
+}
+
+func (c *asyncClient) GetTimestamp(count uint32) (uint64, error) {
+	if !c.running() {
+		return 0, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetTimestampReq
+	req.GetTimestamp.Count = count
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return 0, err
+	}
+
+	return rsp.GetTimestamp.Timestamp, nil
+}
+
+func (c *asyncClient) ListContainers() ([]rpcpb.ContainerInfo, error) {
+	if !c.running() {
+		return nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeListContainersReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.ListContainers.Containers, nil
+}
+
+func (c *asyncClient) ListResources() ([]rpcpb.ResourceInfo, error) {
+	if !c.running() {
+		return nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeListResourcesReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.ListResources.Resources, nil
+}
+
+func (c *asyncClient) RemoveContainer(containerID uint64, physicallyDestroyed bool) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeRemoveContainerReq
+	req.RemoveContainer.ContainerID = containerID
+	req.RemoveContainer.PhysicallyDestroyed = physicallyDestroyed
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) UpContainer(containerID uint64) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeUpContainerReq
+	req.UpContainer.ContainerID = containerID
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) SetContainerWeight(containerID uint64, leaderWeight, resourceWeight float64) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeSetContainerWeightReq
+	req.SetContainerWeight.ContainerID = containerID
+	req.SetContainerWeight.LeaderWeight = leaderWeight
+	req.SetContainerWeight.ResourceWeight = resourceWeight
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) AddScheduler(typ string, args ...string) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeAddSchedulerReq
+	req.AddScheduler.Type = typ
+	req.AddScheduler.Args = args
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) RemoveScheduler(name string) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeRemoveSchedulerReq
+	req.RemoveScheduler.Name = name
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) PauseScheduler(name string, seconds int64) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypePauseSchedulerReq
+	req.PauseScheduler.Name = name
+	req.PauseScheduler.Seconds = seconds
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) ListSchedulers() ([]string, error) {
+	if !c.running() {
+		return nil, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeListSchedulersReq
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.ListSchedulers.Names, nil
+}
+
+func (c *asyncClient) TransferLeader(resourceID uint64, toContainerID uint64) error {
+	return c.addOperator(rpcpb.AddOperatorReq{
+		Type:          rpcpb.TransferLeaderOperator,
+		ResourceID:    resourceID,
+		ToContainerID: toContainerID,
+	})
+}
+
+func (c *asyncClient) MovePeer(resourceID uint64, fromContainerID, toContainerID uint64) error {
+	return c.addOperator(rpcpb.AddOperatorReq{
+		Type:            rpcpb.MovePeerOperator,
+		ResourceID:      resourceID,
+		FromContainerID: fromContainerID,
+		ToContainerID:   toContainerID,
+	})
+}
+
+func (c *asyncClient) SplitResource(resourceID uint64, splitKeys ...[]byte) error {
+	return c.addOperator(rpcpb.AddOperatorReq{
+		Type:       rpcpb.SplitResourceOperator,
+		ResourceID: resourceID,
+		SplitKeys:  splitKeys,
+		Policy:     metapb.CheckPolicy_USEKEY,
+	})
+}
+
+func (c *asyncClient) SplitResourceByPolicy(resourceID uint64, policy metapb.CheckPolicy) error {
+	return c.addOperator(rpcpb.AddOperatorReq{
+		Type:       rpcpb.SplitResourceOperator,
+		ResourceID: resourceID,
+		Policy:     policy,
+	})
+}
+
+func (c *asyncClient) addOperator(op rpcpb.AddOperatorReq) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeAddOperatorReq
+	req.AddOperator = op
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) RemoveOperator(resourceID uint64) error {
+	if !c.running() {
+		return ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeRemoveOperatorReq
+	req.RemoveOperator.ResourceID = resourceID
+
+	_, err := c.syncDo(req)
+	return err
+}
+
+func (c *asyncClient) GetOperatorStatus(resourceID uint64) (rpcpb.GetOperatorStatusRsp, error) {
+	if !c.running() {
+		return rpcpb.GetOperatorStatusRsp{}, ErrClosed
+	}
+
+	req := &rpcpb.Request{}
+	req.Type = rpcpb.TypeGetOperatorStatusReq
+	req.GetOperatorStatus.ResourceID = resourceID
+
+	rsp, err := c.syncDo(req)
+	if err != nil {
+		return rpcpb.GetOperatorStatusRsp{}, err
+	}
+
+	return rsp.GetOperatorStatus, nil
+}
+
 func (c *asyncClient) doClose() {
 	c.cancel()
 	close(c.resourceHeartbeatRspC)
@@ -0,0 +1,163 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prophet
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedulers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminContainers(t *testing.T) {
+	p := newTestSingleProphet(t, nil)
+	defer p.Stop()
+
+	c := p.GetClient()
+	for i := uint64(1); i <= 3; i++ {
+		assert.NoError(t, c.PutContainer(newTestContainerMeta(i)))
+		_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(i, 1))
+		assert.NoError(t, err)
+	}
+
+	containers, err := c.ListContainers()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(containers))
+
+	assert.NoError(t, c.SetContainerWeight(1, 2, 3))
+	assert.Error(t, c.SetContainerWeight(1, -1, 3))
+	assert.Error(t, c.SetContainerWeight(100, 1, 1))
+	containers, err = c.ListContainers()
+	assert.NoError(t, err)
+	for _, info := range containers {
+		meta := &metadata.TestContainer{}
+		assert.NoError(t, meta.Unmarshal(info.Data))
+		if meta.ID() == 1 {
+			assert.Equal(t, float64(2), info.LeaderWeight)
+			assert.Equal(t, float64(3), info.ResourceWeight)
+		}
+	}
+
+	assert.NoError(t, c.RemoveContainer(1, false))
+	assert.Equal(t, metapb.ContainerState_Offline, mustGetContainerState(t, c, 1))
+	assert.NoError(t, c.UpContainer(1))
+	assert.Equal(t, metapb.ContainerState_UP, mustGetContainerState(t, c, 1))
+}
+
+func TestAdminSchedulers(t *testing.T) {
+	p := newTestSingleProphet(t, nil)
+	defer p.Stop()
+
+	c := p.GetClient()
+	assert.NoError(t, c.PutContainer(newTestContainerMeta(1)))
+	_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(1, 1))
+	assert.NoError(t, err)
+
+	names, err := c.ListSchedulers()
+	assert.NoError(t, err)
+	n := len(names)
+
+	assert.Error(t, c.AddScheduler("not-registered"))
+	assert.NoError(t, c.AddScheduler(schedulers.GrantLeaderType, "1"))
+	names, err = c.ListSchedulers()
+	assert.NoError(t, err)
+	assert.Equal(t, n+1, len(names))
+
+	assert.NoError(t, c.PauseScheduler("all", 10))
+	assert.NoError(t, c.PauseScheduler("all", 0))
+	assert.Error(t, c.PauseScheduler("not-exists", 10))
+
+	assert.NoError(t, c.RemoveScheduler(schedulers.GrantLeaderName))
+	assert.Error(t, c.RemoveScheduler(schedulers.GrantLeaderName))
+	names, err = c.ListSchedulers()
+	assert.NoError(t, err)
+	assert.Equal(t, n, len(names))
+}
+
+func TestAdminOperators(t *testing.T) {
+	p := newTestSingleProphet(t, nil)
+	defer p.Stop()
+
+	c := p.GetClient()
+	var peers []metapb.Peer
+	for i := uint64(1); i <= 4; i++ {
+		assert.NoError(t, c.PutContainer(newTestContainerMeta(i)))
+		_, err := c.ContainerHeartbeat(newTestContainerHeartbeat(i, 1))
+		assert.NoError(t, err)
+		if i <= 3 {
+			peers = append(peers, metapb.Peer{ID: 100 + i, ContainerID: i})
+		}
+	}
+
+	// pause all the schedulers to avoid the operators created by them
+	assert.NoError(t, c.PauseScheduler("all", 60))
+	assert.NoError(t, c.ResourceHeartbeat(newTestResourceMeta(1, peers...), rpcpb.ResourceHeartbeatReq{
+		ContainerID: 1,
+		Leader:      &peers[0]}))
+	resources, err := c.ListResources()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(resources))
+	assert.Equal(t, uint64(1), resources[0].Leader)
+
+	rsp, err := c.GetOperatorStatus(1)
+	assert.NoError(t, err)
+	assert.False(t, rsp.Found)
+
+	assert.Error(t, c.TransferLeader(100, 2))
+	assert.Error(t, c.TransferLeader(1, 100))
+	assert.NoError(t, c.TransferLeader(1, 2))
+	rsp, err = c.GetOperatorStatus(1)
+	assert.NoError(t, err)
+	assert.True(t, rsp.Found)
+	assert.Equal(t, metapb.OperatorStatus_RUNNING, rsp.Status)
+
+	// the resource has a running operator
+	assert.Error(t, c.MovePeer(1, 3, 4))
+	assert.NoError(t, c.RemoveOperator(1))
+	assert.Error(t, c.RemoveOperator(1))
+	rsp, err = c.GetOperatorStatus(1)
+	assert.NoError(t, err)
+	assert.True(t, rsp.Found)
+	assert.Equal(t, metapb.OperatorStatus_CANCEL, rsp.Status)
+
+	assert.Error(t, c.MovePeer(1, 4, 3))
+	assert.NoError(t, c.MovePeer(1, 3, 4))
+	rsp, err = c.GetOperatorStatus(1)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.OperatorStatus_RUNNING, rsp.Status)
+	assert.NoError(t, c.RemoveOperator(1))
+
+	assert.Error(t, c.SplitResource(1))
+	assert.NoError(t, c.SplitResource(1, []byte("split-key")))
+	rsp, err = c.GetOperatorStatus(1)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.OperatorStatus_RUNNING, rsp.Status)
+	assert.NoError(t, c.RemoveOperator(1))
+
+	assert.Error(t, c.SplitResourceByPolicy(1, metapb.CheckPolicy_USEKEY))
+	assert.NoError(t, c.SplitResourceByPolicy(1, metapb.CheckPolicy_APPROXIMATE))
+	rsp, err = c.GetOperatorStatus(1)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.OperatorStatus_RUNNING, rsp.Status)
+	assert.Contains(t, rsp.Desc, "APPROXIMATE")
+}
+
+func mustGetContainerState(t *testing.T, c Client, id uint64) metapb.ContainerState {
+	meta, err := c.GetContainer(id)
+	assert.NoError(t, err)
+	return meta.State()
+}
@@ -58,6 +58,49 @@ func TestClientLeaderChange(t *testing.T) {
 	assert.True(t, id > 0)
 }
 
+func TestClientGetTimestamp(t *testing.T) {
+	p := newTestSingleProphet(t, nil)
+	defer p.Stop()
+
+	c := p.GetClient()
+	last := uint64(0)
+	for i := 0; i < 10; i++ {
+		ts, err := c.GetTimestamp(10)
+		assert.NoError(t, err)
+		assert.True(t, ts > last)
+		last = ts + 9
+	}
+
+	_, err := c.GetTimestamp(0)
+	assert.Error(t, err)
+}
+
+func TestClientGetTimestampWithLeaderChange(t *testing.T) {
+	cluster := newTestClusterProphet(t, 3, nil)
+	defer func() {
+		for _, p := range cluster {
+			p.Stop()
+		}
+	}()
+
+	ts1, err := cluster[2].GetClient().GetTimestamp(1)
+	assert.NoError(t, err)
+
+	// stop current leader
+	cluster[0].Stop()
+
+	var ts2 uint64
+	for i := 0; i < 10; i++ {
+		ts2, err = cluster[2].GetClient().GetTimestamp(1)
+		if err == nil {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	assert.NoError(t, err)
+	assert.True(t, ts2 > ts1)
+}
+
 func TestClientGetContainer(t *testing.T) {
 	p := newTestSingleProphet(t, nil)
 	defer p.Stop()
@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/checker"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/hbstream"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/placement"
 	"github.com/matrixorigin/matrixcube/components/prophet/statistics"
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
@@ -443,6 +444,44 @@ func (c *RaftCluster) HandleContainerHeartbeat(stats *metapb.ContainerStats) err
 	return nil
 }
 
+// HandleInconsistentResources handles the resources which the replica on the container is
+// inconsistent with the leader. If RebuildInconsistentReplica is enabled, the replica will be
+// removed, and the replica checker will create a new one.
+func (c *RaftCluster) HandleInconsistentResources(containerID uint64, resources []uint64) {
+	for _, id := range resources {
+		res := c.GetResource(id)
+		if res == nil {
+			continue
+		}
+
+		util.GetLogger().Errorf("resource %d replica on container %d is inconsistent with the leader",
+			id,
+			containerID)
+
+		if !c.GetReplicationConfig().RebuildInconsistentReplica {
+			continue
+		}
+
+		// the leader is the baseline of the consistency check
+		if res.GetLeader().GetContainerID() == containerID {
+			continue
+		}
+		if _, ok := res.GetContainerPeer(containerID); !ok {
+			continue
+		}
+
+		op, err := operator.CreateRemovePeerOperator("rebuild-inconsistent-replica", c, operator.OpReplica, res, containerID)
+		if err != nil {
+			util.GetLogger().Errorf("create operator to rebuild resource %d replica on container %d failed with %+v",
+				id,
+				containerID,
+				err)
+			continue
+		}
+		c.GetOperatorController().AddWaitingOperator(op)
+	}
+}
+
 // processResourceHeartbeat updates the resource information.
 func (c *RaftCluster) processResourceHeartbeat(res *core.CachedResource) error {
 	c.RLock()
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 	"go.etcd.io/etcd/embed"
 )
@@ -36,6 +37,9 @@ type Config struct {
 	DataDir    string            `toml:"data-dir"`
 	RPCAddr    string            `toml:"rpc-addr"`
 	RPCTimeout typeutil.Duration `toml:"rpc-timeout"`
+	// Security the TLS config of the prophet rpc and the embed etcd, the etcd urls must
+	// be https if enabled
+	Security tlsutil.Config `toml:"security" json:"security"`
 
 	// etcd configuration
 	StorageNode  bool            `toml:"storage-node"`
@@ -51,6 +55,7 @@ type Config struct {
 	Schedule      ScheduleConfig      `toml:"schedule" json:"schedule"`
 	Replication   ReplicationConfig   `toml:"replication" json:"replication"`
 	LabelProperty LabelPropertyConfig `toml:"label-property" json:"label-property"`
+	Job           JobConfig           `toml:"job" json:"job"`
 
 	Handler                         metadata.RoleChangeHandler                                                      `toml:"-" json:"-"`
 	Adapter                         metadata.Adapter                                                                `toml:"-" json:"-"`
@@ -108,6 +113,10 @@ func (c *Config) GenEmbedEtcdConfig() (*embed.Config, error) {
 	cfg.AutoCompactionMode = c.EmbedEtcd.AutoCompactionMode
 	cfg.AutoCompactionRetention = c.EmbedEtcd.AutoCompactionRetention
 	cfg.QuotaBackendBytes = int64(c.EmbedEtcd.QuotaBackendBytes)
+	if c.Security.Enabled() {
+		cfg.ClientTLSInfo = c.Security.TLSInfo()
+		cfg.PeerTLSInfo = c.Security.TLSInfo()
+	}
 
 	var err error
 	cfg.LPUrls, err = util.ParseUrls(c.EmbedEtcd.PeerUrls)
@@ -428,6 +437,10 @@ type ReplicationConfig struct {
 
 	// Groups resources groups
 	Groups []uint64 `toml:"groups" json:"groups"`
+
+	// RebuildInconsistentReplica removes the replica which is reported inconsistent with the
+	// leader, and the replica checker will create a new replica from the leader's snapshot.
+	RebuildInconsistentReplica bool `toml:"rebuild-inconsistent-replica" json:"rebuild-inconsistent-replica,string"`
 }
 
 // Clone makes a deep copy of the config.
@@ -476,6 +489,37 @@ func (c *ReplicationConfig) adjust(meta *configMetaData) error {
 	return c.Validate()
 }
 
+// JobConfig is the config section of the jobs.
+type JobConfig struct {
+	// MaxRetries the max retries of the failed job, the job is marked as failed after that.
+	MaxRetries uint64 `toml:"max-retries" json:"max-retries"`
+	// RetryBackoff the backoff before the first retry, doubled for each next retry.
+	RetryBackoff typeutil.Duration `toml:"retry-backoff" json:"retry-backoff"`
+	// MaxRetryBackoff the max backoff before the retry.
+	MaxRetryBackoff typeutil.Duration `toml:"max-retry-backoff" json:"max-retry-backoff"`
+	// HistoryRetention how long the completed and failed jobs are kept in the history.
+	HistoryRetention typeutil.Duration `toml:"history-retention" json:"history-retention"`
+}
+
+func (c *JobConfig) adjust() {
+	adjustUint64(&c.MaxRetries, defaultJobMaxRetries)
+	adjustDuration(&c.RetryBackoff, defaultJobRetryBackoff)
+	adjustDuration(&c.MaxRetryBackoff, defaultJobMaxRetryBackoff)
+	adjustDuration(&c.HistoryRetention, defaultJobHistoryRetention)
+}
+
+// GetRetryBackoff returns the backoff before the retry of the job
+func (c *JobConfig) GetRetryBackoff(retries uint64) time.Duration {
+	backoff := c.RetryBackoff.Duration
+	for i := uint64(1); i < retries && backoff < c.MaxRetryBackoff.Duration; i++ {
+		backoff *= 2
+	}
+	if backoff > c.MaxRetryBackoff.Duration {
+		backoff = c.MaxRetryBackoff.Duration
+	}
+	return backoff
+}
+
 // LabelPropertyConfig is the config section to set properties to container labels.
 type LabelPropertyConfig map[string][]ContainerLabel
 
@@ -29,6 +29,34 @@ type JobProcessor interface {
 	Execute([]byte, storage.JobStorage, ResourcesAware) ([]byte, error)
 }
 
+// JobReporter reports the progress and the result of the job to prophet. It can't be called in
+// the JobProcessor's methods directly, because prophet holds the job lock while calling them.
+type JobReporter interface {
+	// Progress updates the progress of the job in percent
+	Progress(id uint64, progress uint64) error
+	// Complete marks the job completed
+	Complete(id uint64) error
+	// Fail marks the job failed, prophet stops the job and restarts it with backoff, the job is marked as
+	// failed after the max retries.
+	Fail(id uint64, err error) error
+}
+
+// ReportableJobProcessor the job processor which reports the progress and the result of the jobs. The reporter
+// is set before the jobs start.
+type ReportableJobProcessor interface {
+	JobProcessor
+	// SetJobReporter set the job reporter
+	SetJobReporter(JobReporter)
+}
+
+// ExclusiveJobProcessor the job processor which processes only one active job of the job type, creating a job
+// while an active job of the same type exists returns the active job.
+type ExclusiveJobProcessor interface {
+	JobProcessor
+	// Exclusive returns true if only one active job of the job type is allowed
+	Exclusive() bool
+}
+
 // RegisterJobProcessor register job processor
 func (c *Config) RegisterJobProcessor(jobType metapb.JobType, processor JobProcessor) {
 	c.jobMu.Lock()
@@ -52,6 +52,10 @@ const (
 	defaultContainerLimitMode            = "manual"
 	defaultEnableJointConsensus          = false
 	defaultEnableCrossTableMerge         = true
+	defaultJobMaxRetries                 = 3
+	defaultJobRetryBackoff               = time.Second
+	defaultJobMaxRetryBackoff            = time.Minute
+	defaultJobHistoryRetention           = 24 * time.Hour
 )
 
 var (
@@ -154,6 +158,7 @@ func (c *Config) Adjust(meta *toml.MetaData, reloading bool) error {
 	if err := c.Replication.adjust(configMetaData.Child("replication")); err != nil {
 		return err
 	}
+	c.Job.adjust()
 
 	return nil
 }
@@ -109,9 +109,16 @@ func PrepareJoinCluster(cfg *config.Config) {
 	}
 
 	// Below are cases without data directory.
+	tlsCfg, err := cfg.Security.ClientConfig()
+	if err != nil {
+		util.GetLogger().Fatalf("create etcd client tls config failed with %+v",
+			err)
+	}
+
 	client, err := clientv3.New(clientv3.Config{
 		Endpoints:   strings.Split(cfg.EmbedEtcd.Join, ","),
 		DialTimeout: option.DefaultDialTimeout,
+		TLS:         tlsCfg,
 	})
 	if err != nil {
 		util.GetLogger().Fatalf("create etcd client failed with %+v",
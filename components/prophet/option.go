@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/codec"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 )
 
 // Option client option
@@ -29,6 +30,7 @@ type Option func(*options)
 type options struct {
 	leaderGetter func() *metapb.Member
 	rpcTimeout   time.Duration
+	security     tlsutil.Config
 }
 
 func (opts *options) adjust() {
@@ -51,10 +53,16 @@ func WithRPCTimeout(value time.Duration) Option {
 	}
 }
 
-func createConn() goetty.IOSession {
-	encoder, decoder := codec.NewClientCodec(10 * buf.MB)
-	return goetty.NewIOSession(goetty.WithCodec(encoder, decoder),
-		goetty.WithLogger(util.GetLogger()),
-		goetty.WithEnableAsyncWrite(16))
+// WithSecurity set the TLS config to connect to the prophet leader
+func WithSecurity(value tlsutil.Config) Option {
+	return func(opts *options) {
+		opts.security = value
+	}
+}
 
+func createConn(security tlsutil.Config) goetty.IOSession {
+	encoder, decoder := codec.NewClientCodec(10 * buf.MB)
+	return tlsutil.NewIOSession(security, encoder, decoder,
+		tlsutil.WithLogger(util.GetLogger()),
+		tlsutil.WithEnableAsyncWrite(16))
 }
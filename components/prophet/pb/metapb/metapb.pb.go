@@ -278,6 +278,10 @@ const (
 	JobType_RemoveResource JobType = 0
 	// CreateResourcePool create resource pool
 	JobType_CreateResourcePool JobType = 1
+	// Backup backup the data of all the resources to a directory
+	JobType_Backup JobType = 2
+	// Restore restore the data of the resources from a backup directory
+	JobType_Restore JobType = 3
 	// CustomStartAt custom job
 	JobType_CustomStartAt JobType = 100
 )
@@ -285,12 +289,16 @@ const (
 var JobType_name = map[int32]string{
 	0:   "RemoveResource",
 	1:   "CreateResourcePool",
+	2:   "Backup",
+	3:   "Restore",
 	100: "CustomStartAt",
 }
 
 var JobType_value = map[string]int32{
 	"RemoveResource":     0,
 	"CreateResourcePool": 1,
+	"Backup":             2,
+	"Restore":            3,
 	"CustomStartAt":      100,
 }
 
@@ -310,20 +318,24 @@ const (
 	JobState_Created JobState = 0
 	// Working job is working
 	JobState_Working JobState = 1
-	// Completed job completed, need to gc
+	// Completed job completed, kept in the history until the retention expired
 	JobState_Completed JobState = 2
+	// Failed job failed after all the retries, kept in the history until the retention expired
+	JobState_Failed JobState = 3
 )
 
 var JobState_name = map[int32]string{
 	0: "Created",
 	1: "Working",
 	2: "Completed",
+	3: "Failed",
 }
 
 var JobState_value = map[string]int32{
 	"Created":   0,
 	"Working":   1,
 	"Completed": 2,
+	"Failed":    3,
 }
 
 func (x JobState) String() string {
@@ -719,10 +731,12 @@ type ContainerStats struct {
 	// Threads' write disk I/O rates in the container
 	WriteIORates []RecordPair `protobuf:"bytes,18,rep,name=writeIORates,proto3" json:"writeIORates"`
 	// Operations' latencies in the container
-	OpLatencies          []RecordPair `protobuf:"bytes,19,rep,name=opLatencies,proto3" json:"opLatencies"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	OpLatencies []RecordPair `protobuf:"bytes,19,rep,name=opLatencies,proto3" json:"opLatencies"`
+	// Resources which the replica on the container is inconsistent with the leader
+	InconsistentResources []uint64 `protobuf:"varint,20,rep,packed,name=inconsistentResources,proto3" json:"inconsistentResources,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *ContainerStats) Reset()         { *m = ContainerStats{} }
@@ -891,6 +905,13 @@ func (m *ContainerStats) GetOpLatencies() []RecordPair {
 	return nil
 }
 
+func (m *ContainerStats) GetInconsistentResources() []uint64 {
+	if m != nil {
+		return m.InconsistentResources
+	}
+	return nil
+}
+
 // RecordPair record pair
 type RecordPair struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -1127,9 +1148,20 @@ func (m *TimeInterval) GetEnd() uint64 {
 
 // Job job
 type Job struct {
-	Type                 JobType  `protobuf:"varint,1,opt,name=type,proto3,enum=metapb.JobType" json:"type,omitempty"`
-	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	State                JobState `protobuf:"varint,3,opt,name=state,proto3,enum=metapb.JobState" json:"state,omitempty"`
+	Type    JobType  `protobuf:"varint,1,opt,name=type,proto3,enum=metapb.JobType" json:"type,omitempty"`
+	Content []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	State   JobState `protobuf:"varint,3,opt,name=state,proto3,enum=metapb.JobState" json:"state,omitempty"`
+	// ID unique id of the job, allocated by prophet when the job created
+	ID uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Progress the progress of the job in percent, reported by the job processor
+	Progress uint64 `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Error the last error of the job
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Retries the number of the retries after failed
+	Retries uint64 `protobuf:"varint,7,opt,name=retries,proto3" json:"retries,omitempty"`
+	// CreatedAt and UpdatedAt are the unix seconds
+	CreatedAt            int64    `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1189,6 +1221,48 @@ func (m *Job) GetState() JobState {
 	return JobState_Created
 }
 
+func (m *Job) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Job) GetProgress() uint64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *Job) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Job) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Job) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Job) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
 // RemoveResourceJob remove resources job
 type RemoveResourceJob struct {
 	ID                   uint64   `protobuf:"varint,1,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
@@ -1293,6 +1367,102 @@ func (m *ResourcePoolJob) GetPools() []ResourcePool {
 	return nil
 }
 
+// BackupJob backup job
+type BackupJob struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupJob) Reset()         { *m = BackupJob{} }
+func (m *BackupJob) String() string { return proto.CompactTextString(m) }
+func (*BackupJob) ProtoMessage()    {}
+func (*BackupJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{13}
+}
+func (m *BackupJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupJob.Merge(m, src)
+}
+func (m *BackupJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupJob proto.InternalMessageInfo
+
+func (m *BackupJob) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// RestoreJob restore job
+type RestoreJob struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreJob) Reset()         { *m = RestoreJob{} }
+func (m *RestoreJob) String() string { return proto.CompactTextString(m) }
+func (*RestoreJob) ProtoMessage()    {}
+func (*RestoreJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77b4d575d5a68dda, []int{14}
+}
+func (m *RestoreJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreJob.Merge(m, src)
+}
+func (m *RestoreJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreJob proto.InternalMessageInfo
+
+func (m *RestoreJob) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 // ResourcePool resource pool
 type ResourcePool struct {
 	Group                uint64   `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
@@ -1307,7 +1477,7 @@ func (m *ResourcePool) Reset()         { *m = ResourcePool{} }
 func (m *ResourcePool) String() string { return proto.CompactTextString(m) }
 func (*ResourcePool) ProtoMessage()    {}
 func (*ResourcePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77b4d575d5a68dda, []int{13}
+	return fileDescriptor_77b4d575d5a68dda, []int{15}
 }
 func (m *ResourcePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1381,92 +1551,102 @@ func init() {
 	proto.RegisterType((*Job)(nil), "metapb.Job")
 	proto.RegisterType((*RemoveResourceJob)(nil), "metapb.RemoveResourceJob")
 	proto.RegisterType((*ResourcePoolJob)(nil), "metapb.ResourcePoolJob")
+	proto.RegisterType((*BackupJob)(nil), "metapb.BackupJob")
+	proto.RegisterType((*RestoreJob)(nil), "metapb.RestoreJob")
 	proto.RegisterType((*ResourcePool)(nil), "metapb.ResourcePool")
 }
 
 func init() { proto.RegisterFile("metapb.proto", fileDescriptor_77b4d575d5a68dda) }
 
 var fileDescriptor_77b4d575d5a68dda = []byte{
-	// 1261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x36, 0x29, 0x5a, 0x96, 0x46, 0xb2, 0x4c, 0xef, 0x09, 0x02, 0x21, 0x08, 0x1c, 0x83, 0x27,
-	0x08, 0x0c, 0xe1, 0x1c, 0x27, 0x70, 0x82, 0x5c, 0x1c, 0x9c, 0x5e, 0xc8, 0xb4, 0xd0, 0x28, 0x71,
-	0x64, 0x81, 0xb2, 0x92, 0xf6, 0xae, 0x2b, 0x72, 0x2c, 0x13, 0xa1, 0x76, 0x89, 0xe5, 0xd2, 0x89,
-	0xfa, 0x10, 0x7d, 0x9b, 0xbe, 0x43, 0x2e, 0xf3, 0x04, 0x41, 0xeb, 0x27, 0x29, 0x76, 0x49, 0x4a,
-	0x94, 0x94, 0xc6, 0xbd, 0xe3, 0xcc, 0x7c, 0xf3, 0xb3, 0xdf, 0xce, 0xcc, 0x12, 0x9a, 0x33, 0x94,
-	0x34, 0x9e, 0x1c, 0xc7, 0x82, 0x4b, 0x4e, 0xaa, 0x99, 0xf4, 0xe0, 0xbf, 0xd3, 0x50, 0x5e, 0xa7,
-	0x93, 0x63, 0x9f, 0xcf, 0x9e, 0x4e, 0xf9, 0x94, 0x3f, 0xd5, 0xe6, 0x49, 0x7a, 0xa5, 0x25, 0x2d,
-	0xe8, 0xaf, 0xcc, 0xcd, 0x71, 0x61, 0xd7, 0xc3, 0x84, 0xa7, 0xc2, 0xc7, 0x5e, 0xcc, 0xfd, 0x6b,
-	0xd2, 0x86, 0x1d, 0x9f, 0xb3, 0xab, 0x77, 0x28, 0xda, 0xc6, 0xa1, 0x71, 0x64, 0x79, 0x85, 0xa8,
-	0x2c, 0x37, 0x28, 0x92, 0x90, 0xb3, 0xb6, 0x99, 0x59, 0x72, 0xd1, 0xb9, 0x02, 0x6b, 0x88, 0x28,
-	0xc8, 0x7d, 0x30, 0xc3, 0x20, 0x73, 0x3b, 0xad, 0xde, 0x7e, 0x7d, 0x64, 0xf6, 0xcf, 0x3c, 0x33,
-	0x0c, 0xc8, 0x21, 0x34, 0x7c, 0xce, 0x24, 0x0d, 0x19, 0x8a, 0xfe, 0x59, 0xee, 0x5d, 0x56, 0x91,
-	0xc7, 0x60, 0x09, 0x1e, 0x61, 0xbb, 0x72, 0x68, 0x1c, 0xb5, 0x4e, 0xec, 0xe3, 0xfc, 0x68, 0x2a,
-	0xaa, 0xc7, 0x23, 0xf4, 0xb4, 0xd5, 0x19, 0x43, 0x5d, 0x69, 0x46, 0x92, 0xca, 0x84, 0x3c, 0x01,
-	0x2b, 0xc6, 0xbc, 0xca, 0xc6, 0x49, 0xb3, 0xec, 0x72, 0x6a, 0x7d, 0xfe, 0xfa, 0x68, 0xcb, 0xd3,
-	0x76, 0x95, 0x3c, 0xe0, 0x1f, 0xd9, 0x08, 0x7d, 0xce, 0x82, 0xa4, 0x48, 0x5e, 0x52, 0x39, 0xc7,
-	0x60, 0x0d, 0x69, 0x28, 0x88, 0x0d, 0x95, 0x0f, 0x38, 0xd7, 0x01, 0xeb, 0x9e, 0xfa, 0x24, 0xf7,
-	0x60, 0xfb, 0x86, 0x46, 0x29, 0x6a, 0xaf, 0xba, 0x97, 0x09, 0xce, 0xef, 0xe6, 0x92, 0xb4, 0xac,
-	0x96, 0x03, 0x00, 0x91, 0x2b, 0xfa, 0x67, 0x39, 0x6f, 0x25, 0x0d, 0x71, 0xa0, 0xf9, 0x51, 0x84,
-	0x52, 0x22, 0x3b, 0x9d, 0x4b, 0x2c, 0x8a, 0x58, 0xd1, 0xa9, 0x3a, 0x73, 0xf9, 0x0d, 0xce, 0x13,
-	0xcd, 0x84, 0xe5, 0x95, 0x55, 0xe4, 0x21, 0xd4, 0x05, 0xd2, 0x20, 0x0b, 0x61, 0x69, 0xfb, 0x52,
-	0x41, 0x1e, 0x40, 0x4d, 0x09, 0xda, 0x79, 0x5b, 0x1b, 0x17, 0x32, 0x39, 0x82, 0x3d, 0x1a, 0xc7,
-	0x82, 0x7f, 0x0a, 0x67, 0x54, 0xe2, 0x28, 0xfc, 0x15, 0xdb, 0x55, 0x0d, 0x59, 0x57, 0xaf, 0x21,
-	0x75, 0xb0, 0x9d, 0x0d, 0xa4, 0x8e, 0xf9, 0x0c, 0x6a, 0x21, 0x93, 0x28, 0x6e, 0x68, 0xd4, 0xae,
-	0xe9, 0x3b, 0xb8, 0x57, 0xdc, 0xc1, 0x65, 0x38, 0xc3, 0x7e, 0x6e, 0xf3, 0x16, 0x28, 0xe7, 0xb7,
-	0x2a, 0xb4, 0xdc, 0xe2, 0xd2, 0x33, 0xe2, 0xd6, 0x3a, 0xc3, 0xd8, 0xec, 0x8c, 0x87, 0x50, 0x4f,
-	0x24, 0x15, 0x52, 0xc5, 0xcc, 0x79, 0x5b, 0x2a, 0x56, 0x8a, 0xa8, 0xfc, 0x93, 0x22, 0x14, 0x4d,
-	0x3e, 0x8d, 0xa9, 0x1f, 0xca, 0x79, 0xce, 0xe1, 0x42, 0x56, 0xb9, 0xe8, 0x0d, 0x0d, 0x23, 0x3a,
-	0x89, 0x30, 0xe7, 0x70, 0xa9, 0x50, 0x9e, 0x69, 0x82, 0x41, 0x89, 0xbd, 0x85, 0x4c, 0xee, 0x43,
-	0x35, 0x4c, 0x4e, 0xd3, 0x64, 0xae, 0xd9, 0xaa, 0x79, 0xb9, 0x44, 0x1e, 0xc3, 0x6e, 0xd1, 0x06,
-	0x2e, 0x4f, 0x99, 0xd4, 0x4c, 0x59, 0xde, 0xaa, 0x92, 0x74, 0xc0, 0x4e, 0x90, 0x05, 0x21, 0x9b,
-	0x8e, 0x18, 0x8d, 0x33, 0x60, 0x5d, 0x03, 0x37, 0xf4, 0xe4, 0x18, 0x88, 0x40, 0x1f, 0xc3, 0x9b,
-	0x15, 0x34, 0x68, 0xf4, 0x37, 0x2c, 0xe4, 0x3f, 0xb0, 0x4f, 0xe3, 0x38, 0x9a, 0xaf, 0xc0, 0x1b,
-	0x1a, 0xbe, 0x69, 0xd8, 0x68, 0xd4, 0xe6, 0x37, 0x1a, 0x75, 0xa5, 0x0d, 0x77, 0xd7, 0xdb, 0x70,
-	0xad, 0x8d, 0x5b, 0x9b, 0x6d, 0x5c, 0x6e, 0xd4, 0xbd, 0xb5, 0x46, 0x7d, 0x09, 0x75, 0x3f, 0x4e,
-	0xc7, 0x09, 0x9d, 0x62, 0xd2, 0xb6, 0x0f, 0x2b, 0x47, 0x8d, 0x13, 0x52, 0x5c, 0xa8, 0x87, 0x3e,
-	0x17, 0x81, 0x9a, 0xd4, 0x7c, 0xbe, 0x97, 0x50, 0xf2, 0x3f, 0x68, 0xa8, 0x18, 0xfd, 0x0b, 0x8f,
-	0xaa, 0xaa, 0xf6, 0xef, 0xf0, 0x2c, 0x83, 0xc9, 0xff, 0xb3, 0x33, 0x63, 0xe1, 0x4c, 0xee, 0x70,
-	0x5e, 0x41, 0xab, 0xcc, 0x3c, 0x3e, 0xa7, 0x12, 0x99, 0x1f, 0x62, 0xd2, 0xfe, 0xd7, 0x5d, 0x99,
-	0x4b, 0x60, 0xe7, 0x05, 0xc0, 0x12, 0x70, 0xd7, 0xfa, 0xb1, 0x8a, 0xf5, 0xf3, 0x0a, 0xaa, 0x6f,
-	0x71, 0x36, 0xf9, 0xce, 0xbe, 0x25, 0x60, 0x31, 0x3a, 0x2b, 0xb6, 0x96, 0xfe, 0x56, 0x3a, 0x1a,
-	0x04, 0x42, 0x4f, 0x49, 0xdd, 0xd3, 0xdf, 0x4e, 0x0f, 0x76, 0xdc, 0x28, 0x4d, 0xe4, 0x77, 0x42,
-	0x39, 0xd0, 0x9c, 0xd1, 0x4f, 0x6a, 0xa9, 0x66, 0x9d, 0xa3, 0x42, 0xee, 0x7a, 0x2b, 0x3a, 0xe7,
-	0x25, 0x34, 0xcb, 0xc3, 0xa6, 0xca, 0xd6, 0x13, 0x9a, 0x8f, 0x73, 0x26, 0xa8, 0xe3, 0x21, 0x0b,
-	0xf2, 0xa3, 0xa8, 0x4f, 0x27, 0x82, 0xca, 0x6b, 0x3e, 0x21, 0xff, 0x06, 0x4b, 0xce, 0x63, 0xd4,
-	0xe8, 0xd6, 0xc9, 0x5e, 0x41, 0xdd, 0x6b, 0x3e, 0xb9, 0x9c, 0xc7, 0xe8, 0x69, 0x63, 0xfe, 0x2c,
-	0x49, 0xcc, 0x4b, 0x68, 0x7a, 0x85, 0x48, 0x9e, 0xe8, 0x6c, 0x72, 0xe3, 0xed, 0x78, 0xcd, 0x27,
-	0x6a, 0xc7, 0xa0, 0x97, 0x99, 0x1d, 0x84, 0x7d, 0x0f, 0x67, 0xfc, 0x06, 0x8b, 0xd5, 0xad, 0x72,
-	0x3f, 0xd9, 0x5c, 0xdc, 0x8b, 0xe3, 0x97, 0x17, 0xf8, 0x11, 0x6c, 0xab, 0xc7, 0x44, 0x6d, 0xee,
-	0xca, 0xdf, 0xbc, 0x36, 0x19, 0xc0, 0x71, 0x61, 0xaf, 0x48, 0x30, 0xe4, 0x3c, 0x52, 0x49, 0x9e,
-	0xc1, 0x76, 0xcc, 0x79, 0x94, 0xb4, 0x0d, 0xed, 0x7c, 0x6f, 0xd9, 0x1c, 0x4b, 0xdc, 0x22, 0x88,
-	0x02, 0x3a, 0x13, 0x68, 0x96, 0x8d, 0x8a, 0xd1, 0xa9, 0xe0, 0x69, 0x5c, 0x30, 0xaa, 0x85, 0x95,
-	0x55, 0x66, 0xae, 0xad, 0xb2, 0x43, 0x68, 0x08, 0xca, 0xa6, 0x38, 0x14, 0x78, 0x15, 0x7e, 0xd2,
-	0xdc, 0x34, 0xbd, 0xb2, 0xaa, 0x73, 0x08, 0xd5, 0xae, 0x2f, 0x43, 0xce, 0x48, 0x0d, 0xac, 0x01,
-	0x67, 0x68, 0x6f, 0x91, 0x26, 0xd4, 0x46, 0x3e, 0x8d, 0xf0, 0x22, 0x95, 0xb6, 0xd1, 0x79, 0xba,
-	0xac, 0xe2, 0x4d, 0xc8, 0x02, 0xd2, 0x02, 0x38, 0x47, 0x1a, 0xa0, 0x50, 0x92, 0xbd, 0x45, 0xf6,
-	0xa0, 0xe1, 0x61, 0x1c, 0x85, 0x3e, 0xd5, 0x0a, 0xa3, 0xf3, 0x62, 0x6d, 0xbf, 0x23, 0xa9, 0x82,
-	0x39, 0x1e, 0xda, 0x5b, 0xa4, 0x01, 0x3b, 0x17, 0x57, 0x57, 0x51, 0xc8, 0xd0, 0x36, 0xc8, 0x2e,
-	0xd4, 0x2f, 0xf9, 0x6c, 0x92, 0x48, 0x95, 0xd4, 0xec, 0xfc, 0xb0, 0xfa, 0x9a, 0xa2, 0x02, 0x7b,
-	0x29, 0x63, 0x21, 0x9b, 0xda, 0x5b, 0x84, 0x40, 0xeb, 0x3d, 0x0d, 0xa5, 0x0c, 0xd9, 0xd4, 0x15,
-	0x48, 0xa5, 0x0a, 0xa0, 0x00, 0xfa, 0x2a, 0x03, 0xdb, 0xec, 0xfc, 0x02, 0x2d, 0xf7, 0x5a, 0x9f,
-	0x0b, 0x51, 0xa8, 0x8e, 0x51, 0xe6, 0x6e, 0x10, 0x0c, 0x78, 0xa0, 0x8e, 0xd4, 0x52, 0x33, 0xa6,
-	0xb0, 0x5a, 0x36, 0x94, 0x3c, 0x8e, 0x03, 0x2a, 0x33, 0xd9, 0x54, 0xf1, 0xbb, 0x41, 0x70, 0x8e,
-	0x54, 0x30, 0x14, 0x5a, 0x57, 0x51, 0x05, 0x6a, 0x1a, 0x54, 0x44, 0xdb, 0xea, 0xbc, 0x82, 0x5a,
-	0xf1, 0x23, 0x42, 0xea, 0xb0, 0xfd, 0x8e, 0x4b, 0x14, 0xd9, 0x99, 0x72, 0x37, 0xdb, 0x20, 0xfb,
-	0xb0, 0xdb, 0x67, 0x3e, 0x9f, 0x85, 0x6c, 0x9a, 0xd9, 0x4d, 0xa5, 0x3a, 0xc3, 0x19, 0x97, 0x0b,
-	0x55, 0xa5, 0xf3, 0x02, 0x1a, 0xee, 0x35, 0xfa, 0x1f, 0x86, 0x3c, 0x0a, 0xfd, 0xb9, 0x22, 0x7e,
-	0xe4, 0x76, 0x07, 0x19, 0x95, 0xdd, 0xe1, 0xd0, 0xbb, 0xf8, 0xa9, 0xff, 0xb6, 0x7b, 0xd9, 0xb3,
-	0x0d, 0x02, 0x50, 0x1d, 0x8f, 0x7a, 0x6f, 0x7a, 0x3f, 0xdb, 0x66, 0x67, 0x08, 0xad, 0x8b, 0x18,
-	0x05, 0x95, 0x5c, 0xb3, 0x9a, 0x26, 0x2a, 0xf5, 0x68, 0xec, 0xba, 0xbd, 0xd1, 0x28, 0xab, 0xe3,
-	0xb2, 0xff, 0xb6, 0x77, 0x31, 0xbe, 0xcc, 0xfc, 0xdc, 0xee, 0xc0, 0xed, 0x9d, 0xdb, 0xa6, 0xa6,
-	0xa9, 0x37, 0x3c, 0xef, 0xba, 0x3d, 0xbb, 0xa2, 0x85, 0xf1, 0x60, 0xd0, 0x1f, 0xfc, 0xa8, 0x4f,
-	0xb4, 0x93, 0x8f, 0x97, 0x3a, 0xff, 0xea, 0x58, 0xd8, 0x5b, 0xe4, 0x3e, 0x90, 0x8c, 0xeb, 0x72,
-	0x13, 0x66, 0x87, 0x74, 0xd3, 0x44, 0xf2, 0xd9, 0x48, 0x4d, 0x74, 0x57, 0xda, 0x41, 0xe7, 0x39,
-	0xd4, 0x8a, 0x41, 0x53, 0x29, 0x32, 0xb7, 0x20, 0xab, 0xea, 0x3d, 0x17, 0x1f, 0xd4, 0x25, 0xea,
-	0x1b, 0x77, 0xf9, 0x2c, 0x8e, 0x50, 0xd9, 0xcc, 0x53, 0xfb, 0xcb, 0x9f, 0x07, 0xc6, 0xe7, 0xdb,
-	0x03, 0xe3, 0xcb, 0xed, 0x81, 0xf1, 0xc7, 0xed, 0x81, 0x31, 0xa9, 0xea, 0xbf, 0xd1, 0xe7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4e, 0x64, 0xd6, 0xd4, 0x0a, 0x00, 0x00,
+	// 1390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0xc6,
+	0x12, 0x35, 0x25, 0x5a, 0x96, 0x46, 0xb2, 0x4c, 0xef, 0xcd, 0x0d, 0x84, 0x20, 0x70, 0x04, 0xde,
+	0x20, 0x30, 0x84, 0x7b, 0x9d, 0xc0, 0xd7, 0xc8, 0x43, 0xd1, 0xa2, 0x90, 0x69, 0xb5, 0x51, 0xe2,
+	0xd8, 0x02, 0x65, 0x25, 0xed, 0x5b, 0x57, 0xe4, 0x58, 0x26, 0x4c, 0x71, 0x89, 0xe5, 0xd2, 0x89,
+	0xfa, 0x0d, 0xfd, 0x9d, 0xfe, 0x41, 0x1f, 0xf2, 0x98, 0x2f, 0x08, 0x5a, 0x7f, 0x49, 0xb1, 0xbb,
+	0xa4, 0x44, 0x49, 0x49, 0xdc, 0x37, 0x9e, 0x99, 0x33, 0xb3, 0xb3, 0xb3, 0x67, 0x67, 0x09, 0x8d,
+	0x29, 0x0a, 0x1a, 0x8f, 0x0f, 0x62, 0xce, 0x04, 0x23, 0x15, 0x8d, 0x1e, 0xfc, 0x6f, 0x12, 0x88,
+	0xab, 0x74, 0x7c, 0xe0, 0xb1, 0xe9, 0xd3, 0x09, 0x9b, 0xb0, 0xa7, 0xca, 0x3d, 0x4e, 0x2f, 0x15,
+	0x52, 0x40, 0x7d, 0xe9, 0x30, 0xdb, 0x81, 0x6d, 0x17, 0x13, 0x96, 0x72, 0x0f, 0x7b, 0x31, 0xf3,
+	0xae, 0x48, 0x0b, 0xb6, 0x3c, 0x16, 0x5d, 0xbe, 0x41, 0xde, 0x32, 0xda, 0xc6, 0xbe, 0xe9, 0xe6,
+	0x50, 0x7a, 0x6e, 0x90, 0x27, 0x01, 0x8b, 0x5a, 0x25, 0xed, 0xc9, 0xa0, 0x7d, 0x09, 0xe6, 0x00,
+	0x91, 0x93, 0xfb, 0x50, 0x0a, 0x7c, 0x1d, 0x76, 0x5c, 0xb9, 0xfd, 0xf4, 0xa8, 0xd4, 0x3f, 0x71,
+	0x4b, 0x81, 0x4f, 0xda, 0x50, 0xf7, 0x58, 0x24, 0x68, 0x10, 0x21, 0xef, 0x9f, 0x64, 0xd1, 0x45,
+	0x13, 0x79, 0x0c, 0x26, 0x67, 0x21, 0xb6, 0xca, 0x6d, 0x63, 0xbf, 0x79, 0x68, 0x1d, 0x64, 0x5b,
+	0x93, 0x59, 0x5d, 0x16, 0xa2, 0xab, 0xbc, 0xf6, 0x08, 0x6a, 0xd2, 0x32, 0x14, 0x54, 0x24, 0xe4,
+	0x09, 0x98, 0x31, 0x66, 0x55, 0xd6, 0x0f, 0x1b, 0xc5, 0x90, 0x63, 0xf3, 0xc3, 0xa7, 0x47, 0x1b,
+	0xae, 0xf2, 0xcb, 0xc5, 0x7d, 0xf6, 0x2e, 0x1a, 0xa2, 0xc7, 0x22, 0x3f, 0xc9, 0x17, 0x2f, 0x98,
+	0xec, 0x03, 0x30, 0x07, 0x34, 0xe0, 0xc4, 0x82, 0xf2, 0x35, 0xce, 0x54, 0xc2, 0x9a, 0x2b, 0x3f,
+	0xc9, 0x3d, 0xd8, 0xbc, 0xa1, 0x61, 0x8a, 0x2a, 0xaa, 0xe6, 0x6a, 0x60, 0xff, 0x5e, 0x5a, 0x34,
+	0x4d, 0xd7, 0xb2, 0x07, 0xc0, 0x33, 0x43, 0xff, 0x24, 0xeb, 0x5b, 0xc1, 0x42, 0x6c, 0x68, 0xbc,
+	0xe3, 0x81, 0x10, 0x18, 0x1d, 0xcf, 0x04, 0xe6, 0x45, 0x2c, 0xd9, 0x64, 0x9d, 0x19, 0x7e, 0x85,
+	0xb3, 0x44, 0x75, 0xc2, 0x74, 0x8b, 0x26, 0xf2, 0x10, 0x6a, 0x1c, 0xa9, 0xaf, 0x53, 0x98, 0xca,
+	0xbf, 0x30, 0x90, 0x07, 0x50, 0x95, 0x40, 0x05, 0x6f, 0x2a, 0xe7, 0x1c, 0x93, 0x7d, 0xd8, 0xa1,
+	0x71, 0xcc, 0xd9, 0xfb, 0x60, 0x4a, 0x05, 0x0e, 0x83, 0x5f, 0xb1, 0x55, 0x51, 0x94, 0x55, 0xf3,
+	0x0a, 0x53, 0x25, 0xdb, 0x5a, 0x63, 0xaa, 0x9c, 0xcf, 0xa0, 0x1a, 0x44, 0x02, 0xf9, 0x0d, 0x0d,
+	0x5b, 0x55, 0x75, 0x06, 0xf7, 0xf2, 0x33, 0xb8, 0x08, 0xa6, 0xd8, 0xcf, 0x7c, 0xee, 0x9c, 0x65,
+	0xff, 0x51, 0x81, 0xa6, 0x93, 0x1f, 0xba, 0x6e, 0xdc, 0x8a, 0x32, 0x8c, 0x75, 0x65, 0x3c, 0x84,
+	0x5a, 0x22, 0x28, 0x17, 0x32, 0x67, 0xd6, 0xb7, 0x85, 0x61, 0xa9, 0x88, 0xf2, 0x3f, 0x29, 0x42,
+	0xb6, 0xc9, 0xa3, 0x31, 0xf5, 0x02, 0x31, 0xcb, 0x7a, 0x38, 0xc7, 0x72, 0x2d, 0x7a, 0x43, 0x83,
+	0x90, 0x8e, 0x43, 0xcc, 0x7a, 0xb8, 0x30, 0xc8, 0xc8, 0x34, 0x41, 0xbf, 0xd0, 0xbd, 0x39, 0x26,
+	0xf7, 0xa1, 0x12, 0x24, 0xc7, 0x69, 0x32, 0x53, 0xdd, 0xaa, 0xba, 0x19, 0x22, 0x8f, 0x61, 0x3b,
+	0x97, 0x81, 0xc3, 0xd2, 0x48, 0xa8, 0x4e, 0x99, 0xee, 0xb2, 0x91, 0x74, 0xc0, 0x4a, 0x30, 0xf2,
+	0x83, 0x68, 0x32, 0x8c, 0x68, 0xac, 0x89, 0x35, 0x45, 0x5c, 0xb3, 0x93, 0x03, 0x20, 0x1c, 0x3d,
+	0x0c, 0x6e, 0x96, 0xd8, 0xa0, 0xd8, 0x9f, 0xf1, 0x90, 0xff, 0xc2, 0x2e, 0x8d, 0xe3, 0x70, 0xb6,
+	0x44, 0xaf, 0x2b, 0xfa, 0xba, 0x63, 0x4d, 0xa8, 0x8d, 0xcf, 0x08, 0x75, 0x49, 0x86, 0xdb, 0xab,
+	0x32, 0x5c, 0x91, 0x71, 0x73, 0x5d, 0xc6, 0x45, 0xa1, 0xee, 0xac, 0x08, 0xf5, 0x39, 0xd4, 0xbc,
+	0x38, 0x1d, 0x25, 0x74, 0x82, 0x49, 0xcb, 0x6a, 0x97, 0xf7, 0xeb, 0x87, 0x24, 0x3f, 0x50, 0x17,
+	0x3d, 0xc6, 0x7d, 0x79, 0x53, 0xb3, 0xfb, 0xbd, 0xa0, 0x92, 0x6f, 0xa0, 0x2e, 0x73, 0xf4, 0xcf,
+	0x5d, 0x2a, 0xab, 0xda, 0xbd, 0x23, 0xb2, 0x48, 0x26, 0xdf, 0xea, 0x3d, 0x63, 0x1e, 0x4c, 0xee,
+	0x08, 0x5e, 0x62, 0xcb, 0x95, 0x59, 0x7c, 0x4a, 0x05, 0x46, 0x5e, 0x80, 0x49, 0xeb, 0x5f, 0x77,
+	0xad, 0x5c, 0x20, 0x93, 0x23, 0xf8, 0x77, 0x10, 0x79, 0x2c, 0x4a, 0x82, 0x44, 0x60, 0x24, 0xf2,
+	0x99, 0x92, 0xb4, 0xee, 0xb5, 0xcb, 0xfb, 0xa6, 0xfb, 0x79, 0xa7, 0x7d, 0x04, 0xb0, 0x48, 0x7b,
+	0xd7, 0xd0, 0x32, 0xf3, 0xa1, 0xf5, 0x02, 0x2a, 0xaf, 0x71, 0x3a, 0xfe, 0xca, 0x94, 0x26, 0x60,
+	0x46, 0x74, 0x9a, 0xcf, 0x3a, 0xf5, 0x2d, 0x6d, 0xd4, 0xf7, 0xb9, 0xba, 0x5b, 0x35, 0x57, 0x7d,
+	0xdb, 0x3d, 0xd8, 0x72, 0xc2, 0x34, 0x11, 0x5f, 0x49, 0x65, 0x43, 0x63, 0x4a, 0xdf, 0xcb, 0x51,
+	0xac, 0xf5, 0x26, 0x53, 0x6e, 0xbb, 0x4b, 0x36, 0xfb, 0x39, 0x34, 0x8a, 0x57, 0x54, 0x96, 0xad,
+	0xee, 0x75, 0x36, 0x04, 0x34, 0x90, 0xdb, 0xc3, 0xc8, 0xcf, 0xb6, 0x22, 0x3f, 0xed, 0xdf, 0x4a,
+	0x50, 0x7e, 0xc9, 0xc6, 0xe4, 0x3f, 0x60, 0x8a, 0x59, 0x8c, 0x8a, 0xde, 0x3c, 0xdc, 0xc9, 0x3b,
+	0xfe, 0x92, 0x8d, 0x2f, 0x66, 0x31, 0xba, 0xca, 0x99, 0xbd, 0x66, 0xb2, 0x7f, 0x2a, 0x45, 0xc3,
+	0xcd, 0x21, 0x79, 0xa2, 0x96, 0x13, 0x6b, 0x4f, 0xce, 0x4b, 0x36, 0x96, 0xa3, 0x09, 0x5d, 0xed,
+	0xce, 0xb6, 0x68, 0xae, 0x6d, 0xf1, 0x01, 0x54, 0x63, 0xce, 0x26, 0x1c, 0x93, 0xf9, 0xb8, 0xcd,
+	0xb1, 0xdc, 0x0a, 0x72, 0xce, 0xb8, 0x1a, 0x13, 0x35, 0x57, 0x03, 0x59, 0x0b, 0x47, 0xc1, 0xa5,
+	0x4a, 0xf4, 0x48, 0xcd, 0xa1, 0xbc, 0x51, 0x1e, 0x47, 0x2a, 0xd0, 0xef, 0xea, 0x09, 0x51, 0x76,
+	0x17, 0x06, 0xe9, 0x4d, 0x63, 0x3f, 0xf3, 0xd6, 0xb4, 0x77, 0x6e, 0xb0, 0x11, 0x76, 0x5d, 0x9c,
+	0xb2, 0x1b, 0xcc, 0x05, 0x22, 0x7b, 0xf3, 0x64, 0xfd, 0x3d, 0x9a, 0x17, 0x5f, 0xf0, 0x90, 0x7d,
+	0xd8, 0x94, 0x6f, 0xa4, 0x7c, 0x90, 0xca, 0x5f, 0x78, 0x44, 0x35, 0xc1, 0x76, 0x60, 0x27, 0x5f,
+	0x60, 0xc0, 0x58, 0x28, 0x17, 0x79, 0x06, 0x9b, 0x31, 0x63, 0x61, 0xd2, 0x32, 0xda, 0xe5, 0xe2,
+	0xe0, 0x2d, 0xf2, 0xe6, 0x49, 0x24, 0xd1, 0x7e, 0x04, 0xb5, 0x63, 0xea, 0x5d, 0xa7, 0xb1, 0x0c,
+	0x27, 0x60, 0xc6, 0x54, 0x5c, 0x65, 0xca, 0x55, 0xdf, 0x76, 0x5b, 0x4a, 0x3b, 0x11, 0x8c, 0xe3,
+	0x97, 0x18, 0x63, 0x68, 0x14, 0xf3, 0xcb, 0x56, 0x4f, 0x38, 0x4b, 0xe3, 0x5c, 0x35, 0x0a, 0x2c,
+	0x0d, 0xf9, 0xd2, 0xca, 0x90, 0x6f, 0x43, 0x9d, 0xd3, 0x68, 0x82, 0x03, 0x8e, 0x97, 0xc1, 0x7b,
+	0x75, 0xfc, 0x0d, 0xb7, 0x68, 0xea, 0xb4, 0xa1, 0xd2, 0xf5, 0x44, 0xc0, 0x22, 0x52, 0x05, 0xf3,
+	0x8c, 0x45, 0x68, 0x6d, 0x90, 0x06, 0x54, 0x87, 0x1e, 0x0d, 0xf1, 0x3c, 0x15, 0x96, 0xd1, 0x79,
+	0xba, 0xa8, 0xe2, 0x55, 0x10, 0xf9, 0xa4, 0x09, 0x70, 0x8a, 0xd4, 0x47, 0x2e, 0x91, 0xb5, 0x41,
+	0x76, 0xa0, 0xee, 0x62, 0x1c, 0x06, 0x1e, 0x55, 0x06, 0xa3, 0x73, 0xb4, 0xf2, 0xf2, 0x21, 0xa9,
+	0x40, 0x69, 0x34, 0xb0, 0x36, 0x48, 0x1d, 0xb6, 0xce, 0x2f, 0x2f, 0xc3, 0x20, 0x42, 0xcb, 0x20,
+	0xdb, 0x50, 0xbb, 0x60, 0xd3, 0x71, 0x22, 0xe4, 0xa2, 0xa5, 0xce, 0x77, 0xcb, 0xff, 0x19, 0x28,
+	0xc9, 0x6e, 0x1a, 0x45, 0x41, 0x34, 0xb1, 0x36, 0x08, 0x81, 0xe6, 0x5b, 0x1a, 0x08, 0x11, 0x44,
+	0x13, 0x47, 0x89, 0xc5, 0x32, 0x14, 0x41, 0xa9, 0xc1, 0xb7, 0x4a, 0x9d, 0x5f, 0xa0, 0xe9, 0x5c,
+	0xa9, 0x7d, 0x21, 0x72, 0x79, 0x29, 0xa4, 0xbb, 0xeb, 0xfb, 0x67, 0xcc, 0x97, 0x5b, 0x6a, 0x02,
+	0x68, 0xae, 0xc2, 0x86, 0xc4, 0x23, 0x25, 0x2b, 0x85, 0x4b, 0x32, 0x7f, 0xd7, 0xf7, 0x4f, 0x91,
+	0xf2, 0x08, 0xb9, 0xb2, 0x95, 0x65, 0x81, 0xaa, 0x0d, 0x32, 0xa3, 0x65, 0x76, 0x5e, 0x40, 0x35,
+	0xff, 0x45, 0x23, 0x35, 0xd8, 0x7c, 0xc3, 0x04, 0x72, 0xbd, 0xa7, 0x2c, 0xcc, 0x32, 0xc8, 0x2e,
+	0x6c, 0xf7, 0x23, 0x8f, 0x4d, 0x83, 0x68, 0xa2, 0xfd, 0x25, 0x69, 0x3a, 0xc1, 0x29, 0x13, 0x73,
+	0x53, 0xb9, 0x73, 0x04, 0x75, 0xe7, 0x0a, 0xbd, 0xeb, 0x01, 0x0b, 0x03, 0x6f, 0x26, 0x1b, 0x3f,
+	0x74, 0xba, 0x67, 0xba, 0x95, 0xdd, 0xc1, 0xc0, 0x3d, 0xff, 0xa9, 0xff, 0xba, 0x7b, 0xd1, 0xb3,
+	0x0c, 0x02, 0x50, 0x19, 0x0d, 0x7b, 0xaf, 0x7a, 0x3f, 0x5b, 0xa5, 0xce, 0x00, 0x9a, 0xe7, 0x31,
+	0x72, 0x2a, 0x98, 0xea, 0x6a, 0x9a, 0xc8, 0xa5, 0x87, 0x23, 0xc7, 0xe9, 0x0d, 0x87, 0xba, 0x8e,
+	0x8b, 0xfe, 0xeb, 0xde, 0xf9, 0xe8, 0x42, 0xc7, 0x39, 0xdd, 0x33, 0xa7, 0x77, 0x6a, 0x95, 0x54,
+	0x9b, 0x7a, 0x83, 0xd3, 0xae, 0xd3, 0xb3, 0xca, 0x0a, 0x8c, 0xce, 0xce, 0xfa, 0x67, 0x3f, 0x5a,
+	0x66, 0x87, 0xc2, 0x56, 0x36, 0x41, 0xe4, 0xfe, 0x97, 0x6f, 0x96, 0xb5, 0x41, 0xee, 0x03, 0xd1,
+	0xbd, 0x2e, 0x8a, 0x50, 0x27, 0xd7, 0xca, 0xce, 0x92, 0x6b, 0x11, 0x5b, 0x65, 0xb9, 0x55, 0x27,
+	0x4d, 0x04, 0x9b, 0x0e, 0xe5, 0x38, 0xeb, 0x0a, 0xcb, 0xef, 0x7c, 0x0f, 0xd5, 0x7c, 0xc8, 0x48,
+	0xae, 0xce, 0xe7, 0xeb, 0x72, 0xdf, 0x32, 0x7e, 0x2d, 0x4f, 0x57, 0x49, 0xc1, 0x61, 0xd3, 0x38,
+	0x44, 0xe9, 0x2b, 0xc9, 0x05, 0x7e, 0xa0, 0x41, 0x88, 0xbe, 0x55, 0x3e, 0xb6, 0x3e, 0xfe, 0xb5,
+	0x67, 0x7c, 0xb8, 0xdd, 0x33, 0x3e, 0xde, 0xee, 0x19, 0x7f, 0xde, 0xee, 0x19, 0xe3, 0x8a, 0xfa,
+	0x99, 0xff, 0xff, 0xdf, 0x03, 0x00, 0x14, 0x9b, 0x00, 0xea, 0x13, 0x0c, 0x00, 0x00,
 }
 
 func (m *ResourceEpoch) Marshal() (dAtA []byte, err error) {
@@ -1825,6 +2005,25 @@ func (m *ContainerStats) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.InconsistentResources) > 0 {
+		dAtA5 := make([]byte, len(m.InconsistentResources)*10)
+		var j4 int
+		for _, num := range m.InconsistentResources {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1994,6 +2193,37 @@ func (m *Job) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetapb(dAtA, i, uint64(m.State))
 	}
+	if m.ID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.ID))
+	}
+	if m.Progress != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Progress))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Retries != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.Retries))
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(m.UpdatedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2071,6 +2301,60 @@ func (m *ResourcePoolJob) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *BackupJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupJob) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RestoreJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreJob) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetapb(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ResourcePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2306,6 +2590,13 @@ func (m *ContainerStats) Size() (n int) {
 			n += 2 + l + sovMetapb(uint64(l))
 		}
 	}
+	if len(m.InconsistentResources) > 0 {
+		l = 0
+		for _, e := range m.InconsistentResources {
+			l += sovMetapb(uint64(e))
+		}
+		n += 2 + sovMetapb(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2406,6 +2697,25 @@ func (m *Job) Size() (n int) {
 	if m.State != 0 {
 		n += 1 + sovMetapb(uint64(m.State))
 	}
+	if m.ID != 0 {
+		n += 1 + sovMetapb(uint64(m.ID))
+	}
+	if m.Progress != 0 {
+		n += 1 + sovMetapb(uint64(m.Progress))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovMetapb(uint64(m.Retries))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMetapb(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovMetapb(uint64(m.UpdatedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2451,6 +2761,38 @@ func (m *ResourcePoolJob) Size() (n int) {
 	return n
 }
 
+func (m *BackupJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ResourcePool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3604,6 +3946,82 @@ func (m *ContainerStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InconsistentResources = append(m.InconsistentResources, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetapb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InconsistentResources) == 0 {
+					m.InconsistentResources = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InconsistentResources = append(m.InconsistentResources, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InconsistentResources", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4156,6 +4574,133 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			m.Progress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Progress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetapb(dAtA[iNdEx:])
@@ -4376,6 +4921,178 @@ func (m *ResourcePoolJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackupJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResourcePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@
 package rpcpb
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -65,6 +66,36 @@ const (
 	TypeRemoveJobRsp          Type = 34
 	TypeExecuteJobReq         Type = 35
 	TypeExecuteJobRsp         Type = 36
+	TypeGetTimestampReq       Type = 37
+	TypeGetTimestampRsp       Type = 38
+	TypeListContainersReq     Type = 39
+	TypeListContainersRsp     Type = 40
+	TypeListResourcesReq      Type = 41
+	TypeListResourcesRsp      Type = 42
+	TypeRemoveContainerReq    Type = 43
+	TypeRemoveContainerRsp    Type = 44
+	TypeUpContainerReq        Type = 45
+	TypeUpContainerRsp        Type = 46
+	TypeSetContainerWeightReq Type = 47
+	TypeSetContainerWeightRsp Type = 48
+	TypeAddSchedulerReq       Type = 49
+	TypeAddSchedulerRsp       Type = 50
+	TypeRemoveSchedulerReq    Type = 51
+	TypeRemoveSchedulerRsp    Type = 52
+	TypePauseSchedulerReq     Type = 53
+	TypePauseSchedulerRsp     Type = 54
+	TypeListSchedulersReq     Type = 55
+	TypeListSchedulersRsp     Type = 56
+	TypeAddOperatorReq        Type = 57
+	TypeAddOperatorRsp        Type = 58
+	TypeRemoveOperatorReq     Type = 59
+	TypeRemoveOperatorRsp     Type = 60
+	TypeGetOperatorStatusReq  Type = 61
+	TypeGetOperatorStatusRsp  Type = 62
+	TypeListJobsReq           Type = 63
+	TypeListJobsRsp           Type = 64
+	TypeGetJobReq             Type = 65
+	TypeGetJobRsp             Type = 66
 )
 
 var Type_name = map[int32]string{
@@ -105,6 +136,36 @@ var Type_name = map[int32]string{
 	34: "TypeRemoveJobRsp",
 	35: "TypeExecuteJobReq",
 	36: "TypeExecuteJobRsp",
+	37: "TypeGetTimestampReq",
+	38: "TypeGetTimestampRsp",
+	39: "TypeListContainersReq",
+	40: "TypeListContainersRsp",
+	41: "TypeListResourcesReq",
+	42: "TypeListResourcesRsp",
+	43: "TypeRemoveContainerReq",
+	44: "TypeRemoveContainerRsp",
+	45: "TypeUpContainerReq",
+	46: "TypeUpContainerRsp",
+	47: "TypeSetContainerWeightReq",
+	48: "TypeSetContainerWeightRsp",
+	49: "TypeAddSchedulerReq",
+	50: "TypeAddSchedulerRsp",
+	51: "TypeRemoveSchedulerReq",
+	52: "TypeRemoveSchedulerRsp",
+	53: "TypePauseSchedulerReq",
+	54: "TypePauseSchedulerRsp",
+	55: "TypeListSchedulersReq",
+	56: "TypeListSchedulersRsp",
+	57: "TypeAddOperatorReq",
+	58: "TypeAddOperatorRsp",
+	59: "TypeRemoveOperatorReq",
+	60: "TypeRemoveOperatorRsp",
+	61: "TypeGetOperatorStatusReq",
+	62: "TypeGetOperatorStatusRsp",
+	63: "TypeListJobsReq",
+	64: "TypeListJobsRsp",
+	65: "TypeGetJobReq",
+	66: "TypeGetJobRsp",
 }
 
 var Type_value = map[string]int32{
@@ -145,6 +206,36 @@ var Type_value = map[string]int32{
 	"TypeRemoveJobRsp":          34,
 	"TypeExecuteJobReq":         35,
 	"TypeExecuteJobRsp":         36,
+	"TypeGetTimestampReq":       37,
+	"TypeGetTimestampRsp":       38,
+	"TypeListContainersReq":     39,
+	"TypeListContainersRsp":     40,
+	"TypeListResourcesReq":      41,
+	"TypeListResourcesRsp":      42,
+	"TypeRemoveContainerReq":    43,
+	"TypeRemoveContainerRsp":    44,
+	"TypeUpContainerReq":        45,
+	"TypeUpContainerRsp":        46,
+	"TypeSetContainerWeightReq": 47,
+	"TypeSetContainerWeightRsp": 48,
+	"TypeAddSchedulerReq":       49,
+	"TypeAddSchedulerRsp":       50,
+	"TypeRemoveSchedulerReq":    51,
+	"TypeRemoveSchedulerRsp":    52,
+	"TypePauseSchedulerReq":     53,
+	"TypePauseSchedulerRsp":     54,
+	"TypeListSchedulersReq":     55,
+	"TypeListSchedulersRsp":     56,
+	"TypeAddOperatorReq":        57,
+	"TypeAddOperatorRsp":        58,
+	"TypeRemoveOperatorReq":     59,
+	"TypeRemoveOperatorRsp":     60,
+	"TypeGetOperatorStatusReq":  61,
+	"TypeGetOperatorStatusRsp":  62,
+	"TypeListJobsReq":           63,
+	"TypeListJobsRsp":           64,
+	"TypeGetJobReq":             65,
+	"TypeGetJobRsp":             66,
 }
 
 func (x Type) String() string {
@@ -155,6 +246,35 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_25e491924c678914, []int{0}
 }
 
+// OperatorType the type of the operators created by the admin api
+type OperatorType int32
+
+const (
+	TransferLeaderOperator OperatorType = 0
+	MovePeerOperator       OperatorType = 1
+	SplitResourceOperator  OperatorType = 2
+)
+
+var OperatorType_name = map[int32]string{
+	0: "TransferLeaderOperator",
+	1: "MovePeerOperator",
+	2: "SplitResourceOperator",
+}
+
+var OperatorType_value = map[string]int32{
+	"TransferLeaderOperator": 0,
+	"MovePeerOperator":       1,
+	"SplitResourceOperator":  2,
+}
+
+func (x OperatorType) String() string {
+	return proto.EnumName(OperatorType_name, int32(x))
+}
+
+func (OperatorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{1}
+}
+
 // PeerRoleType is the expected peer type of the placement rule
 type PeerRoleType int32
 
@@ -188,7 +308,7 @@ func (x PeerRoleType) String() string {
 }
 
 func (PeerRoleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{1}
+	return fileDescriptor_25e491924c678914, []int{2}
 }
 
 // LabelConstraintOp defines how a LabelConstraint matches a container. It can be one of
@@ -225,7 +345,7 @@ func (x LabelConstraintOp) String() string {
 }
 
 func (LabelConstraintOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{2}
+	return fileDescriptor_25e491924c678914, []int{3}
 }
 
 // Request the prophet rpc request
@@ -251,6 +371,21 @@ type Request struct {
 	CreateJob            CreateJobReq          `protobuf:"bytes,19,opt,name=createJob,proto3" json:"createJob"`
 	RemoveJob            RemoveJobReq          `protobuf:"bytes,20,opt,name=removeJob,proto3" json:"removeJob"`
 	ExecuteJob           ExecuteJobReq         `protobuf:"bytes,21,opt,name=executeJob,proto3" json:"executeJob"`
+	GetTimestamp         GetTimestampReq       `protobuf:"bytes,22,opt,name=getTimestamp,proto3" json:"getTimestamp"`
+	ListContainers       ListContainersReq     `protobuf:"bytes,23,opt,name=listContainers,proto3" json:"listContainers"`
+	ListResources        ListResourcesReq      `protobuf:"bytes,24,opt,name=listResources,proto3" json:"listResources"`
+	RemoveContainer      RemoveContainerReq    `protobuf:"bytes,25,opt,name=removeContainer,proto3" json:"removeContainer"`
+	UpContainer          UpContainerReq        `protobuf:"bytes,26,opt,name=upContainer,proto3" json:"upContainer"`
+	SetContainerWeight   SetContainerWeightReq `protobuf:"bytes,27,opt,name=setContainerWeight,proto3" json:"setContainerWeight"`
+	AddScheduler         AddSchedulerReq       `protobuf:"bytes,28,opt,name=addScheduler,proto3" json:"addScheduler"`
+	RemoveScheduler      RemoveSchedulerReq    `protobuf:"bytes,29,opt,name=removeScheduler,proto3" json:"removeScheduler"`
+	PauseScheduler       PauseSchedulerReq     `protobuf:"bytes,30,opt,name=pauseScheduler,proto3" json:"pauseScheduler"`
+	ListSchedulers       ListSchedulersReq     `protobuf:"bytes,31,opt,name=listSchedulers,proto3" json:"listSchedulers"`
+	AddOperator          AddOperatorReq        `protobuf:"bytes,32,opt,name=addOperator,proto3" json:"addOperator"`
+	RemoveOperator       RemoveOperatorReq     `protobuf:"bytes,33,opt,name=removeOperator,proto3" json:"removeOperator"`
+	GetOperatorStatus    GetOperatorStatusReq  `protobuf:"bytes,34,opt,name=getOperatorStatus,proto3" json:"getOperatorStatus"`
+	ListJobs             ListJobsReq           `protobuf:"bytes,35,opt,name=listJobs,proto3" json:"listJobs"`
+	GetJob               GetJobReq             `protobuf:"bytes,36,opt,name=getJob,proto3" json:"getJob"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -436,6 +571,111 @@ func (m *Request) GetExecuteJob() ExecuteJobReq {
 	return ExecuteJobReq{}
 }
 
+func (m *Request) GetGetTimestamp() GetTimestampReq {
+	if m != nil {
+		return m.GetTimestamp
+	}
+	return GetTimestampReq{}
+}
+
+func (m *Request) GetListContainers() ListContainersReq {
+	if m != nil {
+		return m.ListContainers
+	}
+	return ListContainersReq{}
+}
+
+func (m *Request) GetListResources() ListResourcesReq {
+	if m != nil {
+		return m.ListResources
+	}
+	return ListResourcesReq{}
+}
+
+func (m *Request) GetRemoveContainer() RemoveContainerReq {
+	if m != nil {
+		return m.RemoveContainer
+	}
+	return RemoveContainerReq{}
+}
+
+func (m *Request) GetUpContainer() UpContainerReq {
+	if m != nil {
+		return m.UpContainer
+	}
+	return UpContainerReq{}
+}
+
+func (m *Request) GetSetContainerWeight() SetContainerWeightReq {
+	if m != nil {
+		return m.SetContainerWeight
+	}
+	return SetContainerWeightReq{}
+}
+
+func (m *Request) GetAddScheduler() AddSchedulerReq {
+	if m != nil {
+		return m.AddScheduler
+	}
+	return AddSchedulerReq{}
+}
+
+func (m *Request) GetRemoveScheduler() RemoveSchedulerReq {
+	if m != nil {
+		return m.RemoveScheduler
+	}
+	return RemoveSchedulerReq{}
+}
+
+func (m *Request) GetPauseScheduler() PauseSchedulerReq {
+	if m != nil {
+		return m.PauseScheduler
+	}
+	return PauseSchedulerReq{}
+}
+
+func (m *Request) GetListSchedulers() ListSchedulersReq {
+	if m != nil {
+		return m.ListSchedulers
+	}
+	return ListSchedulersReq{}
+}
+
+func (m *Request) GetAddOperator() AddOperatorReq {
+	if m != nil {
+		return m.AddOperator
+	}
+	return AddOperatorReq{}
+}
+
+func (m *Request) GetRemoveOperator() RemoveOperatorReq {
+	if m != nil {
+		return m.RemoveOperator
+	}
+	return RemoveOperatorReq{}
+}
+
+func (m *Request) GetGetOperatorStatus() GetOperatorStatusReq {
+	if m != nil {
+		return m.GetOperatorStatus
+	}
+	return GetOperatorStatusReq{}
+}
+
+func (m *Request) GetListJobs() ListJobsReq {
+	if m != nil {
+		return m.ListJobs
+	}
+	return ListJobsReq{}
+}
+
+func (m *Request) GetGetJob() GetJobReq {
+	if m != nil {
+		return m.GetJob
+	}
+	return GetJobReq{}
+}
+
 // Response the prophet rpc response
 type Response struct {
 	ID                   uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -460,6 +700,21 @@ type Response struct {
 	CreateJob            CreateJobRsp          `protobuf:"bytes,20,opt,name=createJob,proto3" json:"createJob"`
 	RemoveJob            RemoveJobRsp          `protobuf:"bytes,21,opt,name=removeJob,proto3" json:"removeJob"`
 	ExecuteJob           ExecuteJobRsp         `protobuf:"bytes,22,opt,name=executeJob,proto3" json:"executeJob"`
+	GetTimestamp         GetTimestampRsp       `protobuf:"bytes,23,opt,name=getTimestamp,proto3" json:"getTimestamp"`
+	ListContainers       ListContainersRsp     `protobuf:"bytes,24,opt,name=listContainers,proto3" json:"listContainers"`
+	ListResources        ListResourcesRsp      `protobuf:"bytes,25,opt,name=listResources,proto3" json:"listResources"`
+	RemoveContainer      RemoveContainerRsp    `protobuf:"bytes,26,opt,name=removeContainer,proto3" json:"removeContainer"`
+	UpContainer          UpContainerRsp        `protobuf:"bytes,27,opt,name=upContainer,proto3" json:"upContainer"`
+	SetContainerWeight   SetContainerWeightRsp `protobuf:"bytes,28,opt,name=setContainerWeight,proto3" json:"setContainerWeight"`
+	AddScheduler         AddSchedulerRsp       `protobuf:"bytes,29,opt,name=addScheduler,proto3" json:"addScheduler"`
+	RemoveScheduler      RemoveSchedulerRsp    `protobuf:"bytes,30,opt,name=removeScheduler,proto3" json:"removeScheduler"`
+	PauseScheduler       PauseSchedulerRsp     `protobuf:"bytes,31,opt,name=pauseScheduler,proto3" json:"pauseScheduler"`
+	ListSchedulers       ListSchedulersRsp     `protobuf:"bytes,32,opt,name=listSchedulers,proto3" json:"listSchedulers"`
+	AddOperator          AddOperatorRsp        `protobuf:"bytes,33,opt,name=addOperator,proto3" json:"addOperator"`
+	RemoveOperator       RemoveOperatorRsp     `protobuf:"bytes,34,opt,name=removeOperator,proto3" json:"removeOperator"`
+	GetOperatorStatus    GetOperatorStatusRsp  `protobuf:"bytes,35,opt,name=getOperatorStatus,proto3" json:"getOperatorStatus"`
+	ListJobs             ListJobsRsp           `protobuf:"bytes,36,opt,name=listJobs,proto3" json:"listJobs"`
+	GetJob               GetJobRsp             `protobuf:"bytes,37,opt,name=getJob,proto3" json:"getJob"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -652,6 +907,111 @@ func (m *Response) GetExecuteJob() ExecuteJobRsp {
 	return ExecuteJobRsp{}
 }
 
+func (m *Response) GetGetTimestamp() GetTimestampRsp {
+	if m != nil {
+		return m.GetTimestamp
+	}
+	return GetTimestampRsp{}
+}
+
+func (m *Response) GetListContainers() ListContainersRsp {
+	if m != nil {
+		return m.ListContainers
+	}
+	return ListContainersRsp{}
+}
+
+func (m *Response) GetListResources() ListResourcesRsp {
+	if m != nil {
+		return m.ListResources
+	}
+	return ListResourcesRsp{}
+}
+
+func (m *Response) GetRemoveContainer() RemoveContainerRsp {
+	if m != nil {
+		return m.RemoveContainer
+	}
+	return RemoveContainerRsp{}
+}
+
+func (m *Response) GetUpContainer() UpContainerRsp {
+	if m != nil {
+		return m.UpContainer
+	}
+	return UpContainerRsp{}
+}
+
+func (m *Response) GetSetContainerWeight() SetContainerWeightRsp {
+	if m != nil {
+		return m.SetContainerWeight
+	}
+	return SetContainerWeightRsp{}
+}
+
+func (m *Response) GetAddScheduler() AddSchedulerRsp {
+	if m != nil {
+		return m.AddScheduler
+	}
+	return AddSchedulerRsp{}
+}
+
+func (m *Response) GetRemoveScheduler() RemoveSchedulerRsp {
+	if m != nil {
+		return m.RemoveScheduler
+	}
+	return RemoveSchedulerRsp{}
+}
+
+func (m *Response) GetPauseScheduler() PauseSchedulerRsp {
+	if m != nil {
+		return m.PauseScheduler
+	}
+	return PauseSchedulerRsp{}
+}
+
+func (m *Response) GetListSchedulers() ListSchedulersRsp {
+	if m != nil {
+		return m.ListSchedulers
+	}
+	return ListSchedulersRsp{}
+}
+
+func (m *Response) GetAddOperator() AddOperatorRsp {
+	if m != nil {
+		return m.AddOperator
+	}
+	return AddOperatorRsp{}
+}
+
+func (m *Response) GetRemoveOperator() RemoveOperatorRsp {
+	if m != nil {
+		return m.RemoveOperator
+	}
+	return RemoveOperatorRsp{}
+}
+
+func (m *Response) GetGetOperatorStatus() GetOperatorStatusRsp {
+	if m != nil {
+		return m.GetOperatorStatus
+	}
+	return GetOperatorStatusRsp{}
+}
+
+func (m *Response) GetListJobs() ListJobsRsp {
+	if m != nil {
+		return m.ListJobs
+	}
+	return ListJobsRsp{}
+}
+
+func (m *Response) GetGetJob() GetJobRsp {
+	if m != nil {
+		return m.GetJob
+	}
+	return GetJobRsp{}
+}
+
 // ResourceHeartbeatReq resource heartbeat request
 type ResourceHeartbeatReq struct {
 	ContainerID uint64 `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
@@ -2263,9 +2623,10 @@ func (m *CreateJobReq) GetJob() metapb.Job {
 
 // CreateJobRsp create job rsp
 type CreateJobRsp struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Job                  metapb.Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateJobRsp) Reset()         { *m = CreateJobRsp{} }
@@ -2301,6 +2662,13 @@ func (m *CreateJobRsp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateJobRsp proto.InternalMessageInfo
 
+func (m *CreateJobRsp) GetJob() metapb.Job {
+	if m != nil {
+		return m.Job
+	}
+	return metapb.Job{}
+}
+
 // RemoveJobReq Remove job req
 type RemoveJobReq struct {
 	Job                  metapb.Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job"`
@@ -2493,32 +2861,26 @@ func (m *ExecuteJobRsp) GetData() []byte {
 	return nil
 }
 
-// EventNotify event notify
-type EventNotify struct {
-	Seq                  uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	Type                 uint32                 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	InitEvent            *InitEventData         `protobuf:"bytes,3,opt,name=initEvent,proto3" json:"initEvent,omitempty"`
-	ResourceEvent        *ResourceEventData     `protobuf:"bytes,4,opt,name=resourceEvent,proto3" json:"resourceEvent,omitempty"`
-	ContainerEvent       *ContainerEventData    `protobuf:"bytes,5,opt,name=containerEvent,proto3" json:"containerEvent,omitempty"`
-	ResourceStatsEvent   *metapb.ResourceStats  `protobuf:"bytes,6,opt,name=resourceStatsEvent,proto3" json:"resourceStatsEvent,omitempty"`
-	ContainerStatsEvent  *metapb.ContainerStats `protobuf:"bytes,7,opt,name=containerStatsEvent,proto3" json:"containerStatsEvent,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+// GetTimestampReq get timestamp request
+type GetTimestampReq struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EventNotify) Reset()         { *m = EventNotify{} }
-func (m *EventNotify) String() string { return proto.CompactTextString(m) }
-func (*EventNotify) ProtoMessage()    {}
-func (*EventNotify) Descriptor() ([]byte, []int) {
+func (m *GetTimestampReq) Reset()         { *m = GetTimestampReq{} }
+func (m *GetTimestampReq) String() string { return proto.CompactTextString(m) }
+func (*GetTimestampReq) ProtoMessage()    {}
+func (*GetTimestampReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_25e491924c678914, []int{38}
 }
-func (m *EventNotify) XXX_Unmarshal(b []byte) error {
+func (m *GetTimestampReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTimestampReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventNotify.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTimestampReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2528,89 +2890,93 @@ func (m *EventNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventNotify) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventNotify.Merge(m, src)
+func (m *GetTimestampReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimestampReq.Merge(m, src)
 }
-func (m *EventNotify) XXX_Size() int {
+func (m *GetTimestampReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventNotify) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventNotify.DiscardUnknown(m)
+func (m *GetTimestampReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimestampReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventNotify proto.InternalMessageInfo
+var xxx_messageInfo_GetTimestampReq proto.InternalMessageInfo
 
-func (m *EventNotify) GetSeq() uint64 {
+func (m *GetTimestampReq) GetCount() uint32 {
 	if m != nil {
-		return m.Seq
+		return m.Count
 	}
 	return 0
 }
 
-func (m *EventNotify) GetType() uint32 {
-	if m != nil {
-		return m.Type
-	}
-	return 0
+// GetTimestampRsp get timestamp response, the timestamp is the first one of the allocated
+// timestamps, and the others are timestamp+1, timestamp+2 ... timestamp+count-1.
+type GetTimestampRsp struct {
+	Timestamp            uint64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EventNotify) GetInitEvent() *InitEventData {
-	if m != nil {
-		return m.InitEvent
-	}
-	return nil
+func (m *GetTimestampRsp) Reset()         { *m = GetTimestampRsp{} }
+func (m *GetTimestampRsp) String() string { return proto.CompactTextString(m) }
+func (*GetTimestampRsp) ProtoMessage()    {}
+func (*GetTimestampRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{39}
 }
-
-func (m *EventNotify) GetResourceEvent() *ResourceEventData {
-	if m != nil {
-		return m.ResourceEvent
-	}
-	return nil
+func (m *GetTimestampRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventNotify) GetContainerEvent() *ContainerEventData {
-	if m != nil {
-		return m.ContainerEvent
+func (m *GetTimestampRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTimestampRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *EventNotify) GetResourceStatsEvent() *metapb.ResourceStats {
-	if m != nil {
-		return m.ResourceStatsEvent
-	}
-	return nil
+func (m *GetTimestampRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTimestampRsp.Merge(m, src)
+}
+func (m *GetTimestampRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTimestampRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTimestampRsp.DiscardUnknown(m)
 }
 
-func (m *EventNotify) GetContainerStatsEvent() *metapb.ContainerStats {
+var xxx_messageInfo_GetTimestampRsp proto.InternalMessageInfo
+
+func (m *GetTimestampRsp) GetTimestamp() uint64 {
 	if m != nil {
-		return m.ContainerStatsEvent
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-// InitEventData init event data
-type InitEventData struct {
-	Resources            [][]byte `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
-	Leaders              []uint64 `protobuf:"varint,2,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`
-	Containers           [][]byte `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
+// ListContainersReq list containers request
+type ListContainersReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InitEventData) Reset()         { *m = InitEventData{} }
-func (m *InitEventData) String() string { return proto.CompactTextString(m) }
-func (*InitEventData) ProtoMessage()    {}
-func (*InitEventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{39}
+func (m *ListContainersReq) Reset()         { *m = ListContainersReq{} }
+func (m *ListContainersReq) String() string { return proto.CompactTextString(m) }
+func (*ListContainersReq) ProtoMessage()    {}
+func (*ListContainersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{40}
 }
-func (m *InitEventData) XXX_Unmarshal(b []byte) error {
+func (m *ListContainersReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListContainersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitEventData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListContainersReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2620,62 +2986,41 @@ func (m *InitEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *InitEventData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitEventData.Merge(m, src)
+func (m *ListContainersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContainersReq.Merge(m, src)
 }
-func (m *InitEventData) XXX_Size() int {
+func (m *ListContainersReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitEventData) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitEventData.DiscardUnknown(m)
+func (m *ListContainersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContainersReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitEventData proto.InternalMessageInfo
-
-func (m *InitEventData) GetResources() [][]byte {
-	if m != nil {
-		return m.Resources
-	}
-	return nil
-}
+var xxx_messageInfo_ListContainersReq proto.InternalMessageInfo
 
-func (m *InitEventData) GetLeaders() []uint64 {
-	if m != nil {
-		return m.Leaders
-	}
-	return nil
-}
-
-func (m *InitEventData) GetContainers() [][]byte {
-	if m != nil {
-		return m.Containers
-	}
-	return nil
-}
-
-// ResourceEventData resource created or updated
-type ResourceEventData struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Leader               uint64   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
-	Removed              bool     `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
-	Create               bool     `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ContainerInfo the container info used by the admin api
+type ContainerInfo struct {
+	Data                 []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Stats                *metapb.ContainerStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	LeaderWeight         float64                `protobuf:"fixed64,3,opt,name=leaderWeight,proto3" json:"leaderWeight,omitempty"`
+	ResourceWeight       float64                `protobuf:"fixed64,4,opt,name=resourceWeight,proto3" json:"resourceWeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *ResourceEventData) Reset()         { *m = ResourceEventData{} }
-func (m *ResourceEventData) String() string { return proto.CompactTextString(m) }
-func (*ResourceEventData) ProtoMessage()    {}
-func (*ResourceEventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{40}
+func (m *ContainerInfo) Reset()         { *m = ContainerInfo{} }
+func (m *ContainerInfo) String() string { return proto.CompactTextString(m) }
+func (*ContainerInfo) ProtoMessage()    {}
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{41}
 }
-func (m *ResourceEventData) XXX_Unmarshal(b []byte) error {
+func (m *ContainerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResourceEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContainerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResourceEventData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContainerInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2685,66 +3030,66 @@ func (m *ResourceEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ResourceEventData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResourceEventData.Merge(m, src)
+func (m *ContainerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerInfo.Merge(m, src)
 }
-func (m *ResourceEventData) XXX_Size() int {
+func (m *ContainerInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResourceEventData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResourceEventData.DiscardUnknown(m)
+func (m *ContainerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResourceEventData proto.InternalMessageInfo
+var xxx_messageInfo_ContainerInfo proto.InternalMessageInfo
 
-func (m *ResourceEventData) GetData() []byte {
+func (m *ContainerInfo) GetData() []byte {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-func (m *ResourceEventData) GetLeader() uint64 {
+func (m *ContainerInfo) GetStats() *metapb.ContainerStats {
 	if m != nil {
-		return m.Leader
+		return m.Stats
 	}
-	return 0
+	return nil
 }
 
-func (m *ResourceEventData) GetRemoved() bool {
+func (m *ContainerInfo) GetLeaderWeight() float64 {
 	if m != nil {
-		return m.Removed
+		return m.LeaderWeight
 	}
-	return false
+	return 0
 }
 
-func (m *ResourceEventData) GetCreate() bool {
+func (m *ContainerInfo) GetResourceWeight() float64 {
 	if m != nil {
-		return m.Create
+		return m.ResourceWeight
 	}
-	return false
+	return 0
 }
 
-// ContainerEventData container created or updated
-type ContainerEventData struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ListContainersRsp list containers response
+type ListContainersRsp struct {
+	Containers           []ContainerInfo `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ContainerEventData) Reset()         { *m = ContainerEventData{} }
-func (m *ContainerEventData) String() string { return proto.CompactTextString(m) }
-func (*ContainerEventData) ProtoMessage()    {}
-func (*ContainerEventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{41}
+func (m *ListContainersRsp) Reset()         { *m = ListContainersRsp{} }
+func (m *ListContainersRsp) String() string { return proto.CompactTextString(m) }
+func (*ListContainersRsp) ProtoMessage()    {}
+func (*ListContainersRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{42}
 }
-func (m *ContainerEventData) XXX_Unmarshal(b []byte) error {
+func (m *ListContainersRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContainerEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListContainersRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContainerEventData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListContainersRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2754,46 +3099,44 @@ func (m *ContainerEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ContainerEventData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerEventData.Merge(m, src)
+func (m *ListContainersRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListContainersRsp.Merge(m, src)
 }
-func (m *ContainerEventData) XXX_Size() int {
+func (m *ListContainersRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContainerEventData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerEventData.DiscardUnknown(m)
+func (m *ListContainersRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListContainersRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerEventData proto.InternalMessageInfo
+var xxx_messageInfo_ListContainersRsp proto.InternalMessageInfo
 
-func (m *ContainerEventData) GetData() []byte {
+func (m *ListContainersRsp) GetContainers() []ContainerInfo {
 	if m != nil {
-		return m.Data
+		return m.Containers
 	}
 	return nil
 }
 
-// ChangePeer change peer
-type ChangePeer struct {
-	Peer                 metapb.Peer           `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
-	ChangeType           metapb.ChangePeerType `protobuf:"varint,2,opt,name=changeType,proto3,enum=metapb.ChangePeerType" json:"changeType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// ListResourcesReq list resources request
+type ListResourcesReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
-func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
-func (*ChangePeer) ProtoMessage()    {}
-func (*ChangePeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{42}
+func (m *ListResourcesReq) Reset()         { *m = ListResourcesReq{} }
+func (m *ListResourcesReq) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesReq) ProtoMessage()    {}
+func (*ListResourcesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{43}
 }
-func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
+func (m *ListResourcesReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListResourcesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePeer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListResourcesReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2803,52 +3146,41 @@ func (m *ChangePeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChangePeer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePeer.Merge(m, src)
+func (m *ListResourcesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesReq.Merge(m, src)
 }
-func (m *ChangePeer) XXX_Size() int {
+func (m *ListResourcesReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePeer) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePeer.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ChangePeer proto.InternalMessageInfo
-
-func (m *ChangePeer) GetPeer() metapb.Peer {
-	if m != nil {
-		return m.Peer
-	}
-	return metapb.Peer{}
+func (m *ListResourcesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesReq.DiscardUnknown(m)
 }
 
-func (m *ChangePeer) GetChangeType() metapb.ChangePeerType {
-	if m != nil {
-		return m.ChangeType
-	}
-	return metapb.ChangePeerType_AddNode
-}
+var xxx_messageInfo_ListResourcesReq proto.InternalMessageInfo
 
-// TransferLeader transfer leader
-type TransferLeader struct {
-	Peer                 metapb.Peer `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// ResourceInfo the resource info used by the admin api
+type ResourceInfo struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Leader               uint64   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	ApproximateSize      int64    `protobuf:"varint,3,opt,name=approximateSize,proto3" json:"approximateSize,omitempty"`
+	ApproximateKeys      int64    `protobuf:"varint,4,opt,name=approximateKeys,proto3" json:"approximateKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
-func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
-func (*TransferLeader) ProtoMessage()    {}
-func (*TransferLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{43}
+func (m *ResourceInfo) Reset()         { *m = ResourceInfo{} }
+func (m *ResourceInfo) String() string { return proto.CompactTextString(m) }
+func (*ResourceInfo) ProtoMessage()    {}
+func (*ResourceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{44}
 }
-func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
+func (m *ResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransferLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransferLeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2858,46 +3190,66 @@ func (m *TransferLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *TransferLeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransferLeader.Merge(m, src)
+func (m *ResourceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceInfo.Merge(m, src)
 }
-func (m *TransferLeader) XXX_Size() int {
+func (m *ResourceInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *TransferLeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransferLeader.DiscardUnknown(m)
+func (m *ResourceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransferLeader proto.InternalMessageInfo
+var xxx_messageInfo_ResourceInfo proto.InternalMessageInfo
 
-func (m *TransferLeader) GetPeer() metapb.Peer {
+func (m *ResourceInfo) GetData() []byte {
 	if m != nil {
-		return m.Peer
+		return m.Data
 	}
-	return metapb.Peer{}
+	return nil
 }
 
-// ChangePeerV2 change peer v2
-type ChangePeerV2 struct {
-	// If changes is empty, it means that to exit joint state.
-	Changes              []ChangePeer `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *ResourceInfo) GetLeader() uint64 {
+	if m != nil {
+		return m.Leader
+	}
+	return 0
 }
 
-func (m *ChangePeerV2) Reset()         { *m = ChangePeerV2{} }
-func (m *ChangePeerV2) String() string { return proto.CompactTextString(m) }
-func (*ChangePeerV2) ProtoMessage()    {}
-func (*ChangePeerV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{44}
+func (m *ResourceInfo) GetApproximateSize() int64 {
+	if m != nil {
+		return m.ApproximateSize
+	}
+	return 0
 }
-func (m *ChangePeerV2) XXX_Unmarshal(b []byte) error {
+
+func (m *ResourceInfo) GetApproximateKeys() int64 {
+	if m != nil {
+		return m.ApproximateKeys
+	}
+	return 0
+}
+
+// ListResourcesRsp list resources response
+type ListResourcesRsp struct {
+	Resources            []ResourceInfo `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListResourcesRsp) Reset()         { *m = ListResourcesRsp{} }
+func (m *ListResourcesRsp) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesRsp) ProtoMessage()    {}
+func (*ListResourcesRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{45}
+}
+func (m *ListResourcesRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePeerV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListResourcesRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePeerV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListResourcesRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2907,46 +3259,47 @@ func (m *ChangePeerV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ChangePeerV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePeerV2.Merge(m, src)
+func (m *ListResourcesRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesRsp.Merge(m, src)
 }
-func (m *ChangePeerV2) XXX_Size() int {
+func (m *ListResourcesRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePeerV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePeerV2.DiscardUnknown(m)
+func (m *ListResourcesRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePeerV2 proto.InternalMessageInfo
+var xxx_messageInfo_ListResourcesRsp proto.InternalMessageInfo
 
-func (m *ChangePeerV2) GetChanges() []ChangePeer {
+func (m *ListResourcesRsp) GetResources() []ResourceInfo {
 	if m != nil {
-		return m.Changes
+		return m.Resources
 	}
 	return nil
 }
 
-// Merge merge
-type Merge struct {
-	// target resource
-	Target               []byte   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+// RemoveContainerReq take the container offline, all the resource peers on it
+// will be moved to other containers
+type RemoveContainerReq struct {
+	ContainerID          uint64   `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	PhysicallyDestroyed  bool     `protobuf:"varint,2,opt,name=physicallyDestroyed,proto3" json:"physicallyDestroyed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Merge) Reset()         { *m = Merge{} }
-func (m *Merge) String() string { return proto.CompactTextString(m) }
-func (*Merge) ProtoMessage()    {}
-func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{45}
+func (m *RemoveContainerReq) Reset()         { *m = RemoveContainerReq{} }
+func (m *RemoveContainerReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveContainerReq) ProtoMessage()    {}
+func (*RemoveContainerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{46}
 }
-func (m *Merge) XXX_Unmarshal(b []byte) error {
+func (m *RemoveContainerReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Merge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveContainerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Merge.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveContainerReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -2956,46 +3309,51 @@ func (m *Merge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Merge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Merge.Merge(m, src)
+func (m *RemoveContainerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveContainerReq.Merge(m, src)
 }
-func (m *Merge) XXX_Size() int {
+func (m *RemoveContainerReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *Merge) XXX_DiscardUnknown() {
-	xxx_messageInfo_Merge.DiscardUnknown(m)
+func (m *RemoveContainerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveContainerReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Merge proto.InternalMessageInfo
+var xxx_messageInfo_RemoveContainerReq proto.InternalMessageInfo
 
-func (m *Merge) GetTarget() []byte {
+func (m *RemoveContainerReq) GetContainerID() uint64 {
 	if m != nil {
-		return m.Target
+		return m.ContainerID
 	}
-	return nil
+	return 0
 }
 
-// SplitResource split resource
-type SplitResource struct {
-	Policy               metapb.CheckPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=metapb.CheckPolicy" json:"policy,omitempty"`
-	Keys                 [][]byte           `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *RemoveContainerReq) GetPhysicallyDestroyed() bool {
+	if m != nil {
+		return m.PhysicallyDestroyed
+	}
+	return false
 }
 
-func (m *SplitResource) Reset()         { *m = SplitResource{} }
-func (m *SplitResource) String() string { return proto.CompactTextString(m) }
-func (*SplitResource) ProtoMessage()    {}
-func (*SplitResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{46}
+// RemoveContainerRsp remove container response
+type RemoveContainerRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *SplitResource) XXX_Unmarshal(b []byte) error {
+
+func (m *RemoveContainerRsp) Reset()         { *m = RemoveContainerRsp{} }
+func (m *RemoveContainerRsp) String() string { return proto.CompactTextString(m) }
+func (*RemoveContainerRsp) ProtoMessage()    {}
+func (*RemoveContainerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{47}
+}
+func (m *RemoveContainerRsp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SplitResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RemoveContainerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SplitResource.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RemoveContainerRsp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3005,54 +3363,38 @@ func (m *SplitResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SplitResource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SplitResource.Merge(m, src)
+func (m *RemoveContainerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveContainerRsp.Merge(m, src)
 }
-func (m *SplitResource) XXX_Size() int {
+func (m *RemoveContainerRsp) XXX_Size() int {
 	return m.Size()
 }
-func (m *SplitResource) XXX_DiscardUnknown() {
-	xxx_messageInfo_SplitResource.DiscardUnknown(m)
+func (m *RemoveContainerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveContainerRsp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SplitResource proto.InternalMessageInfo
-
-func (m *SplitResource) GetPolicy() metapb.CheckPolicy {
-	if m != nil {
-		return m.Policy
-	}
-	return metapb.CheckPolicy_SCAN
-}
+var xxx_messageInfo_RemoveContainerRsp proto.InternalMessageInfo
 
-func (m *SplitResource) GetKeys() [][]byte {
-	if m != nil {
-		return m.Keys
-	}
-	return nil
-}
-
-// LabelConstraint is used to filter container when trying to place peer of a resource.
-type LabelConstraint struct {
-	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Op                   LabelConstraintOp `protobuf:"varint,2,opt,name=op,proto3,enum=rpcpb.LabelConstraintOp" json:"op,omitempty"`
-	Values               []string          `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// UpContainerReq bring the offline container back up
+type UpContainerReq struct {
+	ContainerID          uint64   `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LabelConstraint) Reset()         { *m = LabelConstraint{} }
-func (m *LabelConstraint) String() string { return proto.CompactTextString(m) }
-func (*LabelConstraint) ProtoMessage()    {}
-func (*LabelConstraint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{47}
+func (m *UpContainerReq) Reset()         { *m = UpContainerReq{} }
+func (m *UpContainerReq) String() string { return proto.CompactTextString(m) }
+func (*UpContainerReq) ProtoMessage()    {}
+func (*UpContainerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{48}
 }
-func (m *LabelConstraint) XXX_Unmarshal(b []byte) error {
+func (m *UpContainerReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LabelConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpContainerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LabelConstraint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpContainerReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3062,78 +3404,87 @@ func (m *LabelConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *LabelConstraint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LabelConstraint.Merge(m, src)
+func (m *UpContainerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpContainerReq.Merge(m, src)
 }
-func (m *LabelConstraint) XXX_Size() int {
+func (m *UpContainerReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *LabelConstraint) XXX_DiscardUnknown() {
-	xxx_messageInfo_LabelConstraint.DiscardUnknown(m)
+func (m *UpContainerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpContainerReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LabelConstraint proto.InternalMessageInfo
+var xxx_messageInfo_UpContainerReq proto.InternalMessageInfo
 
-func (m *LabelConstraint) GetKey() string {
+func (m *UpContainerReq) GetContainerID() uint64 {
 	if m != nil {
-		return m.Key
+		return m.ContainerID
 	}
-	return ""
+	return 0
 }
 
-func (m *LabelConstraint) GetOp() LabelConstraintOp {
-	if m != nil {
-		return m.Op
-	}
-	return In
+// UpContainerRsp up container response
+type UpContainerRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LabelConstraint) GetValues() []string {
-	if m != nil {
-		return m.Values
+func (m *UpContainerRsp) Reset()         { *m = UpContainerRsp{} }
+func (m *UpContainerRsp) String() string { return proto.CompactTextString(m) }
+func (*UpContainerRsp) ProtoMessage()    {}
+func (*UpContainerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{49}
+}
+func (m *UpContainerRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpContainerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpContainerRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *UpContainerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpContainerRsp.Merge(m, src)
+}
+func (m *UpContainerRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpContainerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpContainerRsp.DiscardUnknown(m)
 }
 
-// PlacementRule place rule
-type PlacementRule struct {
-	// ID unique ID within a group
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// GroupID mark the source that add the rule
-	GroupID string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	// Index rule apply order in a group, rule with less ID is applied first when indexes are equal
-	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	// Override when it is true, all rules with less indexes are disabled
-	Override bool   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
-	StartKey []byte `protobuf:"bytes,5,opt,name=startKey,proto3" json:"startKey,omitempty"`
-	EndKey   []byte `protobuf:"bytes,6,opt,name=endKey,proto3" json:"endKey,omitempty"`
-	// Role expected role of the peers
-	Role PeerRoleType `protobuf:"varint,7,opt,name=role,proto3,enum=rpcpb.PeerRoleType" json:"role,omitempty"`
-	// Count expected count of the peers
-	Count uint32 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
-	// LabelConstraints used to select containers to place peers
-	LabelConstraints []LabelConstraint `protobuf:"bytes,9,rep,name=labelConstraints,proto3" json:"labelConstraints"`
-	// LocationLabels used to make peers isolated physically
-	LocationLabels []string `protobuf:"bytes,10,rep,name=locationLabels,proto3" json:"locationLabels,omitempty"`
-	// IsolationLevelused to isolate replicas explicitly and forcibly
-	IsolationLevel       string   `protobuf:"bytes,11,opt,name=isolationLevel,proto3" json:"isolationLevel,omitempty"`
+var xxx_messageInfo_UpContainerRsp proto.InternalMessageInfo
+
+// SetContainerWeightReq set container weight request
+type SetContainerWeightReq struct {
+	ContainerID          uint64   `protobuf:"varint,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	LeaderWeight         float64  `protobuf:"fixed64,2,opt,name=leaderWeight,proto3" json:"leaderWeight,omitempty"`
+	ResourceWeight       float64  `protobuf:"fixed64,3,opt,name=resourceWeight,proto3" json:"resourceWeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlacementRule) Reset()         { *m = PlacementRule{} }
-func (m *PlacementRule) String() string { return proto.CompactTextString(m) }
-func (*PlacementRule) ProtoMessage()    {}
-func (*PlacementRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25e491924c678914, []int{48}
+func (m *SetContainerWeightReq) Reset()         { *m = SetContainerWeightReq{} }
+func (m *SetContainerWeightReq) String() string { return proto.CompactTextString(m) }
+func (*SetContainerWeightReq) ProtoMessage()    {}
+func (*SetContainerWeightReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{50}
 }
-func (m *PlacementRule) XXX_Unmarshal(b []byte) error {
+func (m *SetContainerWeightReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PlacementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetContainerWeightReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PlacementRule.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetContainerWeightReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3143,1637 +3494,2015 @@ func (m *PlacementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *PlacementRule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlacementRule.Merge(m, src)
+func (m *SetContainerWeightReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetContainerWeightReq.Merge(m, src)
 }
-func (m *PlacementRule) XXX_Size() int {
+func (m *SetContainerWeightReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *PlacementRule) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlacementRule.DiscardUnknown(m)
+func (m *SetContainerWeightReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetContainerWeightReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlacementRule proto.InternalMessageInfo
+var xxx_messageInfo_SetContainerWeightReq proto.InternalMessageInfo
 
-func (m *PlacementRule) GetID() string {
+func (m *SetContainerWeightReq) GetContainerID() uint64 {
 	if m != nil {
-		return m.ID
+		return m.ContainerID
 	}
-	return ""
+	return 0
 }
 
-func (m *PlacementRule) GetGroupID() string {
+func (m *SetContainerWeightReq) GetLeaderWeight() float64 {
 	if m != nil {
-		return m.GroupID
+		return m.LeaderWeight
 	}
-	return ""
+	return 0
 }
 
-func (m *PlacementRule) GetIndex() uint32 {
+func (m *SetContainerWeightReq) GetResourceWeight() float64 {
 	if m != nil {
-		return m.Index
+		return m.ResourceWeight
 	}
 	return 0
 }
 
-func (m *PlacementRule) GetOverride() bool {
-	if m != nil {
-		return m.Override
-	}
-	return false
+// SetContainerWeightRsp set container weight response
+type SetContainerWeightRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlacementRule) GetStartKey() []byte {
-	if m != nil {
-		return m.StartKey
+func (m *SetContainerWeightRsp) Reset()         { *m = SetContainerWeightRsp{} }
+func (m *SetContainerWeightRsp) String() string { return proto.CompactTextString(m) }
+func (*SetContainerWeightRsp) ProtoMessage()    {}
+func (*SetContainerWeightRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{51}
+}
+func (m *SetContainerWeightRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetContainerWeightRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetContainerWeightRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SetContainerWeightRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetContainerWeightRsp.Merge(m, src)
+}
+func (m *SetContainerWeightRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetContainerWeightRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetContainerWeightRsp.DiscardUnknown(m)
 }
 
-func (m *PlacementRule) GetEndKey() []byte {
-	if m != nil {
-		return m.EndKey
-	}
-	return nil
+var xxx_messageInfo_SetContainerWeightRsp proto.InternalMessageInfo
+
+// AddSchedulerReq add scheduler request
+type AddSchedulerReq struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Args                 []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlacementRule) GetRole() PeerRoleType {
-	if m != nil {
-		return m.Role
+func (m *AddSchedulerReq) Reset()         { *m = AddSchedulerReq{} }
+func (m *AddSchedulerReq) String() string { return proto.CompactTextString(m) }
+func (*AddSchedulerReq) ProtoMessage()    {}
+func (*AddSchedulerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{52}
+}
+func (m *AddSchedulerReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddSchedulerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddSchedulerReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Voter
+}
+func (m *AddSchedulerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSchedulerReq.Merge(m, src)
+}
+func (m *AddSchedulerReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddSchedulerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSchedulerReq.DiscardUnknown(m)
 }
 
-func (m *PlacementRule) GetCount() uint32 {
+var xxx_messageInfo_AddSchedulerReq proto.InternalMessageInfo
+
+func (m *AddSchedulerReq) GetType() string {
 	if m != nil {
-		return m.Count
+		return m.Type
 	}
-	return 0
+	return ""
 }
 
-func (m *PlacementRule) GetLabelConstraints() []LabelConstraint {
+func (m *AddSchedulerReq) GetArgs() []string {
 	if m != nil {
-		return m.LabelConstraints
+		return m.Args
 	}
 	return nil
 }
 
-func (m *PlacementRule) GetLocationLabels() []string {
-	if m != nil {
-		return m.LocationLabels
+// AddSchedulerRsp add scheduler response
+type AddSchedulerRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSchedulerRsp) Reset()         { *m = AddSchedulerRsp{} }
+func (m *AddSchedulerRsp) String() string { return proto.CompactTextString(m) }
+func (*AddSchedulerRsp) ProtoMessage()    {}
+func (*AddSchedulerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{53}
+}
+func (m *AddSchedulerRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddSchedulerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddSchedulerRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *AddSchedulerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSchedulerRsp.Merge(m, src)
+}
+func (m *AddSchedulerRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddSchedulerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSchedulerRsp.DiscardUnknown(m)
 }
 
-func (m *PlacementRule) GetIsolationLevel() string {
+var xxx_messageInfo_AddSchedulerRsp proto.InternalMessageInfo
+
+// RemoveSchedulerReq remove scheduler request
+type RemoveSchedulerReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveSchedulerReq) Reset()         { *m = RemoveSchedulerReq{} }
+func (m *RemoveSchedulerReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveSchedulerReq) ProtoMessage()    {}
+func (*RemoveSchedulerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{54}
+}
+func (m *RemoveSchedulerReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveSchedulerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveSchedulerReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveSchedulerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSchedulerReq.Merge(m, src)
+}
+func (m *RemoveSchedulerReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveSchedulerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSchedulerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSchedulerReq proto.InternalMessageInfo
+
+func (m *RemoveSchedulerReq) GetName() string {
 	if m != nil {
-		return m.IsolationLevel
+		return m.Name
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("rpcpb.Type", Type_name, Type_value)
-	proto.RegisterEnum("rpcpb.PeerRoleType", PeerRoleType_name, PeerRoleType_value)
-	proto.RegisterEnum("rpcpb.LabelConstraintOp", LabelConstraintOp_name, LabelConstraintOp_value)
-	proto.RegisterType((*Request)(nil), "rpcpb.Request")
-	proto.RegisterType((*Response)(nil), "rpcpb.Response")
-	proto.RegisterType((*ResourceHeartbeatReq)(nil), "rpcpb.ResourceHeartbeatReq")
-	proto.RegisterType((*ResourceHeartbeatRsp)(nil), "rpcpb.ResourceHeartbeatRsp")
-	proto.RegisterType((*PutContainerReq)(nil), "rpcpb.PutContainerReq")
-	proto.RegisterType((*PutContainerRsp)(nil), "rpcpb.PutContainerRsp")
-	proto.RegisterType((*ContainerHeartbeatReq)(nil), "rpcpb.ContainerHeartbeatReq")
-	proto.RegisterType((*ContainerHeartbeatRsp)(nil), "rpcpb.ContainerHeartbeatRsp")
-	proto.RegisterType((*GetContainerReq)(nil), "rpcpb.GetContainerReq")
-	proto.RegisterType((*GetContainerRsp)(nil), "rpcpb.GetContainerRsp")
-	proto.RegisterType((*AllocIDReq)(nil), "rpcpb.AllocIDReq")
-	proto.RegisterType((*AllocIDRsp)(nil), "rpcpb.AllocIDRsp")
-	proto.RegisterType((*AskSplitReq)(nil), "rpcpb.AskSplitReq")
-	proto.RegisterType((*AskSplitRsp)(nil), "rpcpb.AskSplitRsp")
-	proto.RegisterType((*ReportSplitReq)(nil), "rpcpb.ReportSplitReq")
-	proto.RegisterType((*ReportSplitRsp)(nil), "rpcpb.ReportSplitRsp")
-	proto.RegisterType((*AskBatchSplitReq)(nil), "rpcpb.AskBatchSplitReq")
-	proto.RegisterType((*AskBatchSplitRsp)(nil), "rpcpb.AskBatchSplitRsp")
-	proto.RegisterType((*BatchReportSplitReq)(nil), "rpcpb.BatchReportSplitReq")
-	proto.RegisterType((*BatchReportSplitRsp)(nil), "rpcpb.BatchReportSplitRsp")
-	proto.RegisterType((*SplitID)(nil), "rpcpb.SplitID")
-	proto.RegisterType((*CreateWatcherReq)(nil), "rpcpb.CreateWatcherReq")
-	proto.RegisterType((*CreateResourcesReq)(nil), "rpcpb.CreateResourcesReq")
-	proto.RegisterType((*CreateResourcesRsp)(nil), "rpcpb.CreateResourcesRsp")
-	proto.RegisterType((*RemoveResourcesReq)(nil), "rpcpb.RemoveResourcesReq")
-	proto.RegisterType((*RemoveResourcesRsp)(nil), "rpcpb.RemoveResourcesRsp")
-	proto.RegisterType((*CheckResourceStateReq)(nil), "rpcpb.CheckResourceStateReq")
-	proto.RegisterType((*CheckResourceStateRsp)(nil), "rpcpb.CheckResourceStateRsp")
-	proto.RegisterType((*PutPlacementRuleReq)(nil), "rpcpb.PutPlacementRuleReq")
-	proto.RegisterType((*PutPlacementRuleRsp)(nil), "rpcpb.PutPlacementRuleRsp")
-	proto.RegisterType((*GetAppliedRulesReq)(nil), "rpcpb.GetAppliedRulesReq")
-	proto.RegisterType((*GetAppliedRulesRsp)(nil), "rpcpb.GetAppliedRulesRsp")
-	proto.RegisterType((*CreateJobReq)(nil), "rpcpb.CreateJobReq")
-	proto.RegisterType((*CreateJobRsp)(nil), "rpcpb.CreateJobRsp")
-	proto.RegisterType((*RemoveJobReq)(nil), "rpcpb.RemoveJobReq")
-	proto.RegisterType((*RemoveJobRsp)(nil), "rpcpb.RemoveJobRsp")
-	proto.RegisterType((*ExecuteJobReq)(nil), "rpcpb.ExecuteJobReq")
-	proto.RegisterType((*ExecuteJobRsp)(nil), "rpcpb.ExecuteJobRsp")
-	proto.RegisterType((*EventNotify)(nil), "rpcpb.EventNotify")
-	proto.RegisterType((*InitEventData)(nil), "rpcpb.InitEventData")
-	proto.RegisterType((*ResourceEventData)(nil), "rpcpb.ResourceEventData")
-	proto.RegisterType((*ContainerEventData)(nil), "rpcpb.ContainerEventData")
-	proto.RegisterType((*ChangePeer)(nil), "rpcpb.ChangePeer")
-	proto.RegisterType((*TransferLeader)(nil), "rpcpb.TransferLeader")
-	proto.RegisterType((*ChangePeerV2)(nil), "rpcpb.ChangePeerV2")
-	proto.RegisterType((*Merge)(nil), "rpcpb.Merge")
-	proto.RegisterType((*SplitResource)(nil), "rpcpb.SplitResource")
-	proto.RegisterType((*LabelConstraint)(nil), "rpcpb.LabelConstraint")
-	proto.RegisterType((*PlacementRule)(nil), "rpcpb.PlacementRule")
+// RemoveSchedulerRsp remove scheduler response
+type RemoveSchedulerRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("rpcpb.proto", fileDescriptor_25e491924c678914) }
+func (m *RemoveSchedulerRsp) Reset()         { *m = RemoveSchedulerRsp{} }
+func (m *RemoveSchedulerRsp) String() string { return proto.CompactTextString(m) }
+func (*RemoveSchedulerRsp) ProtoMessage()    {}
+func (*RemoveSchedulerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{55}
+}
+func (m *RemoveSchedulerRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveSchedulerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveSchedulerRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveSchedulerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSchedulerRsp.Merge(m, src)
+}
+func (m *RemoveSchedulerRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveSchedulerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSchedulerRsp.DiscardUnknown(m)
+}
 
-var fileDescriptor_25e491924c678914 = []byte{
-	// 2369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4b, 0x73, 0xdc, 0xc6,
-	0x11, 0xd6, 0xbe, 0x77, 0x7b, 0x1f, 0x1c, 0xce, 0x92, 0x14, 0x44, 0xc9, 0x24, 0x0d, 0xa9, 0x14,
-	0x46, 0x76, 0xc8, 0x88, 0x72, 0xec, 0x94, 0x2a, 0x4a, 0x4c, 0x8a, 0xb4, 0x45, 0x45, 0x91, 0x59,
-	0x90, 0x4a, 0x39, 0xa6, 0xb0, 0xbb, 0xa3, 0x25, 0x42, 0x10, 0x18, 0x61, 0x66, 0x25, 0xf1, 0x96,
-	0x9f, 0x94, 0x53, 0x8e, 0x39, 0xe5, 0xe0, 0xa3, 0x7f, 0x40, 0x4a, 0x95, 0xf0, 0x6f, 0xe4, 0x92,
-	0x9a, 0x07, 0x80, 0xc1, 0x63, 0x97, 0xf4, 0x89, 0x98, 0xee, 0xfe, 0xbe, 0x99, 0x69, 0x0c, 0xbe,
-	0xe9, 0x5e, 0x42, 0x37, 0xa2, 0x63, 0x3a, 0xda, 0xa1, 0x51, 0xc8, 0x43, 0xdc, 0x90, 0x83, 0xf5,
-	0x17, 0x53, 0x8f, 0x9f, 0xce, 0x46, 0x3b, 0xe3, 0xf0, 0x7c, 0xf7, 0xdc, 0xe5, 0x91, 0xf7, 0x31,
-	0x8c, 0xbc, 0xa9, 0x17, 0xe8, 0xc1, 0x78, 0x36, 0x22, 0xbb, 0xe3, 0xf0, 0x9c, 0x86, 0x01, 0x09,
-	0x38, 0xdb, 0xa5, 0x51, 0x48, 0x4f, 0x09, 0xdf, 0xa5, 0xa3, 0xdd, 0x73, 0xc2, 0xdd, 0xe4, 0x8f,
-	0x22, 0x5d, 0xff, 0x95, 0xc1, 0x36, 0x0d, 0xa7, 0xe1, 0xae, 0x34, 0x8f, 0x66, 0x6f, 0xe5, 0x48,
-	0x0e, 0xe4, 0x93, 0x0a, 0xb7, 0xff, 0x09, 0xd0, 0x72, 0xc8, 0xbb, 0x19, 0x61, 0x1c, 0xaf, 0x41,
-	0xd5, 0x9b, 0x58, 0x95, 0xad, 0xca, 0x76, 0xfd, 0xa0, 0x79, 0xf9, 0x69, 0xb3, 0x7a, 0x7c, 0xe8,
-	0x54, 0xbd, 0x09, 0xde, 0x82, 0xee, 0x38, 0x0c, 0xb8, 0xeb, 0x05, 0x24, 0x3a, 0x3e, 0xb4, 0xaa,
-	0x22, 0xc0, 0x31, 0x4d, 0x78, 0x13, 0xea, 0xfc, 0x82, 0x12, 0xab, 0xb6, 0x55, 0xd9, 0x1e, 0xec,
-	0x75, 0x77, 0xd4, 0x2e, 0x5f, 0x5f, 0x50, 0xe2, 0x48, 0x07, 0xfe, 0x01, 0x96, 0x23, 0xc2, 0xc2,
-	0x59, 0x34, 0x26, 0xcf, 0x88, 0x1b, 0xf1, 0x11, 0x71, 0xb9, 0x55, 0xdf, 0xaa, 0x6c, 0x77, 0xf7,
-	0x6e, 0xeb, 0x68, 0x27, 0xef, 0x77, 0xc8, 0xbb, 0x83, 0xfa, 0x8f, 0x9f, 0x36, 0x6f, 0x38, 0x45,
-	0x2c, 0x76, 0x00, 0x27, 0x0b, 0x48, 0x19, 0x1b, 0x92, 0xf1, 0x8e, 0x66, 0x7c, 0x5a, 0x08, 0x48,
-	0x29, 0x4b, 0xd0, 0xf8, 0x5b, 0xe8, 0xd1, 0x19, 0x4f, 0x50, 0x56, 0x53, 0xb2, 0xad, 0x69, 0xb6,
-	0x13, 0xc3, 0x95, 0xf2, 0x64, 0x10, 0x82, 0x61, 0x4a, 0x0c, 0x86, 0x56, 0x86, 0xe1, 0x7b, 0x52,
-	0xca, 0x60, 0x22, 0xf0, 0x43, 0x68, 0xb9, 0xbe, 0x1f, 0x8e, 0x8f, 0x0f, 0xad, 0xb6, 0x04, 0x2f,
-	0x6b, 0xf0, 0xbe, 0xb2, 0xa6, 0xb8, 0x38, 0x0e, 0x7f, 0x05, 0x6d, 0x97, 0x9d, 0xbd, 0xa2, 0xbe,
-	0xc7, 0xad, 0x8e, 0xc4, 0xe0, 0x18, 0xa3, 0xcd, 0x29, 0x28, 0x89, 0xc4, 0x4f, 0xa1, 0xef, 0xb2,
-	0xb3, 0x03, 0x97, 0x8f, 0x4f, 0x15, 0x14, 0x24, 0xf4, 0x66, 0x0a, 0x4d, 0x7d, 0x29, 0x3e, 0x8b,
-	0xc1, 0x4f, 0xa0, 0x1b, 0x11, 0x1a, 0x46, 0x5c, 0x51, 0x74, 0x25, 0xc5, 0x6a, 0xf2, 0x42, 0x13,
-	0x4f, 0x4a, 0x60, 0xc6, 0xe3, 0x17, 0x80, 0x46, 0x82, 0xcc, 0x88, 0xb4, 0x7a, 0x92, 0x63, 0x5d,
-	0x73, 0x1c, 0xe4, 0xdc, 0x29, 0x51, 0x01, 0x29, 0x76, 0x34, 0x8e, 0x88, 0xcb, 0xc9, 0x9f, 0x85,
-	0x87, 0x44, 0x56, 0x3f, 0xb3, 0xa3, 0xa7, 0xa6, 0xcf, 0xd8, 0x51, 0x06, 0x83, 0x8f, 0x61, 0x49,
-	0x19, 0xe2, 0xe3, 0xc8, 0xac, 0x81, 0xa4, 0xb9, 0x95, 0xa1, 0x49, 0xbc, 0x29, 0x51, 0x1e, 0x27,
-	0xa8, 0x22, 0x72, 0x1e, 0xbe, 0x37, 0xa8, 0x96, 0x32, 0x54, 0x4e, 0xd6, 0x6b, 0x50, 0xe5, 0x70,
-	0xf2, 0xb4, 0x9f, 0x92, 0xf1, 0x59, 0x6c, 0x79, 0xc5, 0x5d, 0x4e, 0x2c, 0x94, 0x3d, 0xed, 0x85,
-	0x00, 0xf3, 0xb4, 0x17, 0x9c, 0x22, 0xf9, 0x74, 0xc6, 0x4f, 0x7c, 0x77, 0x4c, 0xce, 0x49, 0xc0,
-	0x9d, 0x99, 0x4f, 0xac, 0xe5, 0x4c, 0xf2, 0x4f, 0x72, 0x6e, 0x23, 0xf9, 0x79, 0xa4, 0xd8, 0xec,
-	0x94, 0xf0, 0x7d, 0x4a, 0x7d, 0x8f, 0x4c, 0x84, 0x85, 0x59, 0x38, 0xb3, 0xd9, 0xef, 0xb3, 0x5e,
-	0x63, 0xb3, 0x39, 0x1c, 0xfe, 0x06, 0x3a, 0x2a, 0x95, 0xcf, 0xc3, 0x91, 0x35, 0x94, 0x24, 0xc3,
-	0x4c, 0xf2, 0x9f, 0x87, 0xa3, 0x14, 0x9e, 0xc6, 0x0a, 0xa0, 0x4a, 0x9c, 0x00, 0xae, 0x64, 0x80,
-	0x4e, 0x6c, 0x37, 0x80, 0x49, 0x2c, 0x7e, 0x0c, 0x40, 0x3e, 0x92, 0xf1, 0x4c, 0x4d, 0xb9, 0x2a,
-	0x91, 0x2b, 0x1a, 0x79, 0x94, 0x38, 0x52, 0xa8, 0x11, 0x6d, 0xff, 0x03, 0xa0, 0xed, 0x10, 0x46,
-	0xc3, 0x80, 0x91, 0xb9, 0x0a, 0x1a, 0xeb, 0x63, 0x75, 0x9e, 0x3e, 0xae, 0x40, 0x83, 0x44, 0x51,
-	0x18, 0x49, 0x05, 0xed, 0x38, 0x6a, 0x80, 0xd7, 0xa0, 0xe9, 0x13, 0x77, 0x42, 0x22, 0x29, 0x95,
-	0x1d, 0x47, 0x8f, 0xca, 0xd5, 0xb4, 0x71, 0x85, 0x9a, 0x32, 0xfa, 0x73, 0xd5, 0xb4, 0x79, 0x95,
-	0x9a, 0x26, 0x94, 0xd7, 0x51, 0xd3, 0xd6, 0x7c, 0x35, 0x4d, 0x78, 0x16, 0xab, 0x69, 0x7b, 0xbe,
-	0x9a, 0xa6, 0x0c, 0xf3, 0xd4, 0xb4, 0x53, 0xaa, 0xa6, 0x09, 0xae, 0x54, 0x4d, 0xa1, 0x5c, 0x4d,
-	0x13, 0xd0, 0x02, 0x35, 0xed, 0x2e, 0x50, 0xd3, 0x04, 0xbf, 0x58, 0x4d, 0x7b, 0x73, 0xd5, 0x34,
-	0x21, 0xb8, 0x52, 0x4d, 0xfb, 0x8b, 0xd5, 0x34, 0x21, 0x2a, 0xaa, 0xe9, 0x0e, 0x34, 0xc8, 0x7b,
-	0x12, 0x70, 0x2d, 0x7f, 0x71, 0x12, 0x8e, 0x84, 0xed, 0x65, 0xc8, 0xbd, 0xb7, 0x17, 0x1a, 0xaa,
-	0xc2, 0xca, 0x84, 0x73, 0x69, 0xa1, 0x70, 0x26, 0x73, 0x5f, 0x47, 0x38, 0xd1, 0x42, 0xe1, 0x4c,
-	0xa9, 0xae, 0x27, 0x9c, 0xcb, 0x57, 0x09, 0xa7, 0x71, 0xb0, 0xaf, 0x27, 0x9c, 0x78, 0xb1, 0x70,
-	0xa6, 0x79, 0xbe, 0x8e, 0x70, 0x0e, 0x17, 0x0a, 0x67, 0xba, 0xd9, 0x85, 0xc2, 0xb9, 0x32, 0x47,
-	0x38, 0x13, 0xf8, 0x3c, 0xe1, 0x5c, 0x9d, 0x23, 0x9c, 0x29, 0x70, 0x9e, 0x70, 0xae, 0xcd, 0x13,
-	0xce, 0x04, 0x6a, 0x0a, 0xe7, 0xdf, 0xab, 0xb0, 0x52, 0x56, 0xf3, 0xe5, 0xcb, 0xcd, 0x4a, 0xb1,
-	0xdc, 0x5c, 0x87, 0x76, 0xac, 0x61, 0x52, 0x52, 0x7b, 0x4e, 0x32, 0xc6, 0x18, 0xea, 0x9c, 0x44,
-	0xe7, 0x52, 0x48, 0xeb, 0x8e, 0x7c, 0xc6, 0xf7, 0x32, 0x3a, 0xda, 0xdd, 0xeb, 0xed, 0xe8, 0x92,
-	0xf9, 0x84, 0x90, 0x28, 0x51, 0xd5, 0xdf, 0x40, 0x67, 0x12, 0x7e, 0x08, 0x84, 0x8d, 0x59, 0x8d,
-	0xad, 0x9a, 0x94, 0x0b, 0x23, 0x50, 0xbc, 0x7d, 0x16, 0xe7, 0x20, 0x89, 0xc4, 0x5f, 0x43, 0x8f,
-	0x92, 0x60, 0xe2, 0x05, 0x53, 0x85, 0x6c, 0x4a, 0x64, 0x66, 0x8a, 0x44, 0xdd, 0x8c, 0x38, 0xfc,
-	0x10, 0x1a, 0x4c, 0x30, 0x6a, 0x61, 0x5c, 0x8d, 0x01, 0xe6, 0x61, 0x8b, 0xa7, 0x53, 0x91, 0xf6,
-	0xbf, 0x6b, 0x65, 0x29, 0x63, 0x14, 0x6f, 0x00, 0xc4, 0x09, 0x48, 0x32, 0x66, 0x58, 0xf0, 0x3e,
-	0xf4, 0xe3, 0xd1, 0x11, 0x0d, 0xc7, 0xa7, 0x32, 0x6b, 0x25, 0x73, 0x4a, 0x67, 0x2c, 0x4e, 0x19,
-	0x04, 0xfe, 0x12, 0x80, 0xbb, 0xd1, 0x94, 0x70, 0xb1, 0x7a, 0x99, 0xdd, 0x7c, 0x1e, 0x0d, 0x3f,
-	0x7e, 0x08, 0x30, 0x3e, 0x75, 0x83, 0x29, 0x91, 0xd1, 0xf5, 0x8c, 0xf6, 0x3e, 0x4d, 0x1c, 0x8e,
-	0x11, 0x84, 0x9f, 0xc0, 0x80, 0x47, 0x6e, 0xc0, 0xde, 0x92, 0xe8, 0x85, 0x7a, 0x59, 0x8d, 0x8c,
-	0x00, 0xbe, 0xce, 0x38, 0x9d, 0x5c, 0x30, 0xb6, 0xa1, 0x71, 0x4e, 0xa2, 0x29, 0xd1, 0xb7, 0x56,
-	0x4f, 0xa3, 0xfe, 0x24, 0x6c, 0x8e, 0x72, 0xe1, 0xc7, 0xd0, 0x67, 0xaa, 0x8a, 0xd4, 0x87, 0xa7,
-	0x95, 0x39, 0xb1, 0xaf, 0x4c, 0x9f, 0x93, 0x0d, 0xc5, 0xdf, 0x40, 0x2f, 0x5d, 0xec, 0x9b, 0x3d,
-	0x7d, 0x19, 0x0d, 0x0b, 0x7b, 0x7a, 0xb3, 0xe7, 0x64, 0x02, 0xf1, 0x36, 0x2c, 0x4d, 0x08, 0xe3,
-	0x61, 0x74, 0x71, 0xe8, 0x45, 0x64, 0xcc, 0xfd, 0x0b, 0x79, 0x17, 0xb5, 0x9d, 0xbc, 0xd9, 0xde,
-	0x85, 0xa5, 0x5c, 0x93, 0x81, 0xef, 0x40, 0x27, 0x39, 0xf8, 0xf2, 0xbd, 0xf6, 0x9c, 0xd4, 0x60,
-	0x2f, 0xe7, 0x00, 0x8c, 0xda, 0x7f, 0x81, 0xd5, 0xd2, 0xb6, 0x07, 0xef, 0xc5, 0xc7, 0xad, 0xa2,
-	0x6f, 0x51, 0xfd, 0xea, 0x92, 0xe8, 0xe2, 0x79, 0x13, 0xdf, 0xd2, 0xc4, 0xe5, 0xae, 0xfe, 0xc6,
-	0xe4, 0xb3, 0xfd, 0x45, 0xe9, 0x04, 0x8c, 0x26, 0xc1, 0x15, 0x23, 0xf8, 0x97, 0xb0, 0x94, 0x6b,
-	0x7a, 0xe6, 0x95, 0x48, 0xf6, 0xab, 0x5c, 0x68, 0x39, 0x23, 0xfe, 0x32, 0xde, 0x46, 0x75, 0xd1,
-	0x36, 0xe2, 0x0f, 0xa6, 0x07, 0x90, 0xf6, 0x4d, 0xf6, 0xbd, 0x74, 0xc4, 0xe8, 0xdc, 0x85, 0x7c,
-	0x0e, 0x5d, 0xa3, 0x6f, 0x2a, 0xdd, 0xd6, 0x13, 0x23, 0x84, 0x51, 0xbc, 0x03, 0x2d, 0x79, 0x56,
-	0xf4, 0xa7, 0xd7, 0xdd, 0x1b, 0x98, 0x07, 0xea, 0xf8, 0x30, 0x2e, 0x31, 0x74, 0x90, 0xfd, 0x18,
-	0x06, 0xd9, 0x96, 0x46, 0x4c, 0xe2, 0x93, 0xb7, 0x3c, 0x9e, 0x44, 0x3c, 0x8b, 0x92, 0x30, 0xf2,
-	0xa6, 0xa7, 0x5c, 0x67, 0x5f, 0x0d, 0x6c, 0x94, 0xc5, 0x32, 0x6a, 0xff, 0x0e, 0x50, 0xbe, 0x59,
-	0x2b, 0xcd, 0xdc, 0x0a, 0x34, 0xc6, 0xe1, 0x2c, 0x50, 0x7c, 0x7d, 0x47, 0x0d, 0xec, 0xc3, 0x3c,
-	0x9a, 0x51, 0xfc, 0x6b, 0x68, 0xeb, 0xa5, 0x8a, 0xd3, 0x52, 0x9b, 0xbb, 0xa1, 0x24, 0xca, 0x7e,
-	0x04, 0xc3, 0x92, 0x4e, 0x4d, 0x9c, 0xde, 0x28, 0xb9, 0xc2, 0x05, 0x53, 0xcf, 0x49, 0x0d, 0xf6,
-	0x6a, 0x09, 0x88, 0x51, 0xfb, 0x0f, 0xd0, 0xd2, 0xd3, 0x88, 0x25, 0x07, 0xe4, 0x43, 0xa2, 0x68,
-	0x6a, 0x20, 0xc4, 0x2e, 0x20, 0x1f, 0xc4, 0xd7, 0x25, 0x16, 0x58, 0xdd, 0xaa, 0x09, 0xb1, 0x4b,
-	0x2d, 0xf6, 0x7d, 0x40, 0xf9, 0x5e, 0x4f, 0x24, 0xe4, 0xad, 0xef, 0x4e, 0x25, 0x51, 0xdf, 0x91,
-	0xcf, 0xb6, 0x03, 0xb8, 0xd8, 0xcc, 0x2d, 0x5e, 0xb3, 0x98, 0xdb, 0x27, 0x2e, 0xe3, 0x4a, 0xea,
-	0xf5, 0xdc, 0xa9, 0xc5, 0x5e, 0x29, 0x72, 0x32, 0x6a, 0xef, 0x02, 0x2e, 0xf6, 0x7a, 0xf8, 0x16,
-	0xd4, 0xbc, 0x89, 0x9a, 0xa3, 0x7e, 0xd0, 0xba, 0xfc, 0xb4, 0x59, 0x3b, 0x3e, 0x64, 0x8e, 0xb0,
-	0x09, 0x9a, 0x62, 0x8d, 0x63, 0xef, 0xc1, 0x6a, 0x69, 0x93, 0x97, 0x32, 0x55, 0xb6, 0x7b, 0x39,
-	0xa6, 0x87, 0xa5, 0x18, 0x46, 0xb1, 0x05, 0x2d, 0x75, 0x8f, 0x4f, 0xd4, 0x0a, 0x9c, 0x78, 0x68,
-	0x1f, 0xc1, 0xb0, 0xa4, 0xf3, 0xc3, 0x3b, 0x50, 0x8f, 0x44, 0xa9, 0x53, 0xc9, 0x68, 0x66, 0x26,
-	0x4c, 0x9f, 0x0b, 0x19, 0x27, 0x5e, 0x6f, 0x49, 0x1d, 0x64, 0x7f, 0x05, 0xb8, 0xd8, 0x0a, 0x5e,
-	0x75, 0x81, 0xd9, 0xdf, 0x15, 0x51, 0xf2, 0xa0, 0x36, 0x22, 0x59, 0x31, 0xa9, 0x53, 0xba, 0x68,
-	0x4d, 0x2a, 0xd0, 0x7e, 0x04, 0x3d, 0xb3, 0x87, 0xc4, 0x77, 0xa1, 0xf6, 0xd7, 0x70, 0xa4, 0xf7,
-	0xd4, 0x8d, 0xc5, 0xe4, 0x79, 0x38, 0xd2, 0x30, 0xe1, 0xb5, 0x07, 0x26, 0x88, 0x51, 0x41, 0x62,
-	0xf6, 0x93, 0xd7, 0x26, 0x31, 0x6b, 0x29, 0xfb, 0x19, 0xf4, 0x33, 0xad, 0xe5, 0xb5, 0x58, 0x4a,
-	0x15, 0xf9, 0x6e, 0x86, 0x69, 0x8e, 0x12, 0xff, 0xaf, 0x0a, 0x5d, 0xa3, 0x76, 0xc7, 0x08, 0x6a,
-	0x8c, 0xbc, 0xd3, 0x99, 0x16, 0x8f, 0xb2, 0x70, 0x8a, 0x7b, 0xd4, 0xbe, 0x6e, 0x4b, 0xf7, 0xa0,
-	0xe3, 0x05, 0x1e, 0x97, 0x40, 0x7d, 0xe7, 0xc7, 0x49, 0x3e, 0x8e, 0xed, 0x87, 0x2e, 0x77, 0x9d,
-	0x34, 0x0c, 0xff, 0xde, 0xa8, 0x35, 0x24, 0x4e, 0xdd, 0xfe, 0x56, 0xae, 0x31, 0x4d, 0xb1, 0xd9,
-	0x70, 0xbc, 0x0f, 0x83, 0xe4, 0x86, 0x53, 0x04, 0x8d, 0x6c, 0x1f, 0x91, 0x71, 0x4a, 0x86, 0x1c,
-	0x00, 0x1f, 0x01, 0x8e, 0xcc, 0x2a, 0x4a, 0xd1, 0x34, 0x17, 0xd4, 0x59, 0x4e, 0x09, 0x00, 0x3f,
-	0x83, 0xe1, 0x38, 0x73, 0xad, 0x28, 0x9e, 0xd6, 0xc2, 0x9b, 0xa7, 0x0c, 0x62, 0x4f, 0xa1, 0x9f,
-	0xc9, 0xd7, 0x15, 0x2a, 0x63, 0x41, 0x4b, 0xd5, 0xa4, 0xb1, 0xc4, 0xc4, 0x43, 0xf1, 0x9d, 0x24,
-	0xfc, 0xcc, 0xaa, 0x49, 0xa0, 0x61, 0xb1, 0xdf, 0xc1, 0x72, 0x21, 0xc1, 0xa5, 0xb7, 0x41, 0xfa,
-	0xd3, 0x82, 0xfa, 0x39, 0x37, 0x2e, 0x82, 0x0d, 0x59, 0xa8, 0xc9, 0x2a, 0x25, 0x1e, 0x0a, 0x84,
-	0xea, 0x18, 0xe4, 0x0b, 0x6d, 0x3b, 0x7a, 0x64, 0x6f, 0x03, 0x2e, 0xbe, 0x92, 0xd2, 0x33, 0xe8,
-	0x03, 0xa4, 0x75, 0x12, 0xbe, 0x0f, 0x75, 0x4a, 0x74, 0x55, 0x53, 0x5e, 0x2f, 0x4b, 0x3f, 0xfe,
-	0x3a, 0x2e, 0x25, 0x5f, 0xa7, 0xbf, 0xa0, 0xa4, 0xc9, 0x4f, 0xf8, 0xe4, 0x8f, 0x29, 0x46, 0xa4,
-	0xfd, 0x5b, 0x18, 0x64, 0x4b, 0xc6, 0xeb, 0xce, 0x68, 0xef, 0x43, 0xcf, 0xac, 0xe7, 0xf0, 0x43,
-	0x68, 0x29, 0xde, 0x58, 0x68, 0x8a, 0x95, 0x6c, 0x7c, 0xc5, 0xeb, 0x38, 0x7b, 0x13, 0x1a, 0xb2,
-	0xf2, 0x14, 0x59, 0x53, 0x65, 0xb1, 0xce, 0x84, 0x1e, 0xd9, 0x27, 0xd0, 0xcf, 0x94, 0x9b, 0xf8,
-	0x0b, 0x68, 0xd2, 0xd0, 0xf7, 0xc6, 0x17, 0x32, 0x70, 0xb0, 0x37, 0x4c, 0xb7, 0x48, 0xc6, 0x67,
-	0x27, 0xd2, 0xe5, 0xe8, 0x10, 0x91, 0xdd, 0x33, 0x72, 0xa1, 0x4e, 0x47, 0xcf, 0x91, 0xcf, 0x36,
-	0x81, 0xa5, 0x17, 0xee, 0x88, 0xf8, 0x4f, 0xc3, 0x80, 0xf1, 0xc8, 0xf5, 0x02, 0x2e, 0x3e, 0xf2,
-	0x33, 0xa2, 0x08, 0x3b, 0x8e, 0x78, 0xc4, 0xdb, 0x50, 0x0d, 0xa9, 0x4e, 0x62, 0xfc, 0x45, 0xe6,
-	0x50, 0x3f, 0x50, 0xa7, 0x1a, 0x8a, 0xf2, 0xa8, 0xf9, 0xde, 0xf5, 0x67, 0x44, 0x9d, 0xb2, 0x8e,
-	0xa3, 0x47, 0xf6, 0xdf, 0x6a, 0xd0, 0xcf, 0x76, 0xb0, 0x69, 0x21, 0xd5, 0xc9, 0xfc, 0xe8, 0x65,
-	0x41, 0x6b, 0x1a, 0x85, 0x33, 0xaa, 0xff, 0x65, 0xd0, 0x71, 0xe2, 0xa1, 0xb8, 0xd7, 0xbd, 0x60,
-	0x42, 0x3e, 0xca, 0x23, 0xd6, 0x77, 0xd4, 0x40, 0x74, 0x75, 0xe1, 0x7b, 0x12, 0x45, 0xde, 0x24,
-	0x3e, 0x62, 0xc9, 0x58, 0xf8, 0x18, 0x77, 0x23, 0xfe, 0x47, 0x72, 0x21, 0xe5, 0xa0, 0xe7, 0x24,
-	0x63, 0xb1, 0x52, 0x12, 0x4c, 0x84, 0xa7, 0xa9, 0x52, 0xac, 0x46, 0xf8, 0x17, 0x50, 0x8f, 0x42,
-	0x5f, 0x15, 0xf9, 0x83, 0xa4, 0x52, 0x97, 0x7d, 0x47, 0xe8, 0x13, 0xf5, 0xe3, 0x9b, 0x08, 0x48,
-	0x2b, 0xa3, 0xb6, 0x51, 0x19, 0xe1, 0x67, 0x80, 0xfc, 0x6c, 0x66, 0x98, 0xd5, 0x91, 0xaf, 0x7f,
-	0xad, 0x3c, 0x71, 0x71, 0x8b, 0x9f, 0x47, 0xe1, 0xfb, 0x30, 0xf0, 0xc3, 0xb1, 0xcb, 0xbd, 0x30,
-	0x90, 0x10, 0x66, 0x81, 0x4c, 0x69, 0xce, 0x2a, 0xe2, 0x3c, 0x16, 0xfa, 0xca, 0x44, 0xde, 0x13,
-	0x5f, 0xfe, 0x8a, 0xd4, 0x71, 0x72, 0xd6, 0x07, 0xff, 0x6a, 0x41, 0x5d, 0x2c, 0x1f, 0xdf, 0x82,
-	0x55, 0xb9, 0x0d, 0x32, 0xf5, 0x18, 0x27, 0x51, 0xf2, 0x19, 0xa2, 0x1b, 0xf8, 0x0e, 0x58, 0xca,
-	0x55, 0x6c, 0xb0, 0x51, 0x65, 0xbe, 0x97, 0x51, 0x54, 0xc5, 0x9f, 0xc1, 0x2d, 0xe1, 0x2d, 0xed,
-	0x23, 0x50, 0x6d, 0x81, 0x9b, 0x51, 0x54, 0xc7, 0x37, 0x61, 0x28, 0xdc, 0xb9, 0x4e, 0x06, 0x35,
-	0x4a, 0x1d, 0x8c, 0xa2, 0x66, 0xec, 0xc8, 0x75, 0x0a, 0xa8, 0x55, 0xea, 0x60, 0x14, 0xb5, 0x31,
-	0x86, 0x81, 0x70, 0xa4, 0xb5, 0x3d, 0xea, 0xe4, 0x6d, 0x8c, 0x22, 0xc0, 0x43, 0x58, 0x92, 0xb6,
-	0xb4, 0x9e, 0x47, 0xdd, 0x82, 0x91, 0x51, 0xd4, 0xc3, 0x16, 0xac, 0x68, 0x63, 0xa6, 0x92, 0x46,
-	0xfd, 0x72, 0x0f, 0xa3, 0x68, 0x80, 0xd7, 0x00, 0xab, 0x2c, 0x9a, 0x45, 0x2f, 0x5a, 0x2a, 0xb3,
-	0x33, 0x8a, 0x10, 0xbe, 0x0d, 0x37, 0x85, 0xbd, 0xa4, 0x52, 0x46, 0xcb, 0x73, 0x9d, 0x8c, 0x22,
-	0x1c, 0xaf, 0x21, 0x5f, 0xd6, 0xa2, 0x61, 0xbc, 0x19, 0xe3, 0x6a, 0x47, 0x2b, 0x78, 0x1d, 0xd6,
-	0xd2, 0x70, 0xb3, 0xe6, 0x44, 0xab, 0xf3, 0x7c, 0x8c, 0xa2, 0xb5, 0xd8, 0x57, 0xac, 0x55, 0xd1,
-	0xcd, 0x79, 0x3e, 0x46, 0x91, 0x95, 0x9c, 0x88, 0xb2, 0xe2, 0x14, 0xdd, 0x5a, 0xe0, 0x66, 0x14,
-	0xad, 0xc7, 0x3b, 0x2f, 0xa9, 0x39, 0xd1, 0xed, 0xb9, 0x4e, 0x46, 0xd1, 0x9d, 0x78, 0x4d, 0xc5,
-	0x7a, 0x12, 0x7d, 0x36, 0xcf, 0xc7, 0x28, 0xda, 0xc0, 0x2b, 0x80, 0xd2, 0x1c, 0xa8, 0xf2, 0x0b,
-	0x6d, 0x16, 0xad, 0x8c, 0xa2, 0xad, 0xd8, 0x6a, 0x16, 0x7c, 0xe8, 0xf3, 0xa2, 0x95, 0x51, 0x64,
-	0xe3, 0x55, 0x58, 0x96, 0x2f, 0xc3, 0xac, 0xeb, 0xd0, 0xdd, 0x12, 0x33, 0xa3, 0xe8, 0xde, 0x83,
-	0x6f, 0xa1, 0x67, 0x8a, 0x11, 0xee, 0x40, 0xe3, 0x4d, 0xc8, 0xe5, 0xd7, 0x0b, 0xd0, 0x54, 0x77,
-	0x16, 0xaa, 0xe0, 0x1e, 0xb4, 0xbf, 0x0b, 0x7d, 0x3f, 0xfc, 0x40, 0x22, 0x54, 0xc5, 0x5d, 0x68,
-	0xbd, 0x20, 0x6e, 0x24, 0x3e, 0xf2, 0xda, 0x83, 0x7d, 0x58, 0x2e, 0x88, 0x37, 0x6e, 0x42, 0xf5,
-	0x38, 0x40, 0x37, 0x04, 0xdd, 0xcb, 0x90, 0x1f, 0x07, 0xa8, 0x22, 0xe8, 0x8e, 0x3e, 0x7a, 0x8c,
-	0x33, 0x54, 0xc5, 0x7d, 0xe8, 0xbc, 0x0c, 0xb9, 0x1e, 0xd6, 0x0e, 0xd0, 0x4f, 0xff, 0xdd, 0xb8,
-	0xf1, 0xe3, 0xe5, 0x46, 0xe5, 0xa7, 0xcb, 0x8d, 0xca, 0x7f, 0x2e, 0x37, 0x2a, 0xa3, 0xa6, 0xfc,
-	0xc7, 0xf0, 0xa3, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x54, 0x9b, 0xd7, 0xd0, 0xab, 0x1e, 0x00,
-	0x00,
+var xxx_messageInfo_RemoveSchedulerRsp proto.InternalMessageInfo
+
+// PauseSchedulerReq pause the scheduler for seconds, 0 means resume the scheduler.
+// The name "all" means all the schedulers.
+type PauseSchedulerReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seconds              int64    `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PauseSchedulerReq) Reset()         { *m = PauseSchedulerReq{} }
+func (m *PauseSchedulerReq) String() string { return proto.CompactTextString(m) }
+func (*PauseSchedulerReq) ProtoMessage()    {}
+func (*PauseSchedulerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{56}
+}
+func (m *PauseSchedulerReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseSchedulerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseSchedulerReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PauseSchedulerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSchedulerReq.Merge(m, src)
+}
+func (m *PauseSchedulerReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseSchedulerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSchedulerReq.DiscardUnknown(m)
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+var xxx_messageInfo_PauseSchedulerReq proto.InternalMessageInfo
+
+func (m *PauseSchedulerReq) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.ContainerID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+	return ""
+}
+
+func (m *PauseSchedulerReq) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+	return 0
+}
+
+// PauseSchedulerRsp pause scheduler response
+type PauseSchedulerRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseSchedulerRsp) Reset()         { *m = PauseSchedulerRsp{} }
+func (m *PauseSchedulerRsp) String() string { return proto.CompactTextString(m) }
+func (*PauseSchedulerRsp) ProtoMessage()    {}
+func (*PauseSchedulerRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{57}
+}
+func (m *PauseSchedulerRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseSchedulerRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseSchedulerRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceHeartbeat.Size()))
-	n1, err := m.ResourceHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *PauseSchedulerRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSchedulerRsp.Merge(m, src)
+}
+func (m *PauseSchedulerRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseSchedulerRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSchedulerRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSchedulerRsp proto.InternalMessageInfo
+
+// ListSchedulersReq list schedulers request
+type ListSchedulersReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSchedulersReq) Reset()         { *m = ListSchedulersReq{} }
+func (m *ListSchedulersReq) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulersReq) ProtoMessage()    {}
+func (*ListSchedulersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{58}
+}
+func (m *ListSchedulersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n1
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerHeartbeat.Size()))
-	n2, err := m.ContainerHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *ListSchedulersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulersReq.Merge(m, src)
+}
+func (m *ListSchedulersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulersReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulersReq proto.InternalMessageInfo
+
+// ListSchedulersRsp list schedulers response
+type ListSchedulersRsp struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSchedulersRsp) Reset()         { *m = ListSchedulersRsp{} }
+func (m *ListSchedulersRsp) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulersRsp) ProtoMessage()    {}
+func (*ListSchedulersRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{59}
+}
+func (m *ListSchedulersRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulersRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulersRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n2
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutContainer.Size()))
-	n3, err := m.PutContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *ListSchedulersRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulersRsp.Merge(m, src)
+}
+func (m *ListSchedulersRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulersRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulersRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulersRsp proto.InternalMessageInfo
+
+func (m *ListSchedulersRsp) GetNames() []string {
+	if m != nil {
+		return m.Names
 	}
-	i += n3
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetContainer.Size()))
-	n4, err := m.GetContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+// AddOperatorReq add operator request. The TransferLeaderOperator transfers the leader
+// of the resource to the target container. The MovePeerOperator moves the peer of the
+// resource from the source container to the target container. The SplitResourceOperator
+// splits the resource by the split keys, or by the policy if no split keys.
+type AddOperatorReq struct {
+	Type                 OperatorType       `protobuf:"varint,1,opt,name=type,proto3,enum=rpcpb.OperatorType" json:"type,omitempty"`
+	ResourceID           uint64             `protobuf:"varint,2,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
+	FromContainerID      uint64             `protobuf:"varint,3,opt,name=fromContainerID,proto3" json:"fromContainerID,omitempty"`
+	ToContainerID        uint64             `protobuf:"varint,4,opt,name=toContainerID,proto3" json:"toContainerID,omitempty"`
+	SplitKeys            [][]byte           `protobuf:"bytes,5,rep,name=splitKeys,proto3" json:"splitKeys,omitempty"`
+	Policy               metapb.CheckPolicy `protobuf:"varint,6,opt,name=policy,proto3,enum=metapb.CheckPolicy" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AddOperatorReq) Reset()         { *m = AddOperatorReq{} }
+func (m *AddOperatorReq) String() string { return proto.CompactTextString(m) }
+func (*AddOperatorReq) ProtoMessage()    {}
+func (*AddOperatorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{60}
+}
+func (m *AddOperatorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddOperatorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n4
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AllocID.Size()))
-	n5, err := m.AllocID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *AddOperatorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOperatorReq.Merge(m, src)
+}
+func (m *AddOperatorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddOperatorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOperatorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddOperatorReq proto.InternalMessageInfo
+
+func (m *AddOperatorReq) GetType() OperatorType {
+	if m != nil {
+		return m.Type
 	}
-	i += n5
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskSplit.Size()))
-	n6, err := m.AskSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return TransferLeaderOperator
+}
+
+func (m *AddOperatorReq) GetResourceID() uint64 {
+	if m != nil {
+		return m.ResourceID
 	}
-	i += n6
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskBatchSplit.Size()))
-	n7, err := m.AskBatchSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *AddOperatorReq) GetFromContainerID() uint64 {
+	if m != nil {
+		return m.FromContainerID
 	}
-	i += n7
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ReportSplit.Size()))
-	n8, err := m.ReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n8
-	dAtA[i] = 0x62
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.BatchReportSplit.Size()))
-	n9, err := m.BatchReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *AddOperatorReq) GetToContainerID() uint64 {
+	if m != nil {
+		return m.ToContainerID
 	}
-	i += n9
-	dAtA[i] = 0x6a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateWatcher.Size()))
-	n10, err := m.CreateWatcher.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *AddOperatorReq) GetSplitKeys() [][]byte {
+	if m != nil {
+		return m.SplitKeys
 	}
-	i += n10
-	dAtA[i] = 0x72
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateResources.Size()))
-	n11, err := m.CreateResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *AddOperatorReq) GetPolicy() metapb.CheckPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	i += n11
-	dAtA[i] = 0x7a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveResources.Size()))
-	n12, err := m.RemoveResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return metapb.CheckPolicy_SCAN
+}
+
+// AddOperatorRsp add operator response
+type AddOperatorRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddOperatorRsp) Reset()         { *m = AddOperatorRsp{} }
+func (m *AddOperatorRsp) String() string { return proto.CompactTextString(m) }
+func (*AddOperatorRsp) ProtoMessage()    {}
+func (*AddOperatorRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{61}
+}
+func (m *AddOperatorRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddOperatorRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddOperatorRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n12
-	dAtA[i] = 0x82
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CheckResourceState.Size()))
-	n13, err := m.CheckResourceState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *AddOperatorRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOperatorRsp.Merge(m, src)
+}
+func (m *AddOperatorRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddOperatorRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOperatorRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddOperatorRsp proto.InternalMessageInfo
+
+// RemoveOperatorReq cancel the running operator of the resource
+type RemoveOperatorReq struct {
+	ResourceID           uint64   `protobuf:"varint,1,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveOperatorReq) Reset()         { *m = RemoveOperatorReq{} }
+func (m *RemoveOperatorReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveOperatorReq) ProtoMessage()    {}
+func (*RemoveOperatorReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{62}
+}
+func (m *RemoveOperatorReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveOperatorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveOperatorReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n13
-	dAtA[i] = 0x8a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutPlacementRule.Size()))
-	n14, err := m.PutPlacementRule.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *RemoveOperatorReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOperatorReq.Merge(m, src)
+}
+func (m *RemoveOperatorReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveOperatorReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOperatorReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveOperatorReq proto.InternalMessageInfo
+
+func (m *RemoveOperatorReq) GetResourceID() uint64 {
+	if m != nil {
+		return m.ResourceID
 	}
-	i += n14
-	dAtA[i] = 0x92
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetAppliedRules.Size()))
-	n15, err := m.GetAppliedRules.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+// RemoveOperatorRsp remove operator response
+type RemoveOperatorRsp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveOperatorRsp) Reset()         { *m = RemoveOperatorRsp{} }
+func (m *RemoveOperatorRsp) String() string { return proto.CompactTextString(m) }
+func (*RemoveOperatorRsp) ProtoMessage()    {}
+func (*RemoveOperatorRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{63}
+}
+func (m *RemoveOperatorRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveOperatorRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveOperatorRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n15
-	dAtA[i] = 0x9a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateJob.Size()))
-	n16, err := m.CreateJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *RemoveOperatorRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOperatorRsp.Merge(m, src)
+}
+func (m *RemoveOperatorRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveOperatorRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOperatorRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveOperatorRsp proto.InternalMessageInfo
+
+// GetOperatorStatusReq get the status of the latest operator of the resource
+type GetOperatorStatusReq struct {
+	ResourceID           uint64   `protobuf:"varint,1,opt,name=resourceID,proto3" json:"resourceID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOperatorStatusReq) Reset()         { *m = GetOperatorStatusReq{} }
+func (m *GetOperatorStatusReq) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorStatusReq) ProtoMessage()    {}
+func (*GetOperatorStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{64}
+}
+func (m *GetOperatorStatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOperatorStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOperatorStatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n16
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveJob.Size()))
-	n17, err := m.RemoveJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *GetOperatorStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorStatusReq.Merge(m, src)
+}
+func (m *GetOperatorStatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOperatorStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOperatorStatusReq proto.InternalMessageInfo
+
+func (m *GetOperatorStatusReq) GetResourceID() uint64 {
+	if m != nil {
+		return m.ResourceID
 	}
-	i += n17
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ExecuteJob.Size()))
-	n18, err := m.ExecuteJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+// GetOperatorStatusRsp get operator status response
+type GetOperatorStatusRsp struct {
+	Found                bool                  `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Status               metapb.OperatorStatus `protobuf:"varint,2,opt,name=status,proto3,enum=metapb.OperatorStatus" json:"status,omitempty"`
+	Desc                 string                `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetOperatorStatusRsp) Reset()         { *m = GetOperatorStatusRsp{} }
+func (m *GetOperatorStatusRsp) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorStatusRsp) ProtoMessage()    {}
+func (*GetOperatorStatusRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{65}
+}
+func (m *GetOperatorStatusRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOperatorStatusRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOperatorStatusRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n18
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *GetOperatorStatusRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorStatusRsp.Merge(m, src)
+}
+func (m *GetOperatorStatusRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOperatorStatusRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorStatusRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOperatorStatusRsp proto.InternalMessageInfo
+
+func (m *GetOperatorStatusRsp) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return i, nil
+	return false
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *GetOperatorStatusRsp) GetStatus() metapb.OperatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return metapb.OperatorStatus_SUCCESS
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+func (m *GetOperatorStatusRsp) GetDesc() string {
+	if m != nil {
+		return m.Desc
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+	return ""
+}
+
+// ListJobsReq list jobs request
+type ListJobsReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListJobsReq) Reset()         { *m = ListJobsReq{} }
+func (m *ListJobsReq) String() string { return proto.CompactTextString(m) }
+func (*ListJobsReq) ProtoMessage()    {}
+func (*ListJobsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{66}
+}
+func (m *ListJobsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListJobsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListJobsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+}
+func (m *ListJobsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsReq.Merge(m, src)
+}
+func (m *ListJobsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListJobsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsReq proto.InternalMessageInfo
+
+// ListJobsRsp list jobs response, includes the running jobs and the history jobs
+type ListJobsRsp struct {
+	Jobs                 []metapb.Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListJobsRsp) Reset()         { *m = ListJobsRsp{} }
+func (m *ListJobsRsp) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRsp) ProtoMessage()    {}
+func (*ListJobsRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{67}
+}
+func (m *ListJobsRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListJobsRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListJobsRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Leader) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Leader)))
-		i += copy(dAtA[i:], m.Leader)
+}
+func (m *ListJobsRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRsp.Merge(m, src)
+}
+func (m *ListJobsRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListJobsRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsRsp proto.InternalMessageInfo
+
+func (m *ListJobsRsp) GetJobs() []metapb.Job {
+	if m != nil {
+		return m.Jobs
 	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceHeartbeat.Size()))
-	n19, err := m.ResourceHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+// GetJobReq get job request
+type GetJobReq struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobReq) Reset()         { *m = GetJobReq{} }
+func (m *GetJobReq) String() string { return proto.CompactTextString(m) }
+func (*GetJobReq) ProtoMessage()    {}
+func (*GetJobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{68}
+}
+func (m *GetJobReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n19
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerHeartbeat.Size()))
-	n20, err := m.ContainerHeartbeat.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *GetJobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobReq.Merge(m, src)
+}
+func (m *GetJobReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobReq proto.InternalMessageInfo
+
+func (m *GetJobReq) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	i += n20
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutContainer.Size()))
-	n21, err := m.PutContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+// GetJobRsp get job response
+type GetJobRsp struct {
+	Job                  metapb.Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetJobRsp) Reset()         { *m = GetJobRsp{} }
+func (m *GetJobRsp) String() string { return proto.CompactTextString(m) }
+func (*GetJobRsp) ProtoMessage()    {}
+func (*GetJobRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{69}
+}
+func (m *GetJobRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n21
-	dAtA[i] = 0x42
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetContainer.Size()))
-	n22, err := m.GetContainer.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *GetJobRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobRsp.Merge(m, src)
+}
+func (m *GetJobRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobRsp proto.InternalMessageInfo
+
+func (m *GetJobRsp) GetJob() metapb.Job {
+	if m != nil {
+		return m.Job
 	}
-	i += n22
-	dAtA[i] = 0x4a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AllocID.Size()))
-	n23, err := m.AllocID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return metapb.Job{}
+}
+
+// EventNotify event notify
+type EventNotify struct {
+	Seq                  uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type                 uint32                 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	InitEvent            *InitEventData         `protobuf:"bytes,3,opt,name=initEvent,proto3" json:"initEvent,omitempty"`
+	ResourceEvent        *ResourceEventData     `protobuf:"bytes,4,opt,name=resourceEvent,proto3" json:"resourceEvent,omitempty"`
+	ContainerEvent       *ContainerEventData    `protobuf:"bytes,5,opt,name=containerEvent,proto3" json:"containerEvent,omitempty"`
+	ResourceStatsEvent   *metapb.ResourceStats  `protobuf:"bytes,6,opt,name=resourceStatsEvent,proto3" json:"resourceStatsEvent,omitempty"`
+	ContainerStatsEvent  *metapb.ContainerStats `protobuf:"bytes,7,opt,name=containerStatsEvent,proto3" json:"containerStatsEvent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *EventNotify) Reset()         { *m = EventNotify{} }
+func (m *EventNotify) String() string { return proto.CompactTextString(m) }
+func (*EventNotify) ProtoMessage()    {}
+func (*EventNotify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{70}
+}
+func (m *EventNotify) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNotify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNotify.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i += n23
-	dAtA[i] = 0x52
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskSplit.Size()))
-	n24, err := m.AskSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *EventNotify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNotify.Merge(m, src)
+}
+func (m *EventNotify) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNotify) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNotify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNotify proto.InternalMessageInfo
+
+func (m *EventNotify) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	i += n24
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskBatchSplit.Size()))
-	n25, err := m.AskBatchSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *EventNotify) GetType() uint32 {
+	if m != nil {
+		return m.Type
 	}
-	i += n25
-	dAtA[i] = 0x62
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ReportSplit.Size()))
-	n26, err := m.ReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *EventNotify) GetInitEvent() *InitEventData {
+	if m != nil {
+		return m.InitEvent
 	}
-	i += n26
-	dAtA[i] = 0x6a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.BatchReportSplit.Size()))
-	n27, err := m.BatchReportSplit.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *EventNotify) GetResourceEvent() *ResourceEventData {
+	if m != nil {
+		return m.ResourceEvent
 	}
-	i += n27
-	dAtA[i] = 0x72
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Event.Size()))
-	n28, err := m.Event.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *EventNotify) GetContainerEvent() *ContainerEventData {
+	if m != nil {
+		return m.ContainerEvent
 	}
-	i += n28
-	dAtA[i] = 0x7a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateResources.Size()))
-	n29, err := m.CreateResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n29
-	dAtA[i] = 0x82
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveResources.Size()))
-	n30, err := m.RemoveResources.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n30
-	dAtA[i] = 0x8a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CheckResourceState.Size()))
-	n31, err := m.CheckResourceState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n31
-	dAtA[i] = 0x92
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutPlacementRule.Size()))
-	n32, err := m.PutPlacementRule.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n32
-	dAtA[i] = 0x9a
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetAppliedRules.Size()))
-	n33, err := m.GetAppliedRules.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n33
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateJob.Size()))
-	n34, err := m.CreateJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n34
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveJob.Size()))
-	n35, err := m.RemoveJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n35
-	dAtA[i] = 0xb2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ExecuteJob.Size()))
-	n36, err := m.ExecuteJob.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n36
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return nil
 }
 
-func (m *ResourceHeartbeatReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *EventNotify) GetResourceStatsEvent() *metapb.ResourceStats {
+	if m != nil {
+		return m.ResourceStatsEvent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResourceHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ContainerID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
-	}
-	if len(m.Resource) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resource)))
-		i += copy(dAtA[i:], m.Resource)
-	}
-	if m.Term != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Term))
+func (m *EventNotify) GetContainerStatsEvent() *metapb.ContainerStats {
+	if m != nil {
+		return m.ContainerStatsEvent
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader.Size()))
-		n37, err := m.Leader.MarshalTo(dAtA[i:])
+	return nil
+}
+
+// InitEventData init event data
+type InitEventData struct {
+	Resources            [][]byte `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Leaders              []uint64 `protobuf:"varint,2,rep,packed,name=leaders,proto3" json:"leaders,omitempty"`
+	Containers           [][]byte `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitEventData) Reset()         { *m = InitEventData{} }
+func (m *InitEventData) String() string { return proto.CompactTextString(m) }
+func (*InitEventData) ProtoMessage()    {}
+func (*InitEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{71}
+}
+func (m *InitEventData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitEventData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n37
-	}
-	if len(m.DownPeers) > 0 {
-		for _, msg := range m.DownPeers {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.PendingPeers) > 0 {
-		for _, msg := range m.PendingPeers {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
-	n38, err := m.Stats.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n38
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *InitEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitEventData.Merge(m, src)
+}
+func (m *InitEventData) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitEventData.DiscardUnknown(m)
 }
 
-func (m *ResourceHeartbeatRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InitEventData proto.InternalMessageInfo
+
+func (m *InitEventData) GetResources() [][]byte {
+	if m != nil {
+		return m.Resources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResourceHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ResourceID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+func (m *InitEventData) GetLeaders() []uint64 {
+	if m != nil {
+		return m.Leaders
 	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceEpoch.Size()))
-	n39, err := m.ResourceEpoch.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *InitEventData) GetContainers() [][]byte {
+	if m != nil {
+		return m.Containers
 	}
-	i += n39
-	if m.TargetPeer != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.TargetPeer.Size()))
-		n40, err := m.TargetPeer.MarshalTo(dAtA[i:])
+	return nil
+}
+
+// ResourceEventData resource created or updated
+type ResourceEventData struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Leader               uint64   `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	Removed              bool     `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	Create               bool     `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceEventData) Reset()         { *m = ResourceEventData{} }
+func (m *ResourceEventData) String() string { return proto.CompactTextString(m) }
+func (*ResourceEventData) ProtoMessage()    {}
+func (*ResourceEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{72}
+}
+func (m *ResourceEventData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceEventData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n40
-	}
-	if m.ChangePeer != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n41, err := m.ChangePeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n41
-	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n42, err := m.TransferLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n42
-	}
-	if m.Merge != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Merge.Size()))
-		n43, err := m.Merge.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
-	}
-	if m.SplitResource != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.SplitResource.Size()))
-		n44, err := m.SplitResource.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
-	}
-	if m.ChangePeerV2 != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
-		n45, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n45
-	}
-	if m.DestoryDirectly {
-		dAtA[i] = 0x48
-		i++
-		if m.DestoryDirectly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ResourceEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceEventData.Merge(m, src)
+}
+func (m *ResourceEventData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceEventData.DiscardUnknown(m)
 }
 
-func (m *PutContainerReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResourceEventData proto.InternalMessageInfo
+
+func (m *ResourceEventData) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutContainerReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Container) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Container)))
-		i += copy(dAtA[i:], m.Container)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ResourceEventData) GetLeader() uint64 {
+	if m != nil {
+		return m.Leader
 	}
-	return i, nil
+	return 0
 }
 
-func (m *PutContainerRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ResourceEventData) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *PutContainerRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ResourceEventData) GetCreate() bool {
+	if m != nil {
+		return m.Create
 	}
-	return i, nil
+	return false
 }
 
-func (m *ContainerHeartbeatReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// ContainerEventData container created or updated
+type ContainerEventData struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContainerHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
-	n46, err := m.Stats.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n46
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ContainerEventData) Reset()         { *m = ContainerEventData{} }
+func (m *ContainerEventData) String() string { return proto.CompactTextString(m) }
+func (*ContainerEventData) ProtoMessage()    {}
+func (*ContainerEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{73}
+}
+func (m *ContainerEventData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerEventData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ContainerEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerEventData.Merge(m, src)
+}
+func (m *ContainerEventData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerEventData.DiscardUnknown(m)
 }
 
-func (m *ContainerHeartbeatRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContainerEventData proto.InternalMessageInfo
+
+func (m *ContainerEventData) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContainerHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+// ChangePeer change peer
+type ChangePeer struct {
+	Peer                 metapb.Peer           `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
+	ChangeType           metapb.ChangePeerType `protobuf:"varint,2,opt,name=changeType,proto3,enum=metapb.ChangePeerType" json:"changeType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
+func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
+func (*ChangePeer) ProtoMessage()    {}
+func (*ChangePeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{74}
+}
+func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangePeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePeer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ChangePeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePeer.Merge(m, src)
+}
+func (m *ChangePeer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePeer.DiscardUnknown(m)
 }
 
-func (m *GetContainerReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChangePeer proto.InternalMessageInfo
+
+func (m *ChangePeer) GetPeer() metapb.Peer {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return metapb.Peer{}
 }
 
-func (m *GetContainerReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ChangePeer) GetChangeType() metapb.ChangePeerType {
+	if m != nil {
+		return m.ChangeType
 	}
-	return i, nil
+	return metapb.ChangePeerType_AddNode
 }
 
-func (m *GetContainerRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// TransferLeader transfer leader
+type TransferLeader struct {
+	Peer                 metapb.Peer `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *GetContainerRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.Stats != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
-		n47, err := m.Stats.MarshalTo(dAtA[i:])
+func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
+func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
+func (*TransferLeader) ProtoMessage()    {}
+func (*TransferLeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{75}
+}
+func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n47
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *AllocIDReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransferLeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeader.Merge(m, src)
 }
-
-func (m *AllocIDReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *TransferLeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeader.DiscardUnknown(m)
 }
 
-func (m *AllocIDRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferLeader proto.InternalMessageInfo
+
+func (m *TransferLeader) GetPeer() metapb.Peer {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return metapb.Peer{}
 }
 
-func (m *AllocIDRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ChangePeerV2 change peer v2
+type ChangePeerV2 struct {
+	// If changes is empty, it means that to exit joint state.
+	Changes              []ChangePeer `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *AskSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ChangePeerV2) Reset()         { *m = ChangePeerV2{} }
+func (m *ChangePeerV2) String() string { return proto.CompactTextString(m) }
+func (*ChangePeerV2) ProtoMessage()    {}
+func (*ChangePeerV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{76}
+}
+func (m *ChangePeerV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangePeerV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePeerV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ChangePeerV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePeerV2.Merge(m, src)
+}
+func (m *ChangePeerV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePeerV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePeerV2.DiscardUnknown(m)
 }
 
-func (m *AskSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ChangePeerV2 proto.InternalMessageInfo
+
+func (m *ChangePeerV2) GetChanges() []ChangePeer {
+	if m != nil {
+		return m.Changes
 	}
-	return i, nil
+	return nil
 }
 
-func (m *AskSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Merge merge
+type Merge struct {
+	// target resource
+	Target               []byte   `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AskSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.SplitID.Size()))
-	n48, err := m.SplitID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n48
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Merge) Reset()         { *m = Merge{} }
+func (m *Merge) String() string { return proto.CompactTextString(m) }
+func (*Merge) ProtoMessage()    {}
+func (*Merge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{77}
+}
+func (m *Merge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Merge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Merge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *Merge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Merge.Merge(m, src)
+}
+func (m *Merge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Merge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Merge.DiscardUnknown(m)
 }
 
-func (m *ReportSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Merge proto.InternalMessageInfo
+
+func (m *Merge) GetTarget() []byte {
+	if m != nil {
+		return m.Target
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Left) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Left)))
-		i += copy(dAtA[i:], m.Left)
-	}
-	if len(m.Right) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Right)))
-		i += copy(dAtA[i:], m.Right)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// SplitResource split resource
+type SplitResource struct {
+	Policy               metapb.CheckPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=metapb.CheckPolicy" json:"policy,omitempty"`
+	Keys                 [][]byte           `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *ReportSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SplitResource) Reset()         { *m = SplitResource{} }
+func (m *SplitResource) String() string { return proto.CompactTextString(m) }
+func (*SplitResource) ProtoMessage()    {}
+func (*SplitResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{78}
 }
-
-func (m *ReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *SplitResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *AskBatchSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SplitResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AskBatchSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *SplitResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitResource.Merge(m, src)
+}
+func (m *SplitResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitResource.DiscardUnknown(m)
 }
 
-func (m *AskBatchSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SplitResource proto.InternalMessageInfo
+
+func (m *SplitResource) GetPolicy() metapb.CheckPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return metapb.CheckPolicy_SCAN
 }
 
-func (m *AskBatchSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.SplitIDs) > 0 {
-		for _, msg := range m.SplitIDs {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SplitResource) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
 	}
-	return i, nil
+	return nil
 }
 
-func (m *BatchReportSplitReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// LabelConstraint is used to filter container when trying to place peer of a resource.
+type LabelConstraint struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op                   LabelConstraintOp `protobuf:"varint,2,opt,name=op,proto3,enum=rpcpb.LabelConstraintOp" json:"op,omitempty"`
+	Values               []string          `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *BatchReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+func (m *LabelConstraint) Reset()         { *m = LabelConstraint{} }
+func (m *LabelConstraint) String() string { return proto.CompactTextString(m) }
+func (*LabelConstraint) ProtoMessage()    {}
+func (*LabelConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{79}
+}
+func (m *LabelConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabelConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabelConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *BatchReportSplitRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *LabelConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelConstraint.Merge(m, src)
 }
-
-func (m *BatchReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *LabelConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabelConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelConstraint.DiscardUnknown(m)
 }
 
-func (m *SplitID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LabelConstraint proto.InternalMessageInfo
+
+func (m *LabelConstraint) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NewID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.NewID))
-	}
-	if len(m.NewPeerIDs) > 0 {
-		dAtA50 := make([]byte, len(m.NewPeerIDs)*10)
-		var j49 int
-		for _, num := range m.NewPeerIDs {
-			for num >= 1<<7 {
-				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j49++
-			}
-			dAtA50[j49] = uint8(num)
-			j49++
-		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j49))
-		i += copy(dAtA[i:], dAtA50[:j49])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *LabelConstraint) GetOp() LabelConstraintOp {
+	if m != nil {
+		return m.Op
 	}
-	return i, nil
+	return In
 }
 
-func (m *CreateWatcherReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *LabelConstraint) GetValues() []string {
+	if m != nil {
+		return m.Values
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreateWatcherReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Flag != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Flag))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// PlacementRule place rule
+type PlacementRule struct {
+	// ID unique ID within a group
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// GroupID mark the source that add the rule
+	GroupID string `protobuf:"bytes,2,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	// Index rule apply order in a group, rule with less ID is applied first when indexes are equal
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Override when it is true, all rules with less indexes are disabled
+	Override bool   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
+	StartKey []byte `protobuf:"bytes,5,opt,name=startKey,proto3" json:"startKey,omitempty"`
+	EndKey   []byte `protobuf:"bytes,6,opt,name=endKey,proto3" json:"endKey,omitempty"`
+	// Role expected role of the peers
+	Role PeerRoleType `protobuf:"varint,7,opt,name=role,proto3,enum=rpcpb.PeerRoleType" json:"role,omitempty"`
+	// Count expected count of the peers
+	Count uint32 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	// LabelConstraints used to select containers to place peers
+	LabelConstraints []LabelConstraint `protobuf:"bytes,9,rep,name=labelConstraints,proto3" json:"labelConstraints"`
+	// LocationLabels used to make peers isolated physically
+	LocationLabels []string `protobuf:"bytes,10,rep,name=locationLabels,proto3" json:"locationLabels,omitempty"`
+	// IsolationLevelused to isolate replicas explicitly and forcibly
+	IsolationLevel       string   `protobuf:"bytes,11,opt,name=isolationLevel,proto3" json:"isolationLevel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateResourcesReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PlacementRule) Reset()         { *m = PlacementRule{} }
+func (m *PlacementRule) String() string { return proto.CompactTextString(m) }
+func (*PlacementRule) ProtoMessage()    {}
+func (*PlacementRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25e491924c678914, []int{80}
 }
-
-func (m *CreateResourcesReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.LeastPeers) > 0 {
-		dAtA52 := make([]byte, len(m.LeastPeers)*10)
-		var j51 int
-		for _, num := range m.LeastPeers {
-			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j51++
-			}
-			dAtA52[j51] = uint8(num)
-			j51++
+func (m *PlacementRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j51))
-		i += copy(dAtA[i:], dAtA52[:j51])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *PlacementRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementRule.Merge(m, src)
+}
+func (m *PlacementRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementRule.DiscardUnknown(m)
 }
 
-func (m *CreateResourcesRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlacementRule proto.InternalMessageInfo
+
+func (m *PlacementRule) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
 	}
-	return i, nil
+	return ""
 }
 
-func (m *RemoveResourcesReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RemoveResourcesReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.IDs) > 0 {
-		dAtA54 := make([]byte, len(m.IDs)*10)
-		var j53 int
-		for _, num := range m.IDs {
-			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j53++
-			}
-			dAtA54[j53] = uint8(num)
-			j53++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j53))
-		i += copy(dAtA[i:], dAtA54[:j53])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetOverride() bool {
+	if m != nil {
+		return m.Override
 	}
-	return i, nil
+	return false
 }
 
-func (m *RemoveResourcesRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RemoveResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CheckResourceStateReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetRole() PeerRoleType {
+	if m != nil {
+		return m.Role
 	}
-	return dAtA[:n], nil
+	return Voter
 }
 
-func (m *CheckResourceStateReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.IDs) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IDs)))
-		i += copy(dAtA[i:], m.IDs)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return i, nil
+	return 0
 }
 
-func (m *CheckResourceStateRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetLabelConstraints() []LabelConstraint {
+	if m != nil {
+		return m.LabelConstraints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckResourceStateRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Removed) > 0 {
-		dAtA56 := make([]byte, len(m.Removed)*10)
-		var j55 int
-		for _, num := range m.Removed {
-			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j55++
-			}
-			dAtA56[j55] = uint8(num)
-			j55++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j55))
-		i += copy(dAtA[i:], dAtA56[:j55])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PlacementRule) GetLocationLabels() []string {
+	if m != nil {
+		return m.LocationLabels
 	}
-	return i, nil
+	return nil
 }
 
-func (m *PutPlacementRuleReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PlacementRule) GetIsolationLevel() string {
+	if m != nil {
+		return m.IsolationLevel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PutPlacementRuleReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Rule.Size()))
-	n57, err := m.Rule.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n57
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func init() {
+	proto.RegisterEnum("rpcpb.Type", Type_name, Type_value)
+	proto.RegisterEnum("rpcpb.OperatorType", OperatorType_name, OperatorType_value)
+	proto.RegisterEnum("rpcpb.PeerRoleType", PeerRoleType_name, PeerRoleType_value)
+	proto.RegisterEnum("rpcpb.LabelConstraintOp", LabelConstraintOp_name, LabelConstraintOp_value)
+	proto.RegisterType((*Request)(nil), "rpcpb.Request")
+	proto.RegisterType((*Response)(nil), "rpcpb.Response")
+	proto.RegisterType((*ResourceHeartbeatReq)(nil), "rpcpb.ResourceHeartbeatReq")
+	proto.RegisterType((*ResourceHeartbeatRsp)(nil), "rpcpb.ResourceHeartbeatRsp")
+	proto.RegisterType((*PutContainerReq)(nil), "rpcpb.PutContainerReq")
+	proto.RegisterType((*PutContainerRsp)(nil), "rpcpb.PutContainerRsp")
+	proto.RegisterType((*ContainerHeartbeatReq)(nil), "rpcpb.ContainerHeartbeatReq")
+	proto.RegisterType((*ContainerHeartbeatRsp)(nil), "rpcpb.ContainerHeartbeatRsp")
+	proto.RegisterType((*GetContainerReq)(nil), "rpcpb.GetContainerReq")
+	proto.RegisterType((*GetContainerRsp)(nil), "rpcpb.GetContainerRsp")
+	proto.RegisterType((*AllocIDReq)(nil), "rpcpb.AllocIDReq")
+	proto.RegisterType((*AllocIDRsp)(nil), "rpcpb.AllocIDRsp")
+	proto.RegisterType((*AskSplitReq)(nil), "rpcpb.AskSplitReq")
+	proto.RegisterType((*AskSplitRsp)(nil), "rpcpb.AskSplitRsp")
+	proto.RegisterType((*ReportSplitReq)(nil), "rpcpb.ReportSplitReq")
+	proto.RegisterType((*ReportSplitRsp)(nil), "rpcpb.ReportSplitRsp")
+	proto.RegisterType((*AskBatchSplitReq)(nil), "rpcpb.AskBatchSplitReq")
+	proto.RegisterType((*AskBatchSplitRsp)(nil), "rpcpb.AskBatchSplitRsp")
+	proto.RegisterType((*BatchReportSplitReq)(nil), "rpcpb.BatchReportSplitReq")
+	proto.RegisterType((*BatchReportSplitRsp)(nil), "rpcpb.BatchReportSplitRsp")
+	proto.RegisterType((*SplitID)(nil), "rpcpb.SplitID")
+	proto.RegisterType((*CreateWatcherReq)(nil), "rpcpb.CreateWatcherReq")
+	proto.RegisterType((*CreateResourcesReq)(nil), "rpcpb.CreateResourcesReq")
+	proto.RegisterType((*CreateResourcesRsp)(nil), "rpcpb.CreateResourcesRsp")
+	proto.RegisterType((*RemoveResourcesReq)(nil), "rpcpb.RemoveResourcesReq")
+	proto.RegisterType((*RemoveResourcesRsp)(nil), "rpcpb.RemoveResourcesRsp")
+	proto.RegisterType((*CheckResourceStateReq)(nil), "rpcpb.CheckResourceStateReq")
+	proto.RegisterType((*CheckResourceStateRsp)(nil), "rpcpb.CheckResourceStateRsp")
+	proto.RegisterType((*PutPlacementRuleReq)(nil), "rpcpb.PutPlacementRuleReq")
+	proto.RegisterType((*PutPlacementRuleRsp)(nil), "rpcpb.PutPlacementRuleRsp")
+	proto.RegisterType((*GetAppliedRulesReq)(nil), "rpcpb.GetAppliedRulesReq")
+	proto.RegisterType((*GetAppliedRulesRsp)(nil), "rpcpb.GetAppliedRulesRsp")
+	proto.RegisterType((*CreateJobReq)(nil), "rpcpb.CreateJobReq")
+	proto.RegisterType((*CreateJobRsp)(nil), "rpcpb.CreateJobRsp")
+	proto.RegisterType((*RemoveJobReq)(nil), "rpcpb.RemoveJobReq")
+	proto.RegisterType((*RemoveJobRsp)(nil), "rpcpb.RemoveJobRsp")
+	proto.RegisterType((*ExecuteJobReq)(nil), "rpcpb.ExecuteJobReq")
+	proto.RegisterType((*ExecuteJobRsp)(nil), "rpcpb.ExecuteJobRsp")
+	proto.RegisterType((*GetTimestampReq)(nil), "rpcpb.GetTimestampReq")
+	proto.RegisterType((*GetTimestampRsp)(nil), "rpcpb.GetTimestampRsp")
+	proto.RegisterType((*ListContainersReq)(nil), "rpcpb.ListContainersReq")
+	proto.RegisterType((*ContainerInfo)(nil), "rpcpb.ContainerInfo")
+	proto.RegisterType((*ListContainersRsp)(nil), "rpcpb.ListContainersRsp")
+	proto.RegisterType((*ListResourcesReq)(nil), "rpcpb.ListResourcesReq")
+	proto.RegisterType((*ResourceInfo)(nil), "rpcpb.ResourceInfo")
+	proto.RegisterType((*ListResourcesRsp)(nil), "rpcpb.ListResourcesRsp")
+	proto.RegisterType((*RemoveContainerReq)(nil), "rpcpb.RemoveContainerReq")
+	proto.RegisterType((*RemoveContainerRsp)(nil), "rpcpb.RemoveContainerRsp")
+	proto.RegisterType((*UpContainerReq)(nil), "rpcpb.UpContainerReq")
+	proto.RegisterType((*UpContainerRsp)(nil), "rpcpb.UpContainerRsp")
+	proto.RegisterType((*SetContainerWeightReq)(nil), "rpcpb.SetContainerWeightReq")
+	proto.RegisterType((*SetContainerWeightRsp)(nil), "rpcpb.SetContainerWeightRsp")
+	proto.RegisterType((*AddSchedulerReq)(nil), "rpcpb.AddSchedulerReq")
+	proto.RegisterType((*AddSchedulerRsp)(nil), "rpcpb.AddSchedulerRsp")
+	proto.RegisterType((*RemoveSchedulerReq)(nil), "rpcpb.RemoveSchedulerReq")
+	proto.RegisterType((*RemoveSchedulerRsp)(nil), "rpcpb.RemoveSchedulerRsp")
+	proto.RegisterType((*PauseSchedulerReq)(nil), "rpcpb.PauseSchedulerReq")
+	proto.RegisterType((*PauseSchedulerRsp)(nil), "rpcpb.PauseSchedulerRsp")
+	proto.RegisterType((*ListSchedulersReq)(nil), "rpcpb.ListSchedulersReq")
+	proto.RegisterType((*ListSchedulersRsp)(nil), "rpcpb.ListSchedulersRsp")
+	proto.RegisterType((*AddOperatorReq)(nil), "rpcpb.AddOperatorReq")
+	proto.RegisterType((*AddOperatorRsp)(nil), "rpcpb.AddOperatorRsp")
+	proto.RegisterType((*RemoveOperatorReq)(nil), "rpcpb.RemoveOperatorReq")
+	proto.RegisterType((*RemoveOperatorRsp)(nil), "rpcpb.RemoveOperatorRsp")
+	proto.RegisterType((*GetOperatorStatusReq)(nil), "rpcpb.GetOperatorStatusReq")
+	proto.RegisterType((*GetOperatorStatusRsp)(nil), "rpcpb.GetOperatorStatusRsp")
+	proto.RegisterType((*ListJobsReq)(nil), "rpcpb.ListJobsReq")
+	proto.RegisterType((*ListJobsRsp)(nil), "rpcpb.ListJobsRsp")
+	proto.RegisterType((*GetJobReq)(nil), "rpcpb.GetJobReq")
+	proto.RegisterType((*GetJobRsp)(nil), "rpcpb.GetJobRsp")
+	proto.RegisterType((*EventNotify)(nil), "rpcpb.EventNotify")
+	proto.RegisterType((*InitEventData)(nil), "rpcpb.InitEventData")
+	proto.RegisterType((*ResourceEventData)(nil), "rpcpb.ResourceEventData")
+	proto.RegisterType((*ContainerEventData)(nil), "rpcpb.ContainerEventData")
+	proto.RegisterType((*ChangePeer)(nil), "rpcpb.ChangePeer")
+	proto.RegisterType((*TransferLeader)(nil), "rpcpb.TransferLeader")
+	proto.RegisterType((*ChangePeerV2)(nil), "rpcpb.ChangePeerV2")
+	proto.RegisterType((*Merge)(nil), "rpcpb.Merge")
+	proto.RegisterType((*SplitResource)(nil), "rpcpb.SplitResource")
+	proto.RegisterType((*LabelConstraint)(nil), "rpcpb.LabelConstraint")
+	proto.RegisterType((*PlacementRule)(nil), "rpcpb.PlacementRule")
 }
 
-func (m *PutPlacementRuleRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+func init() { proto.RegisterFile("rpcpb.proto", fileDescriptor_25e491924c678914) }
 
-func (m *PutPlacementRuleRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+var fileDescriptor_25e491924c678914 = []byte{
+	// 3474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5b, 0xcd, 0x72, 0x1c, 0xb7,
+	0xf1, 0xd7, 0x7e, 0x91, 0xdc, 0xe6, 0xee, 0x12, 0x04, 0x3f, 0x34, 0xa2, 0x24, 0x92, 0x82, 0x64,
+	0x99, 0x96, 0x6d, 0x52, 0xa2, 0x64, 0xd9, 0xd6, 0xdf, 0xb2, 0x4d, 0x89, 0xb2, 0x45, 0x99, 0xb6,
+	0x58, 0x23, 0xd9, 0x3e, 0xfc, 0x0f, 0xa9, 0xe1, 0x2e, 0xb8, 0x5c, 0x6b, 0xb9, 0x03, 0x0d, 0x66,
+	0x25, 0x31, 0xa7, 0x5c, 0x72, 0xcd, 0x1b, 0xe4, 0x1d, 0xf2, 0x04, 0x39, 0xa6, 0x7c, 0x4a, 0xf9,
+	0x01, 0x52, 0xae, 0x44, 0xcf, 0x90, 0x5b, 0x2e, 0x29, 0x60, 0x06, 0x03, 0x60, 0xbe, 0x96, 0xae,
+	0x9c, 0xb8, 0xe8, 0xee, 0x5f, 0x0f, 0x3e, 0x1a, 0x8d, 0x1f, 0x1a, 0x45, 0x98, 0x0d, 0x58, 0x97,
+	0x1d, 0x6e, 0xb2, 0xc0, 0x0f, 0x7d, 0xdc, 0x90, 0x8d, 0x95, 0xfd, 0xfe, 0x20, 0x3c, 0x1e, 0x1f,
+	0x6e, 0x76, 0xfd, 0x93, 0xad, 0x13, 0x2f, 0x0c, 0x06, 0x6f, 0xfc, 0x60, 0xd0, 0x1f, 0x8c, 0xe2,
+	0x46, 0x77, 0x7c, 0x48, 0xb7, 0xba, 0xfe, 0x09, 0xf3, 0x47, 0x74, 0x14, 0xf2, 0x2d, 0x16, 0xf8,
+	0xec, 0x98, 0x86, 0x5b, 0xec, 0x70, 0xeb, 0x84, 0x86, 0x5e, 0xf2, 0x27, 0x72, 0xba, 0xf2, 0xa1,
+	0xe1, 0xad, 0xef, 0xf7, 0xfd, 0x2d, 0x29, 0x3e, 0x1c, 0x1f, 0xc9, 0x96, 0x6c, 0xc8, 0x5f, 0x91,
+	0x39, 0xf9, 0x1b, 0x86, 0x69, 0x97, 0xbe, 0x1c, 0x53, 0x1e, 0xe2, 0x65, 0xa8, 0x0e, 0x7a, 0x4e,
+	0x65, 0xbd, 0xb2, 0x51, 0x7f, 0x30, 0xf5, 0xf6, 0xd7, 0xb5, 0xea, 0xde, 0xae, 0x5b, 0x1d, 0xf4,
+	0xf0, 0x3a, 0xcc, 0x76, 0xfd, 0x51, 0xe8, 0x0d, 0x46, 0x34, 0xd8, 0xdb, 0x75, 0xaa, 0xc2, 0xc0,
+	0x35, 0x45, 0x78, 0x0d, 0xea, 0xe1, 0x29, 0xa3, 0x4e, 0x6d, 0xbd, 0xb2, 0xd1, 0xd9, 0x9e, 0xdd,
+	0x8c, 0x46, 0xf9, 0xfc, 0x94, 0x51, 0x57, 0x2a, 0xf0, 0x53, 0x98, 0x0f, 0x28, 0xf7, 0xc7, 0x41,
+	0x97, 0x3e, 0xa6, 0x5e, 0x10, 0x1e, 0x52, 0x2f, 0x74, 0xea, 0xeb, 0x95, 0x8d, 0xd9, 0xed, 0x8b,
+	0xb1, 0xb5, 0x9b, 0xd6, 0xbb, 0xf4, 0xe5, 0x83, 0xfa, 0xcf, 0xbf, 0xae, 0x9d, 0x73, 0xb3, 0x58,
+	0xec, 0x02, 0x4e, 0x3a, 0xa0, 0x3d, 0x36, 0xa4, 0xc7, 0x4b, 0xb1, 0xc7, 0x87, 0x19, 0x03, 0xed,
+	0x32, 0x07, 0x8d, 0xbf, 0x84, 0x16, 0x1b, 0x87, 0x09, 0xca, 0x99, 0x92, 0xde, 0x96, 0x63, 0x6f,
+	0x07, 0x86, 0x4a, 0xfb, 0xb1, 0x10, 0xc2, 0x43, 0x9f, 0x1a, 0x1e, 0xa6, 0x2d, 0x0f, 0x5f, 0xd3,
+	0x5c, 0x0f, 0x26, 0x02, 0xdf, 0x82, 0x69, 0x6f, 0x38, 0xf4, 0xbb, 0x7b, 0xbb, 0xce, 0x8c, 0x04,
+	0xcf, 0xc7, 0xe0, 0x9d, 0x48, 0xaa, 0x71, 0xca, 0x0e, 0xdf, 0x81, 0x19, 0x8f, 0xbf, 0x78, 0xc6,
+	0x86, 0x83, 0xd0, 0x69, 0x4a, 0x0c, 0x56, 0x98, 0x58, 0xac, 0x41, 0x89, 0x25, 0x7e, 0x08, 0x6d,
+	0x8f, 0xbf, 0x78, 0xe0, 0x85, 0xdd, 0xe3, 0x08, 0x0a, 0x12, 0x7a, 0x5e, 0x43, 0xb5, 0x4e, 0xe3,
+	0x6d, 0x0c, 0xbe, 0x0f, 0xb3, 0x01, 0x65, 0x7e, 0x10, 0x46, 0x2e, 0x66, 0xa5, 0x8b, 0xa5, 0x64,
+	0x41, 0x13, 0x8d, 0x76, 0x60, 0xda, 0xe3, 0x7d, 0x40, 0x87, 0xc2, 0x99, 0x61, 0xe9, 0xb4, 0xa4,
+	0x8f, 0x95, 0xd8, 0xc7, 0x83, 0x94, 0x5a, 0x3b, 0xca, 0x20, 0xc5, 0x88, 0xba, 0x01, 0xf5, 0x42,
+	0xfa, 0xa3, 0xd0, 0xd0, 0xc0, 0x69, 0x5b, 0x23, 0x7a, 0x68, 0xea, 0x8c, 0x11, 0x59, 0x18, 0xbc,
+	0x07, 0x73, 0x91, 0x40, 0x85, 0x23, 0x77, 0x3a, 0xd2, 0xcd, 0x05, 0xcb, 0x4d, 0xa2, 0xd5, 0x8e,
+	0xd2, 0x38, 0xe1, 0x2a, 0xa0, 0x27, 0xfe, 0x2b, 0xc3, 0xd5, 0x9c, 0xe5, 0xca, 0xb5, 0xb5, 0x86,
+	0xab, 0x14, 0x4e, 0x46, 0xfb, 0x31, 0xed, 0xbe, 0x50, 0x92, 0x67, 0xa1, 0x17, 0x52, 0x07, 0xd9,
+	0xd1, 0x9e, 0x31, 0x30, 0xa3, 0x3d, 0xa3, 0x14, 0x93, 0xcf, 0xc6, 0xe1, 0xc1, 0xd0, 0xeb, 0xd2,
+	0x13, 0x3a, 0x0a, 0xdd, 0xf1, 0x90, 0x3a, 0xf3, 0xd6, 0xe4, 0x1f, 0xa4, 0xd4, 0xc6, 0xe4, 0xa7,
+	0x91, 0x62, 0xb0, 0x7d, 0x1a, 0xee, 0x30, 0x36, 0x1c, 0xd0, 0x9e, 0x90, 0x70, 0x07, 0x5b, 0x83,
+	0xfd, 0xda, 0xd6, 0x1a, 0x83, 0x4d, 0xe1, 0xf0, 0xc7, 0xd0, 0x8c, 0xa6, 0xf2, 0x89, 0x7f, 0xe8,
+	0x2c, 0x48, 0x27, 0x0b, 0xd6, 0xe4, 0x3f, 0xf1, 0x0f, 0x35, 0x5c, 0xdb, 0x0a, 0x60, 0x34, 0x71,
+	0x02, 0xb8, 0x68, 0x01, 0x5d, 0x25, 0x37, 0x80, 0x89, 0x2d, 0xbe, 0x07, 0x40, 0xdf, 0xd0, 0xee,
+	0x38, 0xfa, 0xe4, 0x92, 0x44, 0x2e, 0xc6, 0xc8, 0x47, 0x89, 0x42, 0x43, 0x0d, 0xeb, 0x78, 0xcb,
+	0x3f, 0x1f, 0x9c, 0x50, 0x1e, 0x7a, 0x27, 0xcc, 0x59, 0x4e, 0x6f, 0xf9, 0x44, 0x65, 0x6f, 0xf9,
+	0x44, 0x8c, 0xbf, 0x82, 0xce, 0x70, 0xc0, 0x75, 0x0e, 0xe0, 0xce, 0x79, 0xe9, 0xc3, 0x89, 0x7d,
+	0xec, 0x5b, 0x4a, 0xed, 0x25, 0x85, 0x12, 0xf1, 0x2f, 0x24, 0x3a, 0xda, 0x1c, 0x2b, 0xfe, 0xf7,
+	0x4d, 0x9d, 0x11, 0xff, 0x16, 0x46, 0x07, 0xad, 0x4e, 0x62, 0x17, 0x72, 0x82, 0x36, 0x27, 0x8f,
+	0xa5, 0x71, 0x22, 0x39, 0x8c, 0x99, 0x76, 0xb3, 0x62, 0x25, 0x87, 0xef, 0x59, 0x8e, 0x0b, 0xd3,
+	0x5e, 0xc4, 0x3c, 0x37, 0x32, 0xe3, 0x8f, 0x74, 0xd0, 0x3f, 0x0e, 0x9d, 0x8b, 0x56, 0xcc, 0x3f,
+	0xcb, 0x18, 0x18, 0x31, 0x9f, 0x45, 0x8b, 0xc5, 0xf2, 0x7a, 0xbd, 0x67, 0xdd, 0x63, 0xda, 0x1b,
+	0x0f, 0x69, 0xe0, 0x5c, 0xb2, 0x16, 0x6b, 0xc7, 0x50, 0x19, 0x8b, 0x65, 0x22, 0xf4, 0xfc, 0x68,
+	0x27, 0x97, 0x73, 0xe6, 0x27, 0xc7, 0x4f, 0x1a, 0x27, 0xd6, 0x9d, 0x79, 0x63, 0x6e, 0x78, 0x5a,
+	0xb5, 0xd6, 0xfd, 0xc0, 0x52, 0x1a, 0xeb, 0x6e, 0xa3, 0x54, 0xfc, 0x24, 0x02, 0xee, 0xac, 0x65,
+	0xe2, 0x47, 0x2b, 0x53, 0xf1, 0xa3, 0x15, 0x62, 0xbd, 0xbc, 0x5e, 0xef, 0x29, 0xa3, 0x81, 0x17,
+	0xfa, 0x81, 0xb3, 0x6e, 0xad, 0xd7, 0x8e, 0xd6, 0x18, 0xeb, 0x65, 0xd8, 0x8b, 0x6e, 0x44, 0x23,
+	0x4c, 0x3c, 0x5c, 0xb1, 0xba, 0xe1, 0x5a, 0x4a, 0xa3, 0x1b, 0x36, 0x4a, 0x50, 0x85, 0x3e, 0x0d,
+	0x55, 0x53, 0xe4, 0xaa, 0x31, 0x77, 0x88, 0x45, 0x15, 0xbe, 0x4e, 0xeb, 0x0d, 0xaa, 0x90, 0xc1,
+	0x8a, 0xf3, 0x51, 0x8c, 0xf4, 0x89, 0x7f, 0xc8, 0x9d, 0xab, 0xd6, 0xf9, 0xb8, 0x1f, 0x8b, 0x8d,
+	0xf3, 0x51, 0x59, 0xe2, 0x4d, 0x98, 0xea, 0x53, 0xf1, 0xd3, 0xb9, 0x26, 0x31, 0x48, 0x7f, 0xdb,
+	0xca, 0x05, 0xb1, 0x15, 0xf9, 0x2b, 0x86, 0x19, 0x97, 0x72, 0xe6, 0x8f, 0x38, 0x2d, 0x64, 0x52,
+	0x8a, 0x27, 0x55, 0x8b, 0x78, 0xd2, 0x22, 0x34, 0x68, 0x10, 0xf8, 0x81, 0x64, 0x52, 0x4d, 0x37,
+	0x6a, 0xe0, 0x65, 0x98, 0x1a, 0x52, 0xaf, 0x47, 0x03, 0x49, 0x99, 0x9a, 0x6e, 0xdc, 0xca, 0x67,
+	0x55, 0x8d, 0x09, 0xac, 0x8a, 0xb3, 0xdf, 0xca, 0xaa, 0xa6, 0x26, 0xb1, 0xaa, 0xc4, 0xe5, 0x59,
+	0x58, 0xd5, 0x74, 0x31, 0xab, 0x4a, 0xfc, 0x94, 0xb3, 0xaa, 0x99, 0x62, 0x56, 0xa5, 0x3d, 0x14,
+	0xb1, 0xaa, 0x66, 0x2e, 0xab, 0x4a, 0x70, 0xb9, 0xac, 0x0a, 0xf2, 0x59, 0x55, 0x02, 0x2a, 0x61,
+	0x55, 0xb3, 0x25, 0xac, 0x2a, 0xc1, 0x97, 0xb3, 0xaa, 0x56, 0x21, 0xab, 0x4a, 0x1c, 0x4c, 0x64,
+	0x55, 0xed, 0x72, 0x56, 0x95, 0x38, 0xca, 0x20, 0xf1, 0x26, 0x34, 0xe8, 0x2b, 0x3a, 0x0a, 0x9d,
+	0x8e, 0x35, 0x09, 0x8f, 0x84, 0xec, 0x3b, 0x3f, 0x1c, 0x1c, 0x9d, 0xc6, 0xd0, 0xc8, 0x2c, 0x8f,
+	0x40, 0xcd, 0x95, 0x12, 0xa8, 0xe4, 0xdb, 0x67, 0x21, 0x50, 0xa8, 0x94, 0x40, 0x69, 0x57, 0x67,
+	0x23, 0x50, 0xf3, 0x93, 0x08, 0x94, 0x11, 0xd8, 0x67, 0x23, 0x50, 0xb8, 0x9c, 0x40, 0xe9, 0x79,
+	0x3e, 0x0b, 0x81, 0x5a, 0x28, 0x25, 0x50, 0x7a, 0xb0, 0xa5, 0x04, 0x6a, 0xb1, 0x80, 0x40, 0x25,
+	0xf0, 0x22, 0x02, 0xb5, 0x54, 0x40, 0xa0, 0x34, 0xb0, 0x88, 0x40, 0x2d, 0x17, 0x11, 0xa8, 0x04,
+	0x5a, 0x46, 0xa0, 0xce, 0x17, 0x13, 0x28, 0x6b, 0x77, 0x97, 0x11, 0x28, 0xa7, 0x8c, 0x40, 0x25,
+	0x5e, 0x26, 0x12, 0xa8, 0x0b, 0x25, 0x04, 0x4a, 0x6f, 0xde, 0x89, 0x04, 0x6a, 0xa5, 0x94, 0x40,
+	0xa5, 0x83, 0xb6, 0x90, 0x40, 0x5d, 0x2c, 0x24, 0x50, 0x3a, 0x0f, 0x4c, 0x26, 0x50, 0x97, 0x26,
+	0x11, 0x28, 0x1d, 0xf3, 0x67, 0x20, 0x50, 0x97, 0x8b, 0x09, 0x94, 0x5e, 0xac, 0x49, 0x04, 0x6a,
+	0xb5, 0x94, 0x40, 0xa5, 0xe7, 0xa7, 0x8c, 0x40, 0xad, 0x95, 0x11, 0x28, 0xbd, 0xee, 0x13, 0x09,
+	0xd4, 0x7a, 0x19, 0x81, 0xb2, 0xe3, 0xa7, 0x98, 0x40, 0x5d, 0x29, 0x24, 0x50, 0x7a, 0xbd, 0xca,
+	0x09, 0x14, 0x29, 0x23, 0x50, 0xba, 0x1b, 0x67, 0x21, 0x50, 0x57, 0x27, 0x10, 0x28, 0xcd, 0x0a,
+	0xca, 0x09, 0xd4, 0xb5, 0x7c, 0x02, 0xa5, 0x8f, 0xc2, 0x1c, 0x02, 0xf5, 0x4e, 0x1e, 0x81, 0x4a,
+	0x10, 0x8a, 0x40, 0xfd, 0xa5, 0x0a, 0x8b, 0x79, 0x35, 0xa0, 0x74, 0xf9, 0xa9, 0x92, 0x2d, 0x3f,
+	0xad, 0xc0, 0x8c, 0xe2, 0x32, 0x92, 0x5a, 0xb5, 0xdc, 0xa4, 0x8d, 0x31, 0xd4, 0x43, 0x1a, 0x9c,
+	0x48, 0x42, 0x55, 0x77, 0xe5, 0x6f, 0x7c, 0xcd, 0xe2, 0x53, 0xb3, 0xdb, 0xad, 0xcd, 0xb8, 0x84,
+	0x76, 0x40, 0x69, 0x90, 0xb0, 0xab, 0x8f, 0xa0, 0xd9, 0xf3, 0x5f, 0x8f, 0x84, 0x8c, 0x3b, 0x8d,
+	0xf5, 0x9a, 0xa4, 0x0d, 0x86, 0xa1, 0x98, 0x1d, 0xae, 0x72, 0x61, 0x62, 0x89, 0xef, 0x42, 0x8b,
+	0xd1, 0x51, 0x6f, 0x30, 0xea, 0x47, 0xc8, 0xa9, 0xf5, 0x5a, 0xfa, 0x13, 0x09, 0xcb, 0x31, 0xec,
+	0xf0, 0x2d, 0x68, 0x70, 0xe1, 0x31, 0x26, 0x48, 0x4b, 0x0a, 0x60, 0x1e, 0x3a, 0xea, 0x73, 0x91,
+	0x25, 0xf9, 0x47, 0x2d, 0x6f, 0xca, 0x38, 0xc3, 0xab, 0x00, 0x6a, 0x02, 0x92, 0x19, 0x33, 0x24,
+	0x78, 0x07, 0xda, 0xaa, 0xf5, 0x88, 0xf9, 0xdd, 0x63, 0xa7, 0x9a, 0xff, 0x4d, 0xa9, 0x54, 0x79,
+	0xce, 0x42, 0xe0, 0x0f, 0x00, 0x42, 0x2f, 0xe8, 0xd3, 0x50, 0xf4, 0x5e, 0xce, 0x6e, 0x7a, 0x1e,
+	0x0d, 0x3d, 0xbe, 0x05, 0xd0, 0x3d, 0xf6, 0x46, 0x7d, 0x7a, 0x40, 0x93, 0x59, 0x9f, 0x4f, 0xce,
+	0x5d, 0xa5, 0x70, 0x0d, 0x23, 0x7c, 0x1f, 0x3a, 0x61, 0xe0, 0x8d, 0xf8, 0x11, 0x0d, 0xf6, 0xa3,
+	0xc5, 0x6a, 0x58, 0x1b, 0xea, 0xb9, 0xa5, 0x74, 0x53, 0xc6, 0x98, 0x40, 0xe3, 0x84, 0x06, 0x7d,
+	0x1a, 0xb3, 0xd7, 0x56, 0x8c, 0xfa, 0x56, 0xc8, 0xdc, 0x48, 0x85, 0xef, 0x41, 0x9b, 0x47, 0x55,
+	0xa5, 0x38, 0x78, 0xa6, 0xad, 0x93, 0xeb, 0x99, 0xa9, 0x73, 0x6d, 0x53, 0xfc, 0x31, 0xb4, 0x74,
+	0x67, 0x7f, 0xd8, 0x76, 0x66, 0xac, 0xe3, 0xf2, 0xa1, 0xa1, 0x72, 0x2d, 0x43, 0xbc, 0x01, 0x73,
+	0x3d, 0xca, 0x43, 0x3f, 0x38, 0xdd, 0x1d, 0x04, 0xb4, 0x1b, 0x0e, 0x4f, 0x25, 0x27, 0x9d, 0x71,
+	0xd3, 0x62, 0xb2, 0x05, 0x73, 0xa9, 0xa2, 0x23, 0xbe, 0x04, 0xcd, 0x24, 0xf0, 0xe5, 0xba, 0xb6,
+	0x5c, 0x2d, 0x20, 0xf3, 0x29, 0x00, 0x67, 0xe4, 0x77, 0xb0, 0x94, 0x5b, 0x06, 0xc5, 0xdb, 0x2a,
+	0xdc, 0x2a, 0x71, 0x0a, 0x8f, 0x97, 0x2e, 0xb1, 0xce, 0xc6, 0x9b, 0xd8, 0x4b, 0x3d, 0x2f, 0xf4,
+	0xe2, 0x3d, 0x26, 0x7f, 0x93, 0xf7, 0x73, 0x3f, 0xc0, 0x59, 0x62, 0x5c, 0x31, 0x8c, 0xdf, 0x83,
+	0xb9, 0x54, 0x11, 0xb4, 0xe8, 0xaa, 0x44, 0x9e, 0xa5, 0x4c, 0xf3, 0x3d, 0xe2, 0x0f, 0xd4, 0x30,
+	0xaa, 0x65, 0xc3, 0x50, 0x1b, 0xa6, 0x05, 0xa0, 0xeb, 0xa8, 0xe4, 0x9a, 0x6e, 0x71, 0x56, 0xd8,
+	0x91, 0x2b, 0x30, 0x6b, 0xd4, 0x51, 0x73, 0x87, 0x75, 0xdf, 0x30, 0xe1, 0x0c, 0x6f, 0xc2, 0xb4,
+	0x8c, 0x95, 0x78, 0xeb, 0xcd, 0x6e, 0x77, 0xcc, 0x80, 0xda, 0xdb, 0x55, 0x57, 0x8d, 0xd8, 0x88,
+	0xdc, 0x83, 0x8e, 0x5d, 0xe2, 0x14, 0x1f, 0x19, 0xd2, 0xa3, 0x50, 0x7d, 0x44, 0xfc, 0x16, 0x57,
+	0xc3, 0x40, 0x9e, 0xe0, 0xd1, 0xec, 0x47, 0x0d, 0x82, 0x6c, 0x2c, 0x67, 0xe4, 0x33, 0x40, 0xe9,
+	0xe2, 0x6d, 0xee, 0xcc, 0x2d, 0x42, 0xa3, 0xeb, 0x8f, 0x47, 0x91, 0xbf, 0xb6, 0x1b, 0x35, 0xc8,
+	0x6e, 0x1a, 0xcd, 0x19, 0xbe, 0x09, 0x33, 0x71, 0x57, 0x45, 0xb4, 0xd4, 0x0a, 0x07, 0x94, 0x58,
+	0x91, 0xdb, 0xb0, 0x90, 0x53, 0xb9, 0x15, 0xd1, 0x1b, 0x24, 0xe4, 0x4a, 0x78, 0x6a, 0xb9, 0x5a,
+	0x40, 0x96, 0x72, 0x40, 0x9c, 0x91, 0x2f, 0x60, 0x3a, 0xfe, 0x8c, 0xe8, 0xf2, 0x88, 0xbe, 0x4e,
+	0x32, 0x5a, 0xd4, 0x10, 0xc9, 0x6e, 0x44, 0x5f, 0x8b, 0xdd, 0x25, 0x3a, 0x58, 0x5d, 0xaf, 0x89,
+	0x64, 0xa7, 0x25, 0xe4, 0x3a, 0xa0, 0x74, 0xed, 0x57, 0x4c, 0xc8, 0xd1, 0xd0, 0xeb, 0x4b, 0x47,
+	0x6d, 0x57, 0xfe, 0x26, 0x2e, 0xe0, 0x6c, 0x71, 0xb7, 0xbc, 0xcf, 0xe2, 0xdb, 0x43, 0xea, 0xf1,
+	0x30, 0x4a, 0xf5, 0xf1, 0xb7, 0xb5, 0x84, 0x2c, 0x66, 0x7d, 0x72, 0x46, 0xb6, 0x00, 0x67, 0x6b,
+	0xbf, 0xf8, 0x02, 0xd4, 0x06, 0xbd, 0xe8, 0x1b, 0xf5, 0x07, 0xd3, 0x6f, 0x7f, 0x5d, 0xab, 0xed,
+	0xed, 0x72, 0x57, 0xc8, 0xc8, 0x62, 0x16, 0xc0, 0x19, 0xd9, 0x86, 0xa5, 0xdc, 0xa2, 0xaf, 0xf6,
+	0x54, 0xd9, 0x68, 0xa5, 0x3c, 0xdd, 0xca, 0xc5, 0x70, 0x86, 0x1d, 0x98, 0x8e, 0x78, 0x44, 0x2f,
+	0xea, 0x81, 0xab, 0x9a, 0xe4, 0x11, 0x2c, 0xe4, 0x54, 0x82, 0xf1, 0x26, 0xd4, 0x03, 0x71, 0xe5,
+	0xa9, 0x58, 0x39, 0xd3, 0x32, 0x8b, 0xe3, 0x42, 0xda, 0x91, 0xa5, 0x1c, 0x37, 0x9c, 0x91, 0x3b,
+	0x80, 0xb3, 0xa5, 0xe1, 0x49, 0x07, 0x18, 0xf9, 0x2a, 0x8b, 0x92, 0x81, 0xda, 0x10, 0x9f, 0x52,
+	0x51, 0x5a, 0xd6, 0xa7, 0xc8, 0x90, 0xdc, 0x86, 0x96, 0x59, 0x53, 0xc6, 0x57, 0xa1, 0xf6, 0x93,
+	0x7f, 0x18, 0x8f, 0x69, 0x56, 0x25, 0x93, 0x27, 0xfe, 0x61, 0x0c, 0x13, 0x5a, 0x1b, 0xc4, 0xd9,
+	0x99, 0x41, 0x66, 0x11, 0xfa, 0x6c, 0xa0, 0x8e, 0x09, 0xe2, 0x8c, 0x3c, 0x86, 0xb6, 0x55, 0x8f,
+	0x3e, 0x93, 0x97, 0xdc, 0xb4, 0x7d, 0xd5, 0xf2, 0x54, 0x90, 0xae, 0xdf, 0x95, 0x39, 0xd8, 0x2c,
+	0x60, 0xeb, 0xac, 0x51, 0x31, 0xb3, 0xc6, 0x56, 0xca, 0x90, 0x33, 0xb1, 0x6f, 0x42, 0xd5, 0x8e,
+	0x17, 0x50, 0x0b, 0xc8, 0x02, 0xcc, 0x67, 0xca, 0xda, 0xe4, 0xcf, 0x15, 0x68, 0x27, 0x92, 0xbd,
+	0xd1, 0x91, 0xff, 0xbf, 0x67, 0x7c, 0x4c, 0xa0, 0x15, 0xd1, 0xb9, 0xf8, 0xfa, 0x23, 0x88, 0x4a,
+	0xc5, 0xb5, 0x64, 0xf8, 0x3a, 0x74, 0x54, 0x68, 0xc5, 0x56, 0x75, 0x69, 0x95, 0x92, 0x92, 0xa7,
+	0x99, 0x4e, 0x73, 0x26, 0xae, 0xbe, 0xc9, 0x01, 0x9c, 0x0e, 0x3c, 0x6b, 0x30, 0xea, 0xea, 0xab,
+	0xad, 0x09, 0x06, 0x94, 0xae, 0xca, 0x93, 0x3f, 0x55, 0xa0, 0xa5, 0x04, 0x85, 0x73, 0xa0, 0x0b,
+	0x82, 0xd1, 0x63, 0x6c, 0xdc, 0x12, 0xdc, 0xc2, 0x63, 0x2c, 0xf0, 0xdf, 0x0c, 0x4e, 0xbc, 0x90,
+	0x3e, 0x1b, 0xfc, 0x3e, 0x7a, 0x92, 0xad, 0xb9, 0x69, 0x71, 0xca, 0xf2, 0x1b, 0x7a, 0xca, 0x9d,
+	0x7a, 0xc6, 0x52, 0x88, 0xc9, 0x37, 0xe9, 0x4e, 0x72, 0x16, 0x15, 0x0a, 0xcc, 0xa4, 0x68, 0x16,
+	0x0a, 0x74, 0xdf, 0x75, 0xa1, 0x40, 0xe5, 0xf8, 0x63, 0x95, 0xc8, 0x2c, 0x0e, 0x30, 0x99, 0xe1,
+	0xdf, 0x84, 0x05, 0x76, 0x7c, 0xca, 0x07, 0x5d, 0x6f, 0x38, 0x3c, 0xdd, 0xa5, 0x3c, 0x0c, 0xfc,
+	0x53, 0xda, 0x93, 0xa3, 0x9f, 0x71, 0xf3, 0x54, 0x64, 0x31, 0xfb, 0x25, 0x99, 0x32, 0x3b, 0xdf,
+	0xb3, 0xdf, 0xf6, 0x6d, 0x82, 0x6c, 0x0c, 0x67, 0xe4, 0x8f, 0x15, 0x58, 0xca, 0x7d, 0x7a, 0x38,
+	0xc3, 0x48, 0xd2, 0x01, 0x59, 0x3d, 0x53, 0x40, 0xd6, 0x72, 0x03, 0xf2, 0x7c, 0x6e, 0x37, 0x38,
+	0x23, 0x9f, 0xc2, 0x5c, 0xea, 0x31, 0x03, 0xe3, 0xb8, 0xf4, 0x5c, 0x91, 0x15, 0x64, 0xf9, 0x5b,
+	0xc8, 0xbc, 0xa0, 0x1f, 0x9d, 0x5b, 0x4d, 0x57, 0xfe, 0x26, 0xf3, 0x29, 0x28, 0x67, 0x64, 0x43,
+	0x4d, 0x65, 0xda, 0xe1, 0xc8, 0x3b, 0x49, 0x1c, 0x8a, 0xdf, 0x64, 0x31, 0x6b, 0xc9, 0x19, 0xd9,
+	0x81, 0xf9, 0xcc, 0x5b, 0x46, 0x1e, 0x5c, 0x9c, 0x41, 0x9c, 0x76, 0xfd, 0x51, 0x2f, 0xda, 0xdc,
+	0x35, 0x57, 0x35, 0xc9, 0x42, 0xc6, 0x05, 0x4f, 0x92, 0x88, 0xf5, 0xb6, 0x41, 0xde, 0xcb, 0x08,
+	0x39, 0x93, 0xc4, 0xc1, 0x3b, 0x89, 0x63, 0xb5, 0xe9, 0x46, 0x0d, 0xf2, 0xef, 0x0a, 0x74, 0xec,
+	0x77, 0x0d, 0xfc, 0xae, 0x31, 0x4b, 0x9d, 0x24, 0xa6, 0x95, 0x85, 0x51, 0xa8, 0xb7, 0x0f, 0xa8,
+	0x6a, 0xe6, 0x86, 0xb5, 0x01, 0x73, 0x47, 0x81, 0x7f, 0xf2, 0xd0, 0x08, 0x86, 0xe8, 0x06, 0x9a,
+	0x16, 0xe3, 0x6b, 0xd0, 0x0e, 0x7d, 0xd3, 0xae, 0x2e, 0xed, 0x6c, 0xa1, 0x48, 0xa7, 0x92, 0x5d,
+	0xc9, 0x9d, 0xda, 0x88, 0x68, 0x48, 0x22, 0xc0, 0xef, 0xc3, 0x14, 0xf3, 0x87, 0x83, 0xee, 0xa9,
+	0x33, 0x15, 0x77, 0x5c, 0x25, 0x45, 0x71, 0xd6, 0x1f, 0x48, 0x95, 0x1b, 0x9b, 0x10, 0x64, 0x8f,
+	0x9a, 0x33, 0x72, 0x1b, 0xe6, 0x33, 0xaf, 0x33, 0x13, 0x8f, 0xe0, 0x85, 0x0c, 0x88, 0x33, 0x72,
+	0x17, 0x16, 0xf3, 0x1e, 0x67, 0x26, 0x3a, 0x63, 0x79, 0xb8, 0x68, 0xe1, 0x8e, 0xfc, 0xf1, 0x28,
+	0xe2, 0xe5, 0x33, 0x6e, 0xd4, 0x10, 0xa5, 0x05, 0x2e, 0x4d, 0xe2, 0x87, 0x94, 0xe4, 0x0c, 0x48,
+	0x39, 0x88, 0xad, 0x64, 0x0a, 0xa5, 0xbc, 0x1b, 0x3f, 0xaa, 0xc8, 0xdf, 0xa4, 0x0d, 0xb3, 0xc6,
+	0xf3, 0x0f, 0xb9, 0x63, 0x34, 0x39, 0xc3, 0xef, 0x40, 0xfd, 0x27, 0xff, 0x30, 0x8a, 0x97, 0xdc,
+	0x83, 0x55, 0xaa, 0xc9, 0x55, 0x68, 0x26, 0xef, 0x41, 0x85, 0x17, 0x88, 0x9b, 0x89, 0xd1, 0x59,
+	0xb9, 0xc2, 0x7f, 0xaa, 0x30, 0x6b, 0x14, 0xd8, 0x31, 0x82, 0x1a, 0xa7, 0x2f, 0xe3, 0x69, 0x13,
+	0x3f, 0x93, 0xdd, 0x1c, 0x71, 0x77, 0xf9, 0x1b, 0x6f, 0x43, 0x73, 0x30, 0x1a, 0x84, 0x12, 0x18,
+	0x5f, 0xc8, 0xd5, 0x41, 0xb4, 0xa7, 0xe4, 0xbb, 0x5e, 0xe8, 0xb9, 0xda, 0x0c, 0x7f, 0x6e, 0x14,
+	0x02, 0x24, 0xae, 0x9e, 0x2a, 0x39, 0x19, 0x3a, 0x89, 0xb5, 0xcd, 0xf1, 0x0e, 0x74, 0x92, 0xe4,
+	0x16, 0x39, 0x68, 0xd8, 0xc5, 0x7e, 0x4b, 0x29, 0x3d, 0xa4, 0x00, 0xf8, 0x11, 0xe0, 0xc0, 0x2c,
+	0x71, 0x44, 0x6e, 0xa6, 0x4a, 0x8a, 0x20, 0x6e, 0x0e, 0x00, 0x3f, 0x86, 0x85, 0xae, 0xc5, 0x00,
+	0x22, 0x3f, 0xd3, 0xa5, 0x24, 0x21, 0x0f, 0x42, 0xfa, 0xd0, 0xb6, 0xe6, 0x6b, 0xc2, 0x15, 0xc0,
+	0x81, 0xe9, 0x28, 0x79, 0x2b, 0xfe, 0xaf, 0x9a, 0x22, 0xe8, 0x0d, 0x6a, 0x50, 0x93, 0x40, 0xf3,
+	0xf8, 0x7f, 0x29, 0x76, 0x50, 0x6a, 0x82, 0x7f, 0xd3, 0x71, 0x6f, 0x70, 0xf6, 0x9a, 0xdc, 0x1f,
+	0xaa, 0x29, 0x10, 0x51, 0x59, 0x5f, 0x2e, 0xe8, 0x8c, 0x1b, 0xb7, 0x44, 0x2a, 0xcf, 0x2e, 0x49,
+	0x2e, 0xf7, 0x1b, 0x02, 0xe8, 0x22, 0x06, 0xbe, 0x0e, 0x75, 0x46, 0xe3, 0x92, 0x43, 0x7e, 0x31,
+	0x4b, 0xea, 0xf1, 0x5d, 0x55, 0xe7, 0x79, 0xae, 0x9f, 0x39, 0xf5, 0xe4, 0x27, 0xfe, 0x84, 0xd6,
+	0x35, 0x2c, 0xc9, 0x27, 0xd0, 0xb1, 0xeb, 0x39, 0x67, 0xfd, 0x22, 0xd9, 0x81, 0x96, 0x59, 0x6c,
+	0x11, 0x4f, 0x7d, 0x91, 0x5f, 0xb5, 0x79, 0xb3, 0x65, 0x26, 0x75, 0xff, 0x8e, 0xed, 0xc8, 0x1a,
+	0x34, 0x64, 0x59, 0x48, 0xcc, 0x5a, 0x54, 0xb3, 0x8a, 0x67, 0x22, 0x6e, 0x91, 0x03, 0x68, 0x5b,
+	0xb5, 0x20, 0x23, 0xdf, 0x56, 0x26, 0xe6, 0x5b, 0x31, 0xbb, 0x2f, 0xe8, 0x69, 0x14, 0x1d, 0x2d,
+	0x57, 0xfe, 0x26, 0x14, 0xe6, 0xf6, 0xbd, 0x43, 0x3a, 0x7c, 0xe8, 0x8f, 0x78, 0x18, 0x78, 0x83,
+	0x51, 0x28, 0x36, 0xf9, 0x0b, 0x7a, 0x1a, 0x9f, 0x87, 0xe2, 0x27, 0xde, 0x80, 0xaa, 0xcf, 0xe2,
+	0x49, 0x4c, 0x6a, 0xd1, 0x36, 0xea, 0x29, 0x73, 0xab, 0xbe, 0xa8, 0x5d, 0x4c, 0xbd, 0xf2, 0x86,
+	0x63, 0x1a, 0x45, 0x59, 0xd3, 0x8d, 0x5b, 0xe4, 0x0f, 0x35, 0x68, 0xdb, 0xcf, 0x4c, 0x3a, 0x49,
+	0x35, 0xad, 0x97, 0x69, 0x07, 0xa6, 0xfb, 0x81, 0x3f, 0x66, 0xf1, 0x61, 0xd6, 0x74, 0x55, 0x53,
+	0xa4, 0xe0, 0xc1, 0xa8, 0x47, 0xdf, 0xc8, 0x10, 0x6b, 0xbb, 0x51, 0x43, 0x94, 0x5c, 0xfd, 0x57,
+	0x34, 0x08, 0x06, 0x3d, 0x15, 0x62, 0x49, 0x5b, 0xe8, 0x78, 0xe8, 0x05, 0xe2, 0x68, 0x92, 0xe9,
+	0xa0, 0xe5, 0x26, 0x6d, 0xd1, 0x53, 0x3a, 0xea, 0x09, 0xcd, 0x54, 0x34, 0xc5, 0x51, 0x4b, 0x1c,
+	0xbc, 0x81, 0x3f, 0x8c, 0x2a, 0x70, 0xfa, 0xe0, 0x95, 0x45, 0x41, 0x7f, 0x48, 0xa3, 0x83, 0x57,
+	0x18, 0xe8, 0x0b, 0xc8, 0x8c, 0x71, 0x01, 0xc1, 0x8f, 0x01, 0x0d, 0xed, 0x99, 0xe1, 0x4e, 0x73,
+	0xbd, 0x66, 0xbc, 0x4d, 0xa4, 0x26, 0x4e, 0xbd, 0xc3, 0xa5, 0x51, 0x82, 0x7b, 0x0d, 0xfd, 0xae,
+	0x17, 0x0e, 0xfc, 0x91, 0x84, 0x70, 0x07, 0xe4, 0x94, 0xa6, 0xa4, 0xc2, 0x6e, 0xc0, 0xfd, 0x61,
+	0x24, 0xa2, 0xaf, 0xe8, 0x50, 0x3e, 0xf5, 0x36, 0xdd, 0x94, 0xf4, 0xc6, 0xdf, 0xdb, 0x50, 0x17,
+	0xdd, 0xc7, 0x17, 0x60, 0x49, 0x0e, 0x83, 0xf6, 0x07, 0x3c, 0xa4, 0x41, 0xb2, 0x0d, 0xd1, 0x39,
+	0x7c, 0x09, 0x9c, 0x48, 0x95, 0xad, 0x7e, 0xa3, 0x4a, 0xb1, 0x96, 0x33, 0x54, 0xc5, 0x97, 0xe1,
+	0x82, 0xd0, 0xe6, 0x16, 0xf9, 0x50, 0xad, 0x44, 0xcd, 0x19, 0xaa, 0xe3, 0xf3, 0xb0, 0x20, 0xd4,
+	0xa9, 0x32, 0x23, 0x6a, 0xe4, 0x2a, 0x38, 0x43, 0x53, 0x4a, 0x91, 0x2a, 0xe3, 0xa1, 0xe9, 0x5c,
+	0x05, 0x67, 0x68, 0x06, 0x63, 0xe8, 0x08, 0x85, 0x2e, 0xbc, 0xa1, 0x66, 0x5a, 0xc6, 0x19, 0x02,
+	0xbc, 0x00, 0x73, 0x52, 0xa6, 0x8b, 0x6d, 0x68, 0x36, 0x23, 0xe4, 0x0c, 0xb5, 0xb0, 0x03, 0x8b,
+	0xb1, 0xd0, 0x2a, 0x73, 0xa1, 0x76, 0xbe, 0x86, 0x33, 0xd4, 0xc1, 0xcb, 0x80, 0xa3, 0x59, 0x34,
+	0x2b, 0x52, 0x68, 0x2e, 0x4f, 0xce, 0x19, 0x42, 0xf8, 0x22, 0x9c, 0x17, 0xf2, 0x9c, 0x32, 0x16,
+	0x9a, 0x2f, 0x54, 0x72, 0x86, 0xb0, 0xea, 0x43, 0xba, 0xe6, 0x84, 0x16, 0xd4, 0x60, 0x8c, 0xa3,
+	0x1d, 0x2d, 0xe2, 0x15, 0x58, 0xd6, 0xe6, 0xe6, 0x55, 0x10, 0x2d, 0x15, 0xe9, 0x38, 0x43, 0xcb,
+	0x4a, 0x97, 0x2d, 0x24, 0xa1, 0xf3, 0x45, 0x3a, 0xce, 0x90, 0x93, 0x44, 0x44, 0x5e, 0xe5, 0x08,
+	0x5d, 0x28, 0x51, 0x73, 0x86, 0x56, 0xd4, 0xc8, 0x73, 0x0a, 0x42, 0xe8, 0x62, 0xa1, 0x92, 0x33,
+	0x74, 0x49, 0xf5, 0x29, 0x5b, 0xec, 0x41, 0x97, 0x8b, 0x74, 0x9c, 0xa1, 0x55, 0xbc, 0x08, 0x48,
+	0xcf, 0x41, 0x44, 0xb5, 0xd0, 0x5a, 0x56, 0xca, 0x19, 0x5a, 0x57, 0x52, 0xb3, 0xd0, 0x82, 0xae,
+	0x64, 0xa5, 0x9c, 0x21, 0x82, 0x97, 0x60, 0x5e, 0x2e, 0x86, 0x59, 0x4f, 0x41, 0x57, 0x73, 0xc4,
+	0x9c, 0xa1, 0x6b, 0x46, 0x74, 0x9b, 0xe5, 0x10, 0xf4, 0x4e, 0xae, 0x82, 0x33, 0x74, 0x5d, 0xed,
+	0xf7, 0x4c, 0x99, 0x03, 0xbd, 0x5b, 0xa0, 0xe2, 0x0c, 0x6d, 0xa8, 0xe0, 0x49, 0x97, 0x05, 0xd0,
+	0x7b, 0xf9, 0x1a, 0xce, 0xd0, 0x0d, 0x7b, 0xb5, 0xad, 0x5d, 0xf9, 0x7e, 0x91, 0x8e, 0x33, 0xf4,
+	0x81, 0x0a, 0x7d, 0xfb, 0x42, 0x8c, 0x3e, 0xcc, 0x93, 0x73, 0x86, 0x36, 0x55, 0x68, 0xe4, 0xde,
+	0x7c, 0xd1, 0x56, 0x89, 0x9a, 0x33, 0x74, 0x53, 0x4d, 0x54, 0xea, 0x5e, 0x8a, 0x6e, 0xe5, 0x2a,
+	0x38, 0x43, 0xdb, 0x76, 0xdf, 0x2d, 0xd0, 0xed, 0x22, 0x1d, 0x67, 0xe8, 0x8e, 0x9a, 0xde, 0xcc,
+	0x9d, 0x13, 0x7d, 0x54, 0xa0, 0xe2, 0x0c, 0xdd, 0x35, 0x17, 0xc5, 0xba, 0x51, 0xa2, 0x8f, 0x0b,
+	0x54, 0x9c, 0xa1, 0x4f, 0xd4, 0x5c, 0xd9, 0xd7, 0x48, 0xf4, 0x69, 0x9e, 0x9c, 0x33, 0x74, 0x4f,
+	0x9f, 0x02, 0xa9, 0xeb, 0x16, 0xfa, 0xbf, 0x02, 0x15, 0x67, 0xe8, 0x33, 0x75, 0x04, 0xe4, 0x5d,
+	0xad, 0xd0, 0xfd, 0x62, 0x2d, 0x67, 0xe8, 0x73, 0x95, 0x74, 0x8c, 0xcb, 0x0e, 0xfa, 0x22, 0x23,
+	0xe4, 0x0c, 0x7d, 0x89, 0xe7, 0xa1, 0x1d, 0xfb, 0x89, 0x77, 0xc3, 0x4e, 0x4a, 0xc4, 0x19, 0x7a,
+	0x70, 0xe3, 0xff, 0xa1, 0x65, 0xde, 0x87, 0xe5, 0x4a, 0x58, 0xd4, 0x4d, 0x69, 0xd1, 0x39, 0xb1,
+	0xf3, 0xbe, 0xf5, 0x5f, 0x49, 0xd2, 0x95, 0x48, 0x2b, 0x62, 0xa0, 0x16, 0x9d, 0x4a, 0x54, 0xd5,
+	0x1b, 0x5f, 0x42, 0xcb, 0x3c, 0xf3, 0x71, 0x13, 0x1a, 0x3f, 0xf8, 0xa1, 0x3c, 0x24, 0x01, 0xa6,
+	0x22, 0xff, 0xa8, 0x82, 0x5b, 0x30, 0xf3, 0x95, 0x3f, 0x1c, 0xfa, 0xaf, 0x69, 0x80, 0xaa, 0x78,
+	0x16, 0xa6, 0xf7, 0xa9, 0x17, 0x88, 0xb3, 0xb4, 0x76, 0x63, 0x07, 0xe6, 0x33, 0x1c, 0x09, 0x4f,
+	0x41, 0x75, 0x6f, 0x84, 0xce, 0x09, 0x77, 0xdf, 0xf9, 0xe1, 0xde, 0x08, 0x55, 0x84, 0xbb, 0x47,
+	0x6f, 0x06, 0x3c, 0xe4, 0xa8, 0x8a, 0xdb, 0xd0, 0xfc, 0xce, 0x0f, 0xe3, 0x66, 0xed, 0x01, 0xfa,
+	0xe5, 0x5f, 0xab, 0xe7, 0x7e, 0x7e, 0xbb, 0x5a, 0xf9, 0xe5, 0xed, 0x6a, 0xe5, 0x9f, 0x6f, 0x57,
+	0x2b, 0x87, 0x53, 0xf2, 0x9f, 0x25, 0x6e, 0xff, 0x77, 0x00, 0xab, 0xf4, 0x8f, 0xbb, 0xbf, 0x31,
+	0x00, 0x00,
 }
 
-func (m *GetAppliedRulesReq) Marshal() (dAtA []byte, err error) {
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4783,218 +5512,339 @@ func (m *GetAppliedRulesReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetAppliedRulesReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
+	if m.ID != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ContainerID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
 	}
-	return i, nil
-}
-
-func (m *GetAppliedRulesRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceHeartbeat.Size()))
+	n1, err := m.ResourceHeartbeat.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAppliedRulesRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rules) > 0 {
-		for _, msg := range m.Rules {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	i += n1
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerHeartbeat.Size()))
+	n2, err := m.ContainerHeartbeat.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n2
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutContainer.Size()))
+	n3, err := m.PutContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *CreateJobReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n3
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetContainer.Size()))
+	n4, err := m.GetContainer.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateJobReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
+	i += n4
+	dAtA[i] = 0x42
 	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
-	n58, err := m.Job.MarshalTo(dAtA[i:])
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AllocID.Size()))
+	n5, err := m.AllocID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n58
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n5
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskSplit.Size()))
+	n6, err := m.AskSplit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *CreateJobRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n6
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskBatchSplit.Size()))
+	n7, err := m.AskBatchSplit.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateJobRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n7
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ReportSplit.Size()))
+	n8, err := m.ReportSplit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *RemoveJobReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n8
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.BatchReportSplit.Size()))
+	n9, err := m.BatchReportSplit.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveJobReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
+	i += n9
+	dAtA[i] = 0x6a
 	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
-	n59, err := m.Job.MarshalTo(dAtA[i:])
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateWatcher.Size()))
+	n10, err := m.CreateWatcher.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n59
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n10
+	dAtA[i] = 0x72
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateResources.Size()))
+	n11, err := m.CreateResources.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *RemoveJobRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n11
+	dAtA[i] = 0x7a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveResources.Size()))
+	n12, err := m.RemoveResources.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveJobRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n12
+	dAtA[i] = 0x82
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CheckResourceState.Size()))
+	n13, err := m.CheckResourceState.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *ExecuteJobReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n13
+	dAtA[i] = 0x8a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutPlacementRule.Size()))
+	n14, err := m.PutPlacementRule.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteJobReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
+	i += n14
+	dAtA[i] = 0x92
 	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
-	n60, err := m.Job.MarshalTo(dAtA[i:])
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetAppliedRules.Size()))
+	n15, err := m.GetAppliedRules.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n60
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i += n15
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateJob.Size()))
+	n16, err := m.CreateJob.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n16
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveJob.Size()))
+	n17, err := m.RemoveJob.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *ExecuteJobRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n17
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ExecuteJob.Size()))
+	n18, err := m.ExecuteJob.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteJobRsp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i += n18
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetTimestamp.Size()))
+	n19, err := m.GetTimestamp.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	dAtA[i] = 0xba
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListContainers.Size()))
+	n20, err := m.ListContainers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	dAtA[i] = 0xc2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListResources.Size()))
+	n21, err := m.ListResources.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	dAtA[i] = 0xca
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveContainer.Size()))
+	n22, err := m.RemoveContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	dAtA[i] = 0xd2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.UpContainer.Size()))
+	n23, err := m.UpContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
+	dAtA[i] = 0xda
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.SetContainerWeight.Size()))
+	n24, err := m.SetContainerWeight.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	dAtA[i] = 0xe2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AddScheduler.Size()))
+	n25, err := m.AddScheduler.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n25
+	dAtA[i] = 0xea
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveScheduler.Size()))
+	n26, err := m.RemoveScheduler.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	dAtA[i] = 0xf2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.PauseScheduler.Size()))
+	n27, err := m.PauseScheduler.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	dAtA[i] = 0xfa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListSchedulers.Size()))
+	n28, err := m.ListSchedulers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	dAtA[i] = 0x82
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AddOperator.Size()))
+	n29, err := m.AddOperator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	dAtA[i] = 0x8a
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveOperator.Size()))
+	n30, err := m.RemoveOperator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	dAtA[i] = 0x92
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetOperatorStatus.Size()))
+	n31, err := m.GetOperatorStatus.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListJobs.Size()))
+	n32, err := m.ListJobs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetJob.Size()))
+	n33, err := m.GetJob.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n33
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *EventNotify) Marshal() (dAtA []byte, err error) {
+func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5004,245 +5854,348 @@ func (m *EventNotify) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNotify) MarshalTo(dAtA []byte) (int, error) {
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Seq != 0 {
+	if m.ID != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Seq))
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
 	}
 	if m.Type != 0 {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
 	}
-	if m.InitEvent != nil {
+	if len(m.Error) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.InitEvent.Size()))
-		n61, err := m.InitEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n61
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
-	if m.ResourceEvent != nil {
+	if len(m.Leader) > 0 {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceEvent.Size()))
-		n62, err := m.ResourceEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n62
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Leader)))
+		i += copy(dAtA[i:], m.Leader)
 	}
-	if m.ContainerEvent != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerEvent.Size()))
-		n63, err := m.ContainerEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n63
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceHeartbeat.Size()))
+	n34, err := m.ResourceHeartbeat.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.ResourceStatsEvent != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceStatsEvent.Size()))
-		n64, err := m.ResourceStatsEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n64
+	i += n34
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerHeartbeat.Size()))
+	n35, err := m.ContainerHeartbeat.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.ContainerStatsEvent != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerStatsEvent.Size()))
-		n65, err := m.ContainerStatsEvent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n65
+	i += n35
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutContainer.Size()))
+	n36, err := m.PutContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n36
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetContainer.Size()))
+	n37, err := m.GetContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *InitEventData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n37
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AllocID.Size()))
+	n38, err := m.AllocID.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InitEventData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	i += n38
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskSplit.Size()))
+	n39, err := m.AskSplit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if len(m.Leaders) > 0 {
-		dAtA67 := make([]byte, len(m.Leaders)*10)
-		var j66 int
-		for _, num := range m.Leaders {
-			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j66++
-			}
-			dAtA67[j66] = uint8(num)
-			j66++
-		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(j66))
-		i += copy(dAtA[i:], dAtA67[:j66])
+	i += n39
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AskBatchSplit.Size()))
+	n40, err := m.AskBatchSplit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if len(m.Containers) > 0 {
-		for _, b := range m.Containers {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	i += n40
+	dAtA[i] = 0x62
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ReportSplit.Size()))
+	n41, err := m.ReportSplit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n41
+	dAtA[i] = 0x6a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.BatchReportSplit.Size()))
+	n42, err := m.BatchReportSplit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *ResourceEventData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n42
+	dAtA[i] = 0x72
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Event.Size()))
+	n43, err := m.Event.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResourceEventData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i += n43
+	dAtA[i] = 0x7a
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateResources.Size()))
+	n44, err := m.CreateResources.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Leader != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader))
+	i += n44
+	dAtA[i] = 0x82
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveResources.Size()))
+	n45, err := m.RemoveResources.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Removed {
-		dAtA[i] = 0x18
-		i++
-		if m.Removed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	i += n45
+	dAtA[i] = 0x8a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CheckResourceState.Size()))
+	n46, err := m.CheckResourceState.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Create {
-		dAtA[i] = 0x20
-		i++
-		if m.Create {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	i += n46
+	dAtA[i] = 0x92
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.PutPlacementRule.Size()))
+	n47, err := m.PutPlacementRule.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n47
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetAppliedRules.Size()))
+	n48, err := m.GetAppliedRules.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *ContainerEventData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n48
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.CreateJob.Size()))
+	n49, err := m.CreateJob.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContainerEventData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	i += n49
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveJob.Size()))
+	n50, err := m.RemoveJob.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	i += n50
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ExecuteJob.Size()))
+	n51, err := m.ExecuteJob.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return i, nil
-}
-
-func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	i += n51
+	dAtA[i] = 0xba
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetTimestamp.Size()))
+	n52, err := m.GetTimestamp.MarshalTo(dAtA[i:])
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
+	i += n52
+	dAtA[i] = 0xc2
 	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Peer.Size()))
-	n68, err := m.Peer.MarshalTo(dAtA[i:])
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListContainers.Size()))
+	n53, err := m.ListContainers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n68
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangeType))
+	i += n53
+	dAtA[i] = 0xca
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListResources.Size()))
+	n54, err := m.ListResources.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n54
+	dAtA[i] = 0xd2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveContainer.Size()))
+	n55, err := m.RemoveContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n55
+	dAtA[i] = 0xda
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.UpContainer.Size()))
+	n56, err := m.UpContainer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n56
+	dAtA[i] = 0xe2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.SetContainerWeight.Size()))
+	n57, err := m.SetContainerWeight.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n57
+	dAtA[i] = 0xea
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AddScheduler.Size()))
+	n58, err := m.AddScheduler.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n58
+	dAtA[i] = 0xf2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveScheduler.Size()))
+	n59, err := m.RemoveScheduler.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n59
+	dAtA[i] = 0xfa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.PauseScheduler.Size()))
+	n60, err := m.PauseScheduler.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n60
+	dAtA[i] = 0x82
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListSchedulers.Size()))
+	n61, err := m.ListSchedulers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n61
+	dAtA[i] = 0x8a
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.AddOperator.Size()))
+	n62, err := m.AddOperator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n62
+	dAtA[i] = 0x92
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.RemoveOperator.Size()))
+	n63, err := m.RemoveOperator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n63
+	dAtA[i] = 0x9a
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetOperatorStatus.Size()))
+	n64, err := m.GetOperatorStatus.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n64
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ListJobs.Size()))
+	n65, err := m.ListJobs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n65
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x2
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.GetJob.Size()))
+	n66, err := m.GetJob.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n66
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+func (m *ResourceHeartbeatReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5252,26 +6205,76 @@ func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
+	if m.ContainerID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+	}
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
+	}
+	if m.Term != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Term))
+	}
+	if m.Leader != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader.Size()))
+		n67, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if len(m.DownPeers) > 0 {
+		for _, msg := range m.DownPeers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, msg := range m.PendingPeers {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x3a
 	i++
-	i = encodeVarintRpcpb(dAtA, i, uint64(m.Peer.Size()))
-	n69, err := m.Peer.MarshalTo(dAtA[i:])
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
+	n68, err := m.Stats.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n69
+	i += n68
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ChangePeerV2) Marshal() (dAtA []byte, err error) {
+func (m *ResourceHeartbeatRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5281,22 +6284,93 @@ func (m *ChangePeerV2) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeerV2) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Changes) > 0 {
-		for _, msg := range m.Changes {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.ResourceID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceEpoch.Size()))
+	n69, err := m.ResourceEpoch.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n69
+	if m.TargetPeer != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.TargetPeer.Size()))
+		n70, err := m.TargetPeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if m.ChangePeer != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n71, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if m.TransferLeader != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n72, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n72
+	}
+	if m.Merge != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Merge.Size()))
+		n73, err := m.Merge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	if m.SplitResource != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.SplitResource.Size()))
+		n74, err := m.SplitResource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	if m.ChangePeerV2 != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
+		n75, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
+	if m.DestoryDirectly {
+		dAtA[i] = 0x48
+		i++
+		if m.DestoryDirectly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5304,7 +6378,7 @@ func (m *ChangePeerV2) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Merge) Marshal() (dAtA []byte, err error) {
+func (m *PutContainerReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5314,16 +6388,16 @@ func (m *Merge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutContainerReq) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Target) > 0 {
+	if len(m.Container) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Target)))
-		i += copy(dAtA[i:], m.Target)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Container)))
+		i += copy(dAtA[i:], m.Container)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5331,7 +6405,7 @@ func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitResource) Marshal() (dAtA []byte, err error) {
+func (m *PutContainerRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5341,31 +6415,18 @@ func (m *SplitResource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitResource) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutContainerRsp) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Policy))
-	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *LabelConstraint) Marshal() (dAtA []byte, err error) {
+func (m *ContainerHeartbeatReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5375,36 +6436,24 @@ func (m *LabelConstraint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LabelConstraint) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerHeartbeatReq) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
+	n76, err := m.Stats.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Op != 0 {
-		dAtA[i] = 0x10
+	i += n76
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Op))
-	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5412,7 +6461,7 @@ func (m *LabelConstraint) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PlacementRule) Marshal() (dAtA []byte, err error) {
+func (m *ContainerHeartbeatRsp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5422,92 +6471,79 @@ func (m *PlacementRule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PlacementRule) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerHeartbeatRsp) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
+	if len(m.Data) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	if len(m.GroupID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.GroupID)))
-		i += copy(dAtA[i:], m.GroupID)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Index))
+	return i, nil
+}
+
+func (m *GetContainerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Override {
-		dAtA[i] = 0x20
-		i++
-		if m.Override {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	return dAtA[:n], nil
+}
+
+func (m *GetContainerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
 		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
 	}
-	if len(m.StartKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.StartKey)))
-		i += copy(dAtA[i:], m.StartKey)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.EndKey) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.EndKey)))
-		i += copy(dAtA[i:], m.EndKey)
+	return i, nil
+}
+
+func (m *GetContainerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Role != 0 {
-		dAtA[i] = 0x38
+	return dAtA[:n], nil
+}
+
+func (m *GetContainerRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Role))
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x40
+	if m.Stats != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
-	}
-	if len(m.LabelConstraints) > 0 {
-		for _, msg := range m.LabelConstraints {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.LocationLabels) > 0 {
-		for _, s := range m.LocationLabels {
-			dAtA[i] = 0x52
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
+		n77, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-	}
-	if len(m.IsolationLevel) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IsolationLevel)))
-		i += copy(dAtA[i:], m.IsolationLevel)
+		i += n77
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5515,1047 +6551,6605 @@ func (m *PlacementRule) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRpcpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllocIDReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AllocIDReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
-	}
-	if m.ContainerID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ContainerID))
-	}
-	if m.Type != 0 {
-		n += 1 + sovRpcpb(uint64(m.Type))
-	}
-	l = m.ResourceHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ContainerHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.PutContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.GetContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AllocID.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskBatchSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.BatchReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CreateWatcher.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CreateResources.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.RemoveResources.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CheckResourceState.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.PutPlacementRule.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.GetAppliedRules.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.CreateJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.RemoveJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.ExecuteJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllocIDRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocIDRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
-	}
-	if m.Type != 0 {
-		n += 1 + sovRpcpb(uint64(m.Type))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	l = m.ResourceHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ContainerHeartbeat.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.PutContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.GetContainer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AllocID.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.AskBatchSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.ReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.BatchReportSplit.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.Event.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.CreateResources.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = m.RemoveResources.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.CheckResourceState.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.PutPlacementRule.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.GetAppliedRules.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.CreateJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.RemoveJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	l = m.ExecuteJob.Size()
-	n += 2 + l + sovRpcpb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return i, nil
 }
 
-func (m *ResourceHeartbeatReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ContainerID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ContainerID))
-	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Term != 0 {
-		n += 1 + sovRpcpb(uint64(m.Term))
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if len(m.DownPeers) > 0 {
-		for _, e := range m.DownPeers {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
-	if len(m.PendingPeers) > 0 {
-		for _, e := range m.PendingPeers {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	l = m.Stats.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResourceHeartbeatRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ResourceID))
-	}
-	l = m.ResourceEpoch.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	if m.TargetPeer != nil {
-		l = m.TargetPeer.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Merge != nil {
-		l = m.Merge.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.SplitResource != nil {
-		l = m.SplitResource.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ChangePeerV2 != nil {
-		l = m.ChangePeerV2.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.DestoryDirectly {
-		n += 2
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.SplitID.Size()))
+	n78, err := m.SplitID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n78
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutContainerReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReportSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Container)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Left) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Left)))
+		i += copy(dAtA[i:], m.Left)
+	}
+	if len(m.Right) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Right)))
+		i += copy(dAtA[i:], m.Right)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutContainerRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReportSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ContainerHeartbeatReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskBatchSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskBatchSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Stats.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ContainerHeartbeatRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AskBatchSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AskBatchSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
+	if len(m.SplitIDs) > 0 {
+		for _, msg := range m.SplitIDs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetContainerReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchReportSplitReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchReportSplitReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetContainerRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchReportSplitRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchReportSplitRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AllocIDReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SplitID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if m.NewID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.NewID))
+	}
+	if len(m.NewPeerIDs) > 0 {
+		dAtA80 := make([]byte, len(m.NewPeerIDs)*10)
+		var j79 int
+		for _, num := range m.NewPeerIDs {
+			for num >= 1<<7 {
+				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j79++
+			}
+			dAtA80[j79] = uint8(num)
+			j79++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(j79))
+		i += copy(dAtA[i:], dAtA80[:j79])
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AllocIDRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateWatcherReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateWatcherReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ID))
+	if m.Flag != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Flag))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AskSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.LeastPeers) > 0 {
+		dAtA82 := make([]byte, len(m.LeastPeers)*10)
+		var j81 int
+		for _, num := range m.LeastPeers {
+			for num >= 1<<7 {
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j81++
+			}
+			dAtA82[j81] = uint8(num)
+			j81++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(j81))
+		i += copy(dAtA[i:], dAtA82[:j81])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AskSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.SplitID.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReportSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Left)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	l = len(m.Right)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.IDs) > 0 {
+		dAtA84 := make([]byte, len(m.IDs)*10)
+		var j83 int
+		for _, num := range m.IDs {
+			for num >= 1<<7 {
+				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j83++
+			}
+			dAtA84[j83] = uint8(num)
+			j83++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(j83))
+		i += copy(dAtA[i:], dAtA84[:j83])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReportSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AskBatchSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckResourceStateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckResourceStateReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovRpcpb(uint64(m.Count))
+	if len(m.IDs) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IDs)))
+		i += copy(dAtA[i:], m.IDs)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AskBatchSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckResourceStateRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckResourceStateRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.SplitIDs) > 0 {
-		for _, e := range m.SplitIDs {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Removed) > 0 {
+		dAtA86 := make([]byte, len(m.Removed)*10)
+		var j85 int
+		for _, num := range m.Removed {
+			for num >= 1<<7 {
+				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j85++
+			}
+			dAtA86[j85] = uint8(num)
+			j85++
 		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(j85))
+		i += copy(dAtA[i:], dAtA86[:j85])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BatchReportSplitReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PutPlacementRuleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PutPlacementRuleReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Rule.Size()))
+	n87, err := m.Rule.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n87
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BatchReportSplitRsp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PutPlacementRuleRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SplitID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PutPlacementRuleRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NewID != 0 {
-		n += 1 + sovRpcpb(uint64(m.NewID))
-	}
-	if len(m.NewPeerIDs) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIDs {
-			l += sovRpcpb(uint64(e))
-		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateWatcherReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAppliedRulesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAppliedRulesReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Flag != 0 {
-		n += 1 + sovRpcpb(uint64(m.Flag))
+	if m.ResourceID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateResourcesReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAppliedRulesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAppliedRulesRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
-	if len(m.LeastPeers) > 0 {
-		l = 0
-		for _, e := range m.LeastPeers {
-			l += sovRpcpb(uint64(e))
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		n += 1 + sovRpcpb(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateResourcesRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateJobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
+	n88, err := m.Job.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n88
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemoveResourcesReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.IDs) > 0 {
-		l = 0
-		for _, e := range m.IDs {
-			l += sovRpcpb(uint64(e))
-		}
-		n += 1 + sovRpcpb(uint64(l)) + l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
+	n89, err := m.Job.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n89
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemoveResourcesRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveJobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
+	n90, err := m.Job.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n90
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CheckResourceStateReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.IDs)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CheckResourceStateRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteJobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Removed) > 0 {
-		l = 0
-		for _, e := range m.Removed {
-			l += sovRpcpb(uint64(e))
-		}
-		n += 1 + sovRpcpb(uint64(l)) + l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
+	n91, err := m.Job.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n91
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutPlacementRuleReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Rule.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExecuteJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutPlacementRuleRsp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExecuteJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetAppliedRulesReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTimestampReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTimestampReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ResourceID != 0 {
-		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetAppliedRulesRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTimestampRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTimestampRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rules) > 0 {
-		for _, e := range m.Rules {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Timestamp))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateJobReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListContainersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListContainersReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Job.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateJobRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContainerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Stats.Size()))
+		n92, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
+	}
+	if m.LeaderWeight != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LeaderWeight))))
+		i += 8
+	}
+	if m.ResourceWeight != 0 {
+		dAtA[i] = 0x21
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ResourceWeight))))
+		i += 8
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemoveJobReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListContainersRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListContainersRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Job.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Containers) > 0 {
+		for _, msg := range m.Containers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RemoveJobRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListResourcesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListResourcesReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ExecuteJobReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Job.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Leader != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader))
 	}
-	return n
-}
-
-func (m *ExecuteJobRsp) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ApproximateSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ApproximateSize))
 	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.ApproximateKeys != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ApproximateKeys))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *EventNotify) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovRpcpb(uint64(m.Seq))
-	}
-	if m.Type != 0 {
-		n += 1 + sovRpcpb(uint64(m.Type))
-	}
-	if m.InitEvent != nil {
-		l = m.InitEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ResourceEvent != nil {
-		l = m.ResourceEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ContainerEvent != nil {
-		l = m.ContainerEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ResourceStatsEvent != nil {
-		l = m.ResourceStatsEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.ContainerStatsEvent != nil {
-		l = m.ContainerStatsEvent.Size()
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListResourcesRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *InitEventData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListResourcesRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if len(m.Resources) > 0 {
-		for _, b := range m.Resources {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
-	}
-	if len(m.Leaders) > 0 {
-		l = 0
-		for _, e := range m.Leaders {
-			l += sovRpcpb(uint64(e))
-		}
-		n += 1 + sovRpcpb(uint64(l)) + l
-	}
-	if len(m.Containers) > 0 {
-		for _, b := range m.Containers {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
+		for _, msg := range m.Resources {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResourceEventData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveContainerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveContainerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.Leader != 0 {
-		n += 1 + sovRpcpb(uint64(m.Leader))
-	}
-	if m.Removed {
-		n += 2
+	if m.ContainerID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
 	}
-	if m.Create {
-		n += 2
+	if m.PhysicallyDestroyed {
+		dAtA[i] = 0x10
+		i++
+		if m.PhysicallyDestroyed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ContainerEventData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveContainerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveContainerRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChangePeer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpContainerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpContainerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Peer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
-	if m.ChangeType != 0 {
-		n += 1 + sovRpcpb(uint64(m.ChangeType))
+	if m.ContainerID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TransferLeader) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpContainerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpContainerRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Peer.Size()
-	n += 1 + l + sovRpcpb(uint64(l))
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChangePeerV2) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetContainerWeightReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetContainerWeightReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	if m.ContainerID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerID))
+	}
+	if m.LeaderWeight != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LeaderWeight))))
+		i += 8
+	}
+	if m.ResourceWeight != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ResourceWeight))))
+		i += 8
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Merge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetContainerWeightRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetContainerWeightRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SplitResource) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddSchedulerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSchedulerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Policy != 0 {
-		n += 1 + sovRpcpb(uint64(m.Policy))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			l = len(b)
-			n += 1 + l + sovRpcpb(uint64(l))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *LabelConstraint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddSchedulerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSchedulerRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Op != 0 {
-		n += 1 + sovRpcpb(uint64(m.Op))
+	return i, nil
+}
+
+func (m *RemoveSchedulerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSchedulerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PlacementRule) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveSchedulerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSchedulerRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.GroupID)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	return i, nil
+}
+
+func (m *PauseSchedulerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		n += 1 + sovRpcpb(uint64(m.Index))
+	return dAtA[:n], nil
+}
+
+func (m *PauseSchedulerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Override {
-		n += 2
+	if m.Seconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Seconds))
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
+	return i, nil
+}
+
+func (m *PauseSchedulerRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Role != 0 {
-		n += 1 + sovRpcpb(uint64(m.Role))
+	return dAtA[:n], nil
+}
+
+func (m *PauseSchedulerRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Count != 0 {
-		n += 1 + sovRpcpb(uint64(m.Count))
+	return i, nil
+}
+
+func (m *ListSchedulersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LabelConstraints) > 0 {
-		for _, e := range m.LabelConstraints {
-			l = e.Size()
-			n += 1 + l + sovRpcpb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulersReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.LocationLabels) > 0 {
-		for _, s := range m.LocationLabels {
+	return i, nil
+}
+
+func (m *ListSchedulersRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulersRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			dAtA[i] = 0xa
+			i++
 			l = len(s)
-			n += 1 + l + sovRpcpb(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AddOperatorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOperatorReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+	}
+	if m.ResourceID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+	}
+	if m.FromContainerID != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.FromContainerID))
+	}
+	if m.ToContainerID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ToContainerID))
+	}
+	if len(m.SplitKeys) > 0 {
+		for _, b := range m.SplitKeys {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Policy != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Policy))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AddOperatorRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOperatorRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoveOperatorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveOperatorReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoveOperatorRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveOperatorRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetOperatorStatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperatorStatusReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceID))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetOperatorStatusRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperatorStatusRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Found {
+		dAtA[i] = 0x8
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListJobsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJobsReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListJobsRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJobsRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, msg := range m.Jobs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetJobReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetJobRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobRsp) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Job.Size()))
+	n93, err := m.Job.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n93
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EventNotify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNotify) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Seq))
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Type))
+	}
+	if m.InitEvent != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.InitEvent.Size()))
+		n94, err := m.InitEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
+	}
+	if m.ResourceEvent != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceEvent.Size()))
+		n95, err := m.ResourceEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n95
+	}
+	if m.ContainerEvent != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerEvent.Size()))
+		n96, err := m.ContainerEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n96
+	}
+	if m.ResourceStatsEvent != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ResourceStatsEvent.Size()))
+		n97, err := m.ResourceStatsEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n97
+	}
+	if m.ContainerStatsEvent != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ContainerStatsEvent.Size()))
+		n98, err := m.ContainerStatsEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n98
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InitEventData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitEventData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Leaders) > 0 {
+		dAtA100 := make([]byte, len(m.Leaders)*10)
+		var j99 int
+		for _, num := range m.Leaders {
+			for num >= 1<<7 {
+				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j99++
+			}
+			dAtA100[j99] = uint8(num)
+			j99++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(j99))
+		i += copy(dAtA[i:], dAtA100[:j99])
+	}
+	if len(m.Containers) > 0 {
+		for _, b := range m.Containers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResourceEventData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceEventData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Leader != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Leader))
+	}
+	if m.Removed {
+		dAtA[i] = 0x18
+		i++
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Create {
+		dAtA[i] = 0x20
+		i++
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ContainerEventData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerEventData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Peer.Size()))
+	n101, err := m.Peer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n101
+	if m.ChangeType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.ChangeType))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcpb(dAtA, i, uint64(m.Peer.Size()))
+	n102, err := m.Peer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n102
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChangePeerV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePeerV2) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, msg := range m.Changes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Merge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SplitResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Policy))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LabelConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabelConstraint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Op != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Op))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PlacementRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.GroupID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.GroupID)))
+		i += copy(dAtA[i:], m.GroupID)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Index))
+	}
+	if m.Override {
+		dAtA[i] = 0x20
+		i++
+		if m.Override {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
+	}
+	if m.Role != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Role))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(m.Count))
+	}
+	if len(m.LabelConstraints) > 0 {
+		for _, msg := range m.LabelConstraints {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintRpcpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.LocationLabels) > 0 {
+		for _, s := range m.LocationLabels {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.IsolationLevel) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRpcpb(dAtA, i, uint64(len(m.IsolationLevel)))
+		i += copy(dAtA[i:], m.IsolationLevel)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRpcpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	l = m.ResourceHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ContainerHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.PutContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.GetContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AllocID.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskBatchSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.BatchReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CreateWatcher.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CreateResources.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.RemoveResources.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CheckResourceState.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.PutPlacementRule.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetAppliedRules.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.CreateJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ExecuteJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetTimestamp.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListContainers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveContainer.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.UpContainer.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.SetContainerWeight.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.AddScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.PauseScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListSchedulers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.AddOperator.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveOperator.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetOperatorStatus.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListJobs.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = m.ResourceHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ContainerHeartbeat.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.PutContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.GetContainer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AllocID.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.AskBatchSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.ReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.BatchReportSplit.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.Event.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.CreateResources.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = m.RemoveResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.CheckResourceState.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.PutPlacementRule.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetAppliedRules.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.CreateJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ExecuteJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetTimestamp.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListContainers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListResources.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveContainer.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.UpContainer.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.SetContainerWeight.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.AddScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.PauseScheduler.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListSchedulers.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.AddOperator.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.RemoveOperator.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetOperatorStatus.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.ListJobs.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	l = m.GetJob.Size()
+	n += 2 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceHeartbeatReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Term != 0 {
+		n += 1 + sovRpcpb(uint64(m.Term))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if len(m.DownPeers) > 0 {
+		for _, e := range m.DownPeers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, e := range m.PendingPeers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceHeartbeatRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	l = m.ResourceEpoch.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.TargetPeer != nil {
+		l = m.TargetPeer.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.SplitResource != nil {
+		l = m.SplitResource.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ChangePeerV2 != nil {
+		l = m.ChangePeerV2.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.DestoryDirectly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutContainerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Container)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutContainerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerHeartbeatReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerHeartbeatRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContainerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetContainerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocIDReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocIDRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SplitID.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Left)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.Right)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpcpb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SplitIDs) > 0 {
+		for _, e := range m.SplitIDs {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchReportSplitReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchReportSplitRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewID != 0 {
+		n += 1 + sovRpcpb(uint64(m.NewID))
+	}
+	if len(m.NewPeerIDs) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIDs {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateWatcherReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Flag != 0 {
+		n += 1 + sovRpcpb(uint64(m.Flag))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.LeastPeers) > 0 {
+		l = 0
+		for _, e := range m.LeastPeers {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IDs) > 0 {
+		l = 0
+		for _, e := range m.IDs {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckResourceStateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IDs)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckResourceStateRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		l = 0
+		for _, e := range m.Removed {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutPlacementRuleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rule.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutPlacementRuleRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAppliedRulesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAppliedRulesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecuteJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecuteJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTimestampReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovRpcpb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTimestampRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovRpcpb(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListContainersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.LeaderWeight != 0 {
+		n += 9
+	}
+	if m.ResourceWeight != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListContainersRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListResourcesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Leader != 0 {
+		n += 1 + sovRpcpb(uint64(m.Leader))
+	}
+	if m.ApproximateSize != 0 {
+		n += 1 + sovRpcpb(uint64(m.ApproximateSize))
+	}
+	if m.ApproximateKeys != 0 {
+		n += 1 + sovRpcpb(uint64(m.ApproximateKeys))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListResourcesRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveContainerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.PhysicallyDestroyed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveContainerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpContainerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpContainerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetContainerWeightReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ContainerID))
+	}
+	if m.LeaderWeight != 0 {
+		n += 9
+	}
+	if m.ResourceWeight != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetContainerWeightRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSchedulerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSchedulerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveSchedulerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveSchedulerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseSchedulerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovRpcpb(uint64(m.Seconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseSchedulerRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSchedulersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSchedulersRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddOperatorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	if m.FromContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.FromContainerID))
+	}
+	if m.ToContainerID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ToContainerID))
+	}
+	if len(m.SplitKeys) > 0 {
+		for _, b := range m.SplitKeys {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.Policy != 0 {
+		n += 1 + sovRpcpb(uint64(m.Policy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddOperatorRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveOperatorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveOperatorRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorStatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ResourceID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorStatusRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovRpcpb(uint64(m.Status))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListJobsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListJobsRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetJobReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpcpb(uint64(m.ID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetJobRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Job.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EventNotify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovRpcpb(uint64(m.Seq))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpcpb(uint64(m.Type))
+	}
+	if m.InitEvent != nil {
+		l = m.InitEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ResourceEvent != nil {
+		l = m.ResourceEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ContainerEvent != nil {
+		l = m.ContainerEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ResourceStatsEvent != nil {
+		l = m.ResourceStatsEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.ContainerStatsEvent != nil {
+		l = m.ContainerStatsEvent.Size()
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InitEventData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, b := range m.Resources {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		l = 0
+		for _, e := range m.Leaders {
+			l += sovRpcpb(uint64(e))
+		}
+		n += 1 + sovRpcpb(uint64(l)) + l
+	}
+	if len(m.Containers) > 0 {
+		for _, b := range m.Containers {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceEventData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Leader != 0 {
+		n += 1 + sovRpcpb(uint64(m.Leader))
+	}
+	if m.Removed {
+		n += 2
+	}
+	if m.Create {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerEventData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Peer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.ChangeType != 0 {
+		n += 1 + sovRpcpb(uint64(m.ChangeType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Peer.Size()
+	n += 1 + l + sovRpcpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Merge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovRpcpb(uint64(m.Policy))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LabelConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovRpcpb(uint64(m.Op))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlacementRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRpcpb(uint64(m.Index))
+	}
+	if m.Override {
+		n += 2
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRpcpb(uint64(m.Role))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpcpb(uint64(m.Count))
+	}
+	if len(m.LabelConstraints) > 0 {
+		for _, e := range m.LabelConstraints {
+			l = e.Size()
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	if len(m.LocationLabels) > 0 {
+		for _, s := range m.LocationLabels {
+			l = len(s)
+			n += 1 + l + sovRpcpb(uint64(l))
+		}
+	}
+	l = len(m.IsolationLevel)
+	if l > 0 {
+		n += 1 + l + sovRpcpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRpcpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRpcpb(x uint64) (n int) {
+	return sovRpcpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWatcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateWatcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListContainers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetContainerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SetContainerWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListSchedulers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListSchedulers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOperator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddOperator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveOperator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveOperator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetOperatorStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetOperatorStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListJobs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListContainers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetContainerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SetContainerWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseScheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseScheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListSchedulers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListSchedulers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOperator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddOperator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveOperator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoveOperator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetOperatorStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetOperatorStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListJobs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GetJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceHeartbeatReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource[:0], dAtA[iNdEx:postIndex]...)
+			if m.Resource == nil {
+				m.Resource = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownPeers = append(m.DownPeers, metapb.PeerStats{})
+			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPeers = append(m.PendingPeers, metapb.Peer{})
+			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	l = len(m.IsolationLevel)
-	if l > 0 {
-		n += 1 + l + sovRpcpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovRpcpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozRpcpb(x uint64) (n int) {
-	return sovRpcpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6578,17 +13172,141 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceHeartbeatRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
+			}
+			m.ResourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetPeer == nil {
+				m.TargetPeer = &metapb.Peer{}
+			}
+			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ID = 0
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeer{}
+			}
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6598,16 +13316,33 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			m.ContainerID = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeader{}
+			}
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6617,16 +13352,33 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContainerID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			m.Type = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merge == nil {
+				m.Merge = &Merge{}
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitResource", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6636,14 +13388,31 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SplitResource == nil {
+				m.SplitResource = &SplitResource{}
+			}
+			if err := m.SplitResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6670,15 +13439,92 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChangePeerV2 == nil {
+				m.ChangePeerV2 = &ChangePeerV2{}
+			}
+			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestoryDirectly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DestoryDirectly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutContainerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6688,61 +13534,137 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = append(m.Container[:0], dAtA[iNdEx:postIndex]...)
+			if m.Container == nil {
+				m.Container = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutContainerRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerHeartbeatReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6769,15 +13691,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6787,63 +13709,85 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerHeartbeatRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6853,63 +13797,85 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContainerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6919,30 +13885,70 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 13:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContainerRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateWatcher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -6952,28 +13958,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateWatcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7000,15 +14007,126 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &metapb.ContainerStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7018,30 +14136,70 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 16:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7051,61 +14209,83 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 18:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7132,48 +14312,69 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SplitID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 20:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportSplitReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7183,30 +14384,31 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Left = append(m.Left[:0], dAtA[iNdEx:postIndex]...)
+			if m.Left == nil {
+				m.Left = []byte{}
 			}
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7216,23 +14418,24 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Right = append(m.Right[:0], dAtA[iNdEx:postIndex]...)
+			if m.Right == nil {
+				m.Right = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -7260,7 +14463,61 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7283,55 +14540,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskBatchSplitReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskBatchSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7341,29 +14560,31 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7373,60 +14594,68 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHeartbeat", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskBatchSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskBatchSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHeartbeat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7453,81 +14682,70 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContainerHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SplitIDs = append(m.SplitIDs, SplitID{})
+			if err := m.SplitIDs[len(m.SplitIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutContainer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetContainer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchReportSplitReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7537,63 +14755,137 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllocID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
+			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskSplit", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRpcpb
 			}
-			if msglen < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchReportSplitRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskBatchSplit", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewID", wireType)
+			}
+			m.NewID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7603,30 +14895,146 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIDs = append(m.NewPeerIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewPeerIDs) == 0 {
+					m.NewPeerIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIDs = append(m.NewPeerIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskBatchSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportSplit", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWatcherReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWatcherReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
 			}
-			var msglen int
+			m.Flag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7636,30 +15044,70 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Flag |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 13:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateResourcesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchReportSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7669,195 +15117,397 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchReportSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
+			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.LeastPeers = append(m.LeastPeers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LeastPeers) == 0 {
+					m.LeastPeers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LeastPeers = append(m.LeastPeers, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeastPeers", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateResources", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateResourcesRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveResources", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveResourcesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.IDs = append(m.IDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RemoveResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckResourceState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IDs) == 0 {
+					m.IDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IDs = append(m.IDs, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CheckResourceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PutPlacementRule", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveResourcesRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PutPlacementRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 19:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckResourceStateReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckResourceStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetAppliedRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -7867,94 +15517,213 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GetAppliedRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IDs = append(m.IDs[:0], dAtA[iNdEx:postIndex]...)
+			if m.IDs == nil {
+				m.IDs = []byte{}
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateJob", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreateJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveJob", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckResourceStateRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckResourceStateRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Removed = append(m.Removed, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcpb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Removed) == 0 {
+					m.Removed = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Removed = append(m.Removed, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoveJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 22:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutPlacementRuleReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutPlacementRuleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7981,7 +15750,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExecuteJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8010,7 +15779,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
+func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8033,89 +15802,71 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceHeartbeatReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutPlacementRuleRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutPlacementRuleRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
-			}
-			m.ContainerID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContainerID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = append(m.Resource[:0], dAtA[iNdEx:postIndex]...)
-			if m.Resource == nil {
-				m.Resource = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Term = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Term |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAppliedRulesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAppliedRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
 			}
-			var msglen int
+			m.ResourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8125,31 +15876,68 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ResourceID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAppliedRulesRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAppliedRulesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8176,48 +15964,68 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DownPeers = append(m.DownPeers, metapb.PeerStats{})
-			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, PlacementRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingPeers = append(m.PendingPeers, metapb.Peer{})
-			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateJobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8244,7 +16052,7 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8273,7 +16081,7 @@ func (m *ResourceHeartbeatReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
+func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8296,34 +16104,15 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceHeartbeatRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateJobRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
-			}
-			m.ResourceID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResourceID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8350,49 +16139,67 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetPeer == nil {
-				m.TargetPeer = &metapb.Peer{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveJobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8419,88 +16226,121 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeer{}
-			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeader{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveJobRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveJobRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &Merge{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteJobReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8527,18 +16367,15 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SplitResource == nil {
-				m.SplitResource = &SplitResource{}
-			}
-			if err := m.SplitResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeerV2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8548,48 +16385,26 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeerV2 == nil {
-				m.ChangePeerV2 = &ChangePeerV2{}
-			}
-			if err := m.ChangePeerV2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestoryDirectly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DestoryDirectly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8615,7 +16430,7 @@ func (m *ResourceHeartbeatRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
+func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8638,15 +16453,15 @@ func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutContainerReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecuteJobRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecuteJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8673,9 +16488,9 @@ func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Container = append(m.Container[:0], dAtA[iNdEx:postIndex]...)
-			if m.Container == nil {
-				m.Container = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8703,7 +16518,7 @@ func (m *PutContainerReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
+func (m *GetTimestampReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8726,12 +16541,31 @@ func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutContainerRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTimestampReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTimestampReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8757,7 +16591,7 @@ func (m *PutContainerRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
+func (m *GetTimestampRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8780,50 +16614,17 @@ func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerHeartbeatReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTimestampRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerHeartbeatReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTimestampRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var byteLen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -8833,26 +16634,11 @@ func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8878,7 +16664,7 @@ func (m *ContainerHeartbeatReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
+func (m *ListContainersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8901,46 +16687,12 @@ func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerHeartbeatRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerHeartbeatRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -8966,7 +16718,7 @@ func (m *ContainerHeartbeatRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
+func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8989,17 +16741,17 @@ func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetContainerReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.ID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9009,11 +16761,84 @@ func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &metapb.ContainerStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LeaderWeight = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ResourceWeight = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -9039,7 +16864,7 @@ func (m *GetContainerReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
+func (m *ListContainersRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9062,49 +16887,15 @@ func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetContainerRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListContainersRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListContainersRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9131,10 +16922,8 @@ func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &metapb.ContainerStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Containers = append(m.Containers, ContainerInfo{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9163,7 +16952,7 @@ func (m *GetContainerRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
+func (m *ListResourcesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9186,10 +16975,10 @@ func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListResourcesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9217,7 +17006,7 @@ func (m *AllocIDReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
+func (m *ResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9240,17 +17029,51 @@ func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.ID = 0
+			m.Leader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9260,7 +17083,45 @@ func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Leader |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+			}
+			m.ApproximateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
+			}
+			m.ApproximateKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateKeys |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9290,7 +17151,7 @@ func (m *AllocIDRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
+func (m *ListResourcesRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9313,17 +17174,17 @@ func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListResourcesRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9333,24 +17194,24 @@ func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Resources = append(m.Resources, ResourceInfo{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9378,7 +17239,7 @@ func (m *AskSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *RemoveContainerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9401,17 +17262,17 @@ func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveContainerReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			var msglen int
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9421,25 +17282,31 @@ func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicallyDestroyed", wireType)
 			}
-			if err := m.SplitID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.PhysicallyDestroyed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -9465,7 +17332,7 @@ func (m *AskSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveContainerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9488,51 +17355,71 @@ func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveContainerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Left = append(m.Left[:0], dAtA[iNdEx:postIndex]...)
-			if m.Left == nil {
-				m.Left = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpContainerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpContainerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpContainerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			var byteLen int
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9542,26 +17429,11 @@ func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Right = append(m.Right[:0], dAtA[iNdEx:postIndex]...)
-			if m.Right == nil {
-				m.Right = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -9587,7 +17459,7 @@ func (m *ReportSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *UpContainerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9610,10 +17482,10 @@ func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpContainerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpContainerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9641,7 +17513,7 @@ func (m *ReportSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
+func (m *SetContainerWeightReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9664,17 +17536,17 @@ func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetContainerWeightReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetContainerWeightReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
 			}
-			var byteLen int
+			m.ContainerID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9684,45 +17556,33 @@ func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ContainerID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderWeight", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LeaderWeight = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceWeight", wireType)
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ResourceWeight = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -9748,7 +17608,7 @@ func (m *AskBatchSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *SetContainerWeightRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9771,46 +17631,12 @@ func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetContainerWeightRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetContainerWeightRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitIDs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SplitIDs = append(m.SplitIDs, SplitID{})
-			if err := m.SplitIDs[len(m.SplitIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -9836,7 +17662,7 @@ func (m *AskBatchSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
+func (m *AddSchedulerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9859,17 +17685,17 @@ func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchReportSplitReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSchedulerReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchReportSplitReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSchedulerReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -9879,23 +17705,55 @@ func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
-			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9922,7 +17780,7 @@ func (m *BatchReportSplitReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
+func (m *AddSchedulerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9945,10 +17803,10 @@ func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchReportSplitRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSchedulerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchReportSplitRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSchedulerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9976,7 +17834,7 @@ func (m *BatchReportSplitRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitID) Unmarshal(dAtA []byte) error {
+func (m *RemoveSchedulerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9998,108 +17856,45 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewID", wireType)
-			}
-			m.NewID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIDs = append(m.NewPeerIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.NewPeerIDs) == 0 {
-					m.NewPeerIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIDs = append(m.NewPeerIDs, v)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveSchedulerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveSchedulerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIDs", wireType)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10125,7 +17920,7 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveSchedulerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10148,31 +17943,12 @@ func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWatcherReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSchedulerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWatcherReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSchedulerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
-			}
-			m.Flag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Flag |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10198,7 +17974,7 @@ func (m *CreateWatcherReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
+func (m *PauseSchedulerReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10221,17 +17997,17 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResourcesReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseSchedulerReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseSchedulerReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -10241,99 +18017,42 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, make([]byte, postIndex-iNdEx))
-			copy(m.Resources[len(m.Resources)-1], dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LeastPeers = append(m.LeastPeers, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LeastPeers) == 0 {
-					m.LeastPeers = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LeastPeers = append(m.LeastPeers, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeastPeers", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -10360,7 +18079,7 @@ func (m *CreateResourcesReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
+func (m *PauseSchedulerRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10383,10 +18102,10 @@ func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResourcesRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseSchedulerRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseSchedulerRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10414,111 +18133,35 @@ func (m *CreateResourcesRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
+func (m *ListSchedulersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResourcesReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResourcesReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.IDs = append(m.IDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.IDs) == 0 {
-					m.IDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IDs = append(m.IDs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSchedulersReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSchedulersReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10544,7 +18187,7 @@ func (m *RemoveResourcesReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
+func (m *ListSchedulersRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10567,12 +18210,44 @@ func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResourcesRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchedulersRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResourcesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchedulersRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10598,7 +18273,7 @@ func (m *RemoveResourcesRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
+func (m *AddOperatorReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10621,15 +18296,91 @@ func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckResourceStateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddOperatorReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckResourceStateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddOperatorReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OperatorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
+			}
+			m.ResourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromContainerID", wireType)
+			}
+			m.FromContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToContainerID", wireType)
+			}
+			m.ToContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToContainerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10656,11 +18407,28 @@ func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IDs = append(m.IDs[:0], dAtA[iNdEx:postIndex]...)
-			if m.IDs == nil {
-				m.IDs = []byte{}
-			}
+			m.SplitKeys = append(m.SplitKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SplitKeys[len(m.SplitKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= metapb.CheckPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10686,7 +18454,7 @@ func (m *CheckResourceStateReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
+func (m *AddOperatorRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10709,88 +18477,12 @@ func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckResourceStateRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddOperatorRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckResourceStateRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddOperatorRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Removed = append(m.Removed, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRpcpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRpcpb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Removed) == 0 {
-					m.Removed = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRpcpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Removed = append(m.Removed, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10816,7 +18508,7 @@ func (m *CheckResourceStateRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveOperatorReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10839,17 +18531,17 @@ func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutPlacementRuleReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveOperatorReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutPlacementRuleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveOperatorReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceID", wireType)
 			}
-			var msglen int
+			m.ResourceID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -10859,25 +18551,11 @@ func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.ResourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -10903,7 +18581,7 @@ func (m *PutPlacementRuleReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
+func (m *RemoveOperatorRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10926,10 +18604,10 @@ func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutPlacementRuleRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveOperatorRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutPlacementRuleRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveOperatorRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10957,7 +18635,7 @@ func (m *PutPlacementRuleRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
+func (m *GetOperatorStatusReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10980,10 +18658,10 @@ func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAppliedRulesReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperatorStatusReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAppliedRulesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperatorStatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11030,7 +18708,7 @@ func (m *GetAppliedRulesReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
+func (m *GetOperatorStatusRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11053,17 +18731,17 @@ func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAppliedRulesRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOperatorStatusRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAppliedRulesRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOperatorStatusRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11073,85 +18751,36 @@ func (m *GetAppliedRulesRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rules = append(m.Rules, PlacementRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= metapb.OperatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11161,24 +18790,23 @@ func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11205,7 +18833,7 @@ func (m *CreateJobReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
+func (m *ListJobsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11228,10 +18856,10 @@ func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11259,7 +18887,7 @@ func (m *CreateJobRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
+func (m *ListJobsRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11282,15 +18910,15 @@ func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveJobReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobsRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobsRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11317,7 +18945,8 @@ func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Jobs = append(m.Jobs, metapb.Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11346,61 +18975,7 @@ func (m *RemoveJobReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveJobRsp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpcpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveJobRsp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpcpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
+func (m *GetJobReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11423,50 +18998,17 @@ func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteJobReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJobReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJobReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var byteLen int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11476,26 +19018,11 @@ func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcpb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcpb(dAtA[iNdEx:])
@@ -11521,7 +19048,7 @@ func (m *ExecuteJobReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
+func (m *GetJobRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11544,17 +19071,17 @@ func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteJobRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJobRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJobRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcpb
@@ -11564,24 +19091,23 @@ func (m *ExecuteJobRsp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcpb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -15,6 +15,7 @@ package prophet
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"math/rand"
 	"sync"
@@ -32,6 +33,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/schedule/hbstream"
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/components/prophet/tso"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 	"go.etcd.io/etcd/clientv3"
@@ -85,6 +87,7 @@ type defaultProphet struct {
 	storage      storage.Storage
 	basicCluster *core.BasicCluster
 	cluster      *cluster.RaftCluster
+	tso          *tso.Allocator
 
 	// rpc
 	hbStreams  *hbstream.HeartbeatStreams
@@ -95,7 +98,9 @@ type defaultProphet struct {
 	// job task ctx
 	jobMu struct {
 		sync.RWMutex
-		jobs map[metapb.JobType]metapb.Job
+		jobs    map[uint64]metapb.Job
+		retries map[uint64]time.Time
+		cancel  context.CancelFunc
 	}
 }
 
@@ -114,10 +119,17 @@ func NewProphet(cfg *config.Config) Prophet {
 			util.GetLogger().Fatalf("start embed etcd failed with %+v", err)
 		}
 	} else {
+		var tlsCfg *tls.Config
+		tlsCfg, err = cfg.Security.ClientConfig()
+		if err != nil {
+			util.GetLogger().Fatalf("create external etcd client tls config failed with %+v", err)
+		}
+
 		etcdClient, err = clientv3.New(clientv3.Config{
 			Endpoints:        cfg.ExternalEtcd,
 			AutoSyncInterval: time.Second * 30,
 			DialTimeout:      etcdTimeout,
+			TLS:              tlsCfg,
 		})
 		if err != nil {
 			util.GetLogger().Fatalf("create external etcd client failed with %+v", err)
@@ -141,7 +153,8 @@ func NewProphet(cfg *config.Config) Prophet {
 	p.member = member.NewMember(etcdClient, etcd, elector, cfg.StorageNode, p.enableLeader, p.disableLeader)
 	p.runner = task.NewRunner()
 	p.completeC = make(chan struct{})
-	p.jobMu.jobs = make(map[metapb.JobType]metapb.Job)
+	p.jobMu.jobs = make(map[uint64]metapb.Job)
+	p.jobMu.retries = make(map[uint64]time.Time)
 	return p
 }
 
@@ -154,6 +167,7 @@ func (p *defaultProphet) Start() {
 	p.storage = storage.NewStorage(rootPath,
 		storage.NewEtcdKV(rootPath, p.elector.Client(), p.member.GetLeadership()),
 		p.cfg.Adapter)
+	p.tso = tso.NewAllocator(p.storage)
 	p.basicCluster = core.NewBasicCluster(p.cfg.Adapter.NewResource)
 	p.cluster = cluster.NewRaftCluster(p.ctx, rootPath, p.clusterID, p.elector.Client(), p.cfg.Adapter, p.cfg.ResourceStateChangedHandler)
 	p.hbStreams = hbstream.NewHeartbeatStreams(p.ctx, p.clusterID, p.cluster)
@@ -170,6 +184,9 @@ func (p *defaultProphet) Stop() {
 	p.trans.Stop()
 	p.runner.Stop()
 	p.member.Stop()
+	if p.tso != nil {
+		p.tso.Stop()
+	}
 	p.cancel()
 	p.elector.Client().Close()
 	if p.etcd != nil {
@@ -0,0 +1,199 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prophet
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/cluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/core"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule"
+	"github.com/matrixorigin/matrixcube/components/prophet/schedule/operator"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+func (p *defaultProphet) handleListContainers(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	for _, container := range rc.GetContainers() {
+		data, err := container.Meta.Marshal()
+		if err != nil {
+			return err
+		}
+
+		resp.ListContainers.Containers = append(resp.ListContainers.Containers, rpcpb.ContainerInfo{
+			Data:           data,
+			Stats:          container.GetContainerStats(),
+			LeaderWeight:   container.GetLeaderWeight(),
+			ResourceWeight: container.GetResourceWeight(),
+		})
+	}
+	return nil
+}
+
+func (p *defaultProphet) handleListResources(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	for _, res := range rc.GetResources() {
+		data, err := res.Meta.Marshal()
+		if err != nil {
+			return err
+		}
+
+		info := rpcpb.ResourceInfo{
+			Data:            data,
+			ApproximateSize: res.GetApproximateSize(),
+			ApproximateKeys: res.GetApproximateKeys(),
+		}
+		if leader := res.GetLeader(); leader != nil {
+			info.Leader = leader.ContainerID
+		}
+		resp.ListResources.Resources = append(resp.ListResources.Resources, info)
+	}
+	return nil
+}
+
+func (p *defaultProphet) handleRemoveContainer(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	return rc.RemoveContainer(req.RemoveContainer.ContainerID, req.RemoveContainer.PhysicallyDestroyed)
+}
+
+func (p *defaultProphet) handleUpContainer(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	return rc.UpContainer(req.UpContainer.ContainerID)
+}
+
+func (p *defaultProphet) handleSetContainerWeight(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	if req.SetContainerWeight.LeaderWeight < 0 || req.SetContainerWeight.ResourceWeight < 0 {
+		return fmt.Errorf("invalid weight, the weight must be non-negative")
+	}
+
+	return rc.SetContainerWeight(req.SetContainerWeight.ContainerID,
+		req.SetContainerWeight.LeaderWeight,
+		req.SetContainerWeight.ResourceWeight)
+}
+
+func (p *defaultProphet) handleAddScheduler(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	typ := req.AddScheduler.Type
+	args := req.AddScheduler.Args
+	s, err := schedule.CreateScheduler(typ, rc.GetOperatorController(), rc.GetStorage(), schedule.ConfigSliceDecoder(typ, args))
+	if err != nil {
+		return err
+	}
+
+	if err := rc.AddScheduler(s, args...); err != nil {
+		return err
+	}
+
+	util.GetLogger().Infof("scheduler %s added by admin", s.GetName())
+	return rc.GetOpts().Persist(rc.GetStorage())
+}
+
+func (p *defaultProphet) handleRemoveScheduler(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	if err := rc.RemoveScheduler(req.RemoveScheduler.Name); err != nil {
+		return err
+	}
+
+	util.GetLogger().Infof("scheduler %s removed by admin", req.RemoveScheduler.Name)
+	return nil
+}
+
+func (p *defaultProphet) handlePauseScheduler(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	return rc.PauseOrResumeScheduler(req.PauseScheduler.Name, req.PauseScheduler.Seconds)
+}
+
+func (p *defaultProphet) handleListSchedulers(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	resp.ListSchedulers.Names = rc.GetSchedulers()
+	return nil
+}
+
+func (p *defaultProphet) handleAddOperator(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	res := rc.GetResource(req.AddOperator.ResourceID)
+	if res == nil {
+		return fmt.Errorf("resource %d not found", req.AddOperator.ResourceID)
+	}
+
+	op, err := p.createAdminOperator(rc, res, req.AddOperator)
+	if err != nil {
+		return err
+	}
+
+	if !rc.GetOperatorController().AddOperator(op) {
+		return fmt.Errorf("resource %d add operator %s failed, maybe the resource has a running operator",
+			res.Meta.ID(), op.Desc())
+	}
+	return nil
+}
+
+func (p *defaultProphet) createAdminOperator(rc *cluster.RaftCluster, res *core.CachedResource, req rpcpb.AddOperatorReq) (*operator.Operator, error) {
+	if req.Type != rpcpb.SplitResourceOperator && rc.GetContainer(req.ToContainerID) == nil {
+		return nil, fmt.Errorf("container %d not found", req.ToContainerID)
+	}
+
+	switch req.Type {
+	case rpcpb.TransferLeaderOperator:
+		leader := res.GetLeader()
+		if leader == nil {
+			return nil, fmt.Errorf("resource %d has no leader", res.Meta.ID())
+		}
+
+		return operator.CreateTransferLeaderOperator("admin-transfer-leader", rc, res,
+			leader.ContainerID, req.ToContainerID, operator.OpAdmin)
+	case rpcpb.MovePeerOperator:
+		if _, ok := res.GetContainerPeer(req.FromContainerID); !ok {
+			return nil, fmt.Errorf("resource %d has no peer on container %d", res.Meta.ID(), req.FromContainerID)
+		}
+
+		id, err := p.storage.KV().AllocID()
+		if err != nil {
+			return nil, err
+		}
+
+		return operator.CreateMovePeerOperator("admin-move-peer", rc, res, operator.OpAdmin,
+			req.FromContainerID, metapb.Peer{ID: id, ContainerID: req.ToContainerID})
+	case rpcpb.SplitResourceOperator:
+		if len(req.SplitKeys) > 0 {
+			return operator.CreateSplitResourceOperator("admin-split-resource", res, operator.OpAdmin,
+				metapb.CheckPolicy_USEKEY, req.SplitKeys)
+		}
+		if req.Policy == metapb.CheckPolicy_USEKEY {
+			return nil, fmt.Errorf("missing split keys")
+		}
+
+		// the container finds the split key by the policy
+		return operator.CreateSplitResourceOperator("admin-split-resource", res, operator.OpAdmin,
+			req.Policy, nil)
+	default:
+		return nil, fmt.Errorf("operator type %s not support", req.Type.String())
+	}
+}
+
+func (p *defaultProphet) handleRemoveOperator(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	oc := rc.GetOperatorController()
+	op := oc.GetOperator(req.RemoveOperator.ResourceID)
+	if op == nil {
+		return fmt.Errorf("resource %d has no running operator", req.RemoveOperator.ResourceID)
+	}
+
+	oc.RemoveOperator(op, "removed by admin")
+	return nil
+}
+
+func (p *defaultProphet) handleGetOperatorStatus(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	op := rc.GetOperatorController().GetOperatorStatus(req.GetOperatorStatus.ResourceID)
+	if op == nil {
+		return nil
+	}
+
+	resp.GetOperatorStatus.Found = true
+	resp.GetOperatorStatus.Status = op.Status
+	resp.GetOperatorStatus.Desc = op.Op.String()
+	return nil
+}
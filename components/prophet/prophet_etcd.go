@@ -51,7 +51,12 @@ func startEmbedEtcd(ctx context.Context, cfg *config.Config) (*clientv3.Client,
 		return nil, nil, err
 	}
 
-	if err = util.CheckClusterID(etcd.Server.Cluster().ID(), urlMap); err != nil {
+	tlsCfg, err := cfg.Security.ClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = util.CheckClusterID(etcd.Server.Cluster().ID(), urlMap, tlsCfg); err != nil {
 		return nil, nil, err
 	}
 
@@ -69,6 +74,7 @@ func startEmbedEtcd(ctx context.Context, cfg *config.Config) (*clientv3.Client,
 		Endpoints:        endpoints,
 		AutoSyncInterval: time.Second * 30,
 		DialTimeout:      etcdTimeout,
+		TLS:              tlsCfg,
 	})
 	if err != nil {
 		return nil, nil, err
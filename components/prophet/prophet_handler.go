@@ -164,7 +164,7 @@ func (p *defaultProphet) handleRPCRequest(rs goetty.IOSession, data interface{},
 			resp.Error = err.Error()
 		}
 	case rpcpb.TypeRemoveJobReq:
-		resp.Type = rpcpb.TypeCreateJobRsp
+		resp.Type = rpcpb.TypeRemoveJobRsp
 		err := p.handleRemoveJob(rc, req, resp)
 		if err != nil {
 			resp.Error = err.Error()
@@ -175,6 +175,96 @@ func (p *defaultProphet) handleRPCRequest(rs goetty.IOSession, data interface{},
 		if err != nil {
 			resp.Error = err.Error()
 		}
+	case rpcpb.TypeGetTimestampReq:
+		resp.Type = rpcpb.TypeGetTimestampRsp
+		err := p.handleGetTimestamp(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeListContainersReq:
+		resp.Type = rpcpb.TypeListContainersRsp
+		err := p.handleListContainers(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeListResourcesReq:
+		resp.Type = rpcpb.TypeListResourcesRsp
+		err := p.handleListResources(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeRemoveContainerReq:
+		resp.Type = rpcpb.TypeRemoveContainerRsp
+		err := p.handleRemoveContainer(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeUpContainerReq:
+		resp.Type = rpcpb.TypeUpContainerRsp
+		err := p.handleUpContainer(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeSetContainerWeightReq:
+		resp.Type = rpcpb.TypeSetContainerWeightRsp
+		err := p.handleSetContainerWeight(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeAddSchedulerReq:
+		resp.Type = rpcpb.TypeAddSchedulerRsp
+		err := p.handleAddScheduler(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeRemoveSchedulerReq:
+		resp.Type = rpcpb.TypeRemoveSchedulerRsp
+		err := p.handleRemoveScheduler(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypePauseSchedulerReq:
+		resp.Type = rpcpb.TypePauseSchedulerRsp
+		err := p.handlePauseScheduler(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeListSchedulersReq:
+		resp.Type = rpcpb.TypeListSchedulersRsp
+		err := p.handleListSchedulers(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeAddOperatorReq:
+		resp.Type = rpcpb.TypeAddOperatorRsp
+		err := p.handleAddOperator(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeRemoveOperatorReq:
+		resp.Type = rpcpb.TypeRemoveOperatorRsp
+		err := p.handleRemoveOperator(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeGetOperatorStatusReq:
+		resp.Type = rpcpb.TypeGetOperatorStatusRsp
+		err := p.handleGetOperatorStatus(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeListJobsReq:
+		resp.Type = rpcpb.TypeListJobsRsp
+		err := p.handleListJobs(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	case rpcpb.TypeGetJobReq:
+		resp.Type = rpcpb.TypeGetJobRsp
+		err := p.handleGetJob(rc, req, resp)
+		if err != nil {
+			resp.Error = err.Error()
+		}
 	default:
 		return fmt.Errorf("type %s not support", req.Type.String())
 	}
@@ -249,6 +339,11 @@ func (p *defaultProphet) handleContainerHeartbeat(rc *cluster.RaftCluster, req *
 		return err
 	}
 
+	if len(req.ContainerHeartbeat.Stats.InconsistentResources) > 0 {
+		rc.HandleInconsistentResources(req.ContainerHeartbeat.Stats.ContainerID,
+			req.ContainerHeartbeat.Stats.InconsistentResources)
+	}
+
 	if p.cfg.ContainerHeartbeatDataProcessor != nil {
 		data, err := p.cfg.ContainerHeartbeatDataProcessor.HandleHeartbeatReq(req.ContainerHeartbeat.Stats.ContainerID,
 			req.ContainerHeartbeat.Data, p.GetStorage())
@@ -288,6 +383,16 @@ func (p *defaultProphet) handleAllocID(rc *cluster.RaftCluster, req *rpcpb.Reque
 	return nil
 }
 
+func (p *defaultProphet) handleGetTimestamp(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	ts, err := p.tso.Allocate(req.GetTimestamp.Count)
+	if err != nil {
+		return err
+	}
+
+	resp.GetTimestamp.Timestamp = ts
+	return nil
+}
+
 func (p *defaultProphet) handleAskSplit(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
 	split, err := rc.HandleAskSplit(req)
 	if err != nil {
@@ -14,53 +14,74 @@
 package prophet
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/cluster"
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
 )
 
+var (
+	// jobCheckInterval the interval to restart the failed jobs and gc the history jobs
+	jobCheckInterval = time.Second
+)
+
 func (p *defaultProphet) startJobs() {
 	p.jobMu.Lock()
 	go func() {
 		defer p.jobMu.Unlock()
-		p.jobMu.jobs = make(map[metapb.JobType]metapb.Job)
+		p.jobMu.jobs = make(map[uint64]metapb.Job)
+		p.jobMu.retries = make(map[uint64]time.Time)
 
+		var jobs []metapb.Job
 		for {
 			err := p.storage.LoadJobs(16, func(job metapb.Job) {
-				p.jobMu.jobs[job.Type] = job
+				jobs = append(jobs, job)
 			})
 			if err == nil {
 				break
 			}
 
+			jobs = jobs[:0]
 			util.GetLogger().Errorf("load job failed with %+v, retry later",
 				err)
 		}
 
-		util.GetLogger().Infof("load %d jobs", len(p.jobMu.jobs))
-		for _, job := range p.jobMu.jobs {
-			if job.State == metapb.JobState_Completed {
-				err := p.GetStorage().RemoveJob(job.Type)
+		for _, job := range jobs {
+			if job.ID == 0 {
+				var err error
+				job, err = p.migrateJobLocked(job)
 				if err != nil {
-					util.GetLogger().Errorf("remove completed %d job failed with %+v",
+					util.GetLogger().Errorf("migrate job %d failed with %+v",
 						job.Type,
 						err)
+					continue
+				}
+				if job.ID == 0 {
+					continue
 				}
-				continue
 			}
 
-			processor := p.cfg.GetJobProcessor(job.Type)
-			if processor != nil {
-				processor.Start(job, p.storage, p.basicCluster)
-				p.updateJobStatus(job, metapb.JobState_Working)
+			p.jobMu.jobs[job.ID] = job
+		}
+
+		util.GetLogger().Infof("load %d jobs", len(p.jobMu.jobs))
+		for _, job := range p.jobMu.jobs {
+			if isJobFinished(job) {
 				continue
 			}
 
-			util.GetLogger().Errorf("job %d missing processor", job.Type)
+			p.startJobLocked(job)
 		}
+
+		ctx, cancel := context.WithCancel(p.ctx)
+		p.jobMu.cancel = cancel
+		go p.runJobCheck(ctx)
 	}()
 }
 
@@ -69,14 +90,18 @@ func (p *defaultProphet) stopJobs() {
 	go func() {
 		defer p.jobMu.Unlock()
 
+		if p.jobMu.cancel != nil {
+			p.jobMu.cancel()
+			p.jobMu.cancel = nil
+		}
+
 		for _, job := range p.jobMu.jobs {
-			if job.State == metapb.JobState_Completed {
-				err := p.GetStorage().RemoveJob(job.Type)
-				if err != nil {
-					util.GetLogger().Errorf("remove completed %d job failed with %+v",
-						job.Type,
-						err)
-				}
+			if isJobFinished(job) {
+				continue
+			}
+
+			// the job is stopped already, wait to retry
+			if _, ok := p.jobMu.retries[job.ID]; ok {
 				continue
 			}
 
@@ -86,10 +111,13 @@ func (p *defaultProphet) stopJobs() {
 				continue
 			}
 
-			util.GetLogger().Errorf("job %d missing processor", job.Type)
+			util.GetLogger().Errorf("job %d/%d missing processor",
+				job.ID,
+				job.Type)
 		}
 
-		p.jobMu.jobs = make(map[metapb.JobType]metapb.Job)
+		p.jobMu.jobs = make(map[uint64]metapb.Job)
+		p.jobMu.retries = make(map[uint64]time.Time)
 	}()
 }
 
@@ -103,17 +131,32 @@ func (p *defaultProphet) handleCreateJob(rc *cluster.RaftCluster, req *rpcpb.Req
 		return fmt.Errorf("missing job processor for type %d", job.Type)
 	}
 
-	if _, ok := p.jobMu.jobs[job.Type]; ok {
-		return nil
+	if v, ok := processor.(config.ExclusiveJobProcessor); ok && v.Exclusive() {
+		for _, active := range p.jobMu.jobs {
+			if active.Type == job.Type && !isJobFinished(active) {
+				resp.CreateJob.Job = active
+				return nil
+			}
+		}
 	}
 
-	if err := p.updateJobStatus(job, metapb.JobState_Created); err != nil {
+	id, err := p.storage.KV().AllocID()
+	if err != nil {
 		return err
 	}
 
-	processor.Start(job, p.storage, p.basicCluster)
-	p.jobMu.jobs[job.Type] = job
-	p.updateJobStatus(job, metapb.JobState_Working)
+	job.ID = id
+	job.State = metapb.JobState_Created
+	job.Progress = 0
+	job.Error = ""
+	job.Retries = 0
+	job.CreatedAt = time.Now().Unix()
+	if err := p.updateJobLocked(job); err != nil {
+		return err
+	}
+
+	p.startJobLocked(job)
+	resp.CreateJob.Job = p.jobMu.jobs[id]
 	return nil
 }
 
@@ -121,36 +164,47 @@ func (p *defaultProphet) handleRemoveJob(rc *cluster.RaftCluster, req *rpcpb.Req
 	p.jobMu.Lock()
 	defer p.jobMu.Unlock()
 
-	job := req.RemoveJob.Job
+	job, ok, err := p.getActiveJobLocked(req.RemoveJob.Job)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
 	processor := p.cfg.GetJobProcessor(job.Type)
 	if processor == nil {
 		return fmt.Errorf("missing job processor for type %d, %+v", job.Type, job)
 	}
 
-	if _, ok := p.jobMu.jobs[job.Type]; !ok {
-		return nil
-	}
-
-	if err := p.updateJobStatus(job, metapb.JobState_Completed); err != nil {
+	job.State = metapb.JobState_Completed
+	if err := p.updateJobLocked(job); err != nil {
 		return err
 	}
 
 	processor.Remove(job, p.storage, p.basicCluster)
-	delete(p.jobMu.jobs, job.Type)
+	delete(p.jobMu.retries, job.ID)
 	return nil
 }
 
 func (p *defaultProphet) handleExecuteJob(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
-	job := req.ExecuteJob.Job
+	p.jobMu.RLock()
+	job, ok, err := p.getActiveJobLocked(req.ExecuteJob.Job)
+	p.jobMu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("missing job %d for type %d, the job maybe not created or started",
+			req.ExecuteJob.Job.ID,
+			req.ExecuteJob.Job.Type)
+	}
+
 	processor := p.cfg.GetJobProcessor(job.Type)
 	if processor == nil {
 		return fmt.Errorf("missing job processor for type %d", job.Type)
 	}
 
-	if _, ok := p.jobMu.jobs[job.Type]; !ok {
-		return fmt.Errorf("missing job for type %d, the job maybe not created or started", job.Type)
-	}
-
 	data, err := processor.Execute(req.ExecuteJob.Data, p.storage, rc.GetCacheCluster())
 	if err != nil {
 		return err
@@ -160,7 +214,257 @@ func (p *defaultProphet) handleExecuteJob(rc *cluster.RaftCluster, req *rpcpb.Re
 	return nil
 }
 
-func (p *defaultProphet) updateJobStatus(job metapb.Job, state metapb.JobState) error {
-	job.State = state
-	return p.GetStorage().PutJob(job)
+func (p *defaultProphet) handleListJobs(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	p.jobMu.RLock()
+	defer p.jobMu.RUnlock()
+
+	for _, job := range p.jobMu.jobs {
+		resp.ListJobs.Jobs = append(resp.ListJobs.Jobs, job)
+	}
+	sort.Slice(resp.ListJobs.Jobs, func(i, j int) bool {
+		return resp.ListJobs.Jobs[i].ID < resp.ListJobs.Jobs[j].ID
+	})
+	return nil
+}
+
+func (p *defaultProphet) handleGetJob(rc *cluster.RaftCluster, req *rpcpb.Request, resp *rpcpb.Response) error {
+	p.jobMu.RLock()
+	defer p.jobMu.RUnlock()
+
+	job, ok := p.jobMu.jobs[req.GetJob.ID]
+	if !ok {
+		return fmt.Errorf("job %d not found", req.GetJob.ID)
+	}
+
+	resp.GetJob.Job = job
+	return nil
+}
+
+// getActiveJobLocked returns the active job by the id, the only active job of the type is
+// returned if the id is not specified.
+func (p *defaultProphet) getActiveJobLocked(target metapb.Job) (metapb.Job, bool, error) {
+	if target.ID > 0 {
+		job, ok := p.jobMu.jobs[target.ID]
+		return job, ok && !isJobFinished(job), nil
+	}
+
+	var actives []metapb.Job
+	for _, job := range p.jobMu.jobs {
+		if job.Type == target.Type && !isJobFinished(job) {
+			actives = append(actives, job)
+		}
+	}
+
+	switch len(actives) {
+	case 0:
+		return metapb.Job{}, false, nil
+	case 1:
+		return actives[0], true, nil
+	default:
+		return metapb.Job{}, false, fmt.Errorf("%d active jobs for type %d, the job id is required",
+			len(actives),
+			target.Type)
+	}
+}
+
+func (p *defaultProphet) startJobLocked(job metapb.Job) {
+	processor := p.cfg.GetJobProcessor(job.Type)
+	if processor == nil {
+		util.GetLogger().Errorf("job %d/%d missing processor",
+			job.ID,
+			job.Type)
+		return
+	}
+
+	if v, ok := processor.(config.ReportableJobProcessor); ok {
+		v.SetJobReporter(&jobReporter{p: p})
+	}
+	processor.Start(job, p.storage, p.basicCluster)
+
+	job.State = metapb.JobState_Working
+	if err := p.updateJobLocked(job); err != nil {
+		util.GetLogger().Errorf("update job %d to working failed with %+v",
+			job.ID,
+			err)
+	}
+}
+
+// migrateJobLocked allocates the id for the job created by the old version which has no id, and
+// re-keys the job and the job data by the id. The completed old jobs are removed.
+func (p *defaultProphet) migrateJobLocked(old metapb.Job) (metapb.Job, error) {
+	if old.State == metapb.JobState_Completed {
+		return metapb.Job{}, p.storage.RemoveJob(old)
+	}
+
+	id, err := p.storage.KV().AllocID()
+	if err != nil {
+		return old, err
+	}
+
+	data, err := p.storage.GetJobData(old)
+	if err != nil {
+		return old, err
+	}
+
+	job := old
+	job.ID = id
+	job.CreatedAt = time.Now().Unix()
+	if err := p.storage.PutJob(job); err != nil {
+		return old, err
+	}
+	if len(data) > 0 {
+		if err := p.storage.PutJobData(job, data); err != nil {
+			return old, err
+		}
+	}
+	return job, p.storage.RemoveJob(old)
+}
+
+func (p *defaultProphet) updateJobLocked(job metapb.Job) error {
+	job.UpdatedAt = time.Now().Unix()
+	if err := p.storage.PutJob(job); err != nil {
+		return err
+	}
+
+	p.jobMu.jobs[job.ID] = job
+	return nil
+}
+
+// reportJob updates the active job reported by the job processor
+func (p *defaultProphet) reportJob(id uint64, fn func(metapb.Job, config.JobProcessor) (metapb.Job, error)) error {
+	p.jobMu.Lock()
+	defer p.jobMu.Unlock()
+
+	job, ok := p.jobMu.jobs[id]
+	if !ok || isJobFinished(job) {
+		return fmt.Errorf("job %d not found or finished", id)
+	}
+
+	job, err := fn(job, p.cfg.GetJobProcessor(job.Type))
+	if err != nil {
+		return err
+	}
+
+	return p.updateJobLocked(job)
+}
+
+func (p *defaultProphet) runJobCheck(ctx context.Context) {
+	ticker := time.NewTicker(jobCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkJobs(ctx)
+		}
+	}
+}
+
+func (p *defaultProphet) checkJobs(ctx context.Context) {
+	p.jobMu.Lock()
+	defer p.jobMu.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	for id, retryAt := range p.jobMu.retries {
+		if now.Before(retryAt) {
+			continue
+		}
+
+		delete(p.jobMu.retries, id)
+		if job, ok := p.jobMu.jobs[id]; ok && !isJobFinished(job) {
+			util.GetLogger().Infof("job %d/%d restart, retries %d",
+				job.ID,
+				job.Type,
+				job.Retries)
+			p.startJobLocked(job)
+		}
+	}
+
+	retention := p.cfg.Job.HistoryRetention.Duration
+	for id, job := range p.jobMu.jobs {
+		if isJobFinished(job) &&
+			now.Sub(time.Unix(job.UpdatedAt, 0)) > retention {
+			if err := p.storage.RemoveJob(job); err != nil {
+				util.GetLogger().Errorf("remove history job %d failed with %+v",
+					id,
+					err)
+				continue
+			}
+			delete(p.jobMu.jobs, id)
+		}
+	}
+}
+
+func isJobFinished(job metapb.Job) bool {
+	return job.State == metapb.JobState_Completed ||
+		job.State == metapb.JobState_Failed
+}
+
+// jobReporter the JobReporter used by the job processors
+type jobReporter struct {
+	p *defaultProphet
+}
+
+func (r *jobReporter) Progress(id uint64, progress uint64) error {
+	return r.p.reportJob(id, func(job metapb.Job, _ config.JobProcessor) (metapb.Job, error) {
+		if progress > 100 {
+			progress = 100
+		}
+		job.Progress = progress
+		return job, nil
+	})
+}
+
+func (r *jobReporter) Complete(id uint64) error {
+	return r.p.reportJob(id, func(job metapb.Job, _ config.JobProcessor) (metapb.Job, error) {
+		job.State = metapb.JobState_Completed
+		job.Progress = 100
+		job.Error = ""
+		delete(r.p.jobMu.retries, id)
+		return job, nil
+	})
+}
+
+func (r *jobReporter) Fail(id uint64, cause error) error {
+	return r.p.reportJob(id, func(job metapb.Job, processor config.JobProcessor) (metapb.Job, error) {
+		// the job is stopped already, wait to retry
+		if _, ok := r.p.jobMu.retries[id]; ok {
+			return job, nil
+		}
+
+		job.Error = cause.Error()
+		if job.Retries >= r.p.cfg.Job.MaxRetries {
+			util.GetLogger().Errorf("job %d/%d failed after %d retries, last error %+v",
+				job.ID,
+				job.Type,
+				job.Retries,
+				cause)
+			job.State = metapb.JobState_Failed
+			if processor != nil {
+				processor.Remove(job, r.p.storage, r.p.basicCluster)
+			}
+			return job, nil
+		}
+
+		job.Retries++
+		job.State = metapb.JobState_Created
+		if processor != nil {
+			processor.Stop(job, r.p.storage, r.p.basicCluster)
+		}
+
+		backoff := r.p.cfg.Job.GetRetryBackoff(job.Retries)
+		util.GetLogger().Errorf("job %d/%d failed with %+v, retry after %s",
+			job.ID,
+			job.Type,
+			cause,
+			backoff)
+		r.p.jobMu.retries[id] = time.Now().Add(backoff)
+		return job, nil
+	})
 }
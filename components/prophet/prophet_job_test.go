@@ -14,6 +14,8 @@
 package prophet
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -27,40 +29,67 @@ import (
 
 type testJobProcessor struct {
 	sync.Mutex
-	starts  map[metapb.JobType]metapb.Job
-	stops   map[metapb.JobType]metapb.Job
-	removes map[metapb.JobType]metapb.Job
+	starts    map[uint64]metapb.Job
+	stops     map[uint64]metapb.Job
+	removes   map[uint64]metapb.Job
+	exclusive bool
+	reporter  config.JobReporter
 }
 
 func newTestJobProcessor() *testJobProcessor {
 	return &testJobProcessor{
-		starts:  make(map[metapb.JobType]metapb.Job),
-		stops:   make(map[metapb.JobType]metapb.Job),
-		removes: make(map[metapb.JobType]metapb.Job),
+		starts:  make(map[uint64]metapb.Job),
+		stops:   make(map[uint64]metapb.Job),
+		removes: make(map[uint64]metapb.Job),
 	}
 }
 
+func (p *testJobProcessor) Exclusive() bool {
+	return p.exclusive
+}
+
+func (p *testJobProcessor) SetJobReporter(reporter config.JobReporter) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.reporter = reporter
+}
+
+func (p *testJobProcessor) getReporter() config.JobReporter {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.reporter
+}
+
+func (p *testJobProcessor) count() (int, int, int) {
+	p.Lock()
+	defer p.Unlock()
+
+	return len(p.starts), len(p.stops), len(p.removes)
+}
+
 func (p *testJobProcessor) Start(job metapb.Job, s storage.JobStorage, aware config.ResourcesAware) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.starts[job.Type] = job
-	delete(p.stops, job.Type)
+	p.starts[job.ID] = job
+	delete(p.stops, job.ID)
 }
 
 func (p *testJobProcessor) Stop(job metapb.Job, s storage.JobStorage, aware config.ResourcesAware) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.stops[job.Type] = job
-	delete(p.starts, job.Type)
+	p.stops[job.ID] = job
+	delete(p.starts, job.ID)
 }
 
 func (p *testJobProcessor) Remove(job metapb.Job, s storage.JobStorage, aware config.ResourcesAware) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.removes[job.Type] = job
+	p.removes[job.ID] = job
 }
 
 func (p *testJobProcessor) Execute([]byte, storage.JobStorage, config.ResourcesAware) ([]byte, error) {
@@ -130,3 +159,196 @@ func TestStartAndStopAndRemoveJobs(t *testing.T) {
 		&rpcpb.Response{Type: rpcpb.TypeRemoveJobRsp}))
 	assert.Equal(t, 3, len(jp.removes))
 }
+
+func TestJobsWithSameType(t *testing.T) {
+	p := newTestSingleProphet(t, nil).(*defaultProphet)
+	defer p.Stop()
+
+	jp := newTestJobProcessor()
+	p.cfg.RegisterJobProcessor(metapb.JobType(1), jp)
+
+	c := p.GetClient()
+	job1, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1), Content: []byte("job1")})
+	assert.NoError(t, err)
+	job2, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1), Content: []byte("job2")})
+	assert.NoError(t, err)
+	assert.True(t, job1.ID > 0)
+	assert.NotEqual(t, job1.ID, job2.ID)
+	assert.Equal(t, metapb.JobState_Working, job1.State)
+	assert.True(t, job1.CreatedAt > 0)
+	starts, _, _ := jp.count()
+	assert.Equal(t, 2, starts)
+
+	_, err = c.CreateJob(metapb.Job{Type: metapb.JobType(2)})
+	assert.Error(t, err, "missing job processor")
+
+	// the id is required if many active jobs of the type
+	assert.Error(t, c.RemoveJob(metapb.Job{Type: metapb.JobType(1)}))
+	_, err = c.ExecuteJob(metapb.Job{Type: metapb.JobType(1)}, []byte("data"))
+	assert.Error(t, err)
+	_, err = c.ExecuteJob(metapb.Job{ID: job1.ID}, []byte("data"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.RemoveJob(metapb.Job{ID: job1.ID}))
+	_, _, removes := jp.count()
+	assert.Equal(t, 1, removes)
+	_, err = c.ExecuteJob(metapb.Job{ID: job1.ID}, []byte("data"))
+	assert.Error(t, err, "the removed job can not be executed")
+
+	// the removed job is kept in the history
+	jobs, err := c.ListJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(jobs))
+	assert.Equal(t, job1.ID, jobs[0].ID)
+	assert.Equal(t, metapb.JobState_Completed, jobs[0].State)
+	assert.Equal(t, job2.ID, jobs[1].ID)
+	assert.Equal(t, metapb.JobState_Working, jobs[1].State)
+
+	job, err := c.GetJob(job2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("job2"), job.Content)
+	_, err = c.GetJob(job2.ID + 100)
+	assert.Error(t, err)
+
+	// only one active job of the type, the id can be omitted
+	assert.NoError(t, c.RemoveJob(metapb.Job{Type: metapb.JobType(1)}))
+	job, err = c.GetJob(job2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.JobState_Completed, job.State)
+}
+
+func TestExclusiveJob(t *testing.T) {
+	p := newTestSingleProphet(t, nil).(*defaultProphet)
+	defer p.Stop()
+
+	jp := newTestJobProcessor()
+	jp.exclusive = true
+	p.cfg.RegisterJobProcessor(metapb.JobType(1), jp)
+
+	c := p.GetClient()
+	job1, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1), Content: []byte("job1")})
+	assert.NoError(t, err)
+	job2, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1), Content: []byte("job2")})
+	assert.NoError(t, err)
+	assert.Equal(t, job1, job2)
+	starts, _, _ := jp.count()
+	assert.Equal(t, 1, starts)
+
+	// create a new one after the active job removed
+	assert.NoError(t, c.RemoveJob(metapb.Job{Type: metapb.JobType(1)}))
+	job3, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1), Content: []byte("job3")})
+	assert.NoError(t, err)
+	assert.NotEqual(t, job1.ID, job3.ID)
+}
+
+func TestJobProgressAndRetries(t *testing.T) {
+	p := newTestSingleProphet(t, func(c *config.Config) {
+		c.Job.MaxRetries = 1
+		c.Job.RetryBackoff.Duration = time.Millisecond * 100
+		c.Job.HistoryRetention.Duration = time.Second
+	}).(*defaultProphet)
+	defer p.Stop()
+
+	jp := newTestJobProcessor()
+	p.cfg.RegisterJobProcessor(metapb.JobType(1), jp)
+
+	c := p.GetClient()
+	job, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1)})
+	assert.NoError(t, err)
+	reporter := jp.getReporter()
+	assert.NotNil(t, reporter)
+
+	assert.NoError(t, reporter.Progress(job.ID, 50))
+	job, err = c.GetJob(job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(50), job.Progress)
+
+	// the failed job is stopped and restarted after the backoff
+	assert.NoError(t, reporter.Fail(job.ID, errors.New("error1")))
+	job, err = c.GetJob(job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.JobState_Created, job.State)
+	assert.Equal(t, uint64(1), job.Retries)
+	assert.Equal(t, "error1", job.Error)
+	starts, stops, _ := jp.count()
+	assert.Equal(t, 0, starts)
+	assert.Equal(t, 1, stops)
+
+	time.Sleep(time.Millisecond * 200)
+	p.checkJobs(context.Background())
+	job, err = c.GetJob(job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.JobState_Working, job.State)
+	starts, _, _ = jp.count()
+	assert.Equal(t, 1, starts)
+
+	// the job is failed after the max retries
+	assert.NoError(t, reporter.Fail(job.ID, errors.New("error2")))
+	job, err = c.GetJob(job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.JobState_Failed, job.State)
+	assert.Equal(t, "error2", job.Error)
+	_, _, removes := jp.count()
+	assert.Equal(t, 1, removes)
+	assert.Error(t, reporter.Progress(job.ID, 60), "the failed job can not be reported")
+
+	// the completed job
+	job2, err := c.CreateJob(metapb.Job{Type: metapb.JobType(1)})
+	assert.NoError(t, err)
+	assert.NoError(t, reporter.Complete(job2.ID))
+	job2, err = c.GetJob(job2.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, metapb.JobState_Completed, job2.State)
+	assert.Equal(t, uint64(100), job2.Progress)
+
+	// the history jobs are removed after the retention
+	time.Sleep(time.Second * 2)
+	p.checkJobs(context.Background())
+	jobs, err := c.ListJobs()
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+	n := 0
+	assert.NoError(t, p.storage.LoadJobs(16, func(metapb.Job) { n++ }))
+	assert.Equal(t, 0, n)
+}
+
+func TestMigrateJobsWithoutID(t *testing.T) {
+	p := newTestSingleProphet(t, nil).(*defaultProphet)
+	defer p.Stop()
+
+	jp := newTestJobProcessor()
+	p.cfg.RegisterJobProcessor(metapb.JobType(1), jp)
+
+	// the jobs created by the old version are keyed by the job type
+	old := metapb.Job{Type: metapb.JobType(1), Content: []byte("job1"), State: metapb.JobState_Working}
+	assert.NoError(t, p.storage.PutJob(old))
+	assert.NoError(t, p.storage.PutJobData(old, []byte("data1")))
+	assert.NoError(t, p.storage.PutJob(metapb.Job{Type: metapb.JobType(2), State: metapb.JobState_Completed}))
+
+	p.stopJobs()
+	p.startJobs()
+	p.jobMu.RLock()
+	var jobs []metapb.Job
+	for _, job := range p.jobMu.jobs {
+		jobs = append(jobs, job)
+	}
+	p.jobMu.RUnlock()
+	assert.Equal(t, 1, len(jobs))
+	for _, job := range jobs {
+		assert.True(t, job.ID > 0)
+		assert.Equal(t, []byte("job1"), job.Content)
+		assert.Equal(t, metapb.JobState_Working, job.State)
+
+		data, err := p.storage.GetJobData(job)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("data1"), data)
+	}
+
+	var loaded []metapb.Job
+	assert.NoError(t, p.storage.LoadJobs(16, func(job metapb.Job) { loaded = append(loaded, job) }))
+	assert.Equal(t, 1, len(loaded))
+	assert.True(t, loaded[0].ID > 0)
+	data, err := p.storage.GetJobData(old)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+}
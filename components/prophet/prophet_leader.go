@@ -30,6 +30,11 @@ func (p *defaultProphet) enableLeader() error {
 		return err
 	}
 
+	if err := p.tso.Start(); err != nil {
+		util.GetLogger().Errorf("start tso allocator failed with %+v", err)
+		return err
+	}
+
 	p.initClient()
 	p.createEventNotifer()
 	p.notifyElectionComplete()
@@ -44,6 +49,7 @@ func (p *defaultProphet) disableLeader() error {
 
 	p.initClient()
 	p.stopRaftCluster()
+	p.tso.Stop()
 	p.stopEventNotifer()
 	p.notifyElectionComplete()
 	p.stopJobs()
@@ -85,6 +91,7 @@ func (p *defaultProphet) initClient() {
 	p.clientOnce.Do(func() {
 		p.client = NewClient(p.cfg.Adapter,
 			WithRPCTimeout(p.cfg.RPCTimeout.Duration),
-			WithLeaderGetter(p.GetLeader))
+			WithLeaderGetter(p.GetLeader),
+			WithSecurity(p.cfg.Security))
 	})
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,6 +34,31 @@ func TestSingleStart(t *testing.T) {
 	assert.True(t, p.GetClusterID() > 0)
 }
 
+func TestSingleStartWithTLS(t *testing.T) {
+	security, err := tlsutil.GenerateTestCerts("/tmp/prophet/tls")
+	assert.NoError(t, err)
+	security.VerifyClientCert = true
+
+	p := newTestSingleProphet(t, func(c *config.Config) {
+		c.Security = security
+		c.EmbedEtcd.ClientUrls = "https://127.0.0.1:2379"
+		c.EmbedEtcd.PeerUrls = "https://127.0.0.1:2380"
+	})
+	defer p.Stop()
+
+	assert.True(t, p.GetMember().IsLeader())
+	id, err := p.GetClient().AllocID()
+	assert.NoError(t, err)
+	assert.True(t, id > 0)
+
+	// the client without TLS can not talk to the prophet
+	c := NewClient(metadata.NewTestAdapter(), WithRPCTimeout(time.Second),
+		WithLeaderGetter(p.GetMember().Member))
+	defer c.Close()
+	_, err = c.AllocID()
+	assert.Error(t, err)
+}
+
 func TestClusterStart(t *testing.T) {
 	cluster := newTestClusterProphet(t, 4, nil)
 	defer func() {
@@ -18,11 +18,12 @@ import (
 	"github.com/fagongzi/goetty/buf"
 	"github.com/matrixorigin/matrixcube/components/prophet/codec"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 )
 
 func (p *defaultProphet) startListen() {
 	encoder, decoder := codec.NewServerCodec(10 * buf.MB)
-	app, err := goetty.NewTCPApplication(p.cfg.RPCAddr,
+	app, err := tlsutil.NewTCPApplication(p.cfg.Security, p.cfg.RPCAddr,
 		p.handleRPCRequest,
 		goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder),
 			goetty.WithEnableAsyncWrite(16),
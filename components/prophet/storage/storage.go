@@ -27,15 +27,16 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/util"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 )
 
 // JobStorage job  storage
 type JobStorage interface {
 	// PutJob puts the job metadata to the storage
 	PutJob(metapb.Job) error
-	// RemoveJob remove job from storage
-	RemoveJob(jobType metapb.JobType) error
-	// LoadJobs load all jobs
+	// RemoveJob remove job and the job data from storage
+	RemoveJob(metapb.Job) error
+	// LoadJobs load all jobs, the jobs created by the old version have no ID
 	LoadJobs(limit int64, do func(metapb.Job)) error
 
 	// PutJobData put job data
@@ -128,9 +129,18 @@ type ClusterStorage interface {
 	PutBootstrapped(container metadata.Container, resources ...metadata.Resource) (bool, error)
 }
 
+// TimestampStorage timestamp storage
+type TimestampStorage interface {
+	// PutTimestamp puts the upper bound of the allocated timestamps to the storage
+	PutTimestamp(ts uint64) error
+	// GetTimestamp returns the upper bound of the allocated timestamps, 0 means never saved
+	GetTimestamp() (uint64, error)
+}
+
 // Storage meta storage
 type Storage interface {
 	JobStorage
+	TimestampStorage
 	CustomDataStorage
 	RuleStorage
 	ConfigStorage
@@ -158,6 +168,7 @@ type storage struct {
 	jobPath                  string
 	jobDataPath              string
 	customDataPath           string
+	timestampPath            string
 }
 
 // NewTestStorage create test storage
@@ -182,6 +193,7 @@ func NewStorage(rootPath string, kv KV, adapter metadata.Adapter) Storage {
 		jobPath:                  fmt.Sprintf("%s/jobs", rootPath),
 		jobDataPath:              fmt.Sprintf("%s/job-data", rootPath),
 		customDataPath:           fmt.Sprintf("%s/custom", rootPath),
+		timestampPath:            fmt.Sprintf("%s/timestamp", rootPath),
 	}
 }
 
@@ -419,14 +431,14 @@ func (s *storage) PutContainerWeight(id uint64, leaderWeight, resourceWeight flo
 }
 
 func (s *storage) PutJob(job metapb.Job) error {
-	return s.kv.Save(s.jobKey(job.Type),
+	return s.kv.Save(s.jobKey(job),
 		string(protoc.MustMarshal(&job)))
 }
 
-func (s *storage) RemoveJob(jobType metapb.JobType) error {
+func (s *storage) RemoveJob(job metapb.Job) error {
 	b := &Batch{}
-	b.RemoveKeys = append(b.RemoveKeys, s.jobKey(jobType))
-	b.RemoveKeys = append(b.RemoveKeys, s.jobDataKey(jobType))
+	b.RemoveKeys = append(b.RemoveKeys, s.jobKey(job))
+	b.RemoveKeys = append(b.RemoveKeys, s.jobDataKey(job))
 	return s.kv.Batch(b)
 }
 
@@ -440,11 +452,11 @@ func (s *storage) LoadJobs(limit int64, fn func(metapb.Job)) error {
 }
 
 func (s *storage) PutJobData(job metapb.Job, data []byte) error {
-	return s.kv.Save(s.jobDataKey(job.Type), string(data))
+	return s.kv.Save(s.jobDataKey(job), string(data))
 }
 
 func (s *storage) GetJobData(job metapb.Job) ([]byte, error) {
-	v, err := s.kv.Load(s.jobDataKey(job.Type))
+	v, err := s.kv.Load(s.jobDataKey(job))
 	if err != nil {
 		return nil, err
 	}
@@ -453,7 +465,24 @@ func (s *storage) GetJobData(job metapb.Job) ([]byte, error) {
 }
 
 func (s *storage) RemoveJobData(job metapb.Job) error {
-	return s.kv.Remove(s.jobDataKey(job.Type))
+	return s.kv.Remove(s.jobDataKey(job))
+}
+
+func (s *storage) PutTimestamp(ts uint64) error {
+	return s.kv.Save(s.timestampPath, string(typeutil.Uint64ToBytes(ts)))
+}
+
+func (s *storage) GetTimestamp() (uint64, error) {
+	v, err := s.kv.Load(s.timestampPath)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(v) == 0 {
+		return 0, nil
+	}
+
+	return typeutil.BytesToUint64([]byte(v))
 }
 
 func (s *storage) PutCustomData(key []byte, data []byte) error {
@@ -546,10 +575,18 @@ func (s *storage) containerWeightPath(id uint64, typ string) string {
 	return path.Join(s.schedulePath, "weight", fmt.Sprintf("%020d", id), typ)
 }
 
-func (s *storage) jobKey(jobType metapb.JobType) string {
-	return path.Join(s.jobPath, string(format.UInt64ToString(uint64(jobType))))
+// jobKey returns the key of the job, the jobs created by the old version have no ID and
+// are keyed by the job type.
+func (s *storage) jobKey(job metapb.Job) string {
+	if job.ID == 0 {
+		return path.Join(s.jobPath, string(format.UInt64ToString(uint64(job.Type))))
+	}
+	return path.Join(s.jobPath, "id", fmt.Sprintf("%020d", job.ID))
 }
 
-func (s *storage) jobDataKey(jobType metapb.JobType) string {
-	return path.Join(s.jobDataPath, string(format.UInt64ToString(uint64(jobType))))
+func (s *storage) jobDataKey(job metapb.Job) string {
+	if job.ID == 0 {
+		return path.Join(s.jobDataPath, string(format.UInt64ToString(uint64(job.Type))))
+	}
+	return path.Join(s.jobDataPath, "id", fmt.Sprintf("%020d", job.ID))
 }
@@ -200,20 +200,20 @@ func TestPutAndDeleteAndLoadJobs(t *testing.T) {
 	time.Sleep(time.Millisecond * 200)
 
 	storage := NewStorage("/root", NewEtcdKV("/root", client, ls), metadata.NewTestAdapter())
-	assert.NoError(t, storage.PutJob(metapb.Job{Type: metapb.JobType(1), Content: []byte("job1")}))
-	assert.NoError(t, storage.PutJob(metapb.Job{Type: metapb.JobType(2), Content: []byte("job2")}))
-	assert.NoError(t, storage.PutJob(metapb.Job{Type: metapb.JobType(3), Content: []byte("job3")}))
+	assert.NoError(t, storage.PutJob(metapb.Job{ID: 1, Type: metapb.JobType(1), Content: []byte("job1")}))
+	assert.NoError(t, storage.PutJob(metapb.Job{ID: 2, Type: metapb.JobType(2), Content: []byte("job2")}))
+	assert.NoError(t, storage.PutJob(metapb.Job{ID: 3, Type: metapb.JobType(3), Content: []byte("job3")}))
 	var loadedValues []metapb.Job
 	assert.NoError(t, storage.LoadJobs(1, func(job metapb.Job) {
 		loadedValues = append(loadedValues, job)
 	}))
 	assert.Equal(t, 3, len(loadedValues))
 	assert.Equal(t, []byte("job1"), loadedValues[0].Content)
-	assert.NoError(t, storage.RemoveJob(loadedValues[0].Type))
+	assert.NoError(t, storage.RemoveJob(loadedValues[0]))
 	assert.Equal(t, []byte("job2"), loadedValues[1].Content)
-	assert.NoError(t, storage.RemoveJob(loadedValues[1].Type))
+	assert.NoError(t, storage.RemoveJob(loadedValues[1]))
 	assert.Equal(t, []byte("job3"), loadedValues[2].Content)
-	assert.NoError(t, storage.RemoveJob(loadedValues[2].Type))
+	assert.NoError(t, storage.RemoveJob(loadedValues[2]))
 
 	c := 0
 	assert.NoError(t, storage.LoadJobs(1, func(job metapb.Job) {
@@ -293,3 +293,15 @@ func TestBatchPutCustomData(t *testing.T) {
 		assert.Equal(t, data[i], loadedValues[i])
 	}
 }
+
+func TestPutAndGetTimestamp(t *testing.T) {
+	storage := NewTestStorage()
+	v, err := storage.GetTimestamp()
+	assert.NoError(t, err, "TestPutAndGetTimestamp failed")
+	assert.Equal(t, uint64(0), v, "TestPutAndGetTimestamp failed")
+
+	assert.NoError(t, storage.PutTimestamp(100), "TestPutAndGetTimestamp failed")
+	v, err = storage.GetTimestamp()
+	assert.NoError(t, err, "TestPutAndGetTimestamp failed")
+	assert.Equal(t, uint64(100), v, "TestPutAndGetTimestamp failed")
+}
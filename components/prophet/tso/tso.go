@@ -0,0 +1,241 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tso implements the timestamp oracle of the prophet leader.
+//
+// A timestamp is composed of a physical part in milliseconds and a logical counter,
+// physical<<LogicalBits | logical. The allocator persists an upper bound of the physical
+// part to the storage before it is used, so the new leader always starts after all the
+// timestamps allocated by the previous leaders even if the clock goes backwards.
+package tso
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/components/prophet/util"
+)
+
+const (
+	// LogicalBits the bits of the logical part of the timestamp
+	LogicalBits = 18
+	maxLogical  = int64(1 << LogicalBits)
+
+	defaultSaveWindow     = time.Second * 3
+	defaultUpdateInterval = time.Millisecond * 50
+)
+
+var (
+	// ErrNotStarted the allocator is not started, maybe current node is not the leader
+	ErrNotStarted = errors.New("tso allocator is not started")
+	// ErrLogicalOverflow too many timestamps allocated in the current millisecond, retry later
+	ErrLogicalOverflow = errors.New("tso logical part overflow")
+	// ErrInvalidCount the count of the timestamps to allocate is invalid
+	ErrInvalidCount = errors.New("invalid timestamp count")
+)
+
+// ComposeTimestamp returns the timestamp of the physical and logical parts
+func ComposeTimestamp(physical, logical int64) uint64 {
+	return uint64(physical)<<LogicalBits | uint64(logical)
+}
+
+// ParseTimestamp returns the physical and logical parts of the timestamp
+func ParseTimestamp(ts uint64) (int64, int64) {
+	return int64(ts >> LogicalBits), int64(ts & uint64(maxLogical-1))
+}
+
+// Option allocator option
+type Option func(*Allocator)
+
+// WithSaveWindow set the window of the persisted upper bound of the physical part
+func WithSaveWindow(value time.Duration) Option {
+	return func(a *Allocator) {
+		a.saveWindow = value
+	}
+}
+
+// WithUpdateInterval set the interval of advancing the physical part
+func WithUpdateInterval(value time.Duration) Option {
+	return func(a *Allocator) {
+		a.updateInterval = value
+	}
+}
+
+// Allocator the timestamp allocator, it's only started on the prophet leader
+type Allocator struct {
+	storage        storage.TimestampStorage
+	saveWindow     time.Duration
+	updateInterval time.Duration
+
+	mu struct {
+		sync.Mutex
+		running  bool
+		physical int64
+		logical  int64
+		// all the allocated physical parts are less than saved
+		saved int64
+	}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewAllocator returns a timestamp allocator
+func NewAllocator(storage storage.TimestampStorage, opts ...Option) *Allocator {
+	a := &Allocator{
+		storage:        storage,
+		saveWindow:     defaultSaveWindow,
+		updateInterval: defaultUpdateInterval,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Start loads the saved upper bound and starts allocating, it's called when the
+// current node become the leader.
+func (a *Allocator) Start() error {
+	a.Stop()
+
+	saved, err := a.storage.GetTimestamp()
+	if err != nil {
+		return err
+	}
+
+	physical := nowMS()
+	if physical <= int64(saved) {
+		util.GetLogger().Warningf("tso: the saved upper bound %d is greater than now %d, maybe the clock goes backwards",
+			saved, physical)
+		physical = int64(saved) + 1
+	}
+
+	next := physical + a.saveWindow.Milliseconds()
+	if err := a.storage.PutTimestamp(uint64(next)); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.mu.Lock()
+	a.mu.running = true
+	a.mu.physical = physical
+	a.mu.logical = 0
+	a.mu.saved = next
+	a.cancel = cancel
+	a.mu.Unlock()
+
+	a.wg.Add(1)
+	go a.updateLoop(ctx)
+	util.GetLogger().Infof("tso: started with physical %d, saved upper bound %d", physical, next)
+	return nil
+}
+
+// Stop stops allocating, it's called when the current node become the follower.
+func (a *Allocator) Stop() {
+	a.mu.Lock()
+	cancel := a.cancel
+	a.cancel = nil
+	a.mu.running = false
+	a.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		a.wg.Wait()
+		util.GetLogger().Infof("tso: stopped")
+	}
+}
+
+// Allocate allocates count timestamps and returns the first one, the others are
+// the following count-1 timestamps.
+func (a *Allocator) Allocate(count uint32) (uint64, error) {
+	if count == 0 || int64(count) >= maxLogical {
+		return 0, ErrInvalidCount
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.mu.running {
+		return 0, ErrNotStarted
+	}
+
+	if a.mu.logical+int64(count) >= maxLogical {
+		// borrow the next millisecond if it's still protected by the saved upper bound
+		if a.mu.physical+1 >= a.mu.saved {
+			return 0, ErrLogicalOverflow
+		}
+		a.mu.physical++
+		a.mu.logical = 0
+	}
+
+	ts := ComposeTimestamp(a.mu.physical, a.mu.logical)
+	a.mu.logical += int64(count)
+	return ts, nil
+}
+
+func (a *Allocator) updateLoop(ctx context.Context) {
+	defer a.wg.Done()
+
+	ticker := time.NewTicker(a.updateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.update(); err != nil {
+				util.GetLogger().Errorf("tso: update failed with %+v", err)
+			}
+		}
+	}
+}
+
+func (a *Allocator) update() error {
+	a.mu.Lock()
+	physical := a.mu.physical
+	saved := a.mu.saved
+	a.mu.Unlock()
+
+	now := nowMS()
+	if now > physical {
+		physical = now
+	}
+
+	// persist the new upper bound before the physical part reaches it
+	if saved-physical <= a.updateInterval.Milliseconds()*2 {
+		next := physical + a.saveWindow.Milliseconds()
+		if err := a.storage.PutTimestamp(uint64(next)); err != nil {
+			return err
+		}
+		saved = next
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.mu.running {
+		return nil
+	}
+	a.mu.saved = saved
+	if physical > a.mu.physical && physical < saved {
+		a.mu.physical = physical
+		a.mu.logical = 0
+	}
+	return nil
+}
+
+func nowMS() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
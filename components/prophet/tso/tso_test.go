@@ -0,0 +1,109 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tso
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposeAndParseTimestamp(t *testing.T) {
+	ts := ComposeTimestamp(1000, 10)
+	physical, logical := ParseTimestamp(ts)
+	assert.Equal(t, int64(1000), physical)
+	assert.Equal(t, int64(10), logical)
+	assert.True(t, ComposeTimestamp(1001, 0) > ComposeTimestamp(1000, maxLogical-1))
+}
+
+func TestAllocate(t *testing.T) {
+	a := NewAllocator(storage.NewTestStorage(), WithUpdateInterval(time.Millisecond*10))
+	_, err := a.Allocate(1)
+	assert.Equal(t, ErrNotStarted, err)
+
+	assert.NoError(t, a.Start())
+	defer a.Stop()
+
+	_, err = a.Allocate(0)
+	assert.Equal(t, ErrInvalidCount, err)
+
+	last := uint64(0)
+	for i := 0; i < 100; i++ {
+		ts, err := a.Allocate(10)
+		assert.NoError(t, err)
+		assert.True(t, ts > last)
+		last = ts + 9
+		if i%10 == 0 {
+			time.Sleep(time.Millisecond * 5)
+		}
+	}
+}
+
+func TestAllocateWithLogicalOverflow(t *testing.T) {
+	a := NewAllocator(storage.NewTestStorage())
+	assert.NoError(t, a.Start())
+	defer a.Stop()
+
+	ts1, err := a.Allocate(uint32(maxLogical - 1))
+	assert.NoError(t, err)
+	ts2, err := a.Allocate(uint32(maxLogical - 1))
+	assert.NoError(t, err)
+	assert.True(t, ts2 >= ts1+uint64(maxLogical-1))
+}
+
+func TestRestartNeverGoesBackwards(t *testing.T) {
+	s := storage.NewTestStorage()
+	// the saved upper bound of the previous leader is far away in the future
+	future := nowMS() + time.Hour.Milliseconds()
+	assert.NoError(t, s.PutTimestamp(uint64(future)))
+
+	a := NewAllocator(s)
+	assert.NoError(t, a.Start())
+	ts, err := a.Allocate(1)
+	assert.NoError(t, err)
+	physical, _ := ParseTimestamp(ts)
+	assert.True(t, physical > future)
+	a.Stop()
+
+	saved, err := s.GetTimestamp()
+	assert.NoError(t, err)
+	assert.True(t, int64(saved) > physical)
+
+	// the new leader starts after the timestamps allocated by the previous leader
+	b := NewAllocator(s)
+	assert.NoError(t, b.Start())
+	defer b.Stop()
+	ts2, err := b.Allocate(1)
+	assert.NoError(t, err)
+	assert.True(t, ts2 > ts)
+}
+
+func TestWindowIsPersistedInAdvance(t *testing.T) {
+	s := storage.NewTestStorage()
+	a := NewAllocator(s, WithSaveWindow(time.Millisecond*100), WithUpdateInterval(time.Millisecond*10))
+	assert.NoError(t, a.Start())
+	defer a.Stop()
+
+	for i := 0; i < 30; i++ {
+		ts, err := a.Allocate(1)
+		assert.NoError(t, err)
+		physical, _ := ParseTimestamp(ts)
+		saved, err := s.GetTimestamp()
+		assert.NoError(t, err)
+		assert.True(t, physical < int64(saved))
+		time.Sleep(time.Millisecond * 10)
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec"
+	"github.com/fagongzi/goetty/queue"
+)
+
+const (
+	stateReadyToConnect int32 = iota
+	stateConnecting
+	stateConnected
+	stateClosing
+)
+
+var (
+	errConnecting = errors.New("the session is closing or connecting in other goroutine")
+	stopFlag      = &struct{}{}
+)
+
+// SessionOption the option of the client session
+type SessionOption func(*sessionOptions)
+
+type sessionOptions struct {
+	readTimeout, writeTimeout time.Duration
+	asyncFlushBatch           int64
+	logger                    goetty.Logger
+}
+
+// WithTimeout set the read and write timeout
+func WithTimeout(read, write time.Duration) SessionOption {
+	return func(opts *sessionOptions) {
+		opts.readTimeout = read
+		opts.writeTimeout = write
+	}
+}
+
+// WithEnableAsyncWrite enable the async write, the messages are flushed in batch
+func WithEnableAsyncWrite(asyncFlushBatch int64) SessionOption {
+	return func(opts *sessionOptions) {
+		opts.asyncFlushBatch = asyncFlushBatch
+	}
+}
+
+// WithLogger set the logger
+func WithLogger(logger goetty.Logger) SessionOption {
+	return func(opts *sessionOptions) {
+		opts.logger = logger
+	}
+}
+
+// NewIOSession returns a client session. The goetty session dials the plain tcp connection
+// only, so a TLS session which works as the goetty session is returned if the TLS is enabled.
+func NewIOSession(c Config, encoder codec.Encoder, decoder codec.Decoder, opts ...SessionOption) goetty.IOSession {
+	sopts := &sessionOptions{}
+	for _, opt := range opts {
+		opt(sopts)
+	}
+
+	if !c.Enabled() {
+		gopts := []goetty.Option{goetty.WithCodec(encoder, decoder),
+			goetty.WithTimeout(sopts.readTimeout, sopts.writeTimeout)}
+		if sopts.asyncFlushBatch > 0 {
+			gopts = append(gopts, goetty.WithEnableAsyncWrite(sopts.asyncFlushBatch))
+		}
+		if sopts.logger != nil {
+			gopts = append(gopts, goetty.WithLogger(sopts.logger))
+		}
+		return goetty.NewIOSession(gopts...)
+	}
+
+	return &tlsSession{
+		security: c,
+		opts:     sopts,
+		encoder:  encoder,
+		decoder:  decoder,
+		in:       buf.NewByteBuf(goetty.DefaultReadBuf),
+		out:      buf.NewByteBuf(goetty.DefaultWriteBuf),
+	}
+}
+
+// tlsSession is the client side goetty.IOSession over TLS
+type tlsSession struct {
+	security             Config
+	opts                 *sessionOptions
+	encoder              codec.Encoder
+	decoder              codec.Decoder
+	state                int32
+	conn                 net.Conn
+	remoteIP, remoteAddr string
+	in                   *buf.ByteBuf
+	out                  *buf.ByteBuf
+	attrs                sync.Map
+	asyncQueue           queue.Queue
+}
+
+func (s *tlsSession) ID() uint64 {
+	return 0
+}
+
+func (s *tlsSession) Connect(addr string, timeout time.Duration) (bool, error) {
+	if !atomic.CompareAndSwapInt32(&s.state, stateReadyToConnect, stateConnecting) {
+		if s.Connected() {
+			return true, nil
+		}
+		return false, errConnecting
+	}
+
+	s.reset()
+	cfg, err := s.security.ClientConfig()
+	if err != nil {
+		atomic.StoreInt32(&s.state, stateReadyToConnect)
+		return false, err
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", addr, cfg)
+	if err != nil {
+		atomic.StoreInt32(&s.state, stateReadyToConnect)
+		return false, err
+	}
+
+	s.initConn(conn)
+	return true, nil
+}
+
+func (s *tlsSession) Connected() bool {
+	return atomic.LoadInt32(&s.state) == stateConnected
+}
+
+func (s *tlsSession) Close() error {
+	if !atomic.CompareAndSwapInt32(&s.state, stateConnected, stateClosing) {
+		if atomic.LoadInt32(&s.state) == stateReadyToConnect {
+			return nil
+		}
+		return errConnecting
+	}
+
+	s.reset()
+	atomic.StoreInt32(&s.state, stateReadyToConnect)
+	if s.asyncQueue != nil {
+		s.asyncQueue.Put(stopFlag)
+	}
+	return nil
+}
+
+func (s *tlsSession) Read() (interface{}, error) {
+	for {
+		if !s.Connected() {
+			return nil, goetty.ErrIllegalState
+		}
+
+		var msg interface{}
+		var err error
+		var complete bool
+		if s.in.Readable() > 0 {
+			complete, msg, err = s.decoder.Decode(s.in)
+			if !complete && err == nil {
+				complete, msg, err = s.readFromConn()
+			}
+		} else {
+			s.in.Clear()
+			complete, msg, err = s.readFromConn()
+		}
+
+		if err != nil {
+			s.in.Clear()
+			return nil, err
+		}
+
+		if complete {
+			if s.in.Readable() == 0 {
+				s.in.Clear()
+			}
+			return msg, nil
+		}
+	}
+}
+
+func (s *tlsSession) Write(msg interface{}) error {
+	if s.asyncQueue != nil {
+		s.asyncQueue.Put(msg)
+		return nil
+	}
+	return s.write(msg, false)
+}
+
+func (s *tlsSession) WriteAndFlush(msg interface{}) error {
+	if s.asyncQueue != nil {
+		return s.asyncQueue.Put(msg)
+	}
+	return s.write(msg, true)
+}
+
+func (s *tlsSession) Flush() error {
+	if !s.Connected() {
+		return goetty.ErrIllegalState
+	}
+
+	defer s.out.Clear()
+	if s.opts.writeTimeout > 0 {
+		s.conn.SetWriteDeadline(time.Now().Add(s.opts.writeTimeout))
+	} else {
+		s.conn.SetWriteDeadline(time.Time{})
+	}
+	_, err := s.conn.Write(s.out.RawBuf()[s.out.GetReaderIndex():s.out.GetWriteIndex()])
+	return err
+}
+
+func (s *tlsSession) InBuf() *buf.ByteBuf {
+	return s.in
+}
+
+func (s *tlsSession) OutBuf() *buf.ByteBuf {
+	return s.out
+}
+
+func (s *tlsSession) SetAttr(key string, value interface{}) {
+	s.attrs.Store(key, value)
+}
+
+func (s *tlsSession) GetAttr(key string) interface{} {
+	if v, ok := s.attrs.Load(key); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *tlsSession) RemoteAddr() string {
+	return s.remoteAddr
+}
+
+func (s *tlsSession) RemoteIP() string {
+	return s.remoteIP
+}
+
+func (s *tlsSession) write(msg interface{}, flush bool) error {
+	if !s.Connected() {
+		return goetty.ErrIllegalState
+	}
+
+	if err := s.encoder.Encode(msg, s.out); err != nil {
+		return err
+	}
+
+	if flush {
+		return s.Flush()
+	}
+	return nil
+}
+
+func (s *tlsSession) writeLoop(q queue.Queue) {
+	defer q.Dispose()
+
+	items := make([]interface{}, s.opts.asyncFlushBatch)
+	for {
+		n, err := q.Get(s.opts.asyncFlushBatch, items)
+		if err != nil {
+			return
+		}
+
+		for i := int64(0); i < n; i++ {
+			if items[i] == stopFlag {
+				return
+			}
+			s.write(items[i], false)
+		}
+
+		if err := s.Flush(); err != nil {
+			if s.opts.logger != nil {
+				s.opts.logger.Errorf("flush messages failed with %+v, closed this session", err)
+			}
+			return
+		}
+	}
+}
+
+func (s *tlsSession) readFromConn() (bool, interface{}, error) {
+	if s.opts.readTimeout > 0 {
+		s.conn.SetReadDeadline(time.Now().Add(s.opts.readTimeout))
+	} else {
+		s.conn.SetReadDeadline(time.Time{})
+	}
+
+	n, err := io.Copy(s.in, s.conn)
+	if err != nil {
+		return false, nil, err
+	}
+	if n == 0 {
+		return false, nil, io.EOF
+	}
+
+	return s.decoder.Decode(s.in)
+}
+
+func (s *tlsSession) reset() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	s.in.Clear()
+	s.out.Clear()
+	s.remoteAddr = ""
+	s.remoteIP = ""
+}
+
+func (s *tlsSession) initConn(conn net.Conn) {
+	s.conn = conn
+	s.remoteAddr = conn.RemoteAddr().String()
+	s.remoteIP = strings.Split(s.remoteAddr, ":")[0]
+	if s.opts.asyncFlushBatch > 0 {
+		s.asyncQueue = queue.New(64)
+		go s.writeLoop(s.asyncQueue)
+	}
+	atomic.StoreInt32(&s.state, stateConnected)
+}
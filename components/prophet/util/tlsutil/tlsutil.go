@@ -0,0 +1,161 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tlsutil provides the TLS config shared by all the listeners and dialers of prophet
+// and cube, including the raft transport, the client rpc, the prophet rpc and the embed etcd.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"github.com/fagongzi/goetty"
+	"go.etcd.io/etcd/pkg/transport"
+)
+
+// Config the TLS config. The certificate, the key and the CA files are loaded on every new
+// connection, so the rotated files are used without restart, the established connections
+// are not affected.
+type Config struct {
+	// CAFile the CA to verify the peer certificates
+	CAFile string `toml:"ca-file" json:"ca-file"`
+	// CertFile the certificate used by the listeners and the dialers
+	CertFile string `toml:"cert-file" json:"cert-file"`
+	// KeyFile the private key of the certificate
+	KeyFile string `toml:"key-file" json:"key-file"`
+	// VerifyClientCert the listeners require and verify the client certificates, mutual TLS
+	VerifyClientCert bool `toml:"verify-client-cert" json:"verify-client-cert"`
+}
+
+// Enabled returns true if the TLS is enabled
+func (c Config) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// TLSInfo returns the etcd TLS info
+func (c Config) TLSInfo() transport.TLSInfo {
+	return transport.TLSInfo{
+		CertFile:       c.CertFile,
+		KeyFile:        c.KeyFile,
+		TrustedCAFile:  c.CAFile,
+		ClientCertAuth: c.VerifyClientCert,
+	}
+}
+
+// ServerConfig returns the TLS config of the listeners, returns nil if the TLS is disabled
+func (c Config) ServerConfig() (*tls.Config, error) {
+	if !c.Enabled() {
+		return nil, nil
+	}
+
+	// check the files at the start, the config is rebuilt on every handshake
+	cfg, err := c.TLSInfo().ServerConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: cfg.MinVersion,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return c.TLSInfo().ServerConfig()
+		},
+	}, nil
+}
+
+// ClientConfig returns the TLS config of the dialers, returns nil if the TLS is disabled. The
+// certificate and the CA are loaded on every handshake, so the config can be kept by the long
+// lived clients, e.g. the etcd clients.
+func (c Config) ClientConfig() (*tls.Config, error) {
+	if !c.Enabled() {
+		return nil, nil
+	}
+
+	// check the files at the start
+	cfg, err := c.TLSInfo().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}
+	if c.CAFile != "" {
+		// the server certificate is verified with the reloaded CA instead of the RootCAs
+		cfg.RootCAs = nil
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = c.verifyServer
+	}
+	return cfg, nil
+}
+
+func (c Config) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("missing server certificate")
+	}
+
+	ca, err := ioutil.ReadFile(c.CAFile)
+	if err != nil {
+		return err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return fmt.Errorf("invalid CA file %s", c.CAFile)
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err = cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// Listen listens on the address, the accepted connections are TLS connections if enabled
+func (c Config) Listen(addr string) (net.Listener, error) {
+	cfg, err := c.ServerConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg == nil {
+		return listener, nil
+	}
+	return tls.NewListener(listener, cfg), nil
+}
+
+// NewTCPApplication is the goetty.NewTCPApplication with TLS
+func NewTCPApplication(c Config, addr string, handleFunc func(goetty.IOSession, interface{}, uint64) error, opts ...goetty.AppOption) (goetty.NetApplication, error) {
+	listener, err := c.Listen(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return goetty.NewApplication(listener, handleFunc, opts...)
+}
@@ -0,0 +1,179 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/codec/simple"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	tmpDir = "/tmp/cube/tlsutil"
+)
+
+func startEchoApp(t *testing.T, c Config, addr string) goetty.NetApplication {
+	encoder, decoder := simple.NewStringCodec()
+	app, err := NewTCPApplication(c, addr, func(conn goetty.IOSession, msg interface{}, seq uint64) error {
+		return conn.WriteAndFlush(msg)
+	}, goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder)))
+	assert.NoError(t, err)
+	assert.NoError(t, app.Start())
+	return app
+}
+
+func mustEcho(t *testing.T, c Config, addr string, opts ...SessionOption) {
+	encoder, decoder := simple.NewStringCodec()
+	conn := NewIOSession(c, encoder, decoder, opts...)
+	defer conn.Close()
+
+	ok, err := conn.Connect(addr, time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoError(t, conn.WriteAndFlush("hello"))
+	msg, err := conn.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", msg)
+}
+
+func TestConfigEnabled(t *testing.T) {
+	c := Config{}
+	assert.False(t, c.Enabled())
+	cfg, err := c.ServerConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	c.CertFile = "cert.pem"
+	assert.False(t, c.Enabled())
+	c.KeyFile = "key.pem"
+	assert.True(t, c.Enabled())
+	_, err = c.ServerConfig()
+	assert.Error(t, err, "missing files")
+}
+
+func TestSessionWithoutTLS(t *testing.T) {
+	addr := "127.0.0.1:52201"
+	app := startEchoApp(t, Config{}, addr)
+	defer app.Stop()
+
+	mustEcho(t, Config{}, addr)
+	mustEcho(t, Config{}, addr, WithEnableAsyncWrite(16), WithTimeout(time.Second, time.Second))
+}
+
+func TestSessionWithTLS(t *testing.T) {
+	os.RemoveAll(tmpDir)
+	c, err := GenerateTestCerts(tmpDir)
+	assert.NoError(t, err)
+	c.VerifyClientCert = true
+
+	addr := "127.0.0.1:52202"
+	app := startEchoApp(t, c, addr)
+	defer app.Stop()
+
+	mustEcho(t, c, addr)
+	mustEcho(t, c, addr, WithEnableAsyncWrite(16), WithTimeout(time.Second, time.Second))
+
+	// the plain client can not talk to the TLS server
+	encoder, decoder := simple.NewStringCodec()
+	conn := NewIOSession(Config{}, encoder, decoder, WithTimeout(time.Second, time.Second))
+	defer conn.Close()
+	_, err = conn.Connect(addr, time.Second)
+	assert.NoError(t, err)
+	assert.NoError(t, conn.WriteAndFlush("hello"))
+	_, err = conn.Read()
+	assert.Error(t, err)
+
+	// the client without certificate is rejected
+	cfg, err := c.ClientConfig()
+	assert.NoError(t, err)
+	cfg.GetClientCertificate = nil
+	cfg.Certificates = nil
+	tc, err := tls.Dial("tcp", addr, cfg)
+	if err == nil {
+		defer tc.Close()
+		_, err = tc.Read(make([]byte, 1))
+	}
+	assert.Error(t, err)
+}
+
+func TestCertReload(t *testing.T) {
+	os.RemoveAll(tmpDir)
+	c, err := GenerateTestCerts(tmpDir)
+	assert.NoError(t, err)
+
+	addr := "127.0.0.1:52203"
+	app := startEchoApp(t, c, addr)
+	defer app.Stop()
+
+	serial := func() string {
+		cfg, err := c.ClientConfig()
+		assert.NoError(t, err)
+		conn, err := tls.Dial("tcp", addr, cfg)
+		assert.NoError(t, err)
+		defer conn.Close()
+		return conn.ConnectionState().PeerCertificates[0].SerialNumber.String()
+	}
+
+	old := serial()
+	mustEcho(t, c, addr)
+
+	// rotate the CA and the certificate, the new connections use the new files
+	_, err = GenerateTestCerts(tmpDir)
+	assert.NoError(t, err)
+	assert.NotEqual(t, old, serial())
+	mustEcho(t, c, addr)
+}
+
+func TestClientConfigReload(t *testing.T) {
+	os.RemoveAll(tmpDir)
+	c, err := GenerateTestCerts(tmpDir)
+	assert.NoError(t, err)
+	c.VerifyClientCert = true
+
+	addr := "127.0.0.1:52204"
+	app := startEchoApp(t, c, addr)
+	defer app.Stop()
+
+	// the config is created once, like the etcd clients
+	cfg, err := c.ClientConfig()
+	assert.NoError(t, err)
+	dial := func() error {
+		conn, err := tls.Dial("tcp", addr, cfg)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return conn.Handshake()
+	}
+	assert.NoError(t, dial())
+
+	// rotate the CA and the certificate
+	_, err = GenerateTestCerts(tmpDir)
+	assert.NoError(t, err)
+	assert.NoError(t, dial())
+
+	// the server certificate is not signed by the CA
+	other, err := GenerateTestCerts(tmpDir + "-other")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir + "-other")
+	c.CAFile = other.CAFile
+	cfg, err = c.ClientConfig()
+	assert.NoError(t, err)
+	assert.Error(t, dial())
+}
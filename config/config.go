@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixcube/aware"
 	pconfig "github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
@@ -37,6 +38,7 @@ var (
 	defaultSendRaftBatchSize        uint64 = 64
 	defaultMaxConcurrencySnapChunks uint64 = 8
 	defaultSnapChunkSize                   = 4 * mb
+	defaultCompressionThreshold            = 4 * kb
 	defaultApplyWorkerCount         uint64 = 32
 	defaultSendRaftMsgWorkerCount   uint64 = 8
 	defaultRaftMaxWorkers           uint64 = 32
@@ -45,12 +47,18 @@ var (
 	defaultCompactDuration                 = time.Second * 30
 	defaultShardSplitCheckDuration         = time.Second * 30
 	defaultShardStateCheckDuration         = time.Second * 60
+	defaultShardMergeCheckDuration         = time.Second * 5
+	defaultShardMergeTimeout               = time.Minute
+	defaultConsistencyCheckDuration        = time.Hour
+	defaultLoadSplitCheckDuration          = time.Second * 10
+	defaultLoadSplitCheckTimes             = 3
 	defaultMaxEntryBytes                   = 10 * mb
 	defaultShardCapacityBytes       uint64 = uint64(96 * mb)
 	defaultMaxAllowTransferLag      uint64 = 2
 	defaultCompactThreshold         uint64 = 256
 	defaultRaftTickDuration                = time.Second
 	defaultMaxPeerDownTime                 = time.Minute * 30
+	defaultHibernateWakeupInterval         = time.Minute
 	defaultShardHeartbeatDuration          = time.Second * 2
 	defaultStoreHeartbeatDuration          = time.Second * 10
 	defaultMaxInflightMsgs                 = 8
@@ -82,6 +90,9 @@ type Config struct {
 	Worker WorkerConfig `toml:"worker"`
 	// Prophet prophet config
 	Prophet pconfig.Config `toml:"prophet"`
+	// Security the TLS config of the raft transport and the client rpc, it is also used by the
+	// prophet if the prophet security is not set
+	Security tlsutil.Config `toml:"security"`
 	// Storage config
 	Storage StorageConfig
 	// Customize config
@@ -126,6 +137,9 @@ func (c *Config) Adjust() {
 	(&c.Raft).adjust(uint64(c.Replication.ShardCapacityBytes))
 	c.Prophet.DataDir = path.Join(c.DataPath, defaultProphetDirName)
 	c.Prophet.ContainerHeartbeatDataProcessor = c.Customize.CustomStoreHeartbeatDataProcessor
+	if !c.Prophet.Security.Enabled() {
+		c.Prophet.Security = c.Security
+	}
 	(&c.Prophet).Adjust(nil, false)
 	(&c.Worker).adjust()
 
@@ -156,6 +170,10 @@ func (c *Config) validate() {
 	if c.Storage.ForeachDataStorageFunc == nil {
 		log.Panicf("missing Config.Storage.ForeachDataStorageFunc")
 	}
+
+	if _, err := transport.ParseCompression(c.Raft.Compression); err != nil {
+		log.Panicf("invalid Config.Raft.Compression, %+v", err)
+	}
 }
 
 // SnapshotDir returns snapshot dir
@@ -170,10 +188,27 @@ type ReplicationConfig struct {
 	StoreHeartbeatDuration  typeutil.Duration `toml:"store-heartbeat-duration"`
 	ShardSplitCheckDuration typeutil.Duration `toml:"shard-split-check-duration"`
 	ShardStateCheckDuration typeutil.Duration `toml:"shard-state-check-duration"`
+	ShardMergeCheckDuration typeutil.Duration `toml:"shard-merge-check-duration"`
 	DisableShardSplit       bool              `toml:"disable-shard-split"`
 	AllowRemoveLeader       bool              `toml:"allow-remove-leader"`
 	ShardCapacityBytes      typeutil.ByteSize `toml:"shard-capacity-bytes"`
 	ShardSplitCheckBytes    typeutil.ByteSize `toml:"shard-split-check-bytes"`
+	// ConsistencyCheckDuration the interval of the shard leader to check the consistency
+	// between the replicas
+	ConsistencyCheckDuration typeutil.Duration `toml:"consistency-check-duration"`
+	DisableConsistencyCheck  bool              `toml:"disable-consistency-check"`
+	// LoadSplitCheckDuration the interval of the shard leader to check the sampled load of the
+	// shard, the load split is disabled if both the QPS and the bytes threshold are 0
+	LoadSplitCheckDuration typeutil.Duration `toml:"load-split-check-duration"`
+	// LoadSplitQPSThreshold the read and write requests per second of a hot shard
+	LoadSplitQPSThreshold uint64 `toml:"load-split-qps-threshold"`
+	// LoadSplitBytesThreshold the read and written bytes per second of a hot shard
+	LoadSplitBytesThreshold typeutil.ByteSize `toml:"load-split-bytes-threshold"`
+	// LoadSplitCheckTimes the shard is split after it is hot in so many continuous checks
+	LoadSplitCheckTimes int `toml:"load-split-check-times"`
+	// ShardMergeTimeout the source shard leader rollbacks the merge if not all the source
+	// replicas received the PrepareMerge in this duration
+	ShardMergeTimeout typeutil.Duration `toml:"shard-merge-timeout"`
 }
 
 func (c *ReplicationConfig) adjust() {
@@ -197,6 +232,26 @@ func (c *ReplicationConfig) adjust() {
 		c.ShardStateCheckDuration.Duration = defaultShardStateCheckDuration
 	}
 
+	if c.ShardMergeCheckDuration.Duration == 0 {
+		c.ShardMergeCheckDuration.Duration = defaultShardMergeCheckDuration
+	}
+
+	if c.ShardMergeTimeout.Duration == 0 {
+		c.ShardMergeTimeout.Duration = defaultShardMergeTimeout
+	}
+
+	if c.ConsistencyCheckDuration.Duration == 0 {
+		c.ConsistencyCheckDuration.Duration = defaultConsistencyCheckDuration
+	}
+
+	if c.LoadSplitCheckDuration.Duration == 0 {
+		c.LoadSplitCheckDuration.Duration = defaultLoadSplitCheckDuration
+	}
+
+	if c.LoadSplitCheckTimes == 0 {
+		c.LoadSplitCheckTimes = defaultLoadSplitCheckTimes
+	}
+
 	if c.ShardCapacityBytes == 0 {
 		c.ShardCapacityBytes = typeutil.ByteSize(defaultShardCapacityBytes)
 	}
@@ -206,10 +261,19 @@ func (c *ReplicationConfig) adjust() {
 	}
 }
 
+// EnableLoadSplit returns true if the hot shards are split by the sampled load
+func (c *ReplicationConfig) EnableLoadSplit() bool {
+	return !c.DisableShardSplit && (c.LoadSplitQPSThreshold > 0 || c.LoadSplitBytesThreshold > 0)
+}
+
 // SnapshotConfig snapshot config
 type SnapshotConfig struct {
 	MaxConcurrencySnapChunks uint64            `toml:"max-concurrency-snap-chunks"`
 	SnapChunkSize            typeutil.ByteSize `toml:"snap-chunk-size"`
+	// MaxSendBytesPerSecond the bandwidth limit of sending snapshots of the store, 0 means no limit
+	MaxSendBytesPerSecond typeutil.ByteSize `toml:"max-send-bytes-per-second"`
+	// MaxReceiveBytesPerSecond the bandwidth limit of receiving snapshots of the store, 0 means no limit
+	MaxReceiveBytesPerSecond typeutil.ByteSize `toml:"max-receive-bytes-per-second"`
 }
 
 func (c *SnapshotConfig) adjust() {
@@ -262,6 +326,8 @@ type RaftConfig struct {
 	HeartbeatTicks int `toml:"heartbeat-ticks"`
 	// ElectionTimeoutTicks how many ticks to send election message
 	ElectionTimeoutTicks int `toml:"election-timeout-ticks"`
+	// EnableLeaseRead the leader serve the reads directly in the lease without ReadIndex
+	EnableLeaseRead bool `toml:"enable-lease-read"`
 	// MaxSizePerMsg max bytes per raft message
 	MaxSizePerMsg typeutil.ByteSize `toml:"max-size-per-msg"`
 	// MaxInflightMsgs max raft message count in a raft rpc
@@ -270,6 +336,18 @@ type RaftConfig struct {
 	MaxEntryBytes typeutil.ByteSize `toml:"max-entry-bytes"`
 	// SendRaftBatchSize raft message sender count
 	SendRaftBatchSize uint64 `toml:"send-raft-batch-size"`
+	// Compression the compression of the raft message batches, none or snappy. The batches
+	// are compressed only if the receivers enable the compression too.
+	Compression string `toml:"compression"`
+	// CompressionThreshold the raft message batches smaller than it are not compressed
+	CompressionThreshold typeutil.ByteSize `toml:"compression-threshold"`
+	// EnableHibernate the idle shards stop ticking after all the followers caught up, and are
+	// waked up by the requests and the raft messages
+	EnableHibernate bool `toml:"enable-hibernate"`
+	// HibernateWakeupInterval the hibernated shards are waked up in every interval to detect the
+	// failures of the leader and the followers. The interval is aligned with the wall clock, so
+	// that all the replicas of a shard are waked up at the same time.
+	HibernateWakeupInterval typeutil.Duration `toml:"hibernate-wakeup-interval"`
 	// RaftLog raft log 配置
 	RaftLog RaftLogConfig `toml:"raft-log"`
 }
@@ -299,9 +377,24 @@ func (c *RaftConfig) adjust(shardCapacityBytes uint64) {
 		c.MaxEntryBytes = typeutil.ByteSize(defaultMaxEntryBytes)
 	}
 
+	if c.CompressionThreshold == 0 {
+		c.CompressionThreshold = typeutil.ByteSize(defaultCompressionThreshold)
+	}
+
+	if c.HibernateWakeupInterval.Duration == 0 {
+		c.HibernateWakeupInterval.Duration = defaultHibernateWakeupInterval
+	}
+
 	(&c.RaftLog).adjust(shardCapacityBytes)
 }
 
+// GetLeaderLease returns the max lease of the leader. No new leader can be elected in the
+// election timeout after the followers received the heartbeat, a heartbeat interval is
+// reserved for the tick and clock drift.
+func (c *RaftConfig) GetLeaderLease() time.Duration {
+	return c.TickInterval.Duration * time.Duration(c.ElectionTimeoutTicks-c.HeartbeatTicks)
+}
+
 // RaftLogConfig raft log config
 type RaftLogConfig struct {
 	DisableSync           bool              `toml:"disable-sync"`
@@ -344,6 +437,9 @@ func (c *RaftLogConfig) adjust(shardCapacityBytes uint64) {
 type StorageConfig struct {
 	// MetaStorage used to store raft, shards and store's metadata
 	MetaStorage storage.MetadataStorage
+	// LogStorage used to store the raft log entries, the entries are stored in the MetaStorage
+	// if it is not set. The entries in the MetaStorage are migrated to it when the store starts.
+	LogStorage storage.LogStorage
 	// DataStorageFactory is a storage factory  to store application's data
 	DataStorageFactory func(group uint64, shardID uint64) storage.DataStorage
 	// DataMoveFunc move data from a storage to others
@@ -13,6 +13,7 @@ require (
 	github.com/fagongzi/log v0.0.0-20191122063922-293b75312445
 	github.com/fagongzi/util v0.0.0-20210409031311-a10fdf8fbd7a
 	github.com/gogo/protobuf v1.3.1
+	github.com/golang/snappy v0.0.2-0.20190904063534-ff6b7dc882cf
 	github.com/google/btree v1.0.0
 	github.com/juju/ratelimit v1.0.1
 	github.com/montanaflynn/stats v0.6.6
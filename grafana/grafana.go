@@ -143,6 +143,13 @@ func (c *DashboardCreator) raftLogRow() grabana.DashboardBuilderOption {
 		c.withGraph("99.99% raft snapshot build time", 4,
 			`histogram_quantile(0.9999, sum(rate(matrixcube_raftstore_snapshot_building_duration_seconds_bucket[$interval])) by (le, instance))`,
 			"{{ instance }}", axis.Unit("s"), axis.Min(0)),
+
+		c.withGraph("Snapshot throttled time", 6,
+			"sum(rate(matrixcube_raftstore_snapshot_throttled_seconds_total[$interval])) by (type, instance)",
+			"{{ type }}-{{ instance }}", axis.Unit("s"), axis.Min(0)),
+		c.withGraph("99% raft message compression ratio", 6,
+			`histogram_quantile(0.99, sum(rate(matrixcube_raftstore_raft_msg_compression_ratio_bucket[$interval])) by (le, instance))`,
+			"{{ instance }}", axis.Min(0)),
 	)
 }
 
@@ -176,6 +183,9 @@ func (c *DashboardCreator) shardsRow() grabana.DashboardBuilderOption {
 		c.withTable("Leader count per node", 4,
 			`sum(matrixcube_raftstore_store_shard_total{type="leader"}) by (instance)`,
 			"{{ instance }}"),
+		c.withGraph("Load splits", 12,
+			"sum(rate(matrixcube_raftstore_load_split_total[$interval])) by (status)",
+			"{{ status }}"),
 	)
 }
 
@@ -34,8 +34,11 @@ func init() {
 
 	registry.MustRegister(raftReadyCounter)
 	registry.MustRegister(raftMsgsCounter)
+	registry.MustRegister(raftLeaseReadCounter)
 	registry.MustRegister(raftCommandCounter)
 	registry.MustRegister(raftAdminCommandCounter)
+	registry.MustRegister(raftLoadSplitCounter)
+	registry.MustRegister(snapshotThrottledCounter)
 
 	registry.MustRegister(raftLogLagHistogram)
 	registry.MustRegister(raftLogAppendDurationHistogram)
@@ -44,4 +47,5 @@ func init() {
 	registry.MustRegister(snapshotSizeHistogram)
 	registry.MustRegister(snapshotBuildingDurationHistogram)
 	registry.MustRegister(snapshotSendingDurationHistogram)
+	registry.MustRegister(raftMsgCompressionRatioHistogram)
 }
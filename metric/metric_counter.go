@@ -14,6 +14,8 @@
 package metric
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -42,6 +44,14 @@ var (
 			Help:      "Total number of normal commands received.",
 		}, []string{"type"})
 
+	raftLeaseReadCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "matrixcube",
+			Subsystem: "raftstore",
+			Name:      "lease_read_total",
+			Help:      "Total number of the leader lease reads.",
+		}, []string{"type"})
+
 	raftAdminCommandCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "matrixcube",
@@ -49,6 +59,22 @@ var (
 			Name:      "command_admin_total",
 			Help:      "Total number of admin commands processed.",
 		}, []string{"type", "status"})
+
+	raftLoadSplitCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "matrixcube",
+			Subsystem: "raftstore",
+			Name:      "load_split_total",
+			Help:      "Total number of the load-triggered splits of the hot shards.",
+		}, []string{"status"})
+
+	snapshotThrottledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "matrixcube",
+			Subsystem: "raftstore",
+			Name:      "snapshot_throttled_seconds_total",
+			Help:      "Total seconds of the snapshot sending and receiving throttled by the bandwidth limit.",
+		}, []string{"type"})
 )
 
 // IncComandCount inc the command received
@@ -121,6 +147,16 @@ func AddRaftProposalReadLocalCount(value uint64) {
 	raftMsgsCounter.WithLabelValues("read-local").Add(float64(value))
 }
 
+// AddRaftLeaseReadHitCount add the reads served in the leader lease
+func AddRaftLeaseReadHitCount(value uint64) {
+	raftLeaseReadCounter.WithLabelValues("hit").Add(float64(value))
+}
+
+// AddRaftLeaseReadMissCount add the reads fallback to read index because of lease expired
+func AddRaftLeaseReadMissCount(value uint64) {
+	raftLeaseReadCounter.WithLabelValues("miss").Add(float64(value))
+}
+
 // AddRaftProposalReadIndexCount add read index
 func AddRaftProposalReadIndexCount(value uint64) {
 	raftMsgsCounter.WithLabelValues("read-index").Add(float64(value))
@@ -166,6 +202,31 @@ func AddRaftAdminCommandSplitSucceedCount(value uint64) {
 	raftAdminCommandCounter.WithLabelValues("split", "succeed").Add(float64(value))
 }
 
+// AddRaftAdminCommandMergeCount admin command of merge shard
+func AddRaftAdminCommandMergeCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("merge", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandMergeSucceedCount admin command of merge shard succeed
+func AddRaftAdminCommandMergeSucceedCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("merge", "succeed").Add(float64(value))
+}
+
+// AddRaftAdminCommandMergeRollbackCount admin command of merge shard rollback
+func AddRaftAdminCommandMergeRollbackCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("merge", "rollback").Add(float64(value))
+}
+
+// AddRaftAdminCommandVerifyHashCount admin command of verify the shard hash
+func AddRaftAdminCommandVerifyHashCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("verify_hash", "total").Add(float64(value))
+}
+
+// AddRaftAdminCommandVerifyHashFailedCount admin command of verify the shard hash failed
+func AddRaftAdminCommandVerifyHashFailedCount(value uint64) {
+	raftAdminCommandCounter.WithLabelValues("verify_hash", "failed").Add(float64(value))
+}
+
 // AddRaftAdminCommandCompactCount admin command of compact raft log
 func AddRaftAdminCommandCompactCount(value uint64) {
 	raftAdminCommandCounter.WithLabelValues("compact", "succeed").Add(float64(value))
@@ -175,3 +236,23 @@ func AddRaftAdminCommandCompactCount(value uint64) {
 func AddRaftAdminCommandCompactSucceedCount(value uint64) {
 	raftAdminCommandCounter.WithLabelValues("compact", "succeed").Add(float64(value))
 }
+
+// IncLoadSplitCount the hot shard is split by the sampled load
+func IncLoadSplitCount() {
+	raftLoadSplitCounter.WithLabelValues("split").Inc()
+}
+
+// IncLoadSplitNoKeyCount the hot shard has no balanced split key, e.g. a single hot key
+func IncLoadSplitNoKeyCount() {
+	raftLoadSplitCounter.WithLabelValues("no_split_key").Inc()
+}
+
+// AddSnapshotSendThrottled add the throttled time of the snapshot sending
+func AddSnapshotSendThrottled(value time.Duration) {
+	snapshotThrottledCounter.WithLabelValues("send").Add(value.Seconds())
+}
+
+// AddSnapshotReceiveThrottled add the throttled time of the snapshot receiving
+func AddSnapshotReceiveThrottled(value time.Duration) {
+	snapshotThrottledCounter.WithLabelValues("receive").Add(value.Seconds())
+}
@@ -73,6 +73,15 @@ var (
 			Help:      "Bucketed histogram of server send snapshots duration.",
 		})
 
+	raftMsgCompressionRatioHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "matrixcube",
+			Subsystem: "raftstore",
+			Name:      "raft_msg_compression_ratio",
+			Help:      "Bucketed histogram of the compressed size ratio of the raft message batches.",
+			Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+		})
+
 	raftLogLagHistogram = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "matrixcube",
@@ -103,6 +112,13 @@ func ObserveSnapshotSendingDuration(start time.Time) {
 	snapshotSendingDurationHistogram.Observe(time.Now().Sub(start).Seconds())
 }
 
+// ObserveRaftMsgCompressionRatio observe the compressed size ratio of the raft message batch
+func ObserveRaftMsgCompressionRatio(compressed, raw int) {
+	if raw > 0 {
+		raftMsgCompressionRatioHistogram.Observe(float64(compressed) / float64(raw))
+	}
+}
+
 // ObserveRaftLogAppendDuration observe seconds raft log append
 func ObserveRaftLogAppendDuration(start time.Time) {
 	raftLogAppendDurationHistogram.Observe(time.Now().Sub(start).Seconds())
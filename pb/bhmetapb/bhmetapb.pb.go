@@ -28,18 +28,27 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type ShardsPoolCmdType int32
 
 const (
-	ShardsPoolCmdType_CreateShard ShardsPoolCmdType = 0
-	ShardsPoolCmdType_AllocShard  ShardsPoolCmdType = 1
+	ShardsPoolCmdType_CreateShard  ShardsPoolCmdType = 0
+	ShardsPoolCmdType_AllocShard   ShardsPoolCmdType = 1
+	ShardsPoolCmdType_ReleaseShard ShardsPoolCmdType = 2
+	ShardsPoolCmdType_UpdatePool   ShardsPoolCmdType = 3
+	ShardsPoolCmdType_RemovePool   ShardsPoolCmdType = 4
 )
 
 var ShardsPoolCmdType_name = map[int32]string{
 	0: "CreateShard",
 	1: "AllocShard",
+	2: "ReleaseShard",
+	3: "UpdatePool",
+	4: "RemovePool",
 }
 
 var ShardsPoolCmdType_value = map[string]int32{
-	"CreateShard": 0,
-	"AllocShard":  1,
+	"CreateShard":  0,
+	"AllocShard":   1,
+	"ReleaseShard": 2,
+	"UpdatePool":   3,
+	"RemovePool":   4,
 }
 
 func (x ShardsPoolCmdType) String() string {
@@ -420,10 +429,13 @@ func (m *Store) GetPhysicallyDestroyed() bool {
 
 // ShardsPool shards pool
 type ShardsPool struct {
-	Pools                map[uint64]*ShardPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Pools map[uint64]*ShardPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// processedKeys the idempotency keys of the executed cmds, only the latest
+	// keys are kept
+	ProcessedKeys        [][]byte `protobuf:"bytes,2,rep,name=processedKeys,proto3" json:"processedKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ShardsPool) Reset()         { *m = ShardsPool{} }
@@ -466,16 +478,31 @@ func (m *ShardsPool) GetPools() map[uint64]*ShardPool {
 	return nil
 }
 
+func (m *ShardsPool) GetProcessedKeys() [][]byte {
+	if m != nil {
+		return m.ProcessedKeys
+	}
+	return nil
+}
+
 // ShardPool shard pool
 type ShardPool struct {
-	Capacity             uint64            `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	RangePrefix          []byte            `protobuf:"bytes,2,opt,name=rangePrefix,proto3" json:"rangePrefix,omitempty"`
-	AllocatedShards      []*AllocatedShard `protobuf:"bytes,3,rep,name=allocatedShards,proto3" json:"allocatedShards,omitempty"`
-	Seq                  uint64            `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
-	AllocatedOffset      uint64            `protobuf:"varint,5,opt,name=allocatedOffset,proto3" json:"allocatedOffset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Capacity        uint64            `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RangePrefix     []byte            `protobuf:"bytes,2,opt,name=rangePrefix,proto3" json:"rangePrefix,omitempty"`
+	AllocatedShards []*AllocatedShard `protobuf:"bytes,3,rep,name=allocatedShards,proto3" json:"allocatedShards,omitempty"`
+	Seq             uint64            `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	AllocatedOffset uint64            `protobuf:"varint,5,opt,name=allocatedOffset,proto3" json:"allocatedOffset,omitempty"`
+	// recycledShards the released shards whose data has been cleared, they are
+	// allocated before the new created shards
+	RecycledShards []*AllocatedShard `protobuf:"bytes,6,rep,name=recycledShards,proto3" json:"recycledShards,omitempty"`
+	// releasingShards the released shards whose data is clearing
+	ReleasingShards []*AllocatedShard `protobuf:"bytes,7,rep,name=releasingShards,proto3" json:"releasingShards,omitempty"`
+	// removed the pool is removed, the seq is kept to avoid creating the shards
+	// with the same range if the pool is created again
+	Removed              bool     `protobuf:"varint,8,opt,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ShardPool) Reset()         { *m = ShardPool{} }
@@ -546,6 +573,27 @@ func (m *ShardPool) GetAllocatedOffset() uint64 {
 	return 0
 }
 
+func (m *ShardPool) GetRecycledShards() []*AllocatedShard {
+	if m != nil {
+		return m.RecycledShards
+	}
+	return nil
+}
+
+func (m *ShardPool) GetReleasingShards() []*AllocatedShard {
+	if m != nil {
+		return m.ReleasingShards
+	}
+	return nil
+}
+
+func (m *ShardPool) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
 // AllocatedShard allocated shard info
 type AllocatedShard struct {
 	ShardID              uint64   `protobuf:"varint,1,opt,name=shardID,proto3" json:"shardID,omitempty"`
@@ -612,12 +660,15 @@ func (m *AllocatedShard) GetPurpose() []byte {
 
 // ShardsPoolCmd shards pool cmd
 type ShardsPoolCmd struct {
-	Type                 ShardsPoolCmdType    `protobuf:"varint,1,opt,name=type,proto3,enum=bhmetapb.ShardsPoolCmdType" json:"type,omitempty"`
-	Create               *ShardsPoolCreateCmd `protobuf:"bytes,2,opt,name=create,proto3" json:"create,omitempty"`
-	Alloc                *ShardsPoolAllocCmd  `protobuf:"bytes,3,opt,name=alloc,proto3" json:"alloc,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Type                 ShardsPoolCmdType     `protobuf:"varint,1,opt,name=type,proto3,enum=bhmetapb.ShardsPoolCmdType" json:"type,omitempty"`
+	Create               *ShardsPoolCreateCmd  `protobuf:"bytes,2,opt,name=create,proto3" json:"create,omitempty"`
+	Alloc                *ShardsPoolAllocCmd   `protobuf:"bytes,3,opt,name=alloc,proto3" json:"alloc,omitempty"`
+	Release              *ShardsPoolReleaseCmd `protobuf:"bytes,4,opt,name=release,proto3" json:"release,omitempty"`
+	Update               *ShardsPoolUpdateCmd  `protobuf:"bytes,5,opt,name=update,proto3" json:"update,omitempty"`
+	Remove               *ShardsPoolRemoveCmd  `protobuf:"bytes,6,opt,name=remove,proto3" json:"remove,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *ShardsPoolCmd) Reset()         { *m = ShardsPoolCmd{} }
@@ -674,6 +725,27 @@ func (m *ShardsPoolCmd) GetAlloc() *ShardsPoolAllocCmd {
 	return nil
 }
 
+func (m *ShardsPoolCmd) GetRelease() *ShardsPoolReleaseCmd {
+	if m != nil {
+		return m.Release
+	}
+	return nil
+}
+
+func (m *ShardsPoolCmd) GetUpdate() *ShardsPoolUpdateCmd {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *ShardsPoolCmd) GetRemove() *ShardsPoolRemoveCmd {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
 // ShardsPoolCreateCmd shards pool create cmd
 type ShardsPoolCreateCmd struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -770,251 +842,631 @@ func (m *ShardsPoolAllocCmd) GetPurpose() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("bhmetapb.ShardsPoolCmdType", ShardsPoolCmdType_name, ShardsPoolCmdType_value)
-	proto.RegisterType((*StoreIdent)(nil), "bhmetapb.StoreIdent")
-	proto.RegisterType((*Cluster)(nil), "bhmetapb.Cluster")
-	proto.RegisterType((*Shard)(nil), "bhmetapb.Shard")
-	proto.RegisterType((*Store)(nil), "bhmetapb.Store")
-	proto.RegisterType((*ShardsPool)(nil), "bhmetapb.ShardsPool")
-	proto.RegisterMapType((map[uint64]*ShardPool)(nil), "bhmetapb.ShardsPool.PoolsEntry")
-	proto.RegisterType((*ShardPool)(nil), "bhmetapb.ShardPool")
-	proto.RegisterType((*AllocatedShard)(nil), "bhmetapb.AllocatedShard")
-	proto.RegisterType((*ShardsPoolCmd)(nil), "bhmetapb.ShardsPoolCmd")
-	proto.RegisterType((*ShardsPoolCreateCmd)(nil), "bhmetapb.ShardsPoolCreateCmd")
-	proto.RegisterType((*ShardsPoolAllocCmd)(nil), "bhmetapb.ShardsPoolAllocCmd")
+// ShardsPoolReleaseCmd shards pool release cmd. The shard is released in two
+// steps, first it is moved to the releasing shards, and moved to the recycled
+// shards after the data is cleared.
+type ShardsPoolReleaseCmd struct {
+	Group                uint64   `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
+	ShardID              uint64   `protobuf:"varint,2,opt,name=shardID,proto3" json:"shardID,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Cleared              bool     `protobuf:"varint,4,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("bhmetapb.proto", fileDescriptor_75f1d28c03f69d97) }
-
-var fileDescriptor_75f1d28c03f69d97 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0xf5, 0x67, 0x6b, 0xe4, 0x38, 0xce, 0xba, 0x31, 0x16, 0xae, 0x2b, 0x0b, 0x3c, 0xb1,
-	0x69, 0x2a, 0xb5, 0x6a, 0x03, 0x14, 0xbd, 0xd9, 0x52, 0xd0, 0x18, 0x68, 0x51, 0x63, 0x9d, 0x17,
-	0x58, 0x92, 0x23, 0x69, 0x51, 0x8a, 0xcb, 0xec, 0x2e, 0x03, 0xeb, 0x09, 0x7a, 0xef, 0x6b, 0xf4,
-	0x45, 0xd2, 0x5b, 0x6e, 0xbd, 0x05, 0xad, 0x9f, 0x24, 0xd8, 0x25, 0x29, 0x51, 0xb6, 0x72, 0x11,
-	0xe6, 0x9b, 0xf9, 0x66, 0x96, 0xf3, 0xcd, 0xee, 0x08, 0x0e, 0xc3, 0xc5, 0x12, 0x0d, 0xcf, 0xc2,
-	0x61, 0xa6, 0xa4, 0x91, 0x64, 0xbf, 0xc2, 0xa7, 0xdf, 0xce, 0x85, 0x59, 0xe4, 0xe1, 0x30, 0x92,
-	0xcb, 0xd1, 0x5c, 0xce, 0xe5, 0xc8, 0x11, 0xc2, 0x7c, 0xe6, 0x90, 0x03, 0xce, 0x2a, 0x12, 0x4f,
-	0x7f, 0xad, 0xd1, 0x97, 0xdc, 0x28, 0x71, 0x2b, 0x95, 0x98, 0x8b, 0xb4, 0x04, 0x51, 0x1e, 0xe2,
-	0x28, 0x92, 0xcb, 0x4c, 0xa6, 0x98, 0x1a, 0x6d, 0x8b, 0x65, 0x0b, 0x34, 0xa3, 0x2c, 0x1c, 0x15,
-	0xe7, 0x8d, 0xea, 0x9f, 0xe1, 0x4f, 0x01, 0x6e, 0x8c, 0x54, 0x78, 0x15, 0x63, 0x6a, 0xc8, 0x19,
-	0x74, 0xa3, 0x24, 0xd7, 0x06, 0xd5, 0xd5, 0x94, 0x7a, 0x03, 0x2f, 0x68, 0xb1, 0x8d, 0x83, 0x50,
-	0xd8, 0xd3, 0x8e, 0x3b, 0xa5, 0x0d, 0x17, 0xab, 0xa0, 0x3f, 0x81, 0xbd, 0x49, 0x41, 0x23, 0x27,
-	0xd0, 0x10, 0x71, 0x91, 0x7b, 0xd9, 0xb9, 0xfb, 0x78, 0xde, 0xb8, 0x9a, 0xb2, 0x86, 0x88, 0xc9,
-	0x00, 0x7a, 0x4b, 0x7e, 0xcb, 0x30, 0x4b, 0x44, 0xc4, 0xb5, 0x2b, 0xf0, 0x98, 0xd5, 0x5d, 0xfe,
-	0xbf, 0x0d, 0x68, 0xdf, 0x2c, 0xb8, 0x8a, 0x3f, 0x5b, 0xe3, 0x0b, 0x68, 0x6b, 0xc3, 0x95, 0x71,
-	0xd9, 0x07, 0xac, 0x00, 0xe4, 0x08, 0x9a, 0x98, 0xc6, 0xb4, 0xe9, 0x7c, 0xd6, 0x24, 0xdf, 0x43,
-	0x1b, 0x33, 0x19, 0x2d, 0x68, 0x6b, 0xe0, 0x05, 0xbd, 0xf1, 0xb3, 0x61, 0xd9, 0x32, 0x43, 0x2d,
-	0x73, 0x15, 0xe1, 0x2b, 0x1b, 0xbc, 0x6c, 0xbd, 0xff, 0x78, 0xfe, 0x88, 0x15, 0x4c, 0xf2, 0x8d,
-	0x2b, 0x6d, 0x90, 0xb6, 0x07, 0x5e, 0x70, 0xf8, 0x30, 0xe5, 0xc6, 0x06, 0x59, 0xc1, 0x21, 0x01,
-	0xb4, 0x33, 0x44, 0xa5, 0x69, 0x67, 0xd0, 0x0c, 0x7a, 0xe3, 0x83, 0x8a, 0x7c, 0x8d, 0xa8, 0xaa,
-	0xb2, 0x8e, 0x40, 0x7c, 0x38, 0x88, 0x85, 0xe6, 0x61, 0x82, 0x37, 0x59, 0x22, 0x0c, 0xdd, 0x1b,
-	0x78, 0xc1, 0x3e, 0xdb, 0xf2, 0xd9, 0xae, 0xe6, 0x4a, 0xe6, 0x19, 0xdd, 0x77, 0xa2, 0x16, 0x80,
-	0x9c, 0x40, 0x27, 0x4f, 0xc5, 0xdb, 0x1c, 0x29, 0x0c, 0xbc, 0xa0, 0xcb, 0x4a, 0x44, 0xfa, 0x00,
-	0x2a, 0x4f, 0xf0, 0x17, 0x4b, 0xd2, 0xb4, 0x37, 0x68, 0x06, 0x5d, 0x56, 0xf3, 0x10, 0x02, 0xad,
-	0x98, 0x1b, 0x4e, 0x0f, 0x9c, 0x1c, 0xce, 0xf6, 0xff, 0x6c, 0x42, 0xdb, 0x4d, 0xf9, 0xb3, 0xca,
-	0x9e, 0xc2, 0xbe, 0xe2, 0x33, 0x73, 0x11, 0xc7, 0xca, 0x89, 0xdb, 0x65, 0x6b, 0x6c, 0x4f, 0x8c,
-	0x12, 0x81, 0x69, 0x11, 0x6d, 0xba, 0x68, 0xcd, 0x43, 0x9e, 0x43, 0x27, 0xe1, 0x21, 0x26, 0x9a,
-	0xb6, 0xee, 0xc9, 0xc1, 0x45, 0x25, 0x47, 0xc9, 0x20, 0x2f, 0xb6, 0x65, 0x3e, 0xa9, 0xa8, 0x13,
-	0x99, 0x1a, 0x2e, 0x52, 0x54, 0x5b, 0x3a, 0x9f, 0x41, 0xd7, 0x8d, 0xf8, 0x8d, 0x58, 0x22, 0xed,
-	0x0c, 0xbc, 0xa0, 0xc9, 0x36, 0x0e, 0xf2, 0x02, 0x9e, 0x26, 0x5c, 0x9b, 0xd7, 0xc8, 0x95, 0x09,
-	0x91, 0x17, 0xac, 0x3d, 0xc7, 0x7a, 0x18, 0xb0, 0x97, 0xf7, 0x1d, 0x2a, 0x2d, 0x64, 0xea, 0x74,
-	0xee, 0xb2, 0x0a, 0xda, 0xc8, 0x5c, 0x98, 0xd7, 0x5c, 0x2f, 0x68, 0xb7, 0x88, 0x94, 0xd0, 0x76,
-	0x1e, 0x63, 0x96, 0xc8, 0xd5, 0x35, 0x37, 0x8b, 0x72, 0x0e, 0x35, 0x0f, 0xf9, 0x0e, 0x8e, 0xb3,
-	0xc5, 0x4a, 0x8b, 0x88, 0x27, 0xc9, 0x6a, 0x8a, 0xda, 0x28, 0xb9, 0xc2, 0x98, 0xf6, 0xdc, 0x90,
-	0x77, 0x85, 0xfc, 0xbf, 0x3c, 0x00, 0x77, 0xc7, 0xf5, 0xb5, 0x94, 0x09, 0x79, 0x09, 0xed, 0x4c,
-	0xca, 0x44, 0x53, 0xcf, 0x29, 0x77, 0x3e, 0x5c, 0x2f, 0x89, 0x0d, 0x69, 0x68, 0x7f, 0xf4, 0xab,
-	0xd4, 0xa8, 0x15, 0x2b, 0xd8, 0xa7, 0xbf, 0x01, 0x6c, 0x9c, 0xf6, 0xfe, 0xff, 0x81, 0xab, 0xf2,
-	0xb9, 0x5a, 0x93, 0x7c, 0x0d, 0xed, 0x77, 0x3c, 0xc9, 0xd1, 0x8d, 0xb2, 0x37, 0x3e, 0xbe, 0x57,
-	0xd6, 0xe6, 0xb2, 0x82, 0xf1, 0x73, 0xe3, 0x27, 0xcf, 0xff, 0xc7, 0x83, 0xee, 0x3a, 0x60, 0xaf,
-	0x42, 0xc4, 0x33, 0x1e, 0x09, 0x53, 0xd5, 0x5c, 0x63, 0xfb, 0x88, 0x15, 0x4f, 0xe7, 0x78, 0xad,
-	0x70, 0x26, 0x6e, 0xcb, 0x67, 0x58, 0x77, 0x91, 0x4b, 0x78, 0xc2, 0x93, 0x44, 0x46, 0xdc, 0x60,
-	0x5c, 0xf4, 0x40, 0x9b, 0xae, 0x37, 0xba, 0xf9, 0x88, 0x8b, 0x2d, 0x02, 0xbb, 0x9f, 0x60, 0x1b,
-	0xd2, 0xf8, 0xd6, 0x3d, 0xde, 0x16, 0xb3, 0x26, 0x09, 0x6a, 0x55, 0x7f, 0x9f, 0xcd, 0x34, 0x1a,
-	0x77, 0x81, 0x5a, 0xec, 0xbe, 0xdb, 0x9f, 0xc1, 0xe1, 0x76, 0x79, 0xb7, 0xb5, 0xac, 0xb1, 0xde,
-	0x68, 0x15, 0xb4, 0xdd, 0xac, 0xd3, 0x2f, 0x4c, 0xb9, 0xd3, 0xea, 0x2e, 0x9b, 0x9b, 0xe5, 0x2a,
-	0x93, 0x1a, 0xcb, 0xf5, 0x52, 0x41, 0xff, 0x6f, 0x0f, 0x1e, 0x6f, 0x66, 0x34, 0x59, 0xc6, 0x64,
-	0x04, 0x2d, 0xb3, 0xca, 0xd0, 0x1d, 0x72, 0x38, 0xfe, 0x72, 0xd7, 0x28, 0x27, 0xcb, 0xf8, 0xcd,
-	0x2a, 0x43, 0xe6, 0x88, 0xe4, 0x25, 0x74, 0x22, 0x85, 0xf6, 0x31, 0x14, 0x63, 0xfa, 0x6a, 0x67,
-	0x8a, 0x63, 0x4c, 0x96, 0x31, 0x2b, 0xc9, 0x64, 0x0c, 0x6d, 0xf7, 0x89, 0xee, 0x8b, 0x7a, 0xe3,
-	0xb3, 0x5d, 0x59, 0x4e, 0x02, 0x9b, 0x54, 0x50, 0xfd, 0x67, 0x70, 0xbc, 0xa3, 0xa4, 0x3f, 0x05,
-	0xf2, 0x30, 0x67, 0xb3, 0x8f, 0xbc, 0xfa, 0x3e, 0xaa, 0x49, 0xd1, 0xd8, 0x92, 0xe2, 0xf9, 0x8f,
-	0xf0, 0xf4, 0x41, 0x8b, 0xe4, 0x09, 0xf4, 0x8a, 0x73, 0x5c, 0xe8, 0xe8, 0x11, 0x39, 0x04, 0x70,
-	0x27, 0x14, 0xd8, 0xbb, 0x3c, 0xfa, 0xf0, 0x7f, 0xdf, 0x7b, 0x7f, 0xd7, 0xf7, 0x3e, 0xdc, 0xf5,
-	0xbd, 0xff, 0xee, 0xfa, 0x5e, 0xd8, 0x71, 0xff, 0x48, 0x3f, 0x7c, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0xa0, 0x57, 0xc3, 0x2a, 0x07, 0x00, 0x00,
+func (m *ShardsPoolReleaseCmd) Reset()         { *m = ShardsPoolReleaseCmd{} }
+func (m *ShardsPoolReleaseCmd) String() string { return proto.CompactTextString(m) }
+func (*ShardsPoolReleaseCmd) ProtoMessage()    {}
+func (*ShardsPoolReleaseCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{10}
 }
-
-func (m *StoreIdent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShardsPoolReleaseCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardsPoolReleaseCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardsPoolReleaseCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ShardsPoolReleaseCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardsPoolReleaseCmd.Merge(m, src)
+}
+func (m *ShardsPoolReleaseCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardsPoolReleaseCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardsPoolReleaseCmd.DiscardUnknown(m)
 }
 
-func (m *StoreIdent) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ClusterID))
-	}
-	if m.StoreID != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.StoreID))
+var xxx_messageInfo_ShardsPoolReleaseCmd proto.InternalMessageInfo
+
+func (m *ShardsPoolReleaseCmd) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ShardsPoolReleaseCmd) GetShardID() uint64 {
+	if m != nil {
+		return m.ShardID
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Cluster) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShardsPoolReleaseCmd) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+func (m *ShardsPoolReleaseCmd) GetCleared() bool {
+	if m != nil {
+		return m.Cleared
 	}
-	if m.MaxReplicas != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.MaxReplicas))
+	return false
+}
+
+// ShardsPoolUpdateCmd shards pool update cmd, create the pool if not exists
+type ShardsPoolUpdateCmd struct {
+	Group                uint64   `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
+	Capacity             uint64   `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RangePrefix          []byte   `protobuf:"bytes,3,opt,name=rangePrefix,proto3" json:"rangePrefix,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardsPoolUpdateCmd) Reset()         { *m = ShardsPoolUpdateCmd{} }
+func (m *ShardsPoolUpdateCmd) String() string { return proto.CompactTextString(m) }
+func (*ShardsPoolUpdateCmd) ProtoMessage()    {}
+func (*ShardsPoolUpdateCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{11}
+}
+func (m *ShardsPoolUpdateCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardsPoolUpdateCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardsPoolUpdateCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *ShardsPoolUpdateCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardsPoolUpdateCmd.Merge(m, src)
+}
+func (m *ShardsPoolUpdateCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardsPoolUpdateCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardsPoolUpdateCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardsPoolUpdateCmd proto.InternalMessageInfo
+
+func (m *ShardsPoolUpdateCmd) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Shard) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ShardsPoolUpdateCmd) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Shard) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ID != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+func (m *ShardsPoolUpdateCmd) GetRangePrefix() []byte {
+	if m != nil {
+		return m.RangePrefix
 	}
-	if len(m.Start) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Start)))
-		i += copy(dAtA[i:], m.Start)
+	return nil
+}
+
+func (m *ShardsPoolUpdateCmd) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	if len(m.End) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.End)))
-		i += copy(dAtA[i:], m.End)
+	return nil
+}
+
+// ShardsPoolRemoveCmd shards pool remove cmd
+type ShardsPoolRemoveCmd struct {
+	Group                uint64   `protobuf:"varint,1,opt,name=group,proto3" json:"group,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardsPoolRemoveCmd) Reset()         { *m = ShardsPoolRemoveCmd{} }
+func (m *ShardsPoolRemoveCmd) String() string { return proto.CompactTextString(m) }
+func (*ShardsPoolRemoveCmd) ProtoMessage()    {}
+func (*ShardsPoolRemoveCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{12}
+}
+func (m *ShardsPoolRemoveCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardsPoolRemoveCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardsPoolRemoveCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintBhmetapb(dAtA, i, uint64(m.Epoch.Size()))
-	n1, err := m.Epoch.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *ShardsPoolRemoveCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardsPoolRemoveCmd.Merge(m, src)
+}
+func (m *ShardsPoolRemoveCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardsPoolRemoveCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardsPoolRemoveCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardsPoolRemoveCmd proto.InternalMessageInfo
+
+func (m *ShardsPoolRemoveCmd) GetGroup() uint64 {
+	if m != nil {
+		return m.Group
 	}
-	i += n1
-	if m.State != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+	return 0
+}
+
+func (m *ShardsPoolRemoveCmd) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	if len(m.Peers) > 0 {
-		for _, msg := range m.Peers {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return nil
+}
+
+// BackupCmd the cmd of the backup shard command, the snapshot of the shard is
+// created under the path by the shard leader.
+type BackupCmd struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupCmd) Reset()         { *m = BackupCmd{} }
+func (m *BackupCmd) String() string { return proto.CompactTextString(m) }
+func (*BackupCmd) ProtoMessage()    {}
+func (*BackupCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{13}
+}
+func (m *BackupCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DisableSplit {
-		dAtA[i] = 0x38
-		i++
-		if m.DisableSplit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *BackupCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupCmd.Merge(m, src)
+}
+func (m *BackupCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupCmd proto.InternalMessageInfo
+
+func (m *BackupCmd) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// RestoreCmd the cmd of the restore shard command, it only carries the metadata of the
+// backup shard, all the replicas read the snapshot files from the path, and verify them
+// by the checksums before apply.
+type RestoreCmd struct {
+	// path is the snapshot path of the backup shard, it's accessible by all the stores
+	Path  string         `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Files []SnapshotFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files"`
+	// epoch is the epoch of the backup shard
+	Epoch metapb.ResourceEpoch `protobuf:"bytes,3,opt,name=epoch,proto3" json:"epoch"`
+	// index is the applied index of the backup shard
+	Index                uint64   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreCmd) Reset()         { *m = RestoreCmd{} }
+func (m *RestoreCmd) String() string { return proto.CompactTextString(m) }
+func (*RestoreCmd) ProtoMessage()    {}
+func (*RestoreCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{14}
+}
+func (m *RestoreCmd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreCmd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	if m.Group != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
+}
+func (m *RestoreCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCmd.Merge(m, src)
+}
+func (m *RestoreCmd) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCmd proto.InternalMessageInfo
+
+func (m *RestoreCmd) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if len(m.Unique) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Unique)))
-		i += copy(dAtA[i:], m.Unique)
+	return ""
+}
+
+func (m *RestoreCmd) GetFiles() []SnapshotFile {
+	if m != nil {
+		return m.Files
 	}
-	if len(m.RuleGroups) > 0 {
-		for _, s := range m.RuleGroups {
-			dAtA[i] = 0x5a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return nil
+}
+
+func (m *RestoreCmd) GetEpoch() metapb.ResourceEpoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return metapb.ResourceEpoch{}
+}
+
+func (m *RestoreCmd) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// SnapshotFile a file of the snapshot created by the data storage
+type SnapshotFile struct {
+	// name is the path of the file relative to the snapshot path
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// checksum is the crc32 of the file
+	Checksum             uint32   `protobuf:"varint,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotFile) Reset()         { *m = SnapshotFile{} }
+func (m *SnapshotFile) String() string { return proto.CompactTextString(m) }
+func (*SnapshotFile) ProtoMessage()    {}
+func (*SnapshotFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{15}
+}
+func (m *SnapshotFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+}
+func (m *SnapshotFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotFile.Merge(m, src)
+}
+func (m *SnapshotFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotFile proto.InternalMessageInfo
+
+func (m *SnapshotFile) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SnapshotFile) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Store) Marshal() (dAtA []byte, err error) {
+// BackupShard the backup of a shard
+type BackupShard struct {
+	Shard Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard"`
+	// index is the applied index of the shard when the snapshot created
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// path is the snapshot path relative to the backup directory
+	Path                 string         `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Files                []SnapshotFile `protobuf:"bytes,4,rep,name=files,proto3" json:"files"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BackupShard) Reset()         { *m = BackupShard{} }
+func (m *BackupShard) String() string { return proto.CompactTextString(m) }
+func (*BackupShard) ProtoMessage()    {}
+func (*BackupShard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{16}
+}
+func (m *BackupShard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupShard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupShard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupShard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupShard.Merge(m, src)
+}
+func (m *BackupShard) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupShard) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupShard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupShard proto.InternalMessageInfo
+
+func (m *BackupShard) GetShard() Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return Shard{}
+}
+
+func (m *BackupShard) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BackupShard) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BackupShard) GetFiles() []SnapshotFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+// BackupMeta the metadata of a backup
+type BackupMeta struct {
+	Shards               []BackupShard `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards"`
+	CreatedAt            int64         `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BackupMeta) Reset()         { *m = BackupMeta{} }
+func (m *BackupMeta) String() string { return proto.CompactTextString(m) }
+func (*BackupMeta) ProtoMessage()    {}
+func (*BackupMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75f1d28c03f69d97, []int{17}
+}
+func (m *BackupMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupMeta.Merge(m, src)
+}
+func (m *BackupMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupMeta proto.InternalMessageInfo
+
+func (m *BackupMeta) GetShards() []BackupShard {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *BackupMeta) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("bhmetapb.ShardsPoolCmdType", ShardsPoolCmdType_name, ShardsPoolCmdType_value)
+	proto.RegisterType((*StoreIdent)(nil), "bhmetapb.StoreIdent")
+	proto.RegisterType((*Cluster)(nil), "bhmetapb.Cluster")
+	proto.RegisterType((*Shard)(nil), "bhmetapb.Shard")
+	proto.RegisterType((*Store)(nil), "bhmetapb.Store")
+	proto.RegisterType((*ShardsPool)(nil), "bhmetapb.ShardsPool")
+	proto.RegisterMapType((map[uint64]*ShardPool)(nil), "bhmetapb.ShardsPool.PoolsEntry")
+	proto.RegisterType((*ShardPool)(nil), "bhmetapb.ShardPool")
+	proto.RegisterType((*AllocatedShard)(nil), "bhmetapb.AllocatedShard")
+	proto.RegisterType((*ShardsPoolCmd)(nil), "bhmetapb.ShardsPoolCmd")
+	proto.RegisterType((*ShardsPoolCreateCmd)(nil), "bhmetapb.ShardsPoolCreateCmd")
+	proto.RegisterType((*ShardsPoolAllocCmd)(nil), "bhmetapb.ShardsPoolAllocCmd")
+	proto.RegisterType((*ShardsPoolReleaseCmd)(nil), "bhmetapb.ShardsPoolReleaseCmd")
+	proto.RegisterType((*ShardsPoolUpdateCmd)(nil), "bhmetapb.ShardsPoolUpdateCmd")
+	proto.RegisterType((*ShardsPoolRemoveCmd)(nil), "bhmetapb.ShardsPoolRemoveCmd")
+	proto.RegisterType((*BackupCmd)(nil), "bhmetapb.BackupCmd")
+	proto.RegisterType((*RestoreCmd)(nil), "bhmetapb.RestoreCmd")
+	proto.RegisterType((*SnapshotFile)(nil), "bhmetapb.SnapshotFile")
+	proto.RegisterType((*BackupShard)(nil), "bhmetapb.BackupShard")
+	proto.RegisterType((*BackupMeta)(nil), "bhmetapb.BackupMeta")
+}
+
+func init() { proto.RegisterFile("bhmetapb.proto", fileDescriptor_75f1d28c03f69d97) }
+
+var fileDescriptor_75f1d28c03f69d97 = []byte{
+	// 1263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x0f, 0x49, 0xfd, 0xb1, 0x46, 0x8a, 0xe3, 0xac, 0x13, 0x83, 0xf0, 0x97, 0xcf, 0x16, 0x88,
+	0x1e, 0xd4, 0x34, 0xb5, 0x5a, 0x05, 0x01, 0x82, 0x02, 0x2d, 0x1a, 0xdb, 0x69, 0x13, 0xb4, 0x41,
+	0x8d, 0x75, 0x7a, 0x2e, 0x56, 0xe4, 0x48, 0x22, 0x42, 0x71, 0x99, 0xdd, 0xa5, 0x61, 0xa1, 0x0f,
+	0xd0, 0x57, 0xe8, 0xa5, 0xef, 0xd1, 0x47, 0xc8, 0x31, 0x40, 0x0f, 0xbd, 0x05, 0xad, 0x6f, 0x7d,
+	0x8b, 0x62, 0x77, 0x49, 0x89, 0x92, 0xa5, 0x26, 0x17, 0x62, 0x67, 0xe6, 0x37, 0xb3, 0x33, 0xbf,
+	0x9d, 0x1d, 0x2e, 0x6c, 0x0f, 0x27, 0x53, 0x54, 0x2c, 0x1b, 0x1e, 0x65, 0x82, 0x2b, 0x4e, 0xb6,
+	0x4a, 0x79, 0xff, 0xd3, 0x71, 0xac, 0x26, 0xf9, 0xf0, 0x28, 0xe4, 0xd3, 0xfe, 0x98, 0x8f, 0x79,
+	0xdf, 0x00, 0x86, 0xf9, 0xc8, 0x48, 0x46, 0x30, 0x2b, 0xeb, 0xb8, 0xff, 0x7d, 0x05, 0x3e, 0x65,
+	0x4a, 0xc4, 0x97, 0x5c, 0xc4, 0xe3, 0x38, 0x2d, 0x84, 0x30, 0x1f, 0x62, 0x3f, 0xe4, 0xd3, 0x8c,
+	0xa7, 0x98, 0x2a, 0xa9, 0x83, 0x65, 0x13, 0x54, 0xfd, 0x6c, 0xd8, 0xb7, 0xfb, 0xf5, 0xab, 0x69,
+	0x04, 0xa7, 0x00, 0xe7, 0x8a, 0x0b, 0x7c, 0x1e, 0x61, 0xaa, 0xc8, 0x3d, 0x68, 0x85, 0x49, 0x2e,
+	0x15, 0x8a, 0xe7, 0xa7, 0xbe, 0xd3, 0x75, 0x7a, 0x35, 0xba, 0x50, 0x10, 0x1f, 0x9a, 0xd2, 0x60,
+	0x4f, 0x7d, 0xd7, 0xd8, 0x4a, 0x31, 0x38, 0x81, 0xe6, 0x89, 0x85, 0x91, 0x3d, 0x70, 0xe3, 0xc8,
+	0xfa, 0x1e, 0x37, 0xae, 0xde, 0x1d, 0xba, 0xcf, 0x4f, 0xa9, 0x1b, 0x47, 0xa4, 0x0b, 0xed, 0x29,
+	0xbb, 0xa4, 0x98, 0x25, 0x71, 0xc8, 0xa4, 0x09, 0x70, 0x93, 0x56, 0x55, 0xc1, 0x9f, 0x2e, 0xd4,
+	0xcf, 0x27, 0x4c, 0x44, 0x1b, 0x63, 0xdc, 0x81, 0xba, 0x54, 0x4c, 0x28, 0xe3, 0xdd, 0xa1, 0x56,
+	0x20, 0x3b, 0xe0, 0x61, 0x1a, 0xf9, 0x9e, 0xd1, 0xe9, 0x25, 0xf9, 0x1c, 0xea, 0x98, 0xf1, 0x70,
+	0xe2, 0xd7, 0xba, 0x4e, 0xaf, 0x3d, 0xb8, 0x7b, 0x54, 0x94, 0x4c, 0x51, 0xf2, 0x5c, 0x84, 0xf8,
+	0x54, 0x1b, 0x8f, 0x6b, 0x6f, 0xde, 0x1d, 0xde, 0xa0, 0x16, 0x49, 0x3e, 0x31, 0xa1, 0x15, 0xfa,
+	0xf5, 0xae, 0xd3, 0xdb, 0xbe, 0xee, 0x72, 0xae, 0x8d, 0xd4, 0x62, 0x48, 0x0f, 0xea, 0x19, 0xa2,
+	0x90, 0x7e, 0xa3, 0xeb, 0xf5, 0xda, 0x83, 0x4e, 0x09, 0x3e, 0x43, 0x14, 0x65, 0x58, 0x03, 0x20,
+	0x01, 0x74, 0xa2, 0x58, 0xb2, 0x61, 0x82, 0xe7, 0x59, 0x12, 0x2b, 0xbf, 0xd9, 0x75, 0x7a, 0x5b,
+	0x74, 0x49, 0xa7, 0xab, 0x1a, 0x0b, 0x9e, 0x67, 0xfe, 0x96, 0x21, 0xd5, 0x0a, 0x64, 0x0f, 0x1a,
+	0x79, 0x1a, 0xbf, 0xce, 0xd1, 0x87, 0xae, 0xd3, 0x6b, 0xd1, 0x42, 0x22, 0x07, 0x00, 0x22, 0x4f,
+	0xf0, 0x5b, 0x0d, 0x92, 0x7e, 0xbb, 0xeb, 0xf5, 0x5a, 0xb4, 0xa2, 0x21, 0x04, 0x6a, 0x11, 0x53,
+	0xcc, 0xef, 0x18, 0x3a, 0xcc, 0x3a, 0xf8, 0xc5, 0x83, 0xba, 0x39, 0xe5, 0x8d, 0xcc, 0xee, 0xc3,
+	0x96, 0x60, 0x23, 0xf5, 0x24, 0x8a, 0x84, 0x21, 0xb7, 0x45, 0xe7, 0xb2, 0xde, 0x31, 0x4c, 0x62,
+	0x4c, 0xad, 0xd5, 0x33, 0xd6, 0x8a, 0x86, 0xdc, 0x87, 0x46, 0xc2, 0x86, 0x98, 0x48, 0xbf, 0xb6,
+	0x42, 0x07, 0x8b, 0x4b, 0x3a, 0x0a, 0x04, 0x79, 0xb0, 0x4c, 0xf3, 0x5e, 0x09, 0x3d, 0xe1, 0xa9,
+	0x62, 0x71, 0x8a, 0x62, 0x89, 0xe7, 0x7b, 0xd0, 0x32, 0x47, 0xfc, 0x32, 0x9e, 0xa2, 0xdf, 0xe8,
+	0x3a, 0x3d, 0x8f, 0x2e, 0x14, 0xe4, 0x01, 0xdc, 0x4e, 0x98, 0x54, 0xcf, 0x90, 0x09, 0x35, 0x44,
+	0x66, 0x51, 0x4d, 0x83, 0xba, 0x6e, 0xd0, 0xcd, 0x7b, 0x81, 0x42, 0xc6, 0x3c, 0x35, 0x3c, 0xb7,
+	0x68, 0x29, 0x6a, 0xcb, 0x38, 0x56, 0xcf, 0x98, 0x9c, 0xf8, 0x2d, 0x6b, 0x29, 0x44, 0x5d, 0x79,
+	0x84, 0x59, 0xc2, 0x67, 0x67, 0x4c, 0x4d, 0x8a, 0x73, 0xa8, 0x68, 0xc8, 0x67, 0xb0, 0x9b, 0x4d,
+	0x66, 0x32, 0x0e, 0x59, 0x92, 0xcc, 0x4e, 0x51, 0x2a, 0xc1, 0x67, 0x18, 0xf9, 0x6d, 0x73, 0xc8,
+	0xeb, 0x4c, 0xc1, 0xef, 0x0e, 0x80, 0xe9, 0x71, 0x79, 0xc6, 0x79, 0x42, 0x1e, 0x41, 0x3d, 0xe3,
+	0x3c, 0x91, 0xbe, 0x63, 0x98, 0x3b, 0x3c, 0x9a, 0x0f, 0x89, 0x05, 0xe8, 0x48, 0x7f, 0xe4, 0xd3,
+	0x54, 0x89, 0x19, 0xb5, 0x68, 0xf2, 0x11, 0xdc, 0xcc, 0x04, 0x0f, 0x51, 0x4a, 0x8c, 0xbe, 0xc3,
+	0x99, 0xbe, 0x4d, 0x5e, 0xaf, 0x43, 0x97, 0x95, 0xfb, 0x2f, 0x00, 0x16, 0xae, 0xfa, 0x96, 0xbc,
+	0xc2, 0x59, 0x71, 0xa9, 0xf5, 0x92, 0x7c, 0x0c, 0xf5, 0x0b, 0x96, 0xe4, 0x68, 0x0e, 0xbc, 0x3d,
+	0xd8, 0x5d, 0xd9, 0x5c, 0xfb, 0x52, 0x8b, 0xf8, 0xc2, 0x7d, 0xec, 0x04, 0xff, 0xb8, 0xd0, 0x9a,
+	0x1b, 0x74, 0xc3, 0x84, 0x2c, 0x63, 0x61, 0xac, 0xca, 0x98, 0x73, 0x59, 0x5f, 0x75, 0xc1, 0xd2,
+	0x31, 0x9e, 0x09, 0x1c, 0xc5, 0x97, 0xc5, 0x65, 0xad, 0xaa, 0xc8, 0x31, 0xdc, 0x62, 0x49, 0xc2,
+	0x43, 0xa6, 0x30, 0xb2, 0x95, 0xfa, 0x9e, 0x61, 0xc0, 0x5f, 0x24, 0xf1, 0x64, 0x09, 0x40, 0x57,
+	0x1d, 0x74, 0x41, 0x12, 0x5f, 0x9b, 0x2b, 0x5e, 0xa3, 0x7a, 0x49, 0x7a, 0x95, 0xa8, 0x3f, 0x8c,
+	0x46, 0x12, 0x95, 0x69, 0xb3, 0x1a, 0x5d, 0x55, 0x93, 0xaf, 0x61, 0x5b, 0x60, 0x38, 0x0b, 0x93,
+	0xf9, 0xf6, 0x8d, 0xf7, 0x6c, 0xbf, 0x82, 0xd7, 0x15, 0x08, 0x4c, 0x90, 0xc9, 0x38, 0x1d, 0x17,
+	0x21, 0x9a, 0xef, 0xab, 0x60, 0xc5, 0x41, 0x37, 0x9e, 0xc0, 0x29, 0xbf, 0xc0, 0xc8, 0xb4, 0xe4,
+	0x16, 0x2d, 0xc5, 0x60, 0x04, 0xdb, 0xcb, 0xce, 0x1a, 0x2b, 0xf5, 0x62, 0x3e, 0x97, 0x4b, 0x51,
+	0xb3, 0x3d, 0x2f, 0xef, 0x89, 0x2a, 0x26, 0x73, 0x55, 0xa5, 0x7d, 0xb3, 0x5c, 0x64, 0x5c, 0x62,
+	0x31, 0x24, 0x4b, 0x31, 0xf8, 0xc3, 0x85, 0x9b, 0x8b, 0x4e, 0x3b, 0x99, 0x46, 0xa4, 0x0f, 0x35,
+	0x35, 0xcb, 0xd0, 0x6c, 0xb2, 0x3d, 0xf8, 0xdf, 0xba, 0x86, 0x3c, 0x99, 0x46, 0x2f, 0x67, 0x19,
+	0x52, 0x03, 0x24, 0x8f, 0xa0, 0x11, 0x0a, 0xd4, 0x57, 0xda, 0xb6, 0xd1, 0xff, 0xd7, 0xba, 0x18,
+	0xc4, 0xc9, 0x34, 0xa2, 0x05, 0x98, 0x0c, 0xa0, 0x6e, 0x52, 0x34, 0x19, 0xb5, 0x07, 0xf7, 0xd6,
+	0x79, 0x19, 0x0a, 0xb4, 0x93, 0x85, 0x92, 0xc7, 0xd0, 0xb4, 0x14, 0x62, 0x31, 0xd8, 0x0f, 0xd6,
+	0x79, 0x51, 0x0b, 0xd1, 0x7e, 0x25, 0x5c, 0x27, 0x99, 0x67, 0x51, 0x39, 0x77, 0x36, 0x24, 0xf9,
+	0xa3, 0x41, 0x98, 0x24, 0x2d, 0x58, 0xbb, 0xd9, 0x13, 0xf1, 0x1b, 0x9b, 0xdd, 0xa8, 0x41, 0x18,
+	0x37, 0x0b, 0x0e, 0xee, 0xc2, 0xee, 0x9a, 0xd2, 0x83, 0x53, 0x20, 0xd7, 0x6b, 0x5b, 0x4c, 0x7f,
+	0xa7, 0x3a, 0xfd, 0x2b, 0x47, 0xe6, 0x2e, 0x1f, 0x99, 0x80, 0x3b, 0xeb, 0x6a, 0xdd, 0x1c, 0xa7,
+	0x6c, 0x1b, 0x77, 0xb9, 0x6d, 0x8a, 0x79, 0x50, 0xfc, 0x35, 0xf5, 0x3c, 0xf0, 0xa1, 0x19, 0x26,
+	0xc8, 0x04, 0x46, 0x86, 0xde, 0x2d, 0x5a, 0x8a, 0xc1, 0xcf, 0xb0, 0xbb, 0x86, 0xa6, 0x0d, 0x5b,
+	0x56, 0x27, 0x83, 0xfb, 0xdf, 0x93, 0xc1, 0xbb, 0x3e, 0x19, 0x8a, 0xb4, 0x6a, 0xf3, 0xb4, 0x82,
+	0x2f, 0x61, 0x77, 0x0d, 0xd9, 0x1b, 0x36, 0x2f, 0xdc, 0xdd, 0x85, 0xfb, 0x21, 0xb4, 0x8e, 0x59,
+	0xf8, 0x2a, 0xcf, 0xb4, 0x13, 0x81, 0x5a, 0xa6, 0x47, 0xb9, 0x63, 0x46, 0xb9, 0x59, 0x07, 0xbf,
+	0x39, 0x00, 0x14, 0xcd, 0x4b, 0x66, 0x03, 0x44, 0x37, 0xeb, 0x28, 0x4e, 0xd0, 0xce, 0xd9, 0xf6,
+	0x60, 0xaf, 0xd2, 0x06, 0x29, 0xcb, 0xe4, 0x84, 0xab, 0x6f, 0xe2, 0x04, 0xcb, 0x3f, 0xbf, 0x81,
+	0x2e, 0xde, 0x20, 0xde, 0x07, 0xbf, 0x41, 0xee, 0x40, 0x3d, 0x4e, 0x23, 0xbc, 0x2c, 0x66, 0x9a,
+	0x15, 0x82, 0xaf, 0xa0, 0x53, 0xdd, 0x45, 0x27, 0x98, 0xb2, 0x29, 0x96, 0x09, 0xea, 0xb5, 0xe1,
+	0x7c, 0x82, 0xe1, 0x2b, 0x99, 0x4f, 0x8b, 0x97, 0xd5, 0x5c, 0x0e, 0x7e, 0x75, 0xa0, 0x6d, 0x19,
+	0xb0, 0x93, 0x44, 0xbf, 0x74, 0xf4, 0xc2, 0x04, 0x68, 0x0f, 0x6e, 0xad, 0xf4, 0x74, 0x99, 0x92,
+	0xc1, 0x2c, 0x52, 0x72, 0x2b, 0x29, 0xcd, 0x39, 0xf2, 0xd6, 0x71, 0x54, 0xfb, 0x60, 0x8e, 0x82,
+	0x9f, 0x00, 0x6c, 0x66, 0x2f, 0x50, 0x31, 0xf2, 0x10, 0x1a, 0xd2, 0x4e, 0x52, 0xfb, 0x37, 0xbc,
+	0xbb, 0x08, 0x51, 0xc9, 0xbf, 0x7c, 0x50, 0x58, 0xa8, 0x79, 0xb1, 0x0a, 0xac, 0xcc, 0x3e, 0x8f,
+	0x2e, 0x14, 0xf7, 0x47, 0x70, 0xfb, 0xda, 0xdc, 0x22, 0xb7, 0xa0, 0x6d, 0x2f, 0xa5, 0x31, 0xed,
+	0xdc, 0x20, 0xdb, 0x00, 0xe6, 0x3a, 0x5a, 0xd9, 0x21, 0x3b, 0xd0, 0x29, 0x2e, 0x96, 0xd5, 0xb8,
+	0x1a, 0x61, 0xdb, 0x5e, 0xc7, 0xd9, 0xf1, 0xb4, 0x6c, 0x3b, 0xd1, 0xc8, 0xb5, 0xe3, 0x9d, 0xb7,
+	0x7f, 0x1f, 0x38, 0x6f, 0xae, 0x0e, 0x9c, 0xb7, 0x57, 0x07, 0xce, 0x5f, 0x57, 0x07, 0xce, 0xb0,
+	0x61, 0x9e, 0xd7, 0x0f, 0xff, 0x1d, 0x00, 0xd8, 0x61, 0x0e, 0x8d, 0xf7, 0x0b, 0x00, 0x00,
+}
+
+func (m *StoreIdent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1024,27 +1476,194 @@ func (m *Store) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Store) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreIdent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
+	if m.ClusterID != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
-	}
-	if len(m.RaftAddr) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RaftAddr)))
-		i += copy(dAtA[i:], m.RaftAddr)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ClusterID))
 	}
-	if len(m.ClientAddr) > 0 {
-		dAtA[i] = 0x1a
+	if m.StoreID != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.ClientAddr)))
-		i += copy(dAtA[i:], m.ClientAddr)
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.StoreID))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Cluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+	}
+	if m.MaxReplicas != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.MaxReplicas))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Shard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shard) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+	}
+	if len(m.Start) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Start)))
+		i += copy(dAtA[i:], m.Start)
+	}
+	if len(m.End) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.End)))
+		i += copy(dAtA[i:], m.End)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintBhmetapb(dAtA, i, uint64(m.Epoch.Size()))
+	n1, err := m.Epoch.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.State != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.State))
+	}
+	if len(m.Peers) > 0 {
+		for _, msg := range m.Peers {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DisableSplit {
+		dAtA[i] = 0x38
+		i++
+		if m.DisableSplit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Group != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
+	}
+	if len(m.Unique) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Unique)))
+		i += copy(dAtA[i:], m.Unique)
+	}
+	if len(m.RuleGroups) > 0 {
+		for _, s := range m.RuleGroups {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Store) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Store) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ID))
+	}
+	if len(m.RaftAddr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RaftAddr)))
+		i += copy(dAtA[i:], m.RaftAddr)
+	}
+	if len(m.ClientAddr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.ClientAddr)))
+		i += copy(dAtA[i:], m.ClientAddr)
 	}
 	if len(m.Labels) > 0 {
 		for _, msg := range m.Labels {
@@ -1149,6 +1768,14 @@ func (m *ShardsPool) MarshalTo(dAtA []byte) (int, error) {
 			}
 		}
 	}
+	if len(m.ProcessedKeys) > 0 {
+		for _, b := range m.ProcessedKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1203,6 +1830,40 @@ func (m *ShardPool) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintBhmetapb(dAtA, i, uint64(m.AllocatedOffset))
 	}
+	if len(m.RecycledShards) > 0 {
+		for _, msg := range m.RecycledShards {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReleasingShards) > 0 {
+		for _, msg := range m.ReleasingShards {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Removed {
+		dAtA[i] = 0x40
+		i++
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1286,6 +1947,36 @@ func (m *ShardsPoolCmd) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.Release != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Release.Size()))
+		n5, err := m.Release.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Update.Size()))
+		n6, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Remove != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Remove.Size()))
+		n7, err := m.Remove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1345,252 +2036,349 @@ func (m *ShardsPoolAllocCmd) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintBhmetapb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ShardsPoolReleaseCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *StoreIdent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ShardsPoolReleaseCmd) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ClusterID))
+	if m.Group != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
 	}
-	if m.StoreID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.StoreID))
+	if m.ShardID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.ShardID))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	return n
-}
-
-func (m *Cluster) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ID))
-	}
-	if m.MaxReplicas != 0 {
-		n += 1 + sovBhmetapb(uint64(m.MaxReplicas))
+	if m.Cleared {
+		dAtA[i] = 0x20
+		i++
+		if m.Cleared {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Shard) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardsPoolUpdateCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardsPoolUpdateCmd) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ID))
-	}
-	l = len(m.Start)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
-	}
-	l = len(m.End)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
-	}
-	l = m.Epoch.Size()
-	n += 1 + l + sovBhmetapb(uint64(l))
-	if m.State != 0 {
-		n += 1 + sovBhmetapb(uint64(m.State))
-	}
-	if len(m.Peers) > 0 {
-		for _, e := range m.Peers {
-			l = e.Size()
-			n += 1 + l + sovBhmetapb(uint64(l))
-		}
-	}
-	if m.DisableSplit {
-		n += 2
-	}
 	if m.Group != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Group))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
 	}
-	l = len(m.Unique)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Capacity))
 	}
-	if len(m.RuleGroups) > 0 {
-		for _, s := range m.RuleGroups {
-			l = len(s)
-			n += 1 + l + sovBhmetapb(uint64(l))
-		}
+	if len(m.RangePrefix) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.RangePrefix)))
+		i += copy(dAtA[i:], m.RangePrefix)
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Store) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardsPoolRemoveCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardsPoolRemoveCmd) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ID))
-	}
-	l = len(m.RaftAddr)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
-	}
-	l = len(m.ClientAddr)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
-			n += 1 + l + sovBhmetapb(uint64(l))
-		}
-	}
-	if m.State != 0 {
-		n += 1 + sovBhmetapb(uint64(m.State))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovBhmetapb(uint64(m.StartTime))
-	}
-	if m.LastHeartbeatTime != 0 {
-		n += 1 + sovBhmetapb(uint64(m.LastHeartbeatTime))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
-	}
-	l = len(m.GitHash)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
-	}
-	l = len(m.DeployPath)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.Group != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Group))
 	}
-	if m.PhysicallyDestroyed {
-		n += 2
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ShardsPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupCmd) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for k, v := range m.Pools {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovBhmetapb(uint64(l))
-			}
-			mapEntrySize := 1 + sovBhmetapb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovBhmetapb(uint64(mapEntrySize))
-		}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ShardPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestoreCmd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreCmd) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Capacity != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Capacity))
-	}
-	l = len(m.RangePrefix)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	if len(m.AllocatedShards) > 0 {
-		for _, e := range m.AllocatedShards {
-			l = e.Size()
-			n += 1 + l + sovBhmetapb(uint64(l))
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.Seq != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Seq))
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintBhmetapb(dAtA, i, uint64(m.Epoch.Size()))
+	n8, err := m.Epoch.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.AllocatedOffset != 0 {
-		n += 1 + sovBhmetapb(uint64(m.AllocatedOffset))
+	i += n8
+	if m.Index != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Index))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AllocatedShard) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SnapshotFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ShardID != 0 {
-		n += 1 + sovBhmetapb(uint64(m.ShardID))
-	}
-	if m.AllocatedAt != 0 {
-		n += 1 + sovBhmetapb(uint64(m.AllocatedAt))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.Purpose)
-	if l > 0 {
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.Checksum != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Checksum))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ShardsPoolCmd) Size() (n int) {
+func (m *BackupShard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupShard) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintBhmetapb(dAtA, i, uint64(m.Shard.Size()))
+	n9, err := m.Shard.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BackupMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupMeta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, msg := range m.Shards {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBhmetapb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhmetapb(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintBhmetapb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *StoreIdent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovBhmetapb(uint64(m.Type))
-	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.ClusterID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ClusterID))
 	}
-	if m.Alloc != nil {
-		l = m.Alloc.Size()
-		n += 1 + l + sovBhmetapb(uint64(l))
+	if m.StoreID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.StoreID))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1598,28 +2386,69 @@ func (m *ShardsPoolCmd) Size() (n int) {
 	return n
 }
 
-func (m *ShardsPoolCreateCmd) Size() (n int) {
+func (m *Cluster) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ID))
+	}
+	if m.MaxReplicas != 0 {
+		n += 1 + sovBhmetapb(uint64(m.MaxReplicas))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ShardsPoolAllocCmd) Size() (n int) {
+func (m *Shard) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ID))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.DisableSplit {
+		n += 2
+	}
 	if m.Group != 0 {
 		n += 1 + sovBhmetapb(uint64(m.Group))
 	}
-	l = len(m.Purpose)
+	l = len(m.Unique)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.RuleGroups) > 0 {
+		for _, s := range m.RuleGroups {
+			l = len(s)
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovBhmetapb(uint64(l))
 	}
@@ -1629,53 +2458,1703 @@ func (m *ShardsPoolAllocCmd) Size() (n int) {
 	return n
 }
 
-func sovBhmetapb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *Store) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ID))
+	}
+	l = len(m.RaftAddr)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.ClientAddr)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
 		}
 	}
+	if m.State != 0 {
+		n += 1 + sovBhmetapb(uint64(m.State))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovBhmetapb(uint64(m.StartTime))
+	}
+	if m.LastHeartbeatTime != 0 {
+		n += 1 + sovBhmetapb(uint64(m.LastHeartbeatTime))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.GitHash)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.DeployPath)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.PhysicallyDestroyed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
 	return n
 }
-func sozBhmetapb(x uint64) (n int) {
-	return sovBhmetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ShardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for k, v := range m.Pools {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovBhmetapb(uint64(l))
+			}
+			mapEntrySize := 1 + sovBhmetapb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovBhmetapb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ProcessedKeys) > 0 {
+		for _, b := range m.ProcessedKeys {
+			l = len(b)
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *StoreIdent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBhmetapb
+
+func (m *ShardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capacity != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Capacity))
+	}
+	l = len(m.RangePrefix)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.AllocatedShards) > 0 {
+		for _, e := range m.AllocatedShards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.Seq != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Seq))
+	}
+	if m.AllocatedOffset != 0 {
+		n += 1 + sovBhmetapb(uint64(m.AllocatedOffset))
+	}
+	if len(m.RecycledShards) > 0 {
+		for _, e := range m.RecycledShards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if len(m.ReleasingShards) > 0 {
+		for _, e := range m.ReleasingShards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.Removed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocatedShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ShardID))
+	}
+	if m.AllocatedAt != 0 {
+		n += 1 + sovBhmetapb(uint64(m.AllocatedAt))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Type))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Alloc != nil {
+		l = m.Alloc.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Release != nil {
+		l = m.Release.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolCreateCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolAllocCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolReleaseCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovBhmetapb(uint64(m.ShardID))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Cleared {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolUpdateCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Capacity))
+	}
+	l = len(m.RangePrefix)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardsPoolRemoveCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Group))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreCmd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Checksum))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupShard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shard.Size()
+	n += 1 + l + sovBhmetapb(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovBhmetapb(uint64(m.Index))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovBhmetapb(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovBhmetapb(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBhmetapb(uint64(m.CreatedAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBhmetapb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBhmetapb(x uint64) (n int) {
+	return sovBhmetapb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreIdent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreIdent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreIdent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
+			}
+			m.StoreID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= metapb.ResourceState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, metapb.Peer{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSplit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSplit = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unique = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleGroups = append(m.RuleGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Store) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Store: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Store: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, metapb.Pair{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= metapb.ContainerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			m.LastHeartbeatTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeatTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicallyDestroyed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PhysicallyDestroyed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pools == nil {
+				m.Pools = make(map[uint64]*ShardPool)
+			}
+			var mapkey uint64
+			var mapvalue *ShardPool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBhmetapb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBhmetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBhmetapb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthBhmetapb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthBhmetapb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ShardPool{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBhmetapb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthBhmetapb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Pools[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedKeys = append(m.ProcessedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ProcessedKeys[len(m.ProcessedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangePrefix == nil {
+				m.RangePrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocatedShards = append(m.AllocatedShards, &AllocatedShard{})
+			if err := m.AllocatedShards[len(m.AllocatedShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedOffset", wireType)
+			}
+			m.AllocatedOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocatedOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecycledShards", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecycledShards = append(m.RecycledShards, &AllocatedShard{})
+			if err := m.RecycledShards[len(m.RecycledShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StoreIdent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreIdent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasingShards", wireType)
 			}
-			m.ClusterID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1685,16 +4164,31 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasingShards = append(m.ReleasingShards, &AllocatedShard{})
+			if err := m.ReleasingShards[len(m.ReleasingShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			m.StoreID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1704,11 +4198,12 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Removed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -1734,7 +4229,7 @@ func (m *StoreIdent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1757,17 +4252,17 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocatedShard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocatedShard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
 			}
-			m.ID = 0
+			m.ShardID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1777,16 +4272,16 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.ShardID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAt", wireType)
 			}
-			m.MaxReplicas = 0
+			m.AllocatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1796,11 +4291,45 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxReplicas |= uint32(b&0x7F) << shift
+				m.AllocatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
+			if m.Purpose == nil {
+				m.Purpose = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -1826,7 +4355,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shard) Unmarshal(dAtA []byte) error {
+func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1849,17 +4378,17 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shard: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardsPoolCmd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardsPoolCmd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ID = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1869,16 +4398,16 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Type |= ShardsPoolCmdType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1888,31 +4417,33 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
-			if m.Start == nil {
-				m.Start = []byte{}
+			if m.Create == nil {
+				m.Create = &ShardsPoolCreateCmd{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alloc", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1922,29 +4453,31 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
-			if m.End == nil {
-				m.End = []byte{}
+			if m.Alloc == nil {
+				m.Alloc = &ShardsPoolAllocCmd{}
+			}
+			if err := m.Alloc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1971,15 +4504,18 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Release == nil {
+				m.Release = &ShardsPoolReleaseCmd{}
+			}
+			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -1989,14 +4525,31 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= metapb.ResourceState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &ShardsPoolUpdateCmd{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2023,87 +4576,126 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peers = append(m.Peers, metapb.Peer{})
-			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Remove == nil {
+				m.Remove = &ShardsPoolRemoveCmd{}
+			}
+			if err := m.Remove.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSplit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
 			}
-			m.DisableSplit = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
 			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolCreateCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolCreateCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolCreateCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unique = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleGroups", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolAllocCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolAllocCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2113,27 +4705,14 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RuleGroups = append(m.RuleGroups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,9 +4739,9 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
+			if m.Purpose == nil {
+				m.Purpose = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2190,7 +4769,7 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Store) Unmarshal(dAtA []byte) error {
+func (m *ShardsPoolReleaseCmd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2213,17 +4792,17 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Store: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardsPoolReleaseCmd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Store: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardsPoolReleaseCmd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.ID = 0
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2233,16 +4812,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
 			}
-			var stringLen uint64
+			m.ShardID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2252,29 +4831,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2284,29 +4850,31 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientAddr = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleared", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2316,50 +4884,71 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Cleared = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, metapb.Pair{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolUpdateCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= metapb.ContainerState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolUpdateCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolUpdateCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.StartTime = 0
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2369,16 +4958,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			m.LastHeartbeatTime = 0
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2388,16 +4977,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeartbeatTime |= int64(b&0x7F) << shift
+				m.Capacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2407,29 +4996,31 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RangePrefix == nil {
+				m.RangePrefix = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2439,29 +5030,85 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHash = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployPath", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhmetapb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardsPoolRemoveCmd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhmetapb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardsPoolRemoveCmd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardsPoolRemoveCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2471,29 +5118,16 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Group |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeployPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhysicallyDestroyed", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2503,12 +5137,26 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PhysicallyDestroyed = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -2534,7 +5182,7 @@ func (m *Store) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPool) Unmarshal(dAtA []byte) error {
+func (m *BackupCmd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2557,17 +5205,17 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupCmd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupCmd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2577,106 +5225,23 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pools == nil {
-				m.Pools = make(map[uint64]*ShardPool)
-			}
-			var mapkey uint64
-			var mapvalue *ShardPool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBhmetapb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBhmetapb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBhmetapb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthBhmetapb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthBhmetapb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ShardPool{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBhmetapb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthBhmetapb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Pools[mapkey] = mapvalue
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2703,7 +5268,7 @@ func (m *ShardsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardPool) Unmarshal(dAtA []byte) error {
+func (m *RestoreCmd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,17 +5291,17 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreCmd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreCmd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Capacity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2746,16 +5311,29 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2765,29 +5343,29 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RangePrefix = append(m.RangePrefix[:0], dAtA[iNdEx:postIndex]...)
-			if m.RangePrefix == nil {
-				m.RangePrefix = []byte{}
+			m.Files = append(m.Files, SnapshotFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedShards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2814,35 +5392,15 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllocatedShards = append(m.AllocatedShards, &AllocatedShard{})
-			if err := m.AllocatedShards[len(m.AllocatedShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-			}
-			m.Seq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.AllocatedOffset = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2852,7 +5410,7 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AllocatedOffset |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2882,7 +5440,7 @@ func (m *ShardPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
+func (m *SnapshotFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,17 +5463,17 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocatedShard: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocatedShard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ShardID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2925,35 +5483,29 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhmetapb
 			}
-			m.AllocatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBhmetapb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AllocatedAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -2963,26 +5515,11 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Checksum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
-			if m.Purpose == nil {
-				m.Purpose = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -3008,7 +5545,7 @@ func (m *AllocatedShard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
+func (m *BackupShard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3031,17 +5568,17 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPoolCmd: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupShard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPoolCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupShard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3051,16 +5588,49 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ShardsPoolCmdType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhmetapb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3070,31 +5640,27 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBhmetapb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Create == nil {
-				m.Create = &ShardsPoolCreateCmd{}
-			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alloc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3121,10 +5687,8 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Alloc == nil {
-				m.Alloc = &ShardsPoolAllocCmd{}
-			}
-			if err := m.Alloc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Files = append(m.Files, SnapshotFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3153,61 +5717,7 @@ func (m *ShardsPoolCmd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardsPoolCreateCmd) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBhmetapb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPoolCreateCmd: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPoolCreateCmd: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBhmetapb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
+func (m *BackupMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3230,17 +5740,17 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardsPoolAllocCmd: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardsPoolAllocCmd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3250,16 +5760,31 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhmetapb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, BackupShard{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var byteLen int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBhmetapb
@@ -3269,26 +5794,11 @@ func (m *ShardsPoolAllocCmd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBhmetapb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Purpose = append(m.Purpose[:0], dAtA[iNdEx:postIndex]...)
-			if m.Purpose == nil {
-				m.Purpose = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhmetapb(dAtA[iNdEx:])
@@ -33,18 +33,21 @@ const (
 	PeerState_Normal    PeerState = 0
 	PeerState_Applying  PeerState = 1
 	PeerState_Tombstone PeerState = 2
+	PeerState_Merging   PeerState = 3
 )
 
 var PeerState_name = map[int32]string{
 	0: "Normal",
 	1: "Applying",
 	2: "Tombstone",
+	3: "Merging",
 }
 
 var PeerState_value = map[string]int32{
 	"Normal":    0,
 	"Applying":  1,
 	"Tombstone": 2,
+	"Merging":   3,
 }
 
 func (x PeerState) String() string {
@@ -57,21 +60,24 @@ func (PeerState) EnumDescriptor() ([]byte, []int) {
 
 // RaftMessage the message wrapped raft msg with shard info
 type RaftMessage struct {
-	ShardID              uint64               `protobuf:"varint,1,opt,name=shardID,proto3" json:"shardID,omitempty"`
-	Group                uint64               `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
-	From                 metapb.Peer          `protobuf:"bytes,3,opt,name=from,proto3" json:"from"`
-	To                   metapb.Peer          `protobuf:"bytes,4,opt,name=to,proto3" json:"to"`
-	Message              raftpb.Message       `protobuf:"bytes,5,opt,name=message,proto3" json:"message"`
-	ShardEpoch           metapb.ResourceEpoch `protobuf:"bytes,6,opt,name=shardEpoch,proto3" json:"shardEpoch"`
-	IsTombstone          bool                 `protobuf:"varint,7,opt,name=isTombstone,proto3" json:"isTombstone,omitempty"`
-	Start                []byte               `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
-	End                  []byte               `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
-	DisableSplit         bool                 `protobuf:"varint,10,opt,name=disableSplit,proto3" json:"disableSplit,omitempty"`
-	Unique               string               `protobuf:"bytes,11,opt,name=unique,proto3" json:"unique,omitempty"`
-	RuleGroups           []string             `protobuf:"bytes,12,rep,name=ruleGroups,proto3" json:"ruleGroups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ShardID      uint64               `protobuf:"varint,1,opt,name=shardID,proto3" json:"shardID,omitempty"`
+	Group        uint64               `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
+	From         metapb.Peer          `protobuf:"bytes,3,opt,name=from,proto3" json:"from"`
+	To           metapb.Peer          `protobuf:"bytes,4,opt,name=to,proto3" json:"to"`
+	Message      raftpb.Message       `protobuf:"bytes,5,opt,name=message,proto3" json:"message"`
+	ShardEpoch   metapb.ResourceEpoch `protobuf:"bytes,6,opt,name=shardEpoch,proto3" json:"shardEpoch"`
+	IsTombstone  bool                 `protobuf:"varint,7,opt,name=isTombstone,proto3" json:"isTombstone,omitempty"`
+	Start        []byte               `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	End          []byte               `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
+	DisableSplit bool                 `protobuf:"varint,10,opt,name=disableSplit,proto3" json:"disableSplit,omitempty"`
+	Unique       string               `protobuf:"bytes,11,opt,name=unique,proto3" json:"unique,omitempty"`
+	RuleGroups   []string             `protobuf:"bytes,12,rep,name=ruleGroups,proto3" json:"ruleGroups,omitempty"`
+	// hibernate is set in the heartbeats of the idle leader, the followers stop ticking
+	// after they received it
+	Hibernate            bool     `protobuf:"varint,13,opt,name=hibernate,proto3" json:"hibernate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RaftMessage) Reset()         { *m = RaftMessage{} }
@@ -191,10 +197,67 @@ func (m *RaftMessage) GetRuleGroups() []string {
 	return nil
 }
 
+func (m *RaftMessage) GetHibernate() bool {
+	if m != nil {
+		return m.Hibernate
+	}
+	return false
+}
+
+// RaftMessageBatch the raft messages sent to the same container in a batch, the batch
+// is compressed by the transport
+type RaftMessageBatch struct {
+	Messages             []*RaftMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RaftMessageBatch) Reset()         { *m = RaftMessageBatch{} }
+func (m *RaftMessageBatch) String() string { return proto.CompactTextString(m) }
+func (*RaftMessageBatch) ProtoMessage()    {}
+func (*RaftMessageBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b31c127a72499666, []int{1}
+}
+func (m *RaftMessageBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftMessageBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftMessageBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RaftMessageBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftMessageBatch.Merge(m, src)
+}
+func (m *RaftMessageBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftMessageBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftMessageBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftMessageBatch proto.InternalMessageInfo
+
+func (m *RaftMessageBatch) GetMessages() []*RaftMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // ShardLocalState the shard state on the store
 type ShardLocalState struct {
 	State                PeerState      `protobuf:"varint,1,opt,name=state,proto3,enum=bhraftpb.PeerState" json:"state,omitempty"`
 	Shard                bhmetapb.Shard `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard"`
+	MergeState           *MergeState    `protobuf:"bytes,3,opt,name=mergeState,proto3" json:"mergeState,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -204,7 +267,7 @@ func (m *ShardLocalState) Reset()         { *m = ShardLocalState{} }
 func (m *ShardLocalState) String() string { return proto.CompactTextString(m) }
 func (*ShardLocalState) ProtoMessage()    {}
 func (*ShardLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{1}
+	return fileDescriptor_b31c127a72499666, []int{2}
 }
 func (m *ShardLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,6 +310,72 @@ func (m *ShardLocalState) GetShard() bhmetapb.Shard {
 	return bhmetapb.Shard{}
 }
 
+func (m *ShardLocalState) GetMergeState() *MergeState {
+	if m != nil {
+		return m.MergeState
+	}
+	return nil
+}
+
+// MergeState the merge state of the source shard. It is set when the source shard
+// applied the PrepareMerge, and kept in the tombstone state after the source shard
+// merged into the target shard.
+type MergeState struct {
+	// commit is the raft log index of the PrepareMerge
+	Commit               uint64         `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Target               bhmetapb.Shard `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MergeState) Reset()         { *m = MergeState{} }
+func (m *MergeState) String() string { return proto.CompactTextString(m) }
+func (*MergeState) ProtoMessage()    {}
+func (*MergeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b31c127a72499666, []int{3}
+}
+func (m *MergeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeState.Merge(m, src)
+}
+func (m *MergeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeState proto.InternalMessageInfo
+
+func (m *MergeState) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+func (m *MergeState) GetTarget() bhmetapb.Shard {
+	if m != nil {
+		return m.Target
+	}
+	return bhmetapb.Shard{}
+}
+
 // RaftLocalState raft local state about raft log
 type RaftLocalState struct {
 	HardState            raftpb.HardState `protobuf:"bytes,1,opt,name=hardState,proto3" json:"hardState"`
@@ -260,7 +389,7 @@ func (m *RaftLocalState) Reset()         { *m = RaftLocalState{} }
 func (m *RaftLocalState) String() string { return proto.CompactTextString(m) }
 func (*RaftLocalState) ProtoMessage()    {}
 func (*RaftLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{2}
+	return fileDescriptor_b31c127a72499666, []int{4}
 }
 func (m *RaftLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +445,7 @@ func (m *RaftTruncatedState) Reset()         { *m = RaftTruncatedState{} }
 func (m *RaftTruncatedState) String() string { return proto.CompactTextString(m) }
 func (*RaftTruncatedState) ProtoMessage()    {}
 func (*RaftTruncatedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{3}
+	return fileDescriptor_b31c127a72499666, []int{5}
 }
 func (m *RaftTruncatedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +501,7 @@ func (m *RaftApplyState) Reset()         { *m = RaftApplyState{} }
 func (m *RaftApplyState) String() string { return proto.CompactTextString(m) }
 func (*RaftApplyState) ProtoMessage()    {}
 func (*RaftApplyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{4}
+	return fileDescriptor_b31c127a72499666, []int{6}
 }
 func (m *RaftApplyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,21 +546,25 @@ func (m *RaftApplyState) GetTruncatedState() RaftTruncatedState {
 
 // SnapshotMessageHeader snapshot message header
 type SnapshotMessageHeader struct {
-	Shard                bhmetapb.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard"`
-	From                 metapb.Peer    `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
-	To                   metapb.Peer    `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
-	Term                 uint64         `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
-	Index                uint64         `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Shard bhmetapb.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard"`
+	From  metapb.Peer    `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To    metapb.Peer    `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	Term  uint64         `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	Index uint64         `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// checksum is the crc32 of the snapshot file
+	Checksum uint32 `protobuf:"varint,6,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// files is the crc32 of every file in the snapshot, they are verified before apply
+	Files                []SnapshotFileChecksum `protobuf:"bytes,7,rep,name=files,proto3" json:"files"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *SnapshotMessageHeader) Reset()         { *m = SnapshotMessageHeader{} }
 func (m *SnapshotMessageHeader) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMessageHeader) ProtoMessage()    {}
 func (*SnapshotMessageHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{5}
+	return fileDescriptor_b31c127a72499666, []int{7}
 }
 func (m *SnapshotMessageHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,6 +628,77 @@ func (m *SnapshotMessageHeader) GetIndex() uint64 {
 	return 0
 }
 
+func (m *SnapshotMessageHeader) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+func (m *SnapshotMessageHeader) GetFiles() []SnapshotFileChecksum {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+// SnapshotFileChecksum the checksum of a file in the snapshot
+type SnapshotFileChecksum struct {
+	// name is the path of the file relative to the snapshot dir
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Checksum             uint32   `protobuf:"varint,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotFileChecksum) Reset()         { *m = SnapshotFileChecksum{} }
+func (m *SnapshotFileChecksum) String() string { return proto.CompactTextString(m) }
+func (*SnapshotFileChecksum) ProtoMessage()    {}
+func (*SnapshotFileChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b31c127a72499666, []int{8}
+}
+func (m *SnapshotFileChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotFileChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotFileChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotFileChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotFileChecksum.Merge(m, src)
+}
+func (m *SnapshotFileChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotFileChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotFileChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotFileChecksum proto.InternalMessageInfo
+
+func (m *SnapshotFileChecksum) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotFileChecksum) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
 // SnapshotMessage snapshot message
 type SnapshotMessage struct {
 	Header               SnapshotMessageHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
@@ -512,7 +716,7 @@ func (m *SnapshotMessage) Reset()         { *m = SnapshotMessage{} }
 func (m *SnapshotMessage) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMessage) ProtoMessage()    {}
 func (*SnapshotMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b31c127a72499666, []int{6}
+	return fileDescriptor_b31c127a72499666, []int{9}
 }
 func (m *SnapshotMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,65 +790,77 @@ func (m *SnapshotMessage) GetCheckSum() uint64 {
 func init() {
 	proto.RegisterEnum("bhraftpb.PeerState", PeerState_name, PeerState_value)
 	proto.RegisterType((*RaftMessage)(nil), "bhraftpb.RaftMessage")
+	proto.RegisterType((*RaftMessageBatch)(nil), "bhraftpb.RaftMessageBatch")
 	proto.RegisterType((*ShardLocalState)(nil), "bhraftpb.ShardLocalState")
+	proto.RegisterType((*MergeState)(nil), "bhraftpb.MergeState")
 	proto.RegisterType((*RaftLocalState)(nil), "bhraftpb.RaftLocalState")
 	proto.RegisterType((*RaftTruncatedState)(nil), "bhraftpb.RaftTruncatedState")
 	proto.RegisterType((*RaftApplyState)(nil), "bhraftpb.RaftApplyState")
 	proto.RegisterType((*SnapshotMessageHeader)(nil), "bhraftpb.SnapshotMessageHeader")
+	proto.RegisterType((*SnapshotFileChecksum)(nil), "bhraftpb.SnapshotFileChecksum")
 	proto.RegisterType((*SnapshotMessage)(nil), "bhraftpb.SnapshotMessage")
 }
 
 func init() { proto.RegisterFile("bhraftpb.proto", fileDescriptor_b31c127a72499666) }
 
 var fileDescriptor_b31c127a72499666 = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6e, 0xdb, 0x38,
-	0x10, 0x0e, 0xfd, 0x17, 0x7b, 0xec, 0x75, 0xbc, 0xdc, 0x64, 0x21, 0x04, 0x81, 0x63, 0xe8, 0x21,
-	0xf0, 0xee, 0x62, 0x2d, 0x20, 0xbb, 0xfb, 0xb4, 0x48, 0x81, 0x06, 0x2d, 0x9a, 0x14, 0x69, 0x51,
-	0xc8, 0xb9, 0x00, 0x25, 0xd1, 0x12, 0x51, 0x49, 0x54, 0x29, 0x0a, 0x48, 0xfb, 0xd4, 0x8b, 0xf4,
-	0x22, 0x3d, 0x40, 0x91, 0xc7, 0x9c, 0xa0, 0x68, 0x73, 0x92, 0x82, 0x14, 0x25, 0xcb, 0xe9, 0x5f,
-	0x9e, 0xc4, 0x19, 0x7e, 0x33, 0xf3, 0xcd, 0xc7, 0x19, 0xc1, 0xd8, 0x8b, 0x04, 0x59, 0xc9, 0xcc,
-	0x5b, 0x64, 0x82, 0x4b, 0x8e, 0xfb, 0x95, 0xbd, 0x7f, 0x12, 0x32, 0x19, 0x15, 0xde, 0xc2, 0xe7,
-	0x89, 0x93, 0x10, 0x29, 0xd8, 0x15, 0x17, 0x2c, 0x64, 0xa9, 0x31, 0xfc, 0xc2, 0xa3, 0x4e, 0xe6,
-	0x39, 0x5e, 0x94, 0x50, 0x49, 0x1a, 0x87, 0x32, 0xd1, 0xfe, 0xc5, 0x3d, 0xc2, 0x7d, 0x9e, 0x64,
-	0x3c, 0xa5, 0xa9, 0xcc, 0x9d, 0x4c, 0xf0, 0x2c, 0xa2, 0x52, 0x65, 0x34, 0xf9, 0x36, 0xb2, 0xfd,
-	0xdd, 0xc8, 0x16, 0xf2, 0x90, 0x3b, 0xda, 0xed, 0x15, 0x2b, 0x6d, 0x69, 0x43, 0x9f, 0x0c, 0xfc,
-	0x28, 0xe4, 0x0b, 0x2a, 0xfd, 0x60, 0xc1, 0xb8, 0xa3, 0xbe, 0x8e, 0xea, 0xc9, 0x29, 0x1b, 0xd3,
-	0x9f, 0x12, 0x67, 0xbf, 0x6b, 0xc3, 0xd0, 0x25, 0x2b, 0xf9, 0x8c, 0xe6, 0x39, 0x09, 0x29, 0xb6,
-	0x60, 0x3b, 0x8f, 0x88, 0x08, 0xce, 0x1f, 0x59, 0x68, 0x86, 0xe6, 0x1d, 0xb7, 0x32, 0xf1, 0x2e,
-	0x74, 0x43, 0xc1, 0x8b, 0xcc, 0x6a, 0x69, 0x7f, 0x69, 0xe0, 0x23, 0xe8, 0xac, 0x04, 0x4f, 0xac,
-	0xf6, 0x0c, 0xcd, 0x87, 0xc7, 0xa3, 0x85, 0xe1, 0xfc, 0x82, 0x52, 0x71, 0xda, 0xb9, 0xfe, 0x78,
-	0xb8, 0xe5, 0xea, 0x7b, 0x6c, 0x43, 0x4b, 0x72, 0xab, 0xf3, 0x5d, 0x54, 0x4b, 0x72, 0xec, 0xc0,
-	0x76, 0x52, 0xd2, 0xb0, 0xba, 0x1a, 0xb8, 0xb3, 0x30, 0x2f, 0x63, 0xd8, 0x19, 0x6c, 0x85, 0xc2,
-	0xff, 0x03, 0x68, 0x76, 0x8f, 0x33, 0xee, 0x47, 0x56, 0x4f, 0xc7, 0xec, 0x55, 0xc9, 0x5d, 0x9a,
-	0xf3, 0x42, 0xf8, 0x54, 0x5f, 0x9a, 0xc8, 0x06, 0x1c, 0xcf, 0x60, 0xc8, 0xf2, 0x4b, 0x9e, 0x78,
-	0xb9, 0xe4, 0x29, 0xb5, 0xb6, 0x67, 0x68, 0xde, 0x77, 0x9b, 0x2e, 0xd5, 0x71, 0x2e, 0x89, 0x90,
-	0x56, 0x7f, 0x86, 0xe6, 0x23, 0xb7, 0x34, 0xf0, 0x04, 0xda, 0x34, 0x0d, 0xac, 0x81, 0xf6, 0xa9,
-	0x23, 0xb6, 0x61, 0x14, 0xb0, 0x9c, 0x78, 0x31, 0x5d, 0x66, 0x31, 0x93, 0x16, 0xe8, 0x54, 0x1b,
-	0x3e, 0xfc, 0x3b, 0xf4, 0x8a, 0x94, 0xbd, 0x2a, 0xa8, 0x35, 0x9c, 0xa1, 0xf9, 0xc0, 0x35, 0x16,
-	0x9e, 0x02, 0x88, 0x22, 0xa6, 0x4f, 0x94, 0x98, 0xb9, 0x35, 0x9a, 0xb5, 0xe7, 0x03, 0xb7, 0xe1,
-	0xb1, 0x19, 0xec, 0x2c, 0x15, 0xe7, 0x0b, 0xee, 0x93, 0x78, 0x29, 0x89, 0xa4, 0xf8, 0x0f, 0x4d,
-	0x4b, 0x52, 0xfd, 0x40, 0xe3, 0xe3, 0xdf, 0x16, 0xf5, 0x00, 0x2b, 0x3d, 0x35, 0xc6, 0x2d, 0x11,
-	0xf8, 0x2f, 0xe8, 0xea, 0x8e, 0xf5, 0x9b, 0x29, 0x3d, 0xeb, 0x11, 0xd5, 0x49, 0x8d, 0x2a, 0x25,
-	0xc6, 0xa6, 0x30, 0x56, 0x93, 0xd0, 0xa8, 0xf4, 0x1f, 0x0c, 0xd4, 0xcd, 0xb2, 0xae, 0x36, 0x3c,
-	0xfe, 0xb5, 0x7a, 0x92, 0xb3, 0xea, 0xc2, 0x24, 0x59, 0x23, 0xf1, 0x01, 0x0c, 0x62, 0x92, 0xcb,
-	0xf3, 0x34, 0xa0, 0x57, 0x66, 0x5a, 0xd6, 0x0e, 0xfb, 0x01, 0x60, 0x55, 0xe6, 0x52, 0x14, 0xa9,
-	0x4f, 0x24, 0x35, 0x31, 0xbb, 0xd0, 0x65, 0x1a, 0x5f, 0x4e, 0x5d, 0x69, 0x60, 0x0c, 0x1d, 0x49,
-	0x45, 0x62, 0x92, 0xe8, 0xb3, 0xfd, 0x16, 0x95, 0x3c, 0x1f, 0x66, 0x59, 0xfc, 0xba, 0x0c, 0xb6,
-	0x61, 0x44, 0xb2, 0x2c, 0x66, 0x34, 0x38, 0x6f, 0xe4, 0xd8, 0xf0, 0xe1, 0xa7, 0x30, 0x96, 0x1b,
-	0x25, 0x8d, 0x26, 0x07, 0x6b, 0xf9, 0xbe, 0xa6, 0x65, 0x7a, 0xbb, 0x13, 0x69, 0xbf, 0x47, 0xb0,
-	0xb7, 0x4c, 0x49, 0x96, 0x47, 0xbc, 0x5a, 0x9c, 0x33, 0x4a, 0x02, 0x2a, 0xd6, 0x82, 0xa3, 0x9f,
-	0x0b, 0x5e, 0xef, 0x4e, 0xeb, 0x5e, 0xbb, 0xd3, 0xfe, 0xe1, 0xee, 0x54, 0x4a, 0x75, 0xd6, 0x4a,
-	0xad, 0x35, 0xed, 0x36, 0x34, 0xb5, 0x3f, 0x20, 0xd8, 0xb9, 0x43, 0x1e, 0x9f, 0x40, 0x2f, 0xd2,
-	0x0d, 0x18, 0xde, 0x87, 0x6b, 0x51, 0xbe, 0xd9, 0xa7, 0x29, 0x6c, 0x82, 0x54, 0xf1, 0x80, 0x48,
-	0xa2, 0x1b, 0x19, 0xb9, 0xfa, 0xac, 0x8a, 0xaf, 0x98, 0xc8, 0xa5, 0xe6, 0xdd, 0x77, 0x4b, 0x43,
-	0x21, 0xd5, 0x24, 0x68, 0x9a, 0x7d, 0x57, 0x9f, 0xf1, 0x3e, 0xf4, 0x57, 0x2c, 0xa6, 0x4b, 0xf6,
-	0x86, 0x1a, 0xa6, 0xb5, 0xad, 0xee, 0xfc, 0x88, 0xfa, 0x2f, 0x97, 0x45, 0xa2, 0xf7, 0xbb, 0xe3,
-	0xd6, 0xf6, 0x9f, 0xff, 0xc2, 0xa0, 0x1e, 0x78, 0x0c, 0xd0, 0x7b, 0xce, 0x45, 0x42, 0xe2, 0xc9,
-	0x16, 0x1e, 0x41, 0x5f, 0x0f, 0x07, 0x4b, 0xc3, 0x09, 0xc2, 0xbf, 0xc0, 0xa0, 0x5e, 0xe9, 0x49,
-	0xeb, 0x74, 0x72, 0xf3, 0x79, 0x8a, 0xae, 0x6f, 0xa7, 0xe8, 0xe6, 0x76, 0x8a, 0x3e, 0xdd, 0x4e,
-	0x91, 0xd7, 0xd3, 0x7f, 0xc2, 0x7f, 0xbe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xd9, 0xdc, 0xe3,
-	0x09, 0x06, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x8e, 0xdc, 0x44,
+	0x10, 0x8e, 0xe7, 0x7f, 0x6a, 0x66, 0x37, 0x43, 0xb3, 0x41, 0xd6, 0x2a, 0x9a, 0x8c, 0xfc, 0x10,
+	0x0d, 0xa0, 0x8c, 0xc5, 0x02, 0x2f, 0xa0, 0x20, 0x65, 0x21, 0x21, 0x8b, 0x12, 0x84, 0x7a, 0x72,
+	0x81, 0xb6, 0xa7, 0xc7, 0x6e, 0x61, 0xbb, 0x4d, 0xbb, 0x2d, 0x05, 0x9e, 0x38, 0x03, 0x17, 0xe0,
+	0x20, 0xbc, 0xa3, 0x3c, 0xe6, 0x04, 0x08, 0xf6, 0x24, 0xa8, 0xcb, 0xed, 0x3f, 0x7e, 0x56, 0xfb,
+	0x34, 0x55, 0xd5, 0x5f, 0x7d, 0xae, 0xfa, 0xaa, 0xba, 0x07, 0x4e, 0x83, 0x58, 0xb1, 0xa3, 0xce,
+	0x83, 0x5d, 0xae, 0xa4, 0x96, 0x64, 0x56, 0xfb, 0xe7, 0x8f, 0x23, 0xa1, 0xe3, 0x32, 0xd8, 0x85,
+	0x32, 0xf5, 0x53, 0xa6, 0x95, 0x78, 0x2d, 0x95, 0x88, 0x44, 0x66, 0x9d, 0xb0, 0x0c, 0xb8, 0x9f,
+	0x07, 0x7e, 0x10, 0xa7, 0x5c, 0xb3, 0x8e, 0x51, 0x11, 0x9d, 0xbf, 0xb8, 0x45, 0x7a, 0x28, 0xd3,
+	0x5c, 0x66, 0x3c, 0xd3, 0x85, 0x9f, 0x2b, 0x99, 0xc7, 0x5c, 0x1b, 0x46, 0xcb, 0xd7, 0x63, 0x7b,
+	0xd4, 0x61, 0x8b, 0x64, 0x24, 0x7d, 0x0c, 0x07, 0xe5, 0x11, 0x3d, 0x74, 0xd0, 0xb2, 0xf0, 0x87,
+	0x91, 0xdc, 0x71, 0x1d, 0x1e, 0x76, 0x42, 0xfa, 0xe6, 0xd7, 0x37, 0x3d, 0xf9, 0x55, 0x63, 0xf8,
+	0x53, 0xe1, 0xbc, 0xdf, 0x86, 0xb0, 0xa0, 0xec, 0xa8, 0x5f, 0xf2, 0xa2, 0x60, 0x11, 0x27, 0x2e,
+	0x4c, 0x8b, 0x98, 0xa9, 0xc3, 0xd5, 0x57, 0xae, 0xb3, 0x71, 0xb6, 0x23, 0x5a, 0xbb, 0xe4, 0x0c,
+	0xc6, 0x91, 0x92, 0x65, 0xee, 0x0e, 0x30, 0x5e, 0x39, 0xe4, 0x21, 0x8c, 0x8e, 0x4a, 0xa6, 0xee,
+	0x70, 0xe3, 0x6c, 0x17, 0x17, 0xcb, 0x9d, 0xad, 0xf9, 0x3b, 0xce, 0xd5, 0xe5, 0xe8, 0xcd, 0x1f,
+	0x0f, 0xee, 0x50, 0x3c, 0x27, 0x1e, 0x0c, 0xb4, 0x74, 0x47, 0xff, 0x8b, 0x1a, 0x68, 0x49, 0x7c,
+	0x98, 0xa6, 0x55, 0x19, 0xee, 0x18, 0x81, 0x77, 0x77, 0x76, 0x32, 0xb6, 0x3a, 0x8b, 0xad, 0x51,
+	0xe4, 0x73, 0x00, 0xac, 0xee, 0x69, 0x2e, 0xc3, 0xd8, 0x9d, 0x60, 0xce, 0xbd, 0x9a, 0x9c, 0xf2,
+	0x42, 0x96, 0x2a, 0xe4, 0x78, 0x68, 0x33, 0x3b, 0x70, 0xb2, 0x81, 0x85, 0x28, 0x5e, 0xc9, 0x34,
+	0x28, 0xb4, 0xcc, 0xb8, 0x3b, 0xdd, 0x38, 0xdb, 0x19, 0xed, 0x86, 0x4c, 0xc7, 0x85, 0x66, 0x4a,
+	0xbb, 0xb3, 0x8d, 0xb3, 0x5d, 0xd2, 0xca, 0x21, 0x2b, 0x18, 0xf2, 0xec, 0xe0, 0xce, 0x31, 0x66,
+	0x4c, 0xe2, 0xc1, 0xf2, 0x20, 0x0a, 0x16, 0x24, 0x7c, 0x9f, 0x27, 0x42, 0xbb, 0x80, 0x54, 0xbd,
+	0x18, 0x79, 0x0f, 0x26, 0x65, 0x26, 0x7e, 0x28, 0xb9, 0xbb, 0xd8, 0x38, 0xdb, 0x39, 0xb5, 0x1e,
+	0x59, 0x03, 0xa8, 0x32, 0xe1, 0x5f, 0x1b, 0x31, 0x0b, 0x77, 0xb9, 0x19, 0x6e, 0xe7, 0xb4, 0x13,
+	0x21, 0xf7, 0x61, 0x1e, 0x8b, 0x80, 0xab, 0x8c, 0x69, 0xee, 0x9e, 0x20, 0x71, 0x1b, 0xf0, 0x9e,
+	0xc2, 0xaa, 0x33, 0xbc, 0x4b, 0xa6, 0xc3, 0x98, 0x7c, 0x04, 0x33, 0xab, 0x4f, 0xe1, 0x3a, 0x9b,
+	0x21, 0x4a, 0xd2, 0xac, 0x78, 0x07, 0x4d, 0x1b, 0x98, 0xf7, 0xab, 0x03, 0x77, 0xf7, 0x46, 0x99,
+	0x17, 0x32, 0x64, 0xc9, 0x5e, 0x33, 0xcd, 0xc9, 0xfb, 0xd8, 0xbc, 0xe6, 0xb8, 0x06, 0xa7, 0x17,
+	0xef, 0xb6, 0x1c, 0x66, 0x6a, 0x88, 0xa1, 0x15, 0x82, 0x7c, 0x08, 0x63, 0xd4, 0xd5, 0x1d, 0xd8,
+	0xa9, 0x35, 0x17, 0x01, 0x49, 0xad, 0xf6, 0x15, 0x86, 0x7c, 0x02, 0x90, 0x72, 0x15, 0x71, 0x64,
+	0xb0, 0x6b, 0x73, 0xd6, 0x92, 0xbf, 0x6c, 0xce, 0x68, 0x07, 0xe7, 0xed, 0x01, 0xda, 0x13, 0x23,
+	0x66, 0x28, 0xd3, 0x54, 0x68, 0xbb, 0xa3, 0xd6, 0x23, 0x8f, 0x60, 0xa2, 0x99, 0x8a, 0xb8, 0xbe,
+	0xb9, 0x12, 0x0b, 0xf2, 0x38, 0x9c, 0x1a, 0x3d, 0x3a, 0x4d, 0x7f, 0x0a, 0x73, 0x83, 0xdb, 0x37,
+	0x8d, 0x2f, 0x2e, 0xde, 0xa9, 0x77, 0xf0, 0x79, 0x7d, 0x60, 0x59, 0x5a, 0xa4, 0x19, 0x52, 0xc2,
+	0x0a, 0x7d, 0x95, 0x1d, 0xf8, 0x6b, 0x7b, 0x3d, 0xda, 0x80, 0xf7, 0x05, 0x10, 0xf3, 0x99, 0x57,
+	0xaa, 0xcc, 0x42, 0xa6, 0xb9, 0xcd, 0x39, 0x83, 0xb1, 0x40, 0x7c, 0xd5, 0x42, 0xe5, 0x10, 0x02,
+	0x23, 0xcd, 0x55, 0x6a, 0x49, 0xd0, 0xf6, 0x7e, 0x76, 0xaa, 0x3a, 0x9f, 0xe4, 0x79, 0xf2, 0x63,
+	0x95, 0xec, 0xc1, 0x92, 0xe5, 0x79, 0x22, 0xf8, 0xe1, 0xaa, 0xc3, 0xd1, 0x8b, 0x91, 0x6f, 0xe0,
+	0x54, 0xf7, 0x3e, 0x69, 0x45, 0xb9, 0xdf, 0xdf, 0x86, 0x7e, 0x59, 0xb6, 0xb7, 0x7f, 0x64, 0x7a,
+	0xbf, 0x0c, 0xe0, 0xde, 0x3e, 0x63, 0x79, 0x11, 0xcb, 0x7a, 0x7d, 0x9e, 0x73, 0x76, 0xe0, 0xaa,
+	0x9d, 0xbd, 0x73, 0x8b, 0xd9, 0xd7, 0x8f, 0xc5, 0xe0, 0x56, 0x8f, 0xc5, 0xf0, 0xc6, 0xc7, 0xa2,
+	0x56, 0x6a, 0xd4, 0x2a, 0xd5, 0x6a, 0x3a, 0xee, 0x6a, 0x7a, 0x0e, 0xb3, 0x30, 0xe6, 0xe1, 0xf7,
+	0x45, 0x99, 0xe2, 0x1b, 0x71, 0x42, 0x1b, 0x9f, 0x7c, 0x06, 0xe3, 0xa3, 0x48, 0x78, 0xe1, 0x4e,
+	0xf1, 0xa6, 0xac, 0x5b, 0x6d, 0xea, 0x76, 0x9f, 0x89, 0x84, 0x7f, 0x69, 0xe1, 0x75, 0x37, 0x98,
+	0xe2, 0x3d, 0x83, 0xb3, 0xff, 0x02, 0x99, 0xca, 0x32, 0x96, 0x56, 0xfb, 0x33, 0xa7, 0x68, 0xf7,
+	0x6a, 0x18, 0xf4, 0x6b, 0xf0, 0x7e, 0x37, 0xb7, 0xaf, 0x2f, 0x2e, 0x79, 0x0c, 0x93, 0x18, 0x05,
+	0xb6, 0xba, 0x3e, 0xf8, 0x77, 0x61, 0xbd, 0x39, 0xd4, 0x9b, 0x5d, 0x25, 0x99, 0x12, 0x0e, 0x4c,
+	0x33, 0xfc, 0xd4, 0x92, 0xa2, 0x6d, 0xc4, 0x39, 0x0a, 0x55, 0x68, 0xd4, 0x75, 0x46, 0x2b, 0xc7,
+	0x20, 0xcd, 0xa6, 0xa2, 0x8c, 0x33, 0x8a, 0xb6, 0x29, 0xd6, 0x74, 0xb8, 0x17, 0x3f, 0x71, 0xab,
+	0x64, 0xe3, 0x37, 0x8d, 0xec, 0xad, 0x98, 0x23, 0xda, 0xf8, 0x1f, 0x3c, 0x81, 0x79, 0xf3, 0x36,
+	0x10, 0x80, 0xc9, 0xb7, 0x52, 0xa5, 0x2c, 0x59, 0xdd, 0x21, 0x4b, 0x98, 0xe1, 0xf2, 0x8a, 0x2c,
+	0x5a, 0x39, 0xe4, 0x04, 0xe6, 0xcd, 0x1b, 0xbb, 0x1a, 0x90, 0x05, 0x4c, 0xcd, 0xd5, 0x36, 0x67,
+	0xc3, 0xcb, 0xd5, 0xdb, 0xbf, 0xd6, 0xce, 0x9b, 0xeb, 0xb5, 0xf3, 0xf6, 0x7a, 0xed, 0xfc, 0x79,
+	0xbd, 0x76, 0x82, 0x09, 0xfe, 0x4f, 0x7d, 0xfc, 0xf7, 0x00, 0x79, 0x81, 0xf2, 0x72, 0xa7, 0x07,
+	0x00, 0x00,
 }
 
 func (m *RaftMessage) Marshal() (dAtA []byte, err error) {
@@ -757,6 +973,49 @@ func (m *RaftMessage) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.Hibernate {
+		dAtA[i] = 0x68
+		i++
+		if m.Hibernate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RaftMessageBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftMessageBatch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBhraftpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -791,6 +1050,50 @@ func (m *ShardLocalState) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n5
+	if m.MergeState != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.MergeState.Size()))
+		n6, err := m.MergeState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MergeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Commit))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Target.Size()))
+	n7, err := m.Target.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -815,11 +1118,11 @@ func (m *RaftLocalState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintBhraftpb(dAtA, i, uint64(m.HardState.Size()))
-	n6, err := m.HardState.MarshalTo(dAtA[i:])
+	n8, err := m.HardState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
 	if m.LastIndex != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -885,11 +1188,11 @@ func (m *RaftApplyState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintBhraftpb(dAtA, i, uint64(m.TruncatedState.Size()))
-	n7, err := m.TruncatedState.MarshalTo(dAtA[i:])
+	n9, err := m.TruncatedState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n9
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -914,27 +1217,27 @@ func (m *SnapshotMessageHeader) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Shard.Size()))
-	n8, err := m.Shard.MarshalTo(dAtA[i:])
+	n10, err := m.Shard.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n10
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintBhraftpb(dAtA, i, uint64(m.From.Size()))
-	n9, err := m.From.MarshalTo(dAtA[i:])
+	n11, err := m.From.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n11
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintBhraftpb(dAtA, i, uint64(m.To.Size()))
-	n10, err := m.To.MarshalTo(dAtA[i:])
+	n12, err := m.To.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n12
 	if m.Term != 0 {
 		dAtA[i] = 0x20
 		i++
@@ -945,6 +1248,55 @@ func (m *SnapshotMessageHeader) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Index))
 	}
+	if m.Checksum != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Checksum))
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintBhraftpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SnapshotFileChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotFileChecksum) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBhraftpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Checksum != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBhraftpb(dAtA, i, uint64(m.Checksum))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -969,11 +1321,11 @@ func (m *SnapshotMessage) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintBhraftpb(dAtA, i, uint64(m.Header.Size()))
-	n11, err := m.Header.MarshalTo(dAtA[i:])
+	n13, err := m.Header.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n13
 	if len(m.Data) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1069,39 +1421,81 @@ func (m *RaftMessage) Size() (n int) {
 			n += 1 + l + sovBhraftpb(uint64(l))
 		}
 	}
+	if m.Hibernate {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ShardLocalState) Size() (n int) {
+func (m *RaftMessageBatch) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovBhraftpb(uint64(m.State))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovBhraftpb(uint64(l))
+		}
 	}
-	l = m.Shard.Size()
-	n += 1 + l + sovBhraftpb(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *RaftLocalState) Size() (n int) {
+func (m *ShardLocalState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.HardState.Size()
-	n += 1 + l + sovBhraftpb(uint64(l))
-	if m.LastIndex != 0 {
-		n += 1 + sovBhraftpb(uint64(m.LastIndex))
+	if m.State != 0 {
+		n += 1 + sovBhraftpb(uint64(m.State))
+	}
+	l = m.Shard.Size()
+	n += 1 + l + sovBhraftpb(uint64(l))
+	if m.MergeState != nil {
+		l = m.MergeState.Size()
+		n += 1 + l + sovBhraftpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		n += 1 + sovBhraftpb(uint64(m.Commit))
+	}
+	l = m.Target.Size()
+	n += 1 + l + sovBhraftpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftLocalState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HardState.Size()
+	n += 1 + l + sovBhraftpb(uint64(l))
+	if m.LastIndex != 0 {
+		n += 1 + sovBhraftpb(uint64(m.LastIndex))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1162,6 +1556,34 @@ func (m *SnapshotMessageHeader) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovBhraftpb(uint64(m.Index))
 	}
+	if m.Checksum != 0 {
+		n += 1 + sovBhraftpb(uint64(m.Checksum))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovBhraftpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotFileChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBhraftpb(uint64(l))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovBhraftpb(uint64(m.Checksum))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1582,6 +2004,114 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.RuleGroups = append(m.RuleGroups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hibernate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hibernate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhraftpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftMessageBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhraftpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftMessageBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftMessageBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &RaftMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhraftpb(dAtA[iNdEx:])
@@ -1688,6 +2218,148 @@ func (m *ShardLocalState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeState == nil {
+				m.MergeState = &MergeState{}
+			}
+			if err := m.MergeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhraftpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhraftpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhraftpb(dAtA[iNdEx:])
@@ -2183,6 +2855,164 @@ func (m *SnapshotMessageHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, SnapshotFileChecksum{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBhraftpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotFileChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBhraftpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotFileChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotFileChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBhraftpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBhraftpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBhraftpb(dAtA[iNdEx:])
@@ -72,17 +72,23 @@ const (
 	AdminCmdType_VerifyHash     AdminCmdType = 5
 	AdminCmdType_BatchSplit     AdminCmdType = 6
 	AdminCmdType_ChangePeerV2   AdminCmdType = 7
+	AdminCmdType_PrepareMerge   AdminCmdType = 8
+	AdminCmdType_CommitMerge    AdminCmdType = 9
+	AdminCmdType_RollbackMerge  AdminCmdType = 10
 )
 
 var AdminCmdType_name = map[int32]string{
-	0: "InvalidAdmin",
-	1: "ChangePeer",
-	2: "CompactLog",
-	3: "TransferLeader",
-	4: "ComputeHash",
-	5: "VerifyHash",
-	6: "BatchSplit",
-	7: "ChangePeerV2",
+	0:  "InvalidAdmin",
+	1:  "ChangePeer",
+	2:  "CompactLog",
+	3:  "TransferLeader",
+	4:  "ComputeHash",
+	5:  "VerifyHash",
+	6:  "BatchSplit",
+	7:  "ChangePeerV2",
+	8:  "PrepareMerge",
+	9:  "CommitMerge",
+	10: "RollbackMerge",
 }
 
 var AdminCmdType_value = map[string]int32{
@@ -94,6 +100,9 @@ var AdminCmdType_value = map[string]int32{
 	"VerifyHash":     5,
 	"BatchSplit":     6,
 	"ChangePeerV2":   7,
+	"PrepareMerge":   8,
+	"CommitMerge":    9,
+	"RollbackMerge":  10,
 }
 
 func (x AdminCmdType) String() string {
@@ -112,6 +121,7 @@ type RaftRequestHeader struct {
 	Epoch                metapb.ResourceEpoch `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch"`
 	Term                 uint64               `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
 	IgnoreEpochCheck     bool                 `protobuf:"varint,7,opt,name=ignoreEpochCheck,proto3" json:"ignoreEpochCheck,omitempty"`
+	ProposeTime          int64                `protobuf:"varint,8,opt,name=proposeTime,proto3" json:"proposeTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -192,6 +202,13 @@ func (m *RaftRequestHeader) GetIgnoreEpochCheck() bool {
 	return false
 }
 
+func (m *RaftRequestHeader) GetProposeTime() int64 {
+	if m != nil {
+		return m.ProposeTime
+	}
+	return 0
+}
+
 type RaftResponseHeader struct {
 	ID                   []byte        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Error                errorpb.Error `protobuf:"bytes,2,opt,name=error,proto3" json:"error"`
@@ -392,6 +409,9 @@ type AdminRequest struct {
 	VerifyHash           *VerifyHashRequest     `protobuf:"bytes,5,opt,name=verifyHash,proto3" json:"verifyHash,omitempty"`
 	Splits               *BatchSplitRequest     `protobuf:"bytes,6,opt,name=splits,proto3" json:"splits,omitempty"`
 	ChangePeerV2         *ChangePeerV2Request   `protobuf:"bytes,7,opt,name=changePeerV2,proto3" json:"changePeerV2,omitempty"`
+	PrepareMerge         *PrepareMergeRequest   `protobuf:"bytes,8,opt,name=prepareMerge,proto3" json:"prepareMerge,omitempty"`
+	CommitMerge          *CommitMergeRequest    `protobuf:"bytes,9,opt,name=commitMerge,proto3" json:"commitMerge,omitempty"`
+	RollbackMerge        *RollbackMergeRequest  `protobuf:"bytes,10,opt,name=rollbackMerge,proto3" json:"rollbackMerge,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -479,6 +499,27 @@ func (m *AdminRequest) GetChangePeerV2() *ChangePeerV2Request {
 	return nil
 }
 
+func (m *AdminRequest) GetPrepareMerge() *PrepareMergeRequest {
+	if m != nil {
+		return m.PrepareMerge
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetCommitMerge() *CommitMergeRequest {
+	if m != nil {
+		return m.CommitMerge
+	}
+	return nil
+}
+
+func (m *AdminRequest) GetRollbackMerge() *RollbackMergeRequest {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
+}
+
 // AdminResponse admin response
 type AdminResponse struct {
 	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmdType,proto3,enum=raftcmdpb.AdminCmdType" json:"cmdType,omitempty"`
@@ -488,6 +529,10 @@ type AdminResponse struct {
 	VerifyHash           *VerifyHashResponse     `protobuf:"bytes,5,opt,name=verifyHash,proto3" json:"verifyHash,omitempty"`
 	Splits               *BatchSplitResponse     `protobuf:"bytes,9,opt,name=splits,proto3" json:"splits,omitempty"`
 	ChangePeerV2         *ChangePeerV2Response   `protobuf:"bytes,10,opt,name=changePeerV2,proto3" json:"changePeerV2,omitempty"`
+	PrepareMerge         *PrepareMergeResponse   `protobuf:"bytes,11,opt,name=prepareMerge,proto3" json:"prepareMerge,omitempty"`
+	CommitMerge          *CommitMergeResponse    `protobuf:"bytes,12,opt,name=commitMerge,proto3" json:"commitMerge,omitempty"`
+	RollbackMerge        *RollbackMergeResponse  `protobuf:"bytes,13,opt,name=rollbackMerge,proto3" json:"rollbackMerge,omitempty"`
+	ComputeHash          *ComputeHashResponse    `protobuf:"bytes,14,opt,name=computeHash,proto3" json:"computeHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -575,21 +620,60 @@ func (m *AdminResponse) GetChangePeerV2() *ChangePeerV2Response {
 	return nil
 }
 
+func (m *AdminResponse) GetPrepareMerge() *PrepareMergeResponse {
+	if m != nil {
+		return m.PrepareMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetCommitMerge() *CommitMergeResponse {
+	if m != nil {
+		return m.CommitMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetRollbackMerge() *RollbackMergeResponse {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetComputeHash() *ComputeHashResponse {
+	if m != nil {
+		return m.ComputeHash
+	}
+	return nil
+}
+
 // Request request
 type Request struct {
-	ID                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Group                uint64   `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
-	Type                 CMDType  `protobuf:"varint,3,opt,name=type,proto3,enum=raftcmdpb.CMDType" json:"type,omitempty"`
-	CustemType           uint64   `protobuf:"varint,4,opt,name=custemType,proto3" json:"custemType,omitempty"`
-	Key                  []byte   `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
-	Cmd                  []byte   `protobuf:"bytes,6,opt,name=cmd,proto3" json:"cmd,omitempty"`
-	SID                  int64    `protobuf:"varint,7,opt,name=sid,proto3" json:"sid,omitempty"`
-	PID                  int64    `protobuf:"varint,8,opt,name=pid,proto3" json:"pid,omitempty"`
-	StopAt               int64    `protobuf:"varint,9,opt,name=stopAt,proto3" json:"stopAt,omitempty"`
-	ToShard              uint64   `protobuf:"varint,10,opt,name=toShard,proto3" json:"toShard,omitempty"`
-	AllowFollower        bool     `protobuf:"varint,11,opt,name=allowFollower,proto3" json:"allowFollower,omitempty"`
-	LastBroadcast        bool     `protobuf:"varint,12,opt,name=lastBroadcast,proto3" json:"lastBroadcast,omitempty"`
-	IgnoreEpochCheck     bool     `protobuf:"varint,13,opt,name=ignoreEpochCheck,proto3" json:"ignoreEpochCheck,omitempty"`
+	ID         []byte  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group      uint64  `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
+	Type       CMDType `protobuf:"varint,3,opt,name=type,proto3,enum=raftcmdpb.CMDType" json:"type,omitempty"`
+	CustemType uint64  `protobuf:"varint,4,opt,name=custemType,proto3" json:"custemType,omitempty"`
+	Key        []byte  `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Cmd        []byte  `protobuf:"bytes,6,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	SID        int64   `protobuf:"varint,7,opt,name=sid,proto3" json:"sid,omitempty"`
+	PID        int64   `protobuf:"varint,8,opt,name=pid,proto3" json:"pid,omitempty"`
+	// stopAt is the deadline of the request in unix seconds, it's kept for the old
+	// versions, use pb.SetStopAt and pb.GetStopAt to access the deadline
+	StopAt           int64  `protobuf:"varint,9,opt,name=stopAt,proto3" json:"stopAt,omitempty"`
+	ToShard          uint64 `protobuf:"varint,10,opt,name=toShard,proto3" json:"toShard,omitempty"`
+	AllowFollower    bool   `protobuf:"varint,11,opt,name=allowFollower,proto3" json:"allowFollower,omitempty"`
+	LastBroadcast    bool   `protobuf:"varint,12,opt,name=lastBroadcast,proto3" json:"lastBroadcast,omitempty"`
+	IgnoreEpochCheck bool   `protobuf:"varint,13,opt,name=ignoreEpochCheck,proto3" json:"ignoreEpochCheck,omitempty"`
+	// keyRange is not nil means the request is a range request on the keys in
+	// [keyRange.start, keyRange.end) of the shard
+	KeyRange *KeyRange `protobuf:"bytes,14,opt,name=keyRange,proto3" json:"keyRange,omitempty"`
+	// stopAtNanos is the deadline of the request in unix nanoseconds, it's preferred
+	// to the stopAt if it's set
+	StopAtNanos int64 `protobuf:"varint,15,opt,name=stopAtNanos,proto3" json:"stopAtNanos,omitempty"`
+	// cancel is true if the request is used to cancel the queued request with the
+	// same id, it's routed as the cancelled request and never responded
+	Cancel               bool     `protobuf:"varint,16,opt,name=cancel,proto3" json:"cancel,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -719,27 +803,117 @@ func (m *Request) GetIgnoreEpochCheck() bool {
 	return false
 }
 
+func (m *Request) GetKeyRange() *KeyRange {
+	if m != nil {
+		return m.KeyRange
+	}
+	return nil
+}
+
+func (m *Request) GetStopAtNanos() int64 {
+	if m != nil {
+		return m.StopAtNanos
+	}
+	return 0
+}
+
+func (m *Request) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
+// KeyRange the key range [start, end) of the range request
+type KeyRange struct {
+	Start []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// limit is the max count of the items returned, 0 means no limit
+	Limit                uint64   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyRange) Reset()         { *m = KeyRange{} }
+func (m *KeyRange) String() string { return proto.CompactTextString(m) }
+func (*KeyRange) ProtoMessage()    {}
+func (*KeyRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{7}
+}
+func (m *KeyRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRange.Merge(m, src)
+}
+func (m *KeyRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRange proto.InternalMessageInfo
+
+func (m *KeyRange) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *KeyRange) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *KeyRange) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // Response response
 type Response struct {
-	ID                   []byte        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                 CMDType       `protobuf:"varint,2,opt,name=type,proto3,enum=raftcmdpb.CMDType" json:"type,omitempty"`
-	Value                []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	OriginRequest        *Request      `protobuf:"bytes,4,opt,name=originRequest,proto3" json:"originRequest,omitempty"`
-	SID                  int64         `protobuf:"varint,5,opt,name=sid,proto3" json:"sid,omitempty"`
-	PID                  int64         `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
-	Error                errorpb.Error `protobuf:"bytes,7,opt,name=error,proto3" json:"error"`
-	ContinueBroadcast    bool          `protobuf:"varint,8,opt,name=continueBroadcast,proto3" json:"continueBroadcast,omitempty"`
-	Stale                bool          `protobuf:"varint,9,opt,name=stale,proto3" json:"stale,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	ID                []byte        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              CMDType       `protobuf:"varint,2,opt,name=type,proto3,enum=raftcmdpb.CMDType" json:"type,omitempty"`
+	Value             []byte        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	OriginRequest     *Request      `protobuf:"bytes,4,opt,name=originRequest,proto3" json:"originRequest,omitempty"`
+	SID               int64         `protobuf:"varint,5,opt,name=sid,proto3" json:"sid,omitempty"`
+	PID               int64         `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	Error             errorpb.Error `protobuf:"bytes,7,opt,name=error,proto3" json:"error"`
+	ContinueBroadcast bool          `protobuf:"varint,8,opt,name=continueBroadcast,proto3" json:"continueBroadcast,omitempty"`
+	Stale             bool          `protobuf:"varint,9,opt,name=stale,proto3" json:"stale,omitempty"`
+	// count is the count of the items returned by the range request
+	Count uint64 `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+	// nextKey is the key to continue if the range request stopped by the limit
+	NextKey              []byte   `protobuf:"bytes,11,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{7}
+	return fileDescriptor_c4d8ad5550754569, []int{8}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,6 +1005,20 @@ func (m *Response) GetStale() bool {
 	return false
 }
 
+func (m *Response) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Response) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
 type ChangePeerRequest struct {
 	// This can be only called in internal RaftStore now.
 	ChangeType           metapb.ChangePeerType `protobuf:"varint,1,opt,name=changeType,proto3,enum=metapb.ChangePeerType" json:"changeType,omitempty"`
@@ -844,7 +1032,7 @@ func (m *ChangePeerRequest) Reset()         { *m = ChangePeerRequest{} }
 func (m *ChangePeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerRequest) ProtoMessage()    {}
 func (*ChangePeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{8}
+	return fileDescriptor_c4d8ad5550754569, []int{9}
 }
 func (m *ChangePeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1087,7 @@ func (m *ChangePeerResponse) Reset()         { *m = ChangePeerResponse{} }
 func (m *ChangePeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerResponse) ProtoMessage()    {}
 func (*ChangePeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{9}
+	return fileDescriptor_c4d8ad5550754569, []int{10}
 }
 func (m *ChangePeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,7 +1136,7 @@ func (m *CompactLogRequest) Reset()         { *m = CompactLogRequest{} }
 func (m *CompactLogRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactLogRequest) ProtoMessage()    {}
 func (*CompactLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{10}
+	return fileDescriptor_c4d8ad5550754569, []int{11}
 }
 func (m *CompactLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1190,7 @@ func (m *CompactLogResponse) Reset()         { *m = CompactLogResponse{} }
 func (m *CompactLogResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactLogResponse) ProtoMessage()    {}
 func (*CompactLogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{11}
+	return fileDescriptor_c4d8ad5550754569, []int{12}
 }
 func (m *CompactLogResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1043,7 +1231,7 @@ func (m *TransferLeaderRequest) Reset()         { *m = TransferLeaderRequest{} }
 func (m *TransferLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderRequest) ProtoMessage()    {}
 func (*TransferLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{12}
+	return fileDescriptor_c4d8ad5550754569, []int{13}
 }
 func (m *TransferLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1277,7 @@ func (m *TransferLeaderResponse) Reset()         { *m = TransferLeaderResponse{}
 func (m *TransferLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderResponse) ProtoMessage()    {}
 func (*TransferLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{13}
+	return fileDescriptor_c4d8ad5550754569, []int{14}
 }
 func (m *TransferLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1319,7 @@ func (m *VerifyHashRequest) Reset()         { *m = VerifyHashRequest{} }
 func (m *VerifyHashRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyHashRequest) ProtoMessage()    {}
 func (*VerifyHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{14}
+	return fileDescriptor_c4d8ad5550754569, []int{15}
 }
 func (m *VerifyHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1379,7 @@ func (m *VerifyHashResponse) Reset()         { *m = VerifyHashResponse{} }
 func (m *VerifyHashResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyHashResponse) ProtoMessage()    {}
 func (*VerifyHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{15}
+	return fileDescriptor_c4d8ad5550754569, []int{16}
 }
 func (m *VerifyHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,6 +1408,62 @@ func (m *VerifyHashResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyHashResponse proto.InternalMessageInfo
 
+// ComputeHashResponse compute the hash of the shard data at the applied index
+type ComputeHashResponse struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ComputeHashResponse) Reset()         { *m = ComputeHashResponse{} }
+func (m *ComputeHashResponse) String() string { return proto.CompactTextString(m) }
+func (*ComputeHashResponse) ProtoMessage()    {}
+func (*ComputeHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{17}
+}
+func (m *ComputeHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComputeHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComputeHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComputeHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComputeHashResponse.Merge(m, src)
+}
+func (m *ComputeHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComputeHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComputeHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComputeHashResponse proto.InternalMessageInfo
+
+func (m *ComputeHashResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ComputeHashResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type SplitRequest struct {
 	// This can be only called in internal RaftStore now.
 	// The split_key must be in the been splitting region.
@@ -1239,7 +1483,7 @@ func (m *SplitRequest) Reset()         { *m = SplitRequest{} }
 func (m *SplitRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitRequest) ProtoMessage()    {}
 func (*SplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{16}
+	return fileDescriptor_c4d8ad5550754569, []int{18}
 }
 func (m *SplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1303,7 +1547,7 @@ func (m *BatchSplitRequest) Reset()         { *m = BatchSplitRequest{} }
 func (m *BatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchSplitRequest) ProtoMessage()    {}
 func (*BatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{17}
+	return fileDescriptor_c4d8ad5550754569, []int{19}
 }
 func (m *BatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1601,7 @@ func (m *BatchSplitResponse) Reset()         { *m = BatchSplitResponse{} }
 func (m *BatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchSplitResponse) ProtoMessage()    {}
 func (*BatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{18}
+	return fileDescriptor_c4d8ad5550754569, []int{20}
 }
 func (m *BatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,7 +1648,7 @@ func (m *ChangePeerV2Request) Reset()         { *m = ChangePeerV2Request{} }
 func (m *ChangePeerV2Request) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerV2Request) ProtoMessage()    {}
 func (*ChangePeerV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{19}
+	return fileDescriptor_c4d8ad5550754569, []int{21}
 }
 func (m *ChangePeerV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1695,7 @@ func (m *ChangePeerV2Response) Reset()         { *m = ChangePeerV2Response{} }
 func (m *ChangePeerV2Response) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerV2Response) ProtoMessage()    {}
 func (*ChangePeerV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4d8ad5550754569, []int{20}
+	return fileDescriptor_c4d8ad5550754569, []int{22}
 }
 func (m *ChangePeerV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,137 +1731,447 @@ func (m *ChangePeerV2Response) GetShard() *bhmetapb.Shard {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("raftcmdpb.CMDType", CMDType_name, CMDType_value)
-	proto.RegisterEnum("raftcmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
-	proto.RegisterType((*RaftRequestHeader)(nil), "raftcmdpb.RaftRequestHeader")
-	proto.RegisterType((*RaftResponseHeader)(nil), "raftcmdpb.RaftResponseHeader")
-	proto.RegisterType((*RaftCMDRequest)(nil), "raftcmdpb.RaftCMDRequest")
-	proto.RegisterType((*RaftCMDResponse)(nil), "raftcmdpb.RaftCMDResponse")
-	proto.RegisterType((*AdminRequest)(nil), "raftcmdpb.AdminRequest")
-	proto.RegisterType((*AdminResponse)(nil), "raftcmdpb.AdminResponse")
-	proto.RegisterType((*Request)(nil), "raftcmdpb.Request")
-	proto.RegisterType((*Response)(nil), "raftcmdpb.Response")
-	proto.RegisterType((*ChangePeerRequest)(nil), "raftcmdpb.ChangePeerRequest")
-	proto.RegisterType((*ChangePeerResponse)(nil), "raftcmdpb.ChangePeerResponse")
-	proto.RegisterType((*CompactLogRequest)(nil), "raftcmdpb.CompactLogRequest")
-	proto.RegisterType((*CompactLogResponse)(nil), "raftcmdpb.CompactLogResponse")
-	proto.RegisterType((*TransferLeaderRequest)(nil), "raftcmdpb.TransferLeaderRequest")
-	proto.RegisterType((*TransferLeaderResponse)(nil), "raftcmdpb.TransferLeaderResponse")
-	proto.RegisterType((*VerifyHashRequest)(nil), "raftcmdpb.VerifyHashRequest")
-	proto.RegisterType((*VerifyHashResponse)(nil), "raftcmdpb.VerifyHashResponse")
-	proto.RegisterType((*SplitRequest)(nil), "raftcmdpb.SplitRequest")
-	proto.RegisterType((*BatchSplitRequest)(nil), "raftcmdpb.BatchSplitRequest")
-	proto.RegisterType((*BatchSplitResponse)(nil), "raftcmdpb.BatchSplitResponse")
-	proto.RegisterType((*ChangePeerV2Request)(nil), "raftcmdpb.ChangePeerV2Request")
-	proto.RegisterType((*ChangePeerV2Response)(nil), "raftcmdpb.ChangePeerV2Response")
+// PrepareMergeRequest is proposed by the source shard, after applied, the source
+// shard will reject all write requests and wait to be merged into the target shard.
+type PrepareMergeRequest struct {
+	Target               bhmetapb.Shard `protobuf:"bytes,1,opt,name=target,proto3" json:"target"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func init() { proto.RegisterFile("raftcmdpb.proto", fileDescriptor_c4d8ad5550754569) }
+func (m *PrepareMergeRequest) Reset()         { *m = PrepareMergeRequest{} }
+func (m *PrepareMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeRequest) ProtoMessage()    {}
+func (*PrepareMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{23}
+}
+func (m *PrepareMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepareMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepareMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrepareMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeRequest.Merge(m, src)
+}
+func (m *PrepareMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepareMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_c4d8ad5550754569 = []byte{
-	// 1380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x45, 0xfd, 0x79, 0x44, 0xdb, 0xf4, 0xc6, 0x71, 0xd9, 0xa0, 0xb6, 0x55, 0xa2, 0x2d,
-	0x0c, 0xb7, 0xb1, 0x11, 0x35, 0x6d, 0x51, 0x24, 0x6e, 0x61, 0x49, 0x29, 0x22, 0x34, 0x01, 0x02,
-	0x2a, 0x48, 0xd0, 0x23, 0x45, 0xae, 0x25, 0x36, 0x12, 0xc9, 0x2e, 0x57, 0x4e, 0xdc, 0x6b, 0x1f,
-	0xa2, 0x8f, 0x93, 0x5b, 0x90, 0x4b, 0x81, 0x3c, 0x81, 0x91, 0xfa, 0x49, 0x8a, 0xfd, 0x21, 0xb9,
-	0x34, 0x25, 0x3b, 0xe8, 0xc5, 0xe2, 0xfc, 0xed, 0xce, 0xcc, 0x37, 0x3b, 0x33, 0x86, 0x75, 0xe2,
-	0x9e, 0x50, 0x6f, 0xe6, 0xc7, 0xa3, 0x83, 0x98, 0x44, 0x34, 0x42, 0x2b, 0x19, 0xe3, 0xf6, 0xd1,
-	0x38, 0xa0, 0x93, 0xf9, 0xe8, 0xc0, 0x8b, 0x66, 0x87, 0x33, 0x97, 0x92, 0xe0, 0x75, 0x44, 0x82,
-	0x71, 0x10, 0x4a, 0xc2, 0x9b, 0x8f, 0xf0, 0x61, 0x3c, 0x3a, 0x1c, 0x4d, 0x66, 0x98, 0xba, 0xca,
-	0x87, 0x38, 0xe9, 0xf6, 0xfd, 0x8f, 0x33, 0xc7, 0x84, 0x44, 0x24, 0xff, 0x95, 0xc6, 0x8f, 0x3f,
-	0xc2, 0xd8, 0x8b, 0x66, 0x71, 0x14, 0xe2, 0x90, 0x26, 0x87, 0x31, 0x89, 0xe2, 0x09, 0xa6, 0xec,
-	0x3c, 0xe9, 0x4c, 0xc1, 0x95, 0x3b, 0xca, 0x69, 0xe3, 0x68, 0x1c, 0x1d, 0x72, 0xf6, 0x68, 0x7e,
-	0xc2, 0x29, 0x4e, 0xf0, 0x2f, 0xa1, 0x6e, 0x7f, 0xd0, 0x60, 0xc3, 0x71, 0x4f, 0xa8, 0x83, 0xff,
-	0x98, 0xe3, 0x84, 0x3e, 0xc2, 0xae, 0x8f, 0x09, 0xda, 0x82, 0x4a, 0xe0, 0x5b, 0x5a, 0x5b, 0xdb,
-	0x33, 0xba, 0xf5, 0x8b, 0xf3, 0xdd, 0xca, 0xa0, 0xef, 0x54, 0x02, 0x1f, 0x59, 0xd0, 0x48, 0x26,
-	0x2e, 0xf1, 0x07, 0x7d, 0xab, 0xd2, 0xd6, 0xf6, 0xaa, 0x4e, 0x4a, 0xa2, 0xaf, 0xa0, 0x1a, 0x63,
-	0x4c, 0x2c, 0xbd, 0xad, 0xed, 0xb5, 0x3a, 0xc6, 0x81, 0xf4, 0xe9, 0x29, 0xc6, 0xa4, 0x5b, 0x7d,
-	0x77, 0xbe, 0x7b, 0xc3, 0xe1, 0x72, 0x74, 0x17, 0x6a, 0x38, 0x8e, 0xbc, 0x89, 0x55, 0xe3, 0x8a,
-	0xb7, 0x52, 0x45, 0x07, 0x27, 0xd1, 0x9c, 0x78, 0xf8, 0x21, 0x13, 0x4a, 0x0b, 0xa1, 0x89, 0x10,
-	0x54, 0x29, 0x26, 0x33, 0xab, 0xce, 0x6f, 0xe4, 0xdf, 0x68, 0x1f, 0xcc, 0x60, 0x1c, 0x46, 0x44,
-	0xe8, 0xf7, 0x26, 0xd8, 0x7b, 0x69, 0x35, 0xda, 0xda, 0x5e, 0xd3, 0x29, 0xf1, 0xed, 0x3f, 0x01,
-	0x89, 0x08, 0x93, 0x38, 0x0a, 0x13, 0x7c, 0x4d, 0x88, 0xfb, 0x50, 0xe3, 0xf0, 0xf0, 0x00, 0x5b,
-	0x9d, 0xb5, 0x83, 0x14, 0xac, 0x87, 0xec, 0x37, 0xf3, 0x8c, 0x11, 0xa8, 0x0d, 0x2d, 0x6f, 0x4e,
-	0x08, 0x0e, 0xe9, 0x33, 0xe6, 0xa0, 0xce, 0x1d, 0x54, 0x59, 0xf6, 0x1b, 0x0d, 0xd6, 0xd8, 0xe5,
-	0xbd, 0x27, 0x7d, 0x99, 0x61, 0x74, 0x0f, 0xea, 0x13, 0xee, 0x02, 0xbf, 0xbc, 0xd5, 0xf9, 0xec,
-	0x20, 0xaf, 0xcb, 0x12, 0x12, 0x8e, 0xd4, 0x45, 0xf7, 0xa0, 0x49, 0x84, 0x20, 0xb1, 0x2a, 0x6d,
-	0x7d, 0xaf, 0xd5, 0x41, 0xaa, 0x9d, 0x10, 0x71, 0xef, 0x34, 0x27, 0xd3, 0x44, 0xc7, 0x60, 0xb8,
-	0xfe, 0x2c, 0x08, 0xa5, 0x5c, 0xa2, 0xf3, 0x89, 0x62, 0x79, 0xac, 0x88, 0xa5, 0x79, 0xc1, 0xc4,
-	0xfe, 0x47, 0x83, 0xf5, 0x2c, 0x02, 0x91, 0x41, 0x74, 0xff, 0x52, 0x08, 0xdb, 0xa5, 0x10, 0xd4,
-	0x54, 0xcb, 0x63, 0xd3, 0x48, 0x7e, 0x80, 0x15, 0x22, 0xe5, 0x69, 0x28, 0x37, 0x0b, 0xa1, 0x08,
-	0x99, 0xb4, 0xca, 0x75, 0x51, 0x1f, 0x56, 0xa5, 0x67, 0x82, 0x23, 0xa3, 0xb1, 0xca, 0xd1, 0x14,
-	0x4e, 0x28, 0x1a, 0xd9, 0x6f, 0x74, 0x30, 0xd4, 0xa0, 0xd1, 0x5d, 0x68, 0x78, 0x33, 0xff, 0xd9,
-	0x59, 0x8c, 0x79, 0x34, 0x6b, 0xe5, 0xf4, 0xf4, 0x84, 0xd8, 0x49, 0xf5, 0xd0, 0x03, 0x00, 0x6f,
-	0xe2, 0x86, 0x63, 0xcc, 0xca, 0x5b, 0x16, 0x8a, 0x0a, 0x63, 0x2f, 0x13, 0xca, 0x4b, 0x1c, 0x45,
-	0x9f, 0x5b, 0x47, 0xb3, 0xd8, 0xf5, 0xe8, 0xe3, 0x68, 0x2c, 0x83, 0x28, 0x58, 0x67, 0xc2, 0xdc,
-	0x3a, 0x63, 0xa1, 0x47, 0xb0, 0x46, 0x89, 0x1b, 0x26, 0x27, 0x98, 0x3c, 0x16, 0x18, 0x54, 0xf9,
-	0x09, 0x6d, 0xe5, 0x84, 0x67, 0x05, 0x85, 0xf4, 0x94, 0x4b, 0x76, 0xcc, 0x8f, 0x53, 0x4c, 0x82,
-	0x93, 0xb3, 0x47, 0x6e, 0x92, 0xbe, 0x47, 0xd5, 0x8f, 0xe7, 0x99, 0x30, 0xf3, 0x23, 0xd7, 0x67,
-	0x65, 0x9c, 0xc4, 0xd3, 0x80, 0x26, 0xfc, 0x5d, 0x16, 0x2d, 0xbb, 0x2e, 0xf5, 0x26, 0x43, 0x26,
-	0x4d, 0x2d, 0xa5, 0x2e, 0xea, 0x82, 0x91, 0x67, 0xe2, 0x79, 0x87, 0xbf, 0xd9, 0x56, 0x67, 0x67,
-	0x61, 0xee, 0x9e, 0x77, 0x52, 0xeb, 0x82, 0x8d, 0xfd, 0x56, 0x87, 0xd5, 0x02, 0xd0, 0xff, 0x07,
-	0xc2, 0xa3, 0x05, 0x10, 0x6e, 0x2f, 0x81, 0x50, 0xdc, 0x52, 0xc0, 0xf0, 0x68, 0x01, 0x86, 0xdb,
-	0x4b, 0x30, 0xcc, 0xcc, 0x73, 0x10, 0x07, 0x4b, 0x40, 0xfc, 0xfc, 0x0a, 0x10, 0xe5, 0x31, 0x97,
-	0x51, 0x3c, 0x5a, 0x80, 0xe2, 0xf6, 0x12, 0x14, 0x53, 0x4f, 0x14, 0x18, 0xbf, 0xcb, 0x60, 0x5c,
-	0x29, 0x99, 0xaa, 0x30, 0x4a, 0xd3, 0x14, 0xc7, 0xde, 0x25, 0x1c, 0x81, 0x1b, 0xef, 0x2e, 0xc5,
-	0x51, 0x9a, 0x17, 0x81, 0xfc, 0x4b, 0x87, 0x46, 0xfa, 0x0a, 0x97, 0xb5, 0xe3, 0x4d, 0xa8, 0x8d,
-	0x49, 0x34, 0x8f, 0xe5, 0xbc, 0x11, 0x04, 0x9b, 0x36, 0x94, 0xa1, 0xad, 0x73, 0xb4, 0xd5, 0x4e,
-	0xd8, 0x7b, 0xd2, 0xe7, 0x40, 0x73, 0x39, 0xda, 0x01, 0xf0, 0xe6, 0x09, 0xc5, 0x33, 0x5e, 0x1b,
-	0x55, 0x7e, 0x84, 0xc2, 0x41, 0x26, 0xe8, 0x2f, 0xf1, 0x19, 0xcf, 0x9a, 0xe1, 0xb0, 0x4f, 0xc6,
-	0xf1, 0x66, 0x3e, 0xaf, 0x69, 0xc3, 0x61, 0x9f, 0xe8, 0x53, 0xd0, 0x93, 0xc0, 0xe7, 0x95, 0xaa,
-	0x77, 0x1b, 0x17, 0xe7, 0xbb, 0xfa, 0x70, 0xd0, 0x77, 0x18, 0x8f, 0x89, 0xe2, 0xc0, 0xb7, 0x9a,
-	0xb9, 0xe8, 0x29, 0x13, 0xc5, 0x81, 0x8f, 0xb6, 0xa0, 0x9e, 0xd0, 0x28, 0x3e, 0xa6, 0x3c, 0xaf,
-	0xba, 0x23, 0x29, 0x36, 0x41, 0x69, 0x34, 0x64, 0x43, 0x93, 0xe7, 0xac, 0xea, 0xa4, 0x24, 0xfa,
-	0x02, 0x56, 0xdd, 0xe9, 0x34, 0x7a, 0xf5, 0x4b, 0xc4, 0xfe, 0x62, 0x62, 0xb5, 0xf8, 0x3c, 0x2b,
-	0x32, 0x99, 0xd6, 0xd4, 0x4d, 0x68, 0x97, 0x44, 0xae, 0xef, 0xb9, 0x09, 0xb5, 0x0c, 0xa1, 0x55,
-	0x60, 0x2e, 0x1c, 0x8f, 0xab, 0x4b, 0xc6, 0xe3, 0xdb, 0x0a, 0x34, 0xb3, 0x97, 0xb4, 0x0c, 0x86,
-	0x34, 0xe1, 0x95, 0x6b, 0x12, 0xbe, 0x09, 0xb5, 0x53, 0x77, 0x3a, 0x17, 0xc8, 0x18, 0x8e, 0x20,
-	0xd0, 0x4f, 0xb0, 0x2a, 0x56, 0x9a, 0x74, 0x0e, 0x89, 0x6a, 0x5f, 0x3e, 0xc1, 0x8a, 0xea, 0x29,
-	0x04, 0xb5, 0xe5, 0x10, 0xd4, 0x17, 0x40, 0x90, 0x4d, 0xf2, 0xc6, 0xf5, 0x93, 0xfc, 0x1b, 0xd8,
-	0xf0, 0xa2, 0x90, 0x06, 0xe1, 0x1c, 0xe7, 0xa9, 0x6d, 0xf2, 0x8c, 0x95, 0x05, 0x2c, 0xca, 0x84,
-	0xba, 0x53, 0xcc, 0xb1, 0x6d, 0x3a, 0x82, 0xb0, 0x13, 0xd8, 0x28, 0x35, 0x7e, 0xf4, 0x7d, 0xda,
-	0x67, 0x94, 0xee, 0xb4, 0x95, 0x2e, 0x3d, 0xb9, 0x3a, 0x4f, 0xa1, 0xa2, 0x99, 0xed, 0x53, 0x95,
-	0xab, 0xf7, 0x29, 0xfb, 0x18, 0x50, 0xb9, 0x55, 0xa1, 0xaf, 0xa1, 0xc6, 0x17, 0x33, 0x39, 0x9f,
-	0xd7, 0x0f, 0xb2, 0x7d, 0x95, 0xd7, 0x5a, 0x1a, 0x3b, 0xd7, 0xb1, 0x7f, 0x83, 0x8d, 0xd2, 0xc8,
-	0x41, 0x36, 0x18, 0xb2, 0x5f, 0x0d, 0x42, 0x1f, 0xbf, 0xe6, 0x07, 0x55, 0x9d, 0x02, 0x8f, 0xaf,
-	0x3f, 0x82, 0xe6, 0xeb, 0x4f, 0x45, 0xae, 0x3f, 0x39, 0xcb, 0xde, 0x04, 0x54, 0xee, 0x84, 0xf6,
-	0xcf, 0x70, 0x6b, 0xe1, 0x84, 0xca, 0x82, 0xd6, 0xae, 0x09, 0xda, 0x82, 0xad, 0xc5, 0xdd, 0xd1,
-	0x7e, 0x01, 0x1b, 0xa5, 0xb1, 0xc5, 0xe0, 0x0a, 0x94, 0x20, 0x04, 0xc1, 0xd6, 0xca, 0x09, 0x6b,
-	0x99, 0x15, 0x5e, 0xa9, 0xfc, 0x9b, 0xbd, 0x4e, 0x86, 0x36, 0x7e, 0x4d, 0x65, 0x01, 0xa7, 0x24,
-	0x8b, 0xa4, 0xdc, 0x49, 0xed, 0xdf, 0xc1, 0x50, 0xc7, 0x1c, 0xba, 0x0d, 0x4d, 0xde, 0x20, 0x7f,
-	0xc5, 0x67, 0xe2, 0x11, 0x39, 0x19, 0xcd, 0x7a, 0x51, 0x88, 0x5f, 0x0d, 0x0b, 0xeb, 0xb3, 0xc2,
-	0x91, 0x72, 0x16, 0xeb, 0xa0, 0x9f, 0x58, 0x7a, 0x5b, 0x97, 0x72, 0xc9, 0xb1, 0x63, 0xd8, 0x28,
-	0xcd, 0x55, 0xf4, 0xa3, 0xb2, 0x16, 0x6a, 0x7c, 0x97, 0x52, 0x47, 0x9f, 0xaa, 0x2a, 0x13, 0x98,
-	0xef, 0x86, 0x6d, 0x68, 0x91, 0x60, 0x3c, 0xa1, 0x7d, 0x4c, 0x82, 0x53, 0xf1, 0xb2, 0x9b, 0x8e,
-	0xca, 0xb2, 0x7b, 0x80, 0xca, 0x23, 0x00, 0xdd, 0x81, 0x3a, 0xaf, 0x9b, 0xf4, 0xc2, 0x25, 0xc5,
-	0x25, 0x95, 0xec, 0x21, 0xdc, 0x5c, 0x30, 0xd2, 0xd1, 0x03, 0x68, 0x88, 0x6a, 0x4f, 0x8f, 0xb9,
-	0x72, 0x7f, 0x92, 0x67, 0xa6, 0x26, 0xf6, 0x11, 0x6c, 0x2e, 0x9a, 0x2f, 0xe8, 0xcb, 0xab, 0xeb,
-	0x5e, 0x56, 0xfc, 0x7e, 0x1f, 0x1a, 0xb2, 0x6d, 0xa1, 0x16, 0x34, 0x06, 0xe1, 0xa9, 0x3b, 0x0d,
-	0x7c, 0xf3, 0x06, 0x5a, 0x85, 0x15, 0xb6, 0xbe, 0xf2, 0xfe, 0x60, 0x6a, 0xa8, 0x09, 0xd5, 0x61,
-	0xe8, 0xc6, 0x66, 0x05, 0xad, 0x40, 0xed, 0x05, 0x09, 0x28, 0x36, 0x75, 0xc6, 0x74, 0xb0, 0xeb,
-	0x9b, 0xd5, 0xfd, 0xbf, 0x35, 0xb9, 0x49, 0xca, 0xe5, 0x02, 0x99, 0x60, 0xc8, 0xb3, 0x38, 0xdb,
-	0xbc, 0x81, 0xd6, 0x00, 0x72, 0x3f, 0x4d, 0x8d, 0xd3, 0xd9, 0x7b, 0x30, 0x2b, 0x08, 0xc1, 0x5a,
-	0xb1, 0x90, 0x4d, 0x1d, 0xad, 0x43, 0x8b, 0xe9, 0xcc, 0x29, 0x66, 0xa5, 0x66, 0x56, 0x99, 0x51,
-	0x5e, 0x7a, 0x66, 0x8d, 0xd1, 0x39, 0x2c, 0x66, 0x9d, 0x5d, 0xab, 0x26, 0xc3, 0x6c, 0x74, 0xcd,
-	0xf7, 0xff, 0xee, 0x68, 0xef, 0x2e, 0x76, 0xb4, 0xf7, 0x17, 0x3b, 0xda, 0x87, 0x8b, 0x1d, 0x6d,
-	0x54, 0xe7, 0xff, 0xed, 0x7d, 0xfb, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xfe, 0xa5, 0x8f,
-	0x04, 0x0f, 0x00, 0x00,
+var xxx_messageInfo_PrepareMergeRequest proto.InternalMessageInfo
+
+func (m *PrepareMergeRequest) GetTarget() bhmetapb.Shard {
+	if m != nil {
+		return m.Target
+	}
+	return bhmetapb.Shard{}
 }
 
-func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+type PrepareMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrepareMergeResponse) Reset()         { *m = PrepareMergeResponse{} }
+func (m *PrepareMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeResponse) ProtoMessage()    {}
+func (*PrepareMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{24}
+}
+func (m *PrepareMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepareMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepareMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PrepareMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeResponse.Merge(m, src)
+}
+func (m *PrepareMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepareMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeResponse.DiscardUnknown(m)
 }
 
-func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+var xxx_messageInfo_PrepareMergeResponse proto.InternalMessageInfo
+
+// CommitMergeRequest is proposed by the target shard, after applied, the source
+// shard's key range will be merged into the target shard.
+type CommitMergeRequest struct {
+	Source bhmetapb.Shard `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
+	// commit is the raft log index of the source shard's PrepareMerge
+	Commit               uint64   `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitMergeRequest) Reset()         { *m = CommitMergeRequest{} }
+func (m *CommitMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeRequest) ProtoMessage()    {}
+func (*CommitMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{25}
+}
+func (m *CommitMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeRequest.Merge(m, src)
+}
+func (m *CommitMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitMergeRequest proto.InternalMessageInfo
+
+func (m *CommitMergeRequest) GetSource() bhmetapb.Shard {
+	if m != nil {
+		return m.Source
+	}
+	return bhmetapb.Shard{}
+}
+
+func (m *CommitMergeRequest) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+type CommitMergeResponse struct {
+	Shard                bhmetapb.Shard `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CommitMergeResponse) Reset()         { *m = CommitMergeResponse{} }
+func (m *CommitMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeResponse) ProtoMessage()    {}
+func (*CommitMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{26}
+}
+func (m *CommitMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeResponse.Merge(m, src)
+}
+func (m *CommitMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitMergeResponse proto.InternalMessageInfo
+
+func (m *CommitMergeResponse) GetShard() bhmetapb.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return bhmetapb.Shard{}
+}
+
+// RollbackMergeRequest is proposed by the source shard, if the target shard has
+// changed and the merge can never be committed.
+type RollbackMergeRequest struct {
+	// commit is the raft log index of the source shard's PrepareMerge
+	Commit               uint64   `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackMergeRequest) Reset()         { *m = RollbackMergeRequest{} }
+func (m *RollbackMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeRequest) ProtoMessage()    {}
+func (*RollbackMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{27}
+}
+func (m *RollbackMergeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMergeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeRequest.Merge(m, src)
+}
+func (m *RollbackMergeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMergeRequest proto.InternalMessageInfo
+
+func (m *RollbackMergeRequest) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+type RollbackMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackMergeResponse) Reset()         { *m = RollbackMergeResponse{} }
+func (m *RollbackMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeResponse) ProtoMessage()    {}
+func (*RollbackMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d8ad5550754569, []int{28}
+}
+func (m *RollbackMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeResponse.Merge(m, src)
+}
+func (m *RollbackMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackMergeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("raftcmdpb.CMDType", CMDType_name, CMDType_value)
+	proto.RegisterEnum("raftcmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
+	proto.RegisterType((*RaftRequestHeader)(nil), "raftcmdpb.RaftRequestHeader")
+	proto.RegisterType((*RaftResponseHeader)(nil), "raftcmdpb.RaftResponseHeader")
+	proto.RegisterType((*RaftCMDRequest)(nil), "raftcmdpb.RaftCMDRequest")
+	proto.RegisterType((*RaftCMDResponse)(nil), "raftcmdpb.RaftCMDResponse")
+	proto.RegisterType((*AdminRequest)(nil), "raftcmdpb.AdminRequest")
+	proto.RegisterType((*AdminResponse)(nil), "raftcmdpb.AdminResponse")
+	proto.RegisterType((*Request)(nil), "raftcmdpb.Request")
+	proto.RegisterType((*KeyRange)(nil), "raftcmdpb.KeyRange")
+	proto.RegisterType((*Response)(nil), "raftcmdpb.Response")
+	proto.RegisterType((*ChangePeerRequest)(nil), "raftcmdpb.ChangePeerRequest")
+	proto.RegisterType((*ChangePeerResponse)(nil), "raftcmdpb.ChangePeerResponse")
+	proto.RegisterType((*CompactLogRequest)(nil), "raftcmdpb.CompactLogRequest")
+	proto.RegisterType((*CompactLogResponse)(nil), "raftcmdpb.CompactLogResponse")
+	proto.RegisterType((*TransferLeaderRequest)(nil), "raftcmdpb.TransferLeaderRequest")
+	proto.RegisterType((*TransferLeaderResponse)(nil), "raftcmdpb.TransferLeaderResponse")
+	proto.RegisterType((*VerifyHashRequest)(nil), "raftcmdpb.VerifyHashRequest")
+	proto.RegisterType((*VerifyHashResponse)(nil), "raftcmdpb.VerifyHashResponse")
+	proto.RegisterType((*ComputeHashResponse)(nil), "raftcmdpb.ComputeHashResponse")
+	proto.RegisterType((*SplitRequest)(nil), "raftcmdpb.SplitRequest")
+	proto.RegisterType((*BatchSplitRequest)(nil), "raftcmdpb.BatchSplitRequest")
+	proto.RegisterType((*BatchSplitResponse)(nil), "raftcmdpb.BatchSplitResponse")
+	proto.RegisterType((*ChangePeerV2Request)(nil), "raftcmdpb.ChangePeerV2Request")
+	proto.RegisterType((*ChangePeerV2Response)(nil), "raftcmdpb.ChangePeerV2Response")
+	proto.RegisterType((*PrepareMergeRequest)(nil), "raftcmdpb.PrepareMergeRequest")
+	proto.RegisterType((*PrepareMergeResponse)(nil), "raftcmdpb.PrepareMergeResponse")
+	proto.RegisterType((*CommitMergeRequest)(nil), "raftcmdpb.CommitMergeRequest")
+	proto.RegisterType((*CommitMergeResponse)(nil), "raftcmdpb.CommitMergeResponse")
+	proto.RegisterType((*RollbackMergeRequest)(nil), "raftcmdpb.RollbackMergeRequest")
+	proto.RegisterType((*RollbackMergeResponse)(nil), "raftcmdpb.RollbackMergeResponse")
+}
+
+func init() { proto.RegisterFile("raftcmdpb.proto", fileDescriptor_c4d8ad5550754569) }
+
+var fileDescriptor_c4d8ad5550754569 = []byte{
+	// 1708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4b, 0x6f, 0xdb, 0xca,
+	0x15, 0x0e, 0x45, 0xbd, 0x7c, 0x24, 0xd9, 0xf4, 0xd8, 0xf1, 0x65, 0x83, 0xda, 0x56, 0x89, 0xb6,
+	0x30, 0xdc, 0x5e, 0x1b, 0xd7, 0xbd, 0x6d, 0x51, 0xdc, 0xb8, 0xa9, 0x25, 0x25, 0xb0, 0x91, 0xa4,
+	0x08, 0x68, 0x23, 0x41, 0xd1, 0x15, 0x45, 0x8e, 0x25, 0xd6, 0x12, 0xc9, 0x0e, 0x47, 0x8e, 0xdd,
+	0x65, 0xb7, 0xfd, 0x51, 0xdd, 0x66, 0x13, 0x20, 0xbb, 0xee, 0x82, 0xd6, 0x3f, 0xa0, 0xcb, 0xae,
+	0x8b, 0x79, 0x51, 0x43, 0x91, 0xb2, 0x83, 0x6c, 0x6c, 0x9e, 0xe7, 0xcc, 0x99, 0xf3, 0xcd, 0xcc,
+	0x37, 0x82, 0x35, 0xe2, 0x5d, 0x52, 0x7f, 0x1a, 0x24, 0xc3, 0x83, 0x84, 0xc4, 0x34, 0x46, 0x2b,
+	0x99, 0xe2, 0xc9, 0xf1, 0x28, 0xa4, 0xe3, 0xd9, 0xf0, 0xc0, 0x8f, 0xa7, 0x87, 0x53, 0x8f, 0x92,
+	0xf0, 0x26, 0x26, 0xe1, 0x28, 0x8c, 0xa4, 0xe0, 0xcf, 0x86, 0xf8, 0x30, 0x19, 0x1e, 0x0e, 0xc7,
+	0x53, 0x4c, 0x3d, 0xed, 0x43, 0x64, 0x7a, 0xf2, 0xc3, 0x97, 0x85, 0x63, 0x42, 0x62, 0x32, 0xff,
+	0x2f, 0x83, 0x5f, 0x7d, 0x41, 0xb0, 0x1f, 0x4f, 0x93, 0x38, 0xc2, 0x11, 0x4d, 0x0f, 0x13, 0x12,
+	0x27, 0x63, 0x4c, 0x59, 0x3e, 0x39, 0x99, 0xdc, 0x54, 0xbe, 0xd5, 0xb2, 0x8d, 0xe2, 0x51, 0x7c,
+	0xc8, 0xd5, 0xc3, 0xd9, 0x25, 0x97, 0xb8, 0xc0, 0xbf, 0x84, 0xbb, 0xf3, 0xf7, 0x0a, 0xac, 0xbb,
+	0xde, 0x25, 0x75, 0xf1, 0x5f, 0x67, 0x38, 0xa5, 0xa7, 0xd8, 0x0b, 0x30, 0x41, 0x5b, 0x50, 0x09,
+	0x03, 0xdb, 0xe8, 0x1a, 0x7b, 0xed, 0x5e, 0xfd, 0xee, 0xf3, 0x6e, 0xe5, 0x6c, 0xe0, 0x56, 0xc2,
+	0x00, 0xd9, 0xd0, 0x48, 0xc7, 0x1e, 0x09, 0xce, 0x06, 0x76, 0xa5, 0x6b, 0xec, 0x55, 0x5d, 0x25,
+	0xa2, 0x9f, 0x43, 0x35, 0xc1, 0x98, 0xd8, 0x66, 0xd7, 0xd8, 0x6b, 0x1d, 0xb5, 0x0f, 0xe4, 0x9c,
+	0xde, 0x60, 0x4c, 0x7a, 0xd5, 0x0f, 0x9f, 0x77, 0x1f, 0xb9, 0xdc, 0x8e, 0xbe, 0x83, 0x1a, 0x4e,
+	0x62, 0x7f, 0x6c, 0xd7, 0xb8, 0xe3, 0x63, 0xe5, 0xe8, 0xe2, 0x34, 0x9e, 0x11, 0x1f, 0x3f, 0x67,
+	0x46, 0x19, 0x21, 0x3c, 0x11, 0x82, 0x2a, 0xc5, 0x64, 0x6a, 0xd7, 0xf9, 0x88, 0xfc, 0x1b, 0xed,
+	0x83, 0x15, 0x8e, 0xa2, 0x98, 0x08, 0xff, 0xfe, 0x18, 0xfb, 0x57, 0x76, 0xa3, 0x6b, 0xec, 0x35,
+	0xdd, 0x82, 0x1e, 0x75, 0xa1, 0xc5, 0xd6, 0x2c, 0x4e, 0xf1, 0x45, 0x38, 0xc5, 0x76, 0xb3, 0x6b,
+	0xec, 0x99, 0xae, 0xae, 0x72, 0xfe, 0x06, 0x48, 0xac, 0x41, 0x9a, 0xc4, 0x51, 0x8a, 0x1f, 0x58,
+	0x84, 0x7d, 0xa8, 0xf1, 0x06, 0xf2, 0x25, 0x68, 0x1d, 0xad, 0x1e, 0xa8, 0x76, 0x3e, 0x67, 0xff,
+	0xb3, 0xb9, 0x33, 0x81, 0x8d, 0xed, 0xcf, 0x08, 0xc1, 0x11, 0xbd, 0x60, 0x25, 0x98, 0xbc, 0x04,
+	0x5d, 0xe5, 0xfc, 0xd3, 0x80, 0x55, 0x36, 0x78, 0xff, 0xf5, 0x40, 0xf6, 0x00, 0x7d, 0x0f, 0xf5,
+	0x31, 0x9f, 0x02, 0x1f, 0xbc, 0x75, 0xf4, 0xe3, 0x83, 0x39, 0x72, 0x0b, 0xbd, 0x72, 0xa5, 0x2f,
+	0xfa, 0x1e, 0x9a, 0x44, 0x18, 0x52, 0xbb, 0xd2, 0x35, 0xf7, 0x5a, 0x47, 0x48, 0x8f, 0x13, 0x26,
+	0x3e, 0x3b, 0xc3, 0xcd, 0x3c, 0xd1, 0x09, 0xb4, 0xbd, 0x60, 0x1a, 0x46, 0xd2, 0x2e, 0xfb, 0xf7,
+	0x8d, 0x16, 0x79, 0xa2, 0x99, 0x65, 0x78, 0x2e, 0xc4, 0xf9, 0x68, 0xc0, 0x5a, 0x56, 0x81, 0x58,
+	0x41, 0xf4, 0xc3, 0x42, 0x09, 0xdb, 0x85, 0x12, 0xf4, 0xa5, 0x96, 0x69, 0x55, 0x25, 0xbf, 0x85,
+	0x15, 0x22, 0xed, 0xaa, 0x94, 0x8d, 0x5c, 0x29, 0xc2, 0x26, 0xa3, 0xe6, 0xbe, 0x68, 0x00, 0x1d,
+	0x39, 0x33, 0xa1, 0x91, 0xd5, 0xd8, 0xc5, 0x6a, 0x72, 0x19, 0xf2, 0x41, 0xce, 0x3f, 0x6a, 0xd0,
+	0xd6, 0x8b, 0x46, 0xdf, 0x41, 0xc3, 0x9f, 0x06, 0x17, 0xb7, 0x09, 0xe6, 0xd5, 0xac, 0x16, 0x97,
+	0xa7, 0x2f, 0xcc, 0xae, 0xf2, 0x43, 0x4f, 0x01, 0xfc, 0xb1, 0x17, 0x8d, 0x30, 0xdb, 0x00, 0x76,
+	0xa5, 0xd0, 0xc6, 0x7e, 0x66, 0x94, 0x83, 0xb8, 0x9a, 0x3f, 0x8f, 0x8e, 0xa7, 0x89, 0xe7, 0xd3,
+	0x57, 0xf1, 0xc8, 0x36, 0x8b, 0xd1, 0x99, 0x71, 0x1e, 0x9d, 0xa9, 0xd0, 0x29, 0xac, 0x52, 0xe2,
+	0x45, 0xe9, 0x25, 0x26, 0xaf, 0x44, 0x0f, 0xaa, 0x3c, 0x43, 0x57, 0xcb, 0x70, 0x91, 0x73, 0x50,
+	0x59, 0x16, 0xe2, 0xd8, 0x3c, 0xae, 0x31, 0x09, 0x2f, 0x6f, 0x4f, 0xbd, 0x54, 0xed, 0x58, 0x7d,
+	0x1e, 0x6f, 0x33, 0x63, 0x36, 0x8f, 0xb9, 0x3f, 0x83, 0x71, 0x9a, 0x4c, 0x42, 0x9a, 0xda, 0xf5,
+	0x42, 0x64, 0xcf, 0xa3, 0xfe, 0xf8, 0x9c, 0x59, 0x55, 0xa4, 0xf4, 0x45, 0x3d, 0x68, 0xcf, 0x57,
+	0xe2, 0xed, 0x11, 0xdf, 0xd5, 0xad, 0xa3, 0x9d, 0xd2, 0xb5, 0x7b, 0x7b, 0xa4, 0xa2, 0x73, 0x31,
+	0x2c, 0x47, 0x42, 0x70, 0xe2, 0x11, 0xfc, 0x1a, 0x93, 0x91, 0xd8, 0xf2, 0xf9, 0x1c, 0x6f, 0x34,
+	0x73, 0x96, 0x43, 0x8f, 0x41, 0xcf, 0xa0, 0xe5, 0xc7, 0xd3, 0x69, 0x48, 0x45, 0x8a, 0x95, 0x02,
+	0x8c, 0xfb, 0x73, 0xab, 0xca, 0xa0, 0x47, 0xa0, 0xe7, 0xd0, 0x21, 0xf1, 0x64, 0x32, 0xf4, 0xfc,
+	0x2b, 0x91, 0x02, 0x78, 0x8a, 0x5d, 0x1d, 0xc9, 0xba, 0x5d, 0x25, 0xc9, 0x47, 0x39, 0xff, 0xaa,
+	0x41, 0x27, 0x07, 0xda, 0xaf, 0x81, 0xe3, 0x71, 0x09, 0x1c, 0xb7, 0x97, 0xc0, 0x51, 0x8c, 0x92,
+	0xc3, 0xe3, 0x71, 0x09, 0x1e, 0xb7, 0x97, 0xe0, 0x31, 0x0b, 0xcf, 0x74, 0xe8, 0x6c, 0x09, 0x20,
+	0x7f, 0x72, 0x0f, 0x20, 0x65, 0x9a, 0x45, 0x44, 0x1e, 0x97, 0x20, 0x72, 0x7b, 0x09, 0x22, 0xd5,
+	0x4c, 0xe6, 0x01, 0xe8, 0xd7, 0x19, 0x24, 0x8b, 0xfd, 0xd4, 0x21, 0x29, 0x43, 0x15, 0x26, 0xfb,
+	0x0b, 0x98, 0x2c, 0x76, 0x32, 0x8f, 0x49, 0x19, 0x9e, 0x07, 0x65, 0x7f, 0x01, 0x94, 0xad, 0x42,
+	0x92, 0x3c, 0x28, 0x55, 0x92, 0x1c, 0x2a, 0xff, 0x90, 0x47, 0x65, 0xbb, 0xb8, 0x39, 0x74, 0x54,
+	0xca, 0x14, 0x39, 0x58, 0xbe, 0x58, 0x84, 0x65, 0xa7, 0x70, 0x38, 0x2c, 0xc0, 0x52, 0x66, 0xc9,
+	0x87, 0xc9, 0x99, 0x24, 0x33, 0x8a, 0x79, 0x2b, 0x56, 0xcb, 0x66, 0xa2, 0xac, 0xb9, 0x99, 0x28,
+	0xa5, 0xf3, 0x5f, 0x13, 0x1a, 0xea, 0x88, 0x5d, 0x76, 0xd7, 0x6e, 0x42, 0x6d, 0x44, 0xe2, 0x59,
+	0x22, 0xe9, 0x86, 0x10, 0x18, 0xd9, 0xa0, 0x0c, 0xfe, 0x26, 0x87, 0xbf, 0x7e, 0xcd, 0xf5, 0x5f,
+	0x0f, 0x38, 0xf2, 0xb9, 0x1d, 0xed, 0x00, 0xf8, 0xb3, 0x94, 0xe2, 0x29, 0xdf, 0x2c, 0x55, 0x9e,
+	0x42, 0xd3, 0x20, 0x0b, 0xcc, 0x2b, 0x7c, 0xcb, 0x61, 0xd4, 0x76, 0xd9, 0x27, 0xd3, 0xf8, 0xd3,
+	0x80, 0x1f, 0x58, 0x6d, 0x97, 0x7d, 0xa2, 0x1f, 0x81, 0x99, 0x86, 0x01, 0x3f, 0x86, 0xcc, 0x5e,
+	0xe3, 0xee, 0xf3, 0xae, 0x79, 0x7e, 0x36, 0x70, 0x99, 0x8e, 0x99, 0x92, 0x30, 0xb0, 0x9b, 0x73,
+	0xd3, 0x1b, 0x66, 0x4a, 0xc2, 0x00, 0x6d, 0x41, 0x3d, 0xa5, 0x71, 0x72, 0x42, 0x39, 0xd0, 0x4c,
+	0x57, 0x4a, 0x8c, 0x40, 0xd1, 0xf8, 0x9c, 0x71, 0x26, 0x0e, 0xa2, 0xaa, 0xab, 0x44, 0xf4, 0x53,
+	0xe8, 0x78, 0x93, 0x49, 0xfc, 0xfe, 0x45, 0xcc, 0xfe, 0x62, 0xc2, 0xf1, 0xd1, 0x74, 0xf3, 0x4a,
+	0xe6, 0x35, 0xf1, 0x52, 0xda, 0x23, 0xb1, 0x17, 0xf8, 0x5e, 0x4a, 0x39, 0x02, 0x9a, 0x6e, 0x5e,
+	0x59, 0xca, 0x8e, 0x3a, 0x4b, 0xd8, 0xd1, 0x21, 0x34, 0xaf, 0xf0, 0xad, 0xcb, 0x80, 0x2a, 0x9b,
+	0xa8, 0xdf, 0xb5, 0x2f, 0xa5, 0xc9, 0xcd, 0x9c, 0x18, 0xa5, 0x11, 0xc5, 0xfc, 0xd1, 0x8b, 0xe2,
+	0xd4, 0x5e, 0x13, 0x74, 0x4a, 0x53, 0xb1, 0xe2, 0x7d, 0x2f, 0xf2, 0xf1, 0xc4, 0xb6, 0xf8, 0xa0,
+	0x52, 0x72, 0x4e, 0xa1, 0xa9, 0xf2, 0xb1, 0xc6, 0xa6, 0xd4, 0x23, 0x54, 0xf4, 0xdc, 0x15, 0x02,
+	0x5b, 0x7e, 0x1c, 0x05, 0xbc, 0xd9, 0x6d, 0x97, 0x7d, 0x32, 0xbf, 0x49, 0x38, 0x0d, 0xa9, 0xa4,
+	0x4e, 0x42, 0x70, 0xfe, 0x57, 0x81, 0x66, 0x76, 0x1e, 0x2e, 0xc3, 0x8e, 0x42, 0x49, 0xe5, 0x01,
+	0x94, 0x6c, 0x42, 0xed, 0xda, 0x9b, 0xcc, 0x04, 0x9c, 0xda, 0xae, 0x10, 0xd0, 0xef, 0xa1, 0x23,
+	0x68, 0xb8, 0x62, 0x46, 0xe2, 0xcc, 0x5a, 0xce, 0xa9, 0xf2, 0xee, 0x0a, 0x37, 0xb5, 0xe5, 0xb8,
+	0xa9, 0x97, 0xe0, 0x26, 0xe3, 0x96, 0x8d, 0x87, 0xb9, 0xe5, 0x2f, 0x61, 0xdd, 0x8f, 0x23, 0x1a,
+	0x46, 0x33, 0x3c, 0xc7, 0x43, 0x93, 0xaf, 0x78, 0xd1, 0x20, 0x17, 0x7c, 0x22, 0x6e, 0xb2, 0xa6,
+	0x2b, 0x04, 0xa6, 0xf5, 0xe3, 0x59, 0x44, 0x25, 0x1a, 0x85, 0xc0, 0x50, 0x1a, 0xe1, 0x1b, 0xfa,
+	0x12, 0xdf, 0x72, 0x14, 0xb6, 0x5d, 0x25, 0x3a, 0x29, 0xac, 0x17, 0xa8, 0x0b, 0xfa, 0x8d, 0xba,
+	0x5d, 0xb4, 0x3b, 0x69, 0x4b, 0x11, 0xfb, 0xb9, 0x3b, 0x5f, 0x72, 0xcd, 0x33, 0x7b, 0x33, 0x54,
+	0xee, 0x7f, 0x33, 0x38, 0x27, 0x80, 0x8a, 0x17, 0x14, 0xfa, 0x05, 0xd4, 0xf8, 0xe3, 0x43, 0x32,
+	0xcc, 0xb5, 0x83, 0xec, 0x4d, 0xc6, 0x37, 0x94, 0x5a, 0x2b, 0xee, 0xe3, 0xfc, 0x09, 0xd6, 0x0b,
+	0xa4, 0x09, 0x39, 0xd0, 0x96, 0xb7, 0xd4, 0x59, 0x14, 0xe0, 0x1b, 0x9e, 0xa8, 0xea, 0xe6, 0x74,
+	0x9c, 0xc0, 0x0b, 0x99, 0x13, 0xf8, 0x8a, 0x24, 0xf0, 0x73, 0x95, 0xb3, 0x09, 0xa8, 0x78, 0xff,
+	0x39, 0xcf, 0xe0, 0x71, 0x29, 0xc7, 0xca, 0x8a, 0x36, 0x1e, 0x28, 0xda, 0x86, 0xad, 0xf2, 0x3b,
+	0xd1, 0x79, 0x07, 0xeb, 0x05, 0xe2, 0xc5, 0x1a, 0x19, 0x6a, 0x45, 0x08, 0x81, 0x3d, 0x9d, 0xc6,
+	0xec, 0x74, 0x16, 0x1b, 0x8a, 0x7f, 0xb3, 0xe6, 0x32, 0x74, 0xe0, 0x1b, 0x2a, 0x01, 0xaf, 0x44,
+	0x56, 0x49, 0xf1, 0xfe, 0x74, 0x9e, 0xc1, 0x46, 0xc9, 0x51, 0xfe, 0xe5, 0x03, 0x3a, 0x7f, 0x81,
+	0xb6, 0xce, 0xf4, 0xd0, 0x13, 0x68, 0xf2, 0x7b, 0x95, 0xc1, 0x4b, 0xec, 0xfe, 0x4c, 0x66, 0x27,
+	0x76, 0x84, 0xdf, 0x9f, 0xe7, 0xde, 0x98, 0x9a, 0x46, 0xda, 0xd9, 0x62, 0x9d, 0x0d, 0x52, 0xdb,
+	0xec, 0x9a, 0xd2, 0x2e, 0x35, 0x4e, 0x02, 0xeb, 0x05, 0x6a, 0x89, 0x7e, 0xa7, 0xbd, 0x8c, 0x0c,
+	0xfe, 0x9c, 0xd0, 0x19, 0x93, 0xee, 0x2a, 0x3b, 0x90, 0xb9, 0xb3, 0xf6, 0x93, 0x70, 0x34, 0xa6,
+	0x03, 0x4c, 0xc2, 0x6b, 0x71, 0x94, 0x34, 0x5d, 0x5d, 0xe5, 0xf4, 0x01, 0x15, 0x99, 0x03, 0xfa,
+	0x16, 0xea, 0x1c, 0x78, 0x6a, 0xc0, 0x25, 0xe8, 0x94, 0x4e, 0xce, 0x39, 0x6c, 0x94, 0xb0, 0x5a,
+	0xf4, 0x14, 0x1a, 0x62, 0xbb, 0xa8, 0x34, 0xf7, 0x3e, 0x21, 0x64, 0x4e, 0x15, 0xe2, 0x1c, 0xc3,
+	0x66, 0x19, 0x2d, 0x41, 0x3f, 0xbb, 0x7f, 0xe3, 0xa8, 0x2d, 0x33, 0x80, 0x8d, 0x12, 0x96, 0xcc,
+	0x2a, 0xa3, 0x1e, 0x19, 0x61, 0x7a, 0xff, 0xbe, 0x93, 0x4e, 0xce, 0x16, 0x6c, 0x96, 0xd1, 0x1a,
+	0xe7, 0xcf, 0x7c, 0xd7, 0x2c, 0x10, 0x68, 0xbe, 0x6c, 0xfc, 0x67, 0x80, 0x07, 0x92, 0x0b, 0x27,
+	0x7e, 0xd1, 0xf0, 0x24, 0x12, 0x29, 0x52, 0x72, 0x7a, 0xb0, 0x91, 0x4b, 0xfe, 0x35, 0x27, 0xc6,
+	0x01, 0x6c, 0x96, 0xd1, 0x73, 0x6d, 0x4c, 0x23, 0x37, 0xe6, 0x37, 0xf0, 0xb8, 0x94, 0x37, 0xed,
+	0x0f, 0xa0, 0x21, 0xef, 0x1b, 0xd4, 0x82, 0xc6, 0x59, 0x74, 0xed, 0x4d, 0xc2, 0xc0, 0x7a, 0x84,
+	0x3a, 0xb0, 0xc2, 0x5e, 0xc2, 0xfc, 0x60, 0xb7, 0x0c, 0xd4, 0x84, 0xea, 0x79, 0xe4, 0x25, 0x56,
+	0x05, 0xad, 0x40, 0xed, 0x1d, 0x09, 0x29, 0xb6, 0x4c, 0xa6, 0x74, 0xb1, 0x17, 0x58, 0xd5, 0xfd,
+	0x8f, 0x06, 0xb4, 0x75, 0x6e, 0x8f, 0x2c, 0x68, 0xcb, 0x5c, 0x5c, 0x6d, 0x3d, 0x42, 0xab, 0x00,
+	0xf3, 0x7e, 0x5b, 0x06, 0x97, 0xb3, 0x83, 0xc9, 0xaa, 0x20, 0x04, 0xab, 0xf9, 0x13, 0xc5, 0x32,
+	0xd1, 0x1a, 0xb4, 0xb4, 0xcd, 0x6d, 0x55, 0x59, 0xd0, 0xfc, 0x0c, 0xb0, 0x6a, 0x4c, 0x9e, 0xc3,
+	0xdb, 0xaa, 0xb3, 0x61, 0x75, 0x50, 0x59, 0x0d, 0xa6, 0xd1, 0x3b, 0x6c, 0x35, 0x65, 0x52, 0xb5,
+	0xfc, 0xd6, 0x0a, 0x5a, 0x87, 0x4e, 0x6e, 0x6d, 0x2c, 0xe8, 0x59, 0x9f, 0xfe, 0xb3, 0x63, 0x7c,
+	0xb8, 0xdb, 0x31, 0x3e, 0xdd, 0xed, 0x18, 0xff, 0xbe, 0xdb, 0x31, 0x86, 0x75, 0xfe, 0x83, 0xd4,
+	0xaf, 0xfe, 0x3f, 0x00, 0x1f, 0x1c, 0x2c, 0xdf, 0xa7, 0x13, 0x00, 0x00,
+}
+
+func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
@@ -1663,6 +2217,11 @@ func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.ProposeTime != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ProposeTime))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1895,6 +2454,36 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n13
 	}
+	if m.PrepareMerge != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.PrepareMerge.Size()))
+		n14, err := m.PrepareMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.CommitMerge != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CommitMerge.Size()))
+		n15, err := m.CommitMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.RollbackMerge != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.RollbackMerge.Size()))
+		n16, err := m.RollbackMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1925,61 +2514,101 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n14, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		n17, err := m.ChangePeer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n17
 	}
 	if m.CompactLog != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n15, err := m.CompactLog.MarshalTo(dAtA[i:])
+		n18, err := m.CompactLog.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.TransferLeader != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n16, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		n19, err := m.TransferLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if m.VerifyHash != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.VerifyHash.Size()))
-		n17, err := m.VerifyHash.MarshalTo(dAtA[i:])
+		n20, err := m.VerifyHash.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	if m.Splits != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n18, err := m.Splits.MarshalTo(dAtA[i:])
+		n21, err := m.Splits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if m.ChangePeerV2 != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ChangePeerV2.Size()))
-		n19, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
+		n22, err := m.ChangePeerV2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n22
+	}
+	if m.PrepareMerge != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.PrepareMerge.Size()))
+		n23, err := m.PrepareMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.CommitMerge != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.CommitMerge.Size()))
+		n24, err := m.CommitMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.RollbackMerge != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.RollbackMerge.Size()))
+		n25, err := m.RollbackMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.ComputeHash != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.ComputeHash.Size()))
+		n26, err := m.ComputeHash.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2085,13 +2714,40 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.KeyRange != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.KeyRange.Size()))
+		n27, err := m.KeyRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.StopAtNanos != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.StopAtNanos))
+	}
+	if m.Cancel {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
+func (m *KeyRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2101,39 +2757,77 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *KeyRange) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
+	if len(m.Start) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Start)))
+		i += copy(dAtA[i:], m.Start)
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
+	if len(m.End) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Type))
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.End)))
+		i += copy(dAtA[i:], m.End)
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Limit))
 	}
-	if m.OriginRequest != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.OriginRequest.Size()))
-		n20, err := m.OriginRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SID != 0 {
+	return i, nil
+}
+
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.OriginRequest != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.OriginRequest.Size()))
+		n28, err := m.OriginRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.SID != 0 {
 		dAtA[i] = 0x28
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.SID))
@@ -2146,11 +2840,11 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Error.Size()))
-	n21, err := m.Error.MarshalTo(dAtA[i:])
+	n29, err := m.Error.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n29
 	if m.ContinueBroadcast {
 		dAtA[i] = 0x40
 		i++
@@ -2171,6 +2865,17 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Count != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Count))
+	}
+	if len(m.NextKey) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.NextKey)))
+		i += copy(dAtA[i:], m.NextKey)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2200,11 +2905,11 @@ func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Peer.Size()))
-	n22, err := m.Peer.MarshalTo(dAtA[i:])
+	n30, err := m.Peer.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n30
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2229,11 +2934,11 @@ func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Shard.Size()))
-	n23, err := m.Shard.MarshalTo(dAtA[i:])
+	n31, err := m.Shard.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n31
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2310,11 +3015,11 @@ func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Peer.Size()))
-	n24, err := m.Peer.MarshalTo(dAtA[i:])
+	n32, err := m.Peer.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n32
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2401,6 +3106,38 @@ func (m *VerifyHashResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ComputeHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComputeHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Index))
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2428,21 +3165,21 @@ func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.NewShardID))
 	}
 	if len(m.NewPeerIDs) > 0 {
-		dAtA26 := make([]byte, len(m.NewPeerIDs)*10)
-		var j25 int
+		dAtA34 := make([]byte, len(m.NewPeerIDs)*10)
+		var j33 int
 		for _, num := range m.NewPeerIDs {
 			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j25++
+				j33++
 			}
-			dAtA26[j25] = uint8(num)
-			j25++
+			dAtA34[j33] = uint8(num)
+			j33++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintRaftcmdpb(dAtA, i, uint64(j25))
-		i += copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(j33))
+		i += copy(dAtA[i:], dAtA34[:j33])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2578,12 +3315,172 @@ func (m *ChangePeerV2Response) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Shard.Size()))
-		n27, err := m.Shard.MarshalTo(dAtA[i:])
+		n35, err := m.Shard.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n35
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PrepareMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Target.Size()))
+	n36, err := m.Target.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PrepareMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Source.Size()))
+	n37, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	if m.Commit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Shard.Size()))
+	n38, err := m.Shard.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RollbackMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftcmdpb(dAtA, i, uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RollbackMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2622,6 +3519,9 @@ func (m *RaftRequestHeader) Size() (n int) {
 	if m.IgnoreEpochCheck {
 		n += 2
 	}
+	if m.ProposeTime != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.ProposeTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2734,6 +3634,18 @@ func (m *AdminRequest) Size() (n int) {
 		l = m.ChangePeerV2.Size()
 		n += 1 + l + sovRaftcmdpb(uint64(l))
 	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2773,6 +3685,22 @@ func (m *AdminResponse) Size() (n int) {
 		l = m.ChangePeerV2.Size()
 		n += 1 + l + sovRaftcmdpb(uint64(l))
 	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.ComputeHash != nil {
+		l = m.ComputeHash.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2827,6 +3755,39 @@ func (m *Request) Size() (n int) {
 	if m.IgnoreEpochCheck {
 		n += 2
 	}
+	if m.KeyRange != nil {
+		l = m.KeyRange.Size()
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.StopAtNanos != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.StopAtNanos))
+	}
+	if m.Cancel {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeyRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Limit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2868,10 +3829,17 @@ func (m *Response) Size() (n int) {
 	if m.Stale {
 		n += 2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Count != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Count))
 	}
-	return n
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *ChangePeerRequest) Size() (n int) {
@@ -2996,6 +3964,25 @@ func (m *VerifyHashResponse) Size() (n int) {
 	return n
 }
 
+func (m *ComputeHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovRaftcmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SplitRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3095,6 +4082,90 @@ func (m *ChangePeerV2Response) Size() (n int) {
 	return n
 }
 
+func (m *PrepareMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Target.Size()
+	n += 1 + l + sovRaftcmdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrepareMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovRaftcmdpb(uint64(l))
+	if m.Commit != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shard.Size()
+	n += 1 + l + sovRaftcmdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		n += 1 + sovRaftcmdpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRaftcmdpb(x uint64) (n int) {
 	for {
 		n++
@@ -3295,6 +4366,25 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IgnoreEpochCheck = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeTime", wireType)
+			}
+			m.ProposeTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposeTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -4044,6 +5134,114 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeRequest{}
+			}
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeRequest{}
+			}
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeRequest{}
+			}
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -4333,39 +5531,183 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeResponse{}
+			}
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeResponse{}
+			}
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeResponse{}
+			}
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ComputeHash == nil {
+				m.ComputeHash = &ComputeHashResponse{}
+			}
+			if err := m.ComputeHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowRaftcmdpb
 			}
 			if iNdEx >= l {
@@ -4682,6 +6024,81 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IgnoreEpochCheck = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyRange == nil {
+				m.KeyRange = &KeyRange{}
+			}
+			if err := m.KeyRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopAtNanos", wireType)
+			}
+			m.StopAtNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopAtNanos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -4707,7 +6124,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *KeyRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4730,15 +6147,15 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4765,33 +6182,14 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= CMDType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4818,16 +6216,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginRequest", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftcmdpb
@@ -4837,32 +6235,192 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRaftcmdpb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OriginRequest == nil {
-				m.OriginRequest = &Request{}
-			}
-			if err := m.OriginRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
-			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CMDType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OriginRequest == nil {
+				m.OriginRequest = &Request{}
+			}
+			if err := m.OriginRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
+			}
 			m.SID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -4970,6 +6528,59 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Stale = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
@@ -5670,7 +7281,7 @@ func (m *VerifyHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRequest) Unmarshal(dAtA []byte) error {
+func (m *ComputeHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5693,15 +7304,34 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComputeHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComputeHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5728,21 +7358,109 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewShardID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.NewShardID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftcmdpb
-				}
-				if iNdEx >= l {
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewShardID", wireType)
+			}
+			m.NewShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
@@ -6227,6 +7945,467 @@ func (m *ChangePeerV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PrepareMergeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareMergeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepareMergeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareMergeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMergeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMergeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMergeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMergeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackMergeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackMergeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackMergeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftcmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackMergeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftcmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRaftcmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRaftcmdpb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
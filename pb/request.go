@@ -0,0 +1,45 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pb
+
+import (
+	"math"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+)
+
+// SetStopAt sets the deadline of the request in unix nanoseconds, the deadline in unix seconds
+// is also set for the old versions, and it's rounded up so they never give up the request
+// before the deadline.
+func SetStopAt(req *raftcmdpb.Request, stopAt int64) {
+	req.StopAtNanos = stopAt
+	req.StopAt = stopAt / int64(time.Second)
+	if stopAt%int64(time.Second) > 0 {
+		req.StopAt++
+	}
+}
+
+// GetStopAt returns the deadline of the request in unix nanoseconds, the deadline in unix seconds
+// set by the old versions is converted, 0 means no deadline.
+func GetStopAt(req *raftcmdpb.Request) int64 {
+	if req.StopAtNanos > 0 {
+		return req.StopAtNanos
+	}
+
+	if req.StopAt > math.MaxInt64/int64(time.Second) {
+		return math.MaxInt64
+	}
+	return req.StopAt * int64(time.Second)
+}
@@ -0,0 +1,2030 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: txnpb.proto
+
+package txnpb
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// TxnOp the transaction command type
+type TxnOp int32
+
+const (
+	TxnOp_InvalidOp      TxnOp = 0
+	TxnOp_Prewrite       TxnOp = 1
+	TxnOp_Commit         TxnOp = 2
+	TxnOp_Rollback       TxnOp = 3
+	TxnOp_Get            TxnOp = 4
+	TxnOp_CheckTxnStatus TxnOp = 5
+	TxnOp_GC             TxnOp = 6
+)
+
+var TxnOp_name = map[int32]string{
+	0: "InvalidOp",
+	1: "Prewrite",
+	2: "Commit",
+	3: "Rollback",
+	4: "Get",
+	5: "CheckTxnStatus",
+	6: "GC",
+}
+
+var TxnOp_value = map[string]int32{
+	"InvalidOp":      0,
+	"Prewrite":       1,
+	"Commit":         2,
+	"Rollback":       3,
+	"Get":            4,
+	"CheckTxnStatus": 5,
+	"GC":             6,
+}
+
+func (x TxnOp) String() string {
+	return proto.EnumName(TxnOp_name, int32(x))
+}
+
+func (TxnOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{0}
+}
+
+// TxnStatus the status of the transaction
+type TxnStatus int32
+
+const (
+	TxnStatus_Unknown    TxnStatus = 0
+	TxnStatus_Locked     TxnStatus = 1
+	TxnStatus_Committed  TxnStatus = 2
+	TxnStatus_RolledBack TxnStatus = 3
+)
+
+var TxnStatus_name = map[int32]string{
+	0: "Unknown",
+	1: "Locked",
+	2: "Committed",
+	3: "RolledBack",
+}
+
+var TxnStatus_value = map[string]int32{
+	"Unknown":    0,
+	"Locked":     1,
+	"Committed":  2,
+	"RolledBack": 3,
+}
+
+func (x TxnStatus) String() string {
+	return proto.EnumName(TxnStatus_name, int32(x))
+}
+
+func (TxnStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{1}
+}
+
+// TxnErrorType the error type of the transaction command
+type TxnErrorType int32
+
+const (
+	TxnErrorType_NoError          TxnErrorType = 0
+	TxnErrorType_KeyLocked        TxnErrorType = 1
+	TxnErrorType_WriteConflict    TxnErrorType = 2
+	TxnErrorType_TxnAborted       TxnErrorType = 3
+	TxnErrorType_AlreadyCommitted TxnErrorType = 4
+)
+
+var TxnErrorType_name = map[int32]string{
+	0: "NoError",
+	1: "KeyLocked",
+	2: "WriteConflict",
+	3: "TxnAborted",
+	4: "AlreadyCommitted",
+}
+
+var TxnErrorType_value = map[string]int32{
+	"NoError":          0,
+	"KeyLocked":        1,
+	"WriteConflict":    2,
+	"TxnAborted":       3,
+	"AlreadyCommitted": 4,
+}
+
+func (x TxnErrorType) String() string {
+	return proto.EnumName(TxnErrorType_name, int32(x))
+}
+
+func (TxnErrorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{2}
+}
+
+// Lock the lock of the key written by a uncommitted transaction, the value is
+// written in the data key of the startTS
+type Lock struct {
+	Primary      []byte `protobuf:"bytes,1,opt,name=primary,proto3" json:"primary,omitempty"`
+	PrimaryGroup uint64 `protobuf:"varint,2,opt,name=primaryGroup,proto3" json:"primaryGroup,omitempty"`
+	StartTS      uint64 `protobuf:"varint,3,opt,name=startTS,proto3" json:"startTS,omitempty"`
+	// expireAt is the unix milliseconds of the lock expired, an expired lock
+	// can be rolled back by other transactions
+	ExpireAt             int64    `protobuf:"varint,4,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	Delete               bool     `protobuf:"varint,5,opt,name=delete,proto3" json:"delete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{0}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+func (m *Lock) GetPrimary() []byte {
+	if m != nil {
+		return m.Primary
+	}
+	return nil
+}
+
+func (m *Lock) GetPrimaryGroup() uint64 {
+	if m != nil {
+		return m.PrimaryGroup
+	}
+	return 0
+}
+
+func (m *Lock) GetStartTS() uint64 {
+	if m != nil {
+		return m.StartTS
+	}
+	return 0
+}
+
+func (m *Lock) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+func (m *Lock) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+// Write the committed or rolled back version of the key, it's stored in the write
+// key of the commitTS, and the value is stored in the data key of the startTS
+type Write struct {
+	StartTS              uint64   `protobuf:"varint,1,opt,name=startTS,proto3" json:"startTS,omitempty"`
+	Delete               bool     `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+	Rollback             bool     `protobuf:"varint,3,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Write) Reset()         { *m = Write{} }
+func (m *Write) String() string { return proto.CompactTextString(m) }
+func (*Write) ProtoMessage()    {}
+func (*Write) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{1}
+}
+func (m *Write) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Write) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Write.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Write) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Write.Merge(m, src)
+}
+func (m *Write) XXX_Size() int {
+	return m.Size()
+}
+func (m *Write) XXX_DiscardUnknown() {
+	xxx_messageInfo_Write.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Write proto.InternalMessageInfo
+
+func (m *Write) GetStartTS() uint64 {
+	if m != nil {
+		return m.StartTS
+	}
+	return 0
+}
+
+func (m *Write) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *Write) GetRollback() bool {
+	if m != nil {
+		return m.Rollback
+	}
+	return false
+}
+
+// TxnRequest the transaction command
+type TxnRequest struct {
+	Op           TxnOp  `protobuf:"varint,1,opt,name=op,proto3,enum=txnpb.TxnOp" json:"op,omitempty"`
+	StartTS      uint64 `protobuf:"varint,2,opt,name=startTS,proto3" json:"startTS,omitempty"`
+	CommitTS     uint64 `protobuf:"varint,3,opt,name=commitTS,proto3" json:"commitTS,omitempty"`
+	Primary      []byte `protobuf:"bytes,4,opt,name=primary,proto3" json:"primary,omitempty"`
+	PrimaryGroup uint64 `protobuf:"varint,5,opt,name=primaryGroup,proto3" json:"primaryGroup,omitempty"`
+	// ttl is the milliseconds of the lock alive
+	TTL    uint64 `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Value  []byte `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	Delete bool   `protobuf:"varint,8,opt,name=delete,proto3" json:"delete,omitempty"`
+	// safePoint is the timestamp of the GC, the versions invisible to the
+	// transactions started after it are removed
+	SafePoint            uint64   `protobuf:"varint,9,opt,name=safePoint,proto3" json:"safePoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxnRequest) Reset()         { *m = TxnRequest{} }
+func (m *TxnRequest) String() string { return proto.CompactTextString(m) }
+func (*TxnRequest) ProtoMessage()    {}
+func (*TxnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{2}
+}
+func (m *TxnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnRequest.Merge(m, src)
+}
+func (m *TxnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnRequest proto.InternalMessageInfo
+
+func (m *TxnRequest) GetOp() TxnOp {
+	if m != nil {
+		return m.Op
+	}
+	return TxnOp_InvalidOp
+}
+
+func (m *TxnRequest) GetStartTS() uint64 {
+	if m != nil {
+		return m.StartTS
+	}
+	return 0
+}
+
+func (m *TxnRequest) GetCommitTS() uint64 {
+	if m != nil {
+		return m.CommitTS
+	}
+	return 0
+}
+
+func (m *TxnRequest) GetPrimary() []byte {
+	if m != nil {
+		return m.Primary
+	}
+	return nil
+}
+
+func (m *TxnRequest) GetPrimaryGroup() uint64 {
+	if m != nil {
+		return m.PrimaryGroup
+	}
+	return 0
+}
+
+func (m *TxnRequest) GetTTL() uint64 {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *TxnRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TxnRequest) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *TxnRequest) GetSafePoint() uint64 {
+	if m != nil {
+		return m.SafePoint
+	}
+	return 0
+}
+
+// TxnError the error of the transaction command
+type TxnError struct {
+	Type                 TxnErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=txnpb.TxnErrorType" json:"type,omitempty"`
+	Lock                 *Lock        `protobuf:"bytes,2,opt,name=lock,proto3" json:"lock,omitempty"`
+	CommitTS             uint64       `protobuf:"varint,3,opt,name=commitTS,proto3" json:"commitTS,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxnError) Reset()         { *m = TxnError{} }
+func (m *TxnError) String() string { return proto.CompactTextString(m) }
+func (*TxnError) ProtoMessage()    {}
+func (*TxnError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{3}
+}
+func (m *TxnError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnError.Merge(m, src)
+}
+func (m *TxnError) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnError proto.InternalMessageInfo
+
+func (m *TxnError) GetType() TxnErrorType {
+	if m != nil {
+		return m.Type
+	}
+	return TxnErrorType_NoError
+}
+
+func (m *TxnError) GetLock() *Lock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+func (m *TxnError) GetCommitTS() uint64 {
+	if m != nil {
+		return m.CommitTS
+	}
+	return 0
+}
+
+// TxnResponse the response of the transaction command
+type TxnResponse struct {
+	Error    TxnError  `protobuf:"bytes,1,opt,name=error,proto3" json:"error"`
+	Value    []byte    `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Found    bool      `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	Status   TxnStatus `protobuf:"varint,4,opt,name=status,proto3,enum=txnpb.TxnStatus" json:"status,omitempty"`
+	CommitTS uint64    `protobuf:"varint,5,opt,name=commitTS,proto3" json:"commitTS,omitempty"`
+	// nextKey is the start key of the next GC request, empty if the GC is completed
+	NextKey              []byte   `protobuf:"bytes,6,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxnResponse) Reset()         { *m = TxnResponse{} }
+func (m *TxnResponse) String() string { return proto.CompactTextString(m) }
+func (*TxnResponse) ProtoMessage()    {}
+func (*TxnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cec01c879ff9f20, []int{4}
+}
+func (m *TxnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnResponse.Merge(m, src)
+}
+func (m *TxnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnResponse proto.InternalMessageInfo
+
+func (m *TxnResponse) GetError() TxnError {
+	if m != nil {
+		return m.Error
+	}
+	return TxnError{}
+}
+
+func (m *TxnResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TxnResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *TxnResponse) GetStatus() TxnStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxnStatus_Unknown
+}
+
+func (m *TxnResponse) GetCommitTS() uint64 {
+	if m != nil {
+		return m.CommitTS
+	}
+	return 0
+}
+
+func (m *TxnResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("txnpb.TxnOp", TxnOp_name, TxnOp_value)
+	proto.RegisterEnum("txnpb.TxnStatus", TxnStatus_name, TxnStatus_value)
+	proto.RegisterEnum("txnpb.TxnErrorType", TxnErrorType_name, TxnErrorType_value)
+	proto.RegisterType((*Lock)(nil), "txnpb.Lock")
+	proto.RegisterType((*Write)(nil), "txnpb.Write")
+	proto.RegisterType((*TxnRequest)(nil), "txnpb.TxnRequest")
+	proto.RegisterType((*TxnError)(nil), "txnpb.TxnError")
+	proto.RegisterType((*TxnResponse)(nil), "txnpb.TxnResponse")
+}
+
+func init() { proto.RegisterFile("txnpb.proto", fileDescriptor_4cec01c879ff9f20) }
+
+var fileDescriptor_4cec01c879ff9f20 = []byte{
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xda, 0x4a,
+	0x14, 0x65, 0x8c, 0x0d, 0xe6, 0x42, 0x78, 0xf3, 0xe6, 0x45, 0x4f, 0x7e, 0x51, 0x04, 0x08, 0xe9,
+	0xa9, 0x28, 0x55, 0x13, 0x89, 0x7e, 0x41, 0x40, 0x55, 0x54, 0x25, 0x6a, 0xa2, 0x89, 0xab, 0xae,
+	0x8d, 0x19, 0x12, 0x0b, 0x33, 0xe3, 0x0e, 0x43, 0x62, 0x36, 0xfd, 0x95, 0xf6, 0x73, 0xb2, 0xcc,
+	0xa2, 0x6b, 0xd4, 0xf2, 0x25, 0xd5, 0x8c, 0x1d, 0x0c, 0x55, 0x95, 0x76, 0x37, 0xe7, 0x9e, 0x99,
+	0x73, 0x2e, 0xe7, 0x5e, 0x0c, 0x75, 0x95, 0xf2, 0x64, 0x74, 0x9c, 0x48, 0xa1, 0x04, 0x71, 0x0c,
+	0x38, 0x78, 0x75, 0x13, 0xa9, 0xdb, 0xc5, 0xe8, 0x38, 0x14, 0xb3, 0x93, 0x1b, 0x71, 0x23, 0x4e,
+	0x0c, 0x3b, 0x5a, 0x4c, 0x0c, 0x32, 0xc0, 0x9c, 0xb2, 0x57, 0xdd, 0xcf, 0x08, 0xec, 0x0b, 0x11,
+	0x4e, 0x89, 0x07, 0xd5, 0x44, 0x46, 0xb3, 0x40, 0x2e, 0x3d, 0xd4, 0x41, 0xbd, 0x06, 0x7d, 0x82,
+	0xa4, 0x0b, 0x8d, 0xfc, 0x78, 0x26, 0xc5, 0x22, 0xf1, 0xac, 0x0e, 0xea, 0xd9, 0x74, 0xa7, 0x46,
+	0xfe, 0x87, 0xea, 0x5c, 0x05, 0x52, 0xf9, 0xd7, 0x5e, 0x59, 0xd3, 0x83, 0xfa, 0x7a, 0xd5, 0xae,
+	0x5e, 0x67, 0x25, 0xfa, 0xc4, 0x91, 0x03, 0x70, 0x59, 0x9a, 0x44, 0x92, 0x9d, 0x2a, 0xcf, 0xee,
+	0xa0, 0x5e, 0x99, 0x6e, 0x30, 0xf9, 0x17, 0x2a, 0x63, 0x16, 0x33, 0xc5, 0x3c, 0xa7, 0x83, 0x7a,
+	0x2e, 0xcd, 0x51, 0x77, 0x04, 0xce, 0x07, 0x19, 0x29, 0xb6, 0xed, 0x81, 0x9e, 0xf1, 0x28, 0x74,
+	0xac, 0x6d, 0x1d, 0xed, 0x2d, 0x45, 0x1c, 0x8f, 0x82, 0x70, 0x6a, 0x7a, 0x74, 0xe9, 0x06, 0x77,
+	0xbf, 0x58, 0x00, 0x7e, 0xca, 0x29, 0xfb, 0xb8, 0x60, 0x73, 0x45, 0x0e, 0xc1, 0x12, 0x89, 0x31,
+	0x69, 0xf6, 0x1b, 0xc7, 0x59, 0xc8, 0x7e, 0xca, 0x2f, 0x13, 0x6a, 0x89, 0x9d, 0xdf, 0x6a, 0x3d,
+	0xd3, 0x47, 0x0f, 0xdc, 0x50, 0xcc, 0x66, 0x51, 0x91, 0x49, 0x63, 0xbd, 0x6a, 0xbb, 0xc3, 0xbc,
+	0x46, 0x37, 0xec, 0x76, 0xf4, 0xf6, 0xf3, 0xd1, 0x3b, 0xbf, 0x88, 0xfe, 0x3f, 0x28, 0x2b, 0x15,
+	0x7b, 0x15, 0x63, 0x51, 0x5d, 0xaf, 0xda, 0x65, 0xdf, 0xbf, 0xa0, 0xba, 0x46, 0xf6, 0xc1, 0xb9,
+	0x0b, 0xe2, 0x05, 0xf3, 0xaa, 0x46, 0x36, 0x03, 0x5b, 0x01, 0xb9, 0x3b, 0x01, 0x1d, 0x42, 0x6d,
+	0x1e, 0x4c, 0xd8, 0x95, 0x88, 0xb8, 0xf2, 0x6a, 0xc6, 0xa9, 0x28, 0x74, 0x3f, 0x81, 0xeb, 0xa7,
+	0xfc, 0x8d, 0x94, 0x42, 0x92, 0x17, 0x60, 0xab, 0x65, 0xc2, 0xf2, 0x84, 0xfe, 0x29, 0x12, 0x32,
+	0xb4, 0xbf, 0x4c, 0x18, 0x35, 0x17, 0x48, 0x1b, 0xec, 0x58, 0x84, 0x53, 0x93, 0x53, 0xbd, 0x5f,
+	0xcf, 0x2f, 0xea, 0x7d, 0xa3, 0x86, 0xf8, 0xf3, 0x90, 0xba, 0x5f, 0x11, 0xd4, 0xcd, 0x88, 0xe6,
+	0x89, 0xe0, 0x73, 0x46, 0x5e, 0x82, 0xc3, 0xb4, 0x9b, 0x69, 0xa2, 0xde, 0xff, 0xeb, 0xa7, 0x26,
+	0x06, 0xf6, 0xc3, 0xaa, 0x5d, 0xa2, 0xd9, 0x9d, 0x22, 0x08, 0x6b, 0x3b, 0x88, 0x7d, 0x70, 0x26,
+	0x62, 0xc1, 0xc7, 0xf9, 0x3a, 0x64, 0x80, 0xf4, 0xa0, 0x32, 0x57, 0x81, 0x5a, 0xcc, 0xcd, 0x30,
+	0x9a, 0x7d, 0x5c, 0x28, 0x5f, 0x9b, 0x3a, 0xcd, 0xf9, 0x9d, 0xe6, 0x9d, 0xdf, 0x4d, 0x98, 0xb3,
+	0x54, 0x9d, 0xb3, 0xa5, 0x99, 0x53, 0x83, 0x3e, 0xc1, 0xa3, 0x10, 0x1c, 0xb3, 0x59, 0x64, 0x0f,
+	0x6a, 0x6f, 0xf9, 0x5d, 0x10, 0x47, 0xe3, 0xcb, 0x04, 0x97, 0x48, 0x03, 0xdc, 0x2b, 0xc9, 0xee,
+	0xf5, 0xe2, 0x63, 0x44, 0x00, 0x2a, 0x99, 0x2a, 0xb6, 0x34, 0x43, 0xf3, 0xbd, 0xc5, 0x65, 0x52,
+	0x85, 0xf2, 0x19, 0x53, 0xd8, 0x26, 0x04, 0x9a, 0xc3, 0x5b, 0x16, 0x4e, 0x37, 0x6d, 0x62, 0x87,
+	0x54, 0xc0, 0x3a, 0x1b, 0xe2, 0xca, 0xd1, 0x10, 0x6a, 0x9b, 0x32, 0xa9, 0x43, 0xf5, 0x3d, 0x9f,
+	0x72, 0x71, 0xcf, 0x71, 0x49, 0x0b, 0xeb, 0x69, 0xb0, 0x31, 0x46, 0xba, 0x83, 0xcc, 0x44, 0xb1,
+	0x31, 0xb6, 0x48, 0x13, 0x40, 0xfb, 0xb0, 0xf1, 0xc0, 0x38, 0x1d, 0x85, 0xd0, 0xd8, 0x9e, 0xb0,
+	0xd6, 0x79, 0x27, 0x0c, 0xc4, 0x25, 0xfd, 0xf6, 0x9c, 0x2d, 0x37, 0x52, 0x7f, 0xc3, 0x9e, 0xf9,
+	0xcf, 0x0e, 0x05, 0x9f, 0xc4, 0x51, 0xa8, 0x32, 0x39, 0x3f, 0xe5, 0xa7, 0x23, 0x21, 0xb5, 0x7c,
+	0x99, 0xec, 0x03, 0x3e, 0x8d, 0x25, 0x0b, 0xc6, 0xcb, 0xc2, 0xd4, 0x1e, 0xe0, 0xc7, 0xef, 0x2d,
+	0xf4, 0xb0, 0x6e, 0xa1, 0xc7, 0x75, 0x0b, 0x7d, 0x5b, 0xb7, 0xd0, 0xa8, 0x62, 0xbe, 0x53, 0xaf,
+	0x7f, 0x0c, 0x00, 0x5b, 0x42, 0xc5, 0x9d, 0xec, 0x04, 0x00, 0x00,
+}
+
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Primary) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(len(m.Primary)))
+		i += copy(dAtA[i:], m.Primary)
+	}
+	if m.PrimaryGroup != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.PrimaryGroup))
+	}
+	if m.StartTS != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.StartTS))
+	}
+	if m.ExpireAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.ExpireAt))
+	}
+	if m.Delete {
+		dAtA[i] = 0x28
+		i++
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Write) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Write) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartTS != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.StartTS))
+	}
+	if m.Delete {
+		dAtA[i] = 0x10
+		i++
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Rollback {
+		dAtA[i] = 0x18
+		i++
+		if m.Rollback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TxnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Op != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.Op))
+	}
+	if m.StartTS != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.StartTS))
+	}
+	if m.CommitTS != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.CommitTS))
+	}
+	if len(m.Primary) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(len(m.Primary)))
+		i += copy(dAtA[i:], m.Primary)
+	}
+	if m.PrimaryGroup != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.PrimaryGroup))
+	}
+	if m.TTL != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.TTL))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Delete {
+		dAtA[i] = 0x40
+		i++
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TxnError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.Type))
+	}
+	if m.Lock != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.Lock.Size()))
+		n1, err := m.Lock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.CommitTS != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.CommitTS))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TxnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintTxnpb(dAtA, i, uint64(m.Error.Size()))
+	n2, err := m.Error.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Found {
+		dAtA[i] = 0x18
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.Status))
+	}
+	if m.CommitTS != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(m.CommitTS))
+	}
+	if len(m.NextKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTxnpb(dAtA, i, uint64(len(m.NextKey)))
+		i += copy(dAtA[i:], m.NextKey)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintTxnpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Primary)
+	if l > 0 {
+		n += 1 + l + sovTxnpb(uint64(l))
+	}
+	if m.PrimaryGroup != 0 {
+		n += 1 + sovTxnpb(uint64(m.PrimaryGroup))
+	}
+	if m.StartTS != 0 {
+		n += 1 + sovTxnpb(uint64(m.StartTS))
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovTxnpb(uint64(m.ExpireAt))
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Write) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTS != 0 {
+		n += 1 + sovTxnpb(uint64(m.StartTS))
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.Rollback {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovTxnpb(uint64(m.Op))
+	}
+	if m.StartTS != 0 {
+		n += 1 + sovTxnpb(uint64(m.StartTS))
+	}
+	if m.CommitTS != 0 {
+		n += 1 + sovTxnpb(uint64(m.CommitTS))
+	}
+	l = len(m.Primary)
+	if l > 0 {
+		n += 1 + l + sovTxnpb(uint64(l))
+	}
+	if m.PrimaryGroup != 0 {
+		n += 1 + sovTxnpb(uint64(m.PrimaryGroup))
+	}
+	if m.TTL != 0 {
+		n += 1 + sovTxnpb(uint64(m.TTL))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTxnpb(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovTxnpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTxnpb(uint64(m.Type))
+	}
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovTxnpb(uint64(l))
+	}
+	if m.CommitTS != 0 {
+		n += 1 + sovTxnpb(uint64(m.CommitTS))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Error.Size()
+	n += 1 + l + sovTxnpb(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTxnpb(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovTxnpb(uint64(m.Status))
+	}
+	if m.CommitTS != 0 {
+		n += 1 + sovTxnpb(uint64(m.CommitTS))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovTxnpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTxnpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTxnpb(x uint64) (n int) {
+	return sovTxnpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxnpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Primary = append(m.Primary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Primary == nil {
+				m.Primary = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryGroup", wireType)
+			}
+			m.PrimaryGroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryGroup |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+			}
+			m.StartTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+			}
+			m.ExpireAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxnpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Write) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxnpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Write: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Write: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+			}
+			m.StartTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxnpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxnpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= TxnOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+			}
+			m.StartTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTS", wireType)
+			}
+			m.CommitTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Primary = append(m.Primary[:0], dAtA[iNdEx:postIndex]...)
+			if m.Primary == nil {
+				m.Primary = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryGroup", wireType)
+			}
+			m.PrimaryGroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryGroup |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafePoint", wireType)
+			}
+			m.SafePoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SafePoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxnpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxnpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TxnErrorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lock == nil {
+				m.Lock = &Lock{}
+			}
+			if err := m.Lock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTS", wireType)
+			}
+			m.CommitTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxnpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxnpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxnStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTS", wireType)
+			}
+			m.CommitTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxnpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxnpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxnpb(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxnpb
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxnpb
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxnpb
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthTxnpb
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowTxnpb
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipTxnpb(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthTxnpb
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthTxnpb = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxnpb   = fmt.Errorf("proto: integer overflow")
+)
@@ -19,8 +19,8 @@ import (
 	"sync"
 	"time"
 
-	"github.com/fagongzi/goetty"
 	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/hack"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
@@ -35,11 +35,17 @@ var (
 var (
 	// ErrTimeout timeout error
 	ErrTimeout = errors.New("exec timeout")
+	// ErrKeyNotInShard the key range of the request is not in the shard, the shard
+	// has been changed after the request routed
+	ErrKeyNotInShard = errors.New("key not in shard")
 )
 
 var (
 	// RetryInterval retry interval
 	RetryInterval = time.Second
+	// canceledRetention the max time to keep the canceled request ids, the retrying of the
+	// canceled requests are stopped in the time
+	canceledRetention = time.Minute
 )
 
 type doneFunc func(*raftcmdpb.Response)
@@ -51,6 +57,11 @@ type ShardsProxy interface {
 	Dispatch(req *raftcmdpb.Request) error
 	DispatchTo(req *raftcmdpb.Request, shard uint64, store string) error
 	Router() raftstore.Router
+	// Cancel stops retrying the request, and drops it if it's still queued in the shard. The
+	// request which is already proposed is not cancelled, and the response of it is still
+	// returned to the done callback. Only the ID, Group, Key, ToShard and the deadline of the
+	// request are used.
+	Cancel(req *raftcmdpb.Request)
 }
 
 // NewShardsProxy returns a shard proxy
@@ -88,6 +99,7 @@ type shardsProxy struct {
 	doneCB      doneFunc
 	errorDoneCB errorDoneFunc
 	backends    sync.Map // store addr -> *backend
+	canceled    sync.Map // request id -> struct{}
 }
 
 func (p *shardsProxy) Dispatch(req *raftcmdpb.Request) error {
@@ -116,6 +128,38 @@ func (p *shardsProxy) Router() raftstore.Router {
 	return p.router
 }
 
+func (p *shardsProxy) Cancel(req *raftcmdpb.Request) {
+	key := string(req.ID)
+	p.canceled.Store(key, struct{}{})
+
+	// the request is never retried after the stopAt, so the mark is removed at that time
+	retention := canceledRetention
+	if stopAt := pb.GetStopAt(req); stopAt > 0 {
+		if d := time.Until(time.Unix(0, stopAt)); d < retention {
+			retention = d
+		}
+	}
+	util.DefaultTimeoutWheel().Schedule(retention, p.removeCanceled, key)
+
+	// the cancel request is routed as the request, and it's never responded
+	cancel := raftcmdpb.Request{
+		ID:      req.ID,
+		Group:   req.Group,
+		Key:     req.Key,
+		ToShard: req.ToShard,
+		Cancel:  true,
+	}
+	if err := p.dispatch(&cancel); err != nil && logger.DebugEnabled() {
+		logger.Debugf("%s send cancel request failed with %+v",
+			hex.EncodeToString(req.ID),
+			err)
+	}
+}
+
+func (p *shardsProxy) removeCanceled(arg interface{}) {
+	p.canceled.Delete(arg)
+}
+
 func (p *shardsProxy) forwardToBackend(req *raftcmdpb.Request, leader string) error {
 	if p.store != nil && p.local.ClientAddr == leader {
 		req.PID = 0
@@ -146,6 +190,16 @@ func (p *shardsProxy) onLocalResp(header *raftcmdpb.RaftResponseHeader, rsp *raf
 }
 
 func (p *shardsProxy) done(rsp *raftcmdpb.Response) {
+	if rsp.Type != raftcmdpb.CMDType_RaftError && !rsp.Stale {
+		// the request will not be retried
+		p.canceled.Delete(hack.SliceToString(rsp.ID))
+	}
+
+	if rsp.Type == raftcmdpb.CMDType_Invalid && rsp.Error.KeyNotInShard != nil {
+		p.errorDoneCB(rsp.OriginRequest, ErrKeyNotInShard)
+		return
+	}
+
 	if rsp.Type == raftcmdpb.CMDType_Invalid && rsp.Error.Message != "" {
 		p.errorDoneCB(rsp.OriginRequest, errors.New(rsp.Error.String()))
 		return
@@ -166,7 +220,20 @@ func (p *shardsProxy) errorDone(req *raftcmdpb.Request, err error) {
 
 func (p *shardsProxy) retryWithRaftError(req *raftcmdpb.Request, err string, later time.Duration) {
 	if req != nil {
-		if time.Now().Unix() >= req.StopAt {
+		// the cancel request is best effort
+		if req.Cancel {
+			return
+		}
+
+		if _, ok := p.canceled.LoadAndDelete(hack.SliceToString(req.ID)); ok {
+			if logger.DebugEnabled() {
+				logger.Debugf("%s canceled, skip retry",
+					hex.EncodeToString(req.ID))
+			}
+			return
+		}
+
+		if time.Now().UnixNano() >= pb.GetStopAt(req) {
 			p.errorDoneCB(req, errors.New(err))
 			return
 		}
@@ -177,9 +244,12 @@ func (p *shardsProxy) retryWithRaftError(req *raftcmdpb.Request, err string, lat
 
 func (p *shardsProxy) doRetry(arg interface{}) {
 	req := arg.(raftcmdpb.Request)
+	p.dispatch(&req)
+}
+
+func (p *shardsProxy) dispatch(req *raftcmdpb.Request) error {
 	if req.ToShard == 0 {
-		p.Dispatch(&req)
-		return
+		return p.Dispatch(req)
 	}
 
 	to := ""
@@ -189,7 +259,7 @@ func (p *shardsProxy) doRetry(arg interface{}) {
 		to = p.router.LeaderPeerStore(req.ToShard).ClientAddr
 	}
 
-	p.DispatchTo(&req, req.ToShard, to)
+	return p.DispatchTo(req, req.ToShard, to)
 }
 
 func (p *shardsProxy) getConn(addr string) (*backend, error) {
@@ -210,9 +280,7 @@ func (p *shardsProxy) getConnLocked(addr string) *backend {
 }
 
 func (p *shardsProxy) createConn(addr string) *backend {
-	encoder, decoder := p.store.CreateRPCCliendSideCodec()
-	bc := newBackend(p, addr,
-		goetty.NewIOSession(goetty.WithCodec(encoder, decoder)))
+	bc := newBackend(p, addr, p.store.CreateRPCClientSideSession())
 
 	old, loaded := p.backends.LoadOrStore(addr, bc)
 	if loaded {
@@ -15,6 +15,8 @@ package raftstore
 
 import (
 	"bytes"
+	"encoding/hex"
+	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/fagongzi/util/uuid"
@@ -51,19 +53,47 @@ func (q *readIndexQueue) push(c cmd) {
 	q.reads = append(q.reads, c)
 }
 
-func (q *readIndexQueue) ready(state raft.ReadState) {
-	if !bytes.Equal(state.RequestCtx, q.reads[q.readyToRead].getUUID()) {
-		logger.Fatalf("shard %d apply read failed, uuid not match",
-			q.shardID)
-	}
-
+// ready returns the time when the read index was issued, zero if the read is not found.
+func (q *readIndexQueue) ready(state raft.ReadState) time.Time {
+	// The read index requests of the follower are forwarded to the leader, the
+	// responses may be out of order or lost, and the reads may be already dropped.
 	for idx := range q.reads {
 		if bytes.Equal(state.RequestCtx, q.reads[idx].getUUID()) {
-			q.reads[idx].readIndexCommittedIndex = state.Index
-			q.readyToRead++
-			return
+			if q.reads[idx].readIndexCommittedIndex == 0 {
+				q.reads[idx].readIndexCommittedIndex = state.Index
+				q.readyToRead++
+			}
+			return q.reads[idx].readIndexAt
 		}
 	}
+
+	logger.Warningf("shard %d read index %s not found, maybe already dropped",
+		q.shardID,
+		hex.EncodeToString(state.RequestCtx))
+	return time.Time{}
+}
+
+// tick drops the reads which are waiting for the read index more than maxTicks,
+// the read index requests forwarded to the leader may be lost.
+func (q *readIndexQueue) tick(ticks int, maxTicks int, term uint64) {
+	if len(q.reads) == q.readyToRead {
+		return
+	}
+
+	newCmds := q.reads[:0] // avoid alloc new slice
+	for _, c := range q.reads {
+		if c.readIndexCommittedIndex == 0 {
+			c.readIndexTicks += ticks
+			if c.readIndexTicks > maxTicks {
+				c.resp(errorStaleCMDResp(c.getUUID(), term))
+				continue
+			}
+		}
+
+		newCmds = append(newCmds, c)
+	}
+
+	q.reads = newCmds
 }
 
 func (q *readIndexQueue) doReadLEAppliedIndex(appliedIndex uint64, pr *peerReplica) {
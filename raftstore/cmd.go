@@ -14,6 +14,8 @@
 package raftstore
 
 import (
+	"time"
+
 	"github.com/fagongzi/util/uuid"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/pb"
@@ -25,6 +27,8 @@ type cmd struct {
 	req                     *raftcmdpb.RaftCMDRequest
 	cb                      func(*raftcmdpb.RaftCMDResponse)
 	readIndexCommittedIndex uint64
+	readIndexTicks          int
+	readIndexAt             time.Time
 	term                    uint64
 	tp                      int
 	size                    int
@@ -34,8 +38,13 @@ func (c *cmd) isFull(n, max int) bool {
 	return max <= c.size+n || (testMaxProposalRequestCount > 0 && len(c.req.Requests) >= testMaxProposalRequestCount)
 }
 
+func (c *cmd) allowFollower() bool {
+	return len(c.req.Requests) > 0 && c.req.Requests[0].AllowFollower
+}
+
 func (c *cmd) canAppend(req *raftcmdpb.Request) bool {
-	return c.req.Header.IgnoreEpochCheck == req.IgnoreEpochCheck
+	return c.req.Header.IgnoreEpochCheck == req.IgnoreEpochCheck &&
+		c.allowFollower() == req.AllowFollower
 }
 
 func newCMD(req *raftcmdpb.RaftCMDRequest, cb func(*raftcmdpb.RaftCMDResponse), tp int, size int) cmd {
@@ -57,6 +66,21 @@ func resp(req *raftcmdpb.Request, resp *raftcmdpb.Response, cb func(*raftcmdpb.R
 	cb(rsp)
 }
 
+func respWithError(req *raftcmdpb.Request, err *errorpb.Error, cb func(*raftcmdpb.RaftCMDResponse)) {
+	resp := pb.AcquireResponse()
+	resp.Type = raftcmdpb.CMDType_Invalid
+	resp.ID = req.ID
+	resp.SID = req.SID
+	resp.PID = req.PID
+	resp.Error = *err
+	resp.OriginRequest = req
+
+	rsp := pb.AcquireRaftCMDResponse()
+	rsp.Responses = append(rsp.Responses, resp)
+
+	cb(rsp)
+}
+
 func respWithRetry(req *raftcmdpb.Request, cb func(*raftcmdpb.RaftCMDResponse)) {
 	resp := pb.AcquireResponse()
 	resp.Type = raftcmdpb.CMDType_Invalid
@@ -32,6 +32,9 @@ var (
 	errLargeRaftEntrySize = errors.New("raft entry is too large")
 	errKeyNotInShard      = errors.New("key not in shard")
 	errStoreNotMatch      = errors.New("store not match")
+	errShardMerging       = errors.New("shard is merging")
+	errRequestExpired     = errors.New("request expired")
+	errRequestCanceled    = errors.New("request canceled")
 
 	infoStaleCMD  = new(errorpb.StaleCommand)
 	storeNotMatch = new(errorpb.StoreNotMatch)
@@ -113,3 +116,20 @@ func checkKeyInShard(key []byte, shard *bhmetapb.Shard) *errorpb.Error {
 		KeyNotInShard: e,
 	}
 }
+
+func checkKeyRangeInShard(keyRange *raftcmdpb.KeyRange, shard *bhmetapb.Shard) *errorpb.Error {
+	if bytes.Compare(keyRange.Start, shard.Start) >= 0 &&
+		(len(shard.End) == 0 || (len(keyRange.End) > 0 && bytes.Compare(keyRange.End, shard.End) <= 0)) {
+		return nil
+	}
+
+	return &errorpb.Error{
+		Message: errKeyNotInShard.Error(),
+		KeyNotInShard: &errorpb.KeyNotInShard{
+			Key:     keyRange.Start,
+			ShardID: shard.ID,
+			Start:   shard.Start,
+			End:     shard.End,
+		},
+	}
+}
@@ -31,6 +31,7 @@ const (
 	raftLogSuffix    = 0x01
 	raftStateSuffix  = 0x02
 	applyStateSuffix = 0x03
+	executedSuffix   = 0x04
 )
 
 // local is in (0x01, 0x02);
@@ -143,6 +144,12 @@ func getRaftLogKey(shardID uint64, logIndex uint64) []byte {
 	return getIDKey(shardID, raftLogSuffix, 8, logIndex)
 }
 
+// getExecutedKey returns the key of the executed requests of the raft log, it's only
+// saved if some requests of the raft log are rejected.
+func getExecutedKey(shardID uint64, logIndex uint64) []byte {
+	return getIDKey(shardID, executedSuffix, 8, logIndex)
+}
+
 func getRaftLogIndex(key []byte) (uint64, error) {
 	expectKeyLen := len(raftPrefixKey) + 8*2 + 1
 	if len(key) != expectKeyLen {
@@ -0,0 +1,45 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"time"
+)
+
+// leaderLease the leader can serve the reads directly in the lease. The lease is renewed
+// when the heartbeat quorum of a ReadIndex is confirmed, starting from the time the ReadIndex
+// was issued. With the check quorum enabled, the followers reject the vote requests in the
+// election timeout after they received the heartbeat, so no new leader can be elected in
+// the lease.
+type leaderLease struct {
+	maxLease time.Duration
+	term     uint64
+	expireAt time.Time
+}
+
+func (l *leaderLease) renew(term uint64, start time.Time) {
+	expireAt := start.Add(l.maxLease)
+	if l.term != term || expireAt.After(l.expireAt) {
+		l.term = term
+		l.expireAt = expireAt
+	}
+}
+
+func (l *leaderLease) expire() {
+	l.expireAt = time.Time{}
+}
+
+func (l *leaderLease) inLease(term uint64, now time.Time) bool {
+	return l.term == term && now.Before(l.expireAt)
+}
@@ -0,0 +1,317 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/matrixorigin/matrixcube/util"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+const (
+	// migrateBatchEntries how many raft log entries are migrated in a batch
+	migrateBatchEntries = 1024
+)
+
+// kvLogStorage saves the raft log entries in the MetadataStorage with the raft log keys, it is
+// the default LogStorage. The raft ready handler adds the entries to the write batch of the raft
+// state by appendTo, so the entries and the hard state are persisted atomically.
+type kvLogStorage struct {
+	ms storage.MetadataStorage
+	// lastIndexes the last appended index of the shards, the entries after it are removed
+	// in the next append
+	lastIndexes sync.Map
+}
+
+func newKVLogStorage(ms storage.MetadataStorage) storage.LogStorage {
+	return &kvLogStorage{ms: ms}
+}
+
+func (s *kvLogStorage) Append(shardID uint64, entries []raftpb.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	wb := util.NewWriteBatch()
+	if err := s.appendTo(wb, shardID, entries); err != nil {
+		return err
+	}
+	return s.ms.Write(wb, false)
+}
+
+// appendTo adds the entries of the shard to the write batch, the entries are written with the
+// other changes in the write batch.
+func (s *kvLogStorage) appendTo(wb *util.WriteBatch, shardID uint64, entries []raftpb.Entry) error {
+	for _, e := range entries {
+		err := wb.Set(getRaftLogKey(shardID, e.Index), protoc.MustMarshal(&e))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Delete any previously appended log entries which never committed.
+	lastIndex := entries[len(entries)-1].Index
+	if v, ok := s.lastIndexes.Load(shardID); ok {
+		for index := lastIndex + 1; index < v.(uint64)+1; index++ {
+			err := wb.Delete(getRaftLogKey(shardID, index))
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		err := s.ms.Scan(getRaftLogKey(shardID, lastIndex+1), getRaftLogKey(shardID, math.MaxUint64),
+			func(key, value []byte) (bool, error) {
+				return true, wb.Delete(key)
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.lastIndexes.Store(shardID, lastIndex)
+	return nil
+}
+
+func (s *kvLogStorage) Sync() error {
+	return nil
+}
+
+func (s *kvLogStorage) Entries(shardID uint64, low, high, maxSize uint64) ([]raftpb.Entry, error) {
+	var ents []raftpb.Entry
+	if low == high {
+		return ents, nil
+	}
+
+	startKey := getRaftLogKey(shardID, low)
+	if low+1 == high {
+		// If election happens in inactive shards, they will just try
+		// to fetch one empty log.
+		v, err := s.ms.Get(startKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(v) == 0 {
+			return nil, raft.ErrUnavailable
+		}
+
+		e, err := s.unmarshal(shardID, v, low)
+		if err != nil {
+			return nil, err
+		}
+
+		ents = append(ents, e)
+		return ents, nil
+	}
+
+	var totalSize uint64
+	nextIndex := low
+	exceededMaxSize := false
+	endKey := getRaftLogKey(shardID, high)
+	err := s.ms.Scan(startKey, endKey, func(key, value []byte) (bool, error) {
+		e := raftpb.Entry{}
+		protoc.MustUnmarshal(&e, value)
+
+		// May meet gap or has been compacted.
+		if e.Index != nextIndex {
+			return false, nil
+		}
+
+		nextIndex++
+		totalSize += uint64(len(value))
+
+		exceededMaxSize = totalSize > maxSize
+		if !exceededMaxSize || len(ents) == 0 {
+			ents = append(ents, e)
+		}
+
+		return !exceededMaxSize, nil
+	}, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// If we get the correct number of entries the total size exceeds max_size, returns.
+	if len(ents) == int(high-low) || exceededMaxSize {
+		return ents, nil
+	}
+
+	return nil, raft.ErrUnavailable
+}
+
+func (s *kvLogStorage) Term(shardID uint64, index uint64) (uint64, error) {
+	v, err := s.ms.Get(getRaftLogKey(shardID, index))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(v) == 0 {
+		return 0, raft.ErrUnavailable
+	}
+
+	e, err := s.unmarshal(shardID, v, index)
+	if err != nil {
+		return 0, err
+	}
+
+	return e.Term, nil
+}
+
+func (s *kvLogStorage) Compact(shardID uint64, index uint64) error {
+	key, _, err := s.ms.Seek(getRaftLogKey(shardID, 0))
+	if err != nil {
+		return err
+	}
+
+	// no entries of the shard
+	if id, ok := decodeRaftLogKey(key); !ok || id != shardID {
+		return nil
+	}
+
+	firstIndex, err := getRaftLogIndex(key)
+	if err != nil {
+		return err
+	}
+
+	if firstIndex >= index {
+		return nil
+	}
+
+	wb := util.NewWriteBatch()
+	for idx := firstIndex; idx < index; idx++ {
+		err := wb.Delete(getRaftLogKey(shardID, idx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.ms.Write(wb, false)
+}
+
+func (s *kvLogStorage) Remove(shardID uint64) error {
+	s.lastIndexes.Delete(shardID)
+
+	wb := util.NewWriteBatch()
+	err := s.ms.Scan(getRaftLogKey(shardID, 0), getRaftLogKey(shardID, math.MaxUint64),
+		func(key, value []byte) (bool, error) {
+			return true, wb.Delete(key)
+		}, false)
+	if err != nil {
+		return err
+	}
+
+	return s.ms.Write(wb, false)
+}
+
+func (s *kvLogStorage) Close() error {
+	return nil
+}
+
+func (s *kvLogStorage) unmarshal(shardID uint64, v []byte, expectIndex uint64) (raftpb.Entry, error) {
+	e := raftpb.Entry{}
+	protoc.MustUnmarshal(&e, v)
+	if e.Index != expectIndex {
+		return e, fmt.Errorf("shard %d raft log index not match, logIndex %d expect %d",
+			shardID,
+			e.Index,
+			expectIndex)
+	}
+
+	return e, nil
+}
+
+// migrateRaftLogs moves the raft log entries from the MetadataStorage to the configured LogStorage.
+// The entries are removed from the MetadataStorage after they are persisted in the LogStorage, and
+// the migration is retried in the next start if the store is crashed.
+func (s *store) migrateRaftLogs() {
+	if s.cfg.Storage.LogStorage == nil {
+		return
+	}
+
+	var shards []uint64
+	var entries []raftpb.Entry
+	var shardID uint64
+	flush := func() error {
+		if len(entries) == 0 {
+			return nil
+		}
+
+		err := s.logs.Append(shardID, entries)
+		entries = entries[:0]
+		return err
+	}
+
+	start := []byte{localPrefix, raftPrefix}
+	end := []byte{localPrefix, raftPrefix + 1}
+	err := s.MetadataStorage().Scan(start, end, func(key, value []byte) (bool, error) {
+		id, ok := decodeRaftLogKey(key)
+		if !ok {
+			return true, nil
+		}
+
+		if id != shardID || len(entries) >= migrateBatchEntries {
+			if err := flush(); err != nil {
+				return false, err
+			}
+		}
+		if id != shardID {
+			shardID = id
+			shards = append(shards, id)
+		}
+
+		e := raftpb.Entry{}
+		protoc.MustUnmarshal(&e, value)
+		entries = append(entries, e)
+		return true, nil
+	}, false)
+	if err == nil {
+		err = flush()
+	}
+	if err == nil {
+		err = s.logs.Sync()
+	}
+	if err != nil {
+		logger.Fatalf("migrate raft logs failed with %+v", err)
+	}
+
+	if len(shards) == 0 {
+		return
+	}
+
+	for _, id := range shards {
+		err := s.MetadataStorage().RangeDelete(getRaftLogKey(id, 0), getRaftLogKey(id, math.MaxUint64))
+		if err != nil {
+			logger.Fatalf("shard %d remove migrated raft logs failed with %+v", id, err)
+		}
+	}
+	logger.Infof("raft logs of %d shards are migrated to the log storage", len(shards))
+}
+
+// decodeRaftLogKey returns the shard id of the raft log key
+func decodeRaftLogKey(key []byte) (uint64, bool) {
+	prefixLen := len(raftPrefixKey)
+	if len(key) != prefixLen+8*2+1 || key[prefixLen+8] != raftLogSuffix {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(key[prefixLen : prefixLen+8]), true
+}
@@ -0,0 +1,79 @@
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/storage/raftlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrateRaftLogs(t *testing.T) {
+	migrate := false
+	var logs *raftlog.Storage
+	c := NewSingleTestClusterStore(t,
+		DisableScheduleTestCluster,
+		DiskTestCluster,
+		SetCMDTestClusterHandler,
+		GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			if !migrate {
+				return
+			}
+
+			if logs != nil {
+				assert.NoError(t, logs.Close())
+			}
+			s, err := raftlog.NewStorage(fmt.Sprintf("%s-log", cfg.DataPath))
+			assert.NoError(t, err)
+			logs = s
+			cfg.Storage.LogStorage = s
+		}))
+	defer func() {
+		c.Stop()
+		if logs != nil {
+			assert.NoError(t, logs.Close())
+		}
+	}()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	_, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+
+	id := c.GetShardByIndex(0).ID
+	lastIndex, err := c.stores[0].getPR(id, false).ps.LastIndex()
+	assert.NoError(t, err)
+
+	migrate = true
+	c.Restart()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	// the raft logs are removed from the metadata storage after migrated
+	cnt := 0
+	assert.NoError(t, c.metadataStorages[0].Scan(getRaftLogKey(id, 0), getRaftLogKey(id, lastIndex+1),
+		func(key, value []byte) (bool, error) {
+			cnt++
+			return true, nil
+		}, false))
+	assert.Equal(t, 0, cnt)
+	_, err = logs.Term(id, lastIndex)
+	assert.NoError(t, err)
+
+	resps, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w2", "key2", "value2"),
+		createTestReadReq("r1", "key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", string(resps["r1"].Responses[0].Value))
+
+	// the entries appended to the log storage are replayed after restart
+	c.Restart()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	resps, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestReadReq("r2", "key2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value2", string(resps["r2"].Responses[0].Value))
+}
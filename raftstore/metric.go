@@ -130,6 +130,8 @@ type raftProposeMetrics struct {
 	normal         uint64
 	transferLeader uint64
 	confChange     uint64
+	leaseReadHit   uint64
+	leaseReadMiss  uint64
 }
 
 func (m *raftProposeMetrics) flush() {
@@ -157,12 +159,23 @@ func (m *raftProposeMetrics) flush() {
 		metric.AddRaftProposalConfChangeCount(m.confChange)
 		m.confChange = 0
 	}
+
+	if m.leaseReadHit > 0 {
+		metric.AddRaftLeaseReadHitCount(m.leaseReadHit)
+		m.leaseReadHit = 0
+	}
+
+	if m.leaseReadMiss > 0 {
+		metric.AddRaftLeaseReadMissCount(m.leaseReadMiss)
+		m.leaseReadMiss = 0
+	}
 }
 
 type raftAdminMetrics struct {
 	confChange uint64
 	split      uint64
 	compact    uint64
+	merge      uint64
 
 	confChangeReject uint64
 
@@ -171,6 +184,8 @@ type raftAdminMetrics struct {
 	removePeerSucceed uint64
 	splitSucceed      uint64
 	compactSucceed    uint64
+	mergeSucceed      uint64
+	mergeRollback     uint64
 }
 
 func (m *raftAdminMetrics) incBy(by raftAdminMetrics) {
@@ -183,6 +198,9 @@ func (m *raftAdminMetrics) incBy(by raftAdminMetrics) {
 	m.splitSucceed += by.splitSucceed
 	m.compact += by.compact
 	m.compactSucceed += by.compactSucceed
+	m.merge += by.merge
+	m.mergeSucceed += by.mergeSucceed
+	m.mergeRollback += by.mergeRollback
 }
 
 func (m *raftAdminMetrics) flush() {
@@ -216,4 +234,17 @@ func (m *raftAdminMetrics) flush() {
 		metric.AddRaftAdminCommandCompactSucceedCount(m.compactSucceed)
 		m.compactSucceed = 0
 	}
+
+	if m.merge > 0 {
+		metric.AddRaftAdminCommandMergeCount(m.merge)
+		m.merge = 0
+	}
+	if m.mergeSucceed > 0 {
+		metric.AddRaftAdminCommandMergeSucceedCount(m.mergeSucceed)
+		m.mergeSucceed = 0
+	}
+	if m.mergeRollback > 0 {
+		metric.AddRaftAdminCommandMergeRollbackCount(m.mergeRollback)
+		m.mergeRollback = 0
+	}
 }
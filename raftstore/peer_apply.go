@@ -22,6 +22,7 @@ import (
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb"
@@ -49,6 +50,7 @@ func (pr *peerReplica) doRegistrationJob(delegate *applyDelegate) error {
 		old.term = delegate.term
 		old.applyState = delegate.applyState
 		old.appliedIndexTerm = delegate.appliedIndexTerm
+		old.mergeState = delegate.mergeState
 		old.clearAllCommandsAsStale()
 	}
 
@@ -72,40 +74,10 @@ func (s *store) doDestroy(shardID uint64, tombstone bool) {
 }
 
 func (pr *peerReplica) doCompactRaftLog(shardID, startIndex, endIndex uint64) error {
-	firstIndex := startIndex
-
-	if firstIndex == 0 {
-		startKey := getRaftLogKey(shardID, 0)
-		firstIndex = endIndex
-		key, _, err := pr.store.MetadataStorage().Seek(startKey)
-		if err != nil {
-			return err
-		}
-
-		if len(key) > 0 {
-			firstIndex, err = getRaftLogIndex(key)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	if firstIndex >= endIndex {
-		logger.Infof("shard %d no need to gc raft log",
-			shardID)
-		return nil
-	}
-
-	wb := util.NewWriteBatch()
-	for index := firstIndex; index < endIndex; index++ {
-		key := getRaftLogKey(shardID, index)
-		err := wb.Delete(key)
-		if err != nil {
-			return err
-		}
+	err := pr.store.logs.Compact(shardID, endIndex)
+	if err == nil {
+		err = pr.store.compactExecuted(shardID, endIndex)
 	}
-
-	err := pr.store.MetadataStorage().Write(wb, false)
 	if err == nil {
 		logger.Debugf("shard %d raft log gc complete, entriesCount=<%d>",
 			shardID,
@@ -154,6 +126,7 @@ func (pr *peerReplica) doApplyingSnapshotJob() error {
 	if pr.store.aware != nil {
 		pr.store.aware.SnapshotApplied(pr.ps.shard)
 	}
+	pr.store.cdc.onSnapshotApplied(pr.ps.shard, pr.ps.getTruncatedIndex())
 	pr.stopRaftTick = false
 	logger.Infof("shard %d apply snapshot data complete, %+v",
 		pr.shardID,
@@ -197,11 +170,14 @@ func (res *asyncApplyResult) hasSplitExecResult() bool {
 }
 
 type execResult struct {
-	adminType    raftcmdpb.AdminCmdType
-	changePeer   *changePeer
-	splitResult  *splitResult
-	raftGCResult *raftGCResult
-	needSyncData bool
+	adminType     raftcmdpb.AdminCmdType
+	changePeer    *changePeer
+	splitResult   *splitResult
+	raftGCResult  *raftGCResult
+	prepareMerge  *prepareMergeResult
+	commitMerge   *commitMergeResult
+	rollbackMerge *rollbackMergeResult
+	needSyncData  bool
 }
 
 type changePeer struct {
@@ -216,6 +192,20 @@ type splitResult struct {
 	shards  []bhmetapb.Shard
 }
 
+type prepareMergeResult struct {
+	shard bhmetapb.Shard
+	state bhraftpb.MergeState
+}
+
+type commitMergeResult struct {
+	shard  bhmetapb.Shard
+	source bhmetapb.Shard
+}
+
+type rollbackMergeResult struct {
+	shard bhmetapb.Shard
+}
+
 type raftGCResult struct {
 	state      bhraftpb.RaftTruncatedState
 	firstIndex uint64
@@ -234,6 +224,9 @@ type applyContext struct {
 	offset     int
 	batchSize  int
 	metrics    applyMetrics
+	// executed the offsets of the executed requests of the current raft log, the rejected
+	// requests are not fed to the change subscriptions
+	executed []int
 }
 
 func newApplyContext(pr *peerReplica) *applyContext {
@@ -259,6 +252,16 @@ func (ctx *applyContext) reset() {
 	ctx.offset = 0
 	ctx.batchSize = 0
 	ctx.metrics = applyMetrics{}
+	ctx.executed = ctx.executed[:0]
+}
+
+// executedBitmap returns the bitmap of the executed requests, or nil if all the requests of
+// the raft log are executed.
+func (ctx *applyContext) executedBitmap() []byte {
+	if ctx.req == nil || ctx.req.AdminRequest != nil || len(ctx.executed) == len(ctx.req.Requests) {
+		return nil
+	}
+	return encodeExecuted(ctx.executed, len(ctx.req.Requests))
 }
 
 func (ctx *applyContext) WriteBatch() *util.WriteBatch {
@@ -285,13 +288,57 @@ func (ctx *applyContext) BatchSize() int {
 }
 
 func (ctx *applyContext) DataStorage() storage.DataStorage {
-	return ctx.pr.store.DataStorageByGroup(ctx.pr.ps.shard.Group, ctx.pr.shardID)
+	ds := ctx.pr.store.DataStorageByGroup(ctx.pr.ps.shard.Group, ctx.pr.shardID)
+	if kv, ok := ds.(kvDataStorage); ok && ctx.req != nil {
+		return proposeTimeDataStorage{kvDataStorage: kv, timestamp: ctx.req.Header.ProposeTime}
+	}
+	return ds
 }
 
 func (ctx *applyContext) StoreID() uint64 {
 	return ctx.pr.store.Meta().ID
 }
 
+type kvDataStorage interface {
+	storage.DataStorage
+	storage.KVStorage
+}
+
+// proposeTimeDataStorage uses the propose time of the applying request as the base time of
+// the keys with TTL, so that all the replicas have the same expire time.
+type proposeTimeDataStorage struct {
+	kvDataStorage
+	timestamp int64
+}
+
+func (s proposeTimeDataStorage) SetWithTTL(key []byte, value []byte, ttl int32) error {
+	wb := util.NewWriteBatch()
+	wb.Timestamp = s.timestamp
+	if err := wb.SetWithTTL(key, value, ttl); err != nil {
+		return err
+	}
+	return s.Write(wb, false)
+}
+
+// flushWriteBatch writes the pending writes of the previous requests in the same raft entry to
+// the data storage, it is used by the commands that operate the data storage directly.
+func flushWriteBatch(ctx command.Context) error {
+	kv, ok := ctx.DataStorage().(storage.KVStorage)
+	if !ok {
+		return nil
+	}
+
+	wb := ctx.WriteBatch()
+	if err := kv.Write(wb, false); err != nil {
+		return err
+	}
+
+	ts := wb.Timestamp
+	wb.Reset()
+	wb.Timestamp = ts
+	return nil
+}
+
 type applyDelegate struct {
 	store  *store
 	ps     *peerStorage
@@ -306,6 +353,20 @@ type applyDelegate struct {
 	pendingCMDs          []cmd
 	pendingChangePeerCMD cmd
 	ctx                  *applyContext
+	loadSampler          *loadSampler
+
+	// mergeState is not nil if the shard applied the PrepareMerge, all write requests
+	// will be rejected.
+	mergeState *bhraftpb.MergeState
+	// If the CommitMerge is applied before the local source shard applied the PrepareMerge,
+	// we set waitingMerge, and the CommitMerge and all the following committed entries will
+	// be applied after the source shard is ready.
+	waitingMerge        bool
+	pendingMergeEntries []raftpb.Entry
+
+	// the consistency check of the last ComputeHash, used to verify the consistency with
+	// the leader when applying the VerifyHash.
+	hashCheck *hashCheck
 
 	// sync data after exec admin requests.
 	// Before restart we applied index is `100`, If `Customize.CustomAdjustInitAppliedIndexFactory` is set,
@@ -392,10 +453,19 @@ func (d *applyDelegate) applyCommittedEntries(commitedEntries []raftpb.Entry) {
 		return
 	}
 
+	if d.waitingMerge {
+		d.pendingMergeEntries = append(d.pendingMergeEntries, commitedEntries...)
+		return
+	}
+
 	start := time.Now()
 	req := pb.AcquireRaftCMDRequest()
 
-	for _, entry := range commitedEntries {
+	// the applied entries are fed to the change subscriptions
+	var changes []changeEntry
+	capture := d.store.cdc.hasSubscribers()
+
+	for idx, entry := range commitedEntries {
 		if d.isPendingRemove() {
 			// This peer is about to be destroyed, skip everything.
 			break
@@ -429,6 +499,18 @@ func (d *applyDelegate) applyCommittedEntries(commitedEntries []raftpb.Entry) {
 			result = d.applyConfChange(&entry)
 		}
 
+		if d.waitingMerge {
+			logger.Infof("shard %d wait for the source shard to apply the prepare merge at index %d",
+				d.shard.ID,
+				entry.Index)
+			d.pendingMergeEntries = append(d.pendingMergeEntries, commitedEntries[idx:]...)
+			break
+		}
+
+		if capture {
+			changes = append(changes, changeEntry{index: entry.Index, requests: getChangeRequests(req, d.ctx.executedBitmap())})
+		}
+
 		asyncResult := asyncApplyResult{}
 		asyncResult.shardID = d.shard.ID
 		asyncResult.appliedIndexTerm = d.appliedIndexTerm
@@ -445,6 +527,10 @@ func (d *applyDelegate) applyCommittedEntries(commitedEntries []raftpb.Entry) {
 		}
 	}
 
+	if capture && len(changes) > 0 {
+		d.store.cdc.onApplied(d.shard, changes, d.applyState.AppliedIndex)
+	}
+
 	// only release RaftCMDRequest. Header and Requests fields is pb created in Unmarshal
 	pb.ReleaseRaftCMDRequest(req)
 
@@ -521,6 +607,11 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 			d.shard.ID)
 	}
 
+	if d.shouldWaitMergeSource() {
+		d.waitingMerge = true
+		return nil
+	}
+
 	c, ok := d.findCB(d.ctx)
 	if d.isPendingRemove() {
 		logger.Fatalf("shard %d apply raft comand can not pending remove",
@@ -533,8 +624,11 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 	var writeBytes uint64
 	var diffBytes int64
 
+	d.ctx.dataWB.Timestamp = d.ctx.req.Header.ProposeTime
 	if !d.checkEpoch(d.ctx.req) {
 		resp = errorStaleEpochResp(d.ctx.req.Header.ID, d.term, d.shard)
+	} else if !d.checkMerging(d.ctx.req) {
+		resp = errorStaleEpochResp(d.ctx.req.Header.ID, d.term, d.shard)
 	} else {
 		if d.ctx.req.AdminRequest != nil {
 			resp, result, err = d.execAdminRequest(d.ctx)
@@ -564,6 +658,12 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 		d.ctx.metrics.sizeDiffHint += uint64(diffBytes)
 	}
 
+	// the rejected requests are saved with the apply state, so they are skipped when the
+	// changes are loaded from the raft log
+	if executed := d.ctx.executedBitmap(); executed != nil {
+		d.ctx.raftWB.Set(getExecutedKey(d.shard.ID, d.ctx.index), executed)
+	}
+
 	d.ctx.applyState.AppliedIndex = d.ctx.index
 	if !d.isPendingRemove() {
 		if sc, ok := d.store.cfg.Test.Shards[d.shard.ID]; !ok || !sc.SkipSaveRaftApplyState {
@@ -598,6 +698,10 @@ func (d *applyDelegate) doApplyRaftCMD() *execResult {
 	d.applyState = d.ctx.applyState
 	d.term = d.ctx.term
 
+	if result != nil && result.prepareMerge != nil {
+		d.store.markMergePrepared(d.shard.ID, result.prepareMerge.state)
+	}
+
 	if ok {
 		if resp != nil {
 			buildTerm(d.term, resp)
@@ -635,6 +739,45 @@ func (d *applyDelegate) checkEpoch(req *raftcmdpb.RaftCMDRequest) bool {
 	return checkEpoch(d.shard, req)
 }
 
+// checkMerging returns false if the request can not be applied after the shard
+// applied the PrepareMerge.
+func (d *applyDelegate) checkMerging(req *raftcmdpb.RaftCMDRequest) bool {
+	if d.mergeState == nil {
+		return true
+	}
+
+	return isAllowedInMerging(req)
+}
+
+// shouldWaitMergeSource returns true if the current request is a CommitMerge,
+// and the source shard on the current store has not applied the PrepareMerge.
+func (d *applyDelegate) shouldWaitMergeSource() bool {
+	req := d.ctx.req
+	if req.AdminRequest == nil ||
+		req.AdminRequest.CmdType != raftcmdpb.AdminCmdType_CommitMerge ||
+		!d.checkEpoch(req) {
+		return false
+	}
+
+	commit := req.AdminRequest.CommitMerge
+	return d.store.waitMergePrepared(commit.Source.ID, commit.Commit, d.shard.ID)
+}
+
+func (d *applyDelegate) resumeCommitMerge() {
+	if !d.waitingMerge {
+		return
+	}
+
+	entries := d.pendingMergeEntries
+	d.waitingMerge = false
+	d.pendingMergeEntries = nil
+
+	logger.Infof("shard %d resume to apply %d committed entries after the source shard is ready",
+		d.shard.ID,
+		len(entries))
+	d.applyCommittedEntries(entries)
+}
+
 func isChangePeerCMD(req *raftcmdpb.RaftCMDRequest) bool {
 	return nil != req.AdminRequest &&
 		(req.AdminRequest.CmdType == raftcmdpb.AdminCmdType_ChangePeer ||
@@ -21,6 +21,7 @@ import (
 
 	"github.com/fagongzi/util/collection/deque"
 	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
@@ -51,6 +52,28 @@ func (d *applyDelegate) execAdminRequest(ctx *applyContext) (*raftcmdpb.RaftCMDR
 		return resp, result, err
 	case raftcmdpb.AdminCmdType_CompactLog:
 		return d.doExecCompactRaftLog(ctx)
+	case raftcmdpb.AdminCmdType_PrepareMerge:
+		resp, result, err := d.doExecPrepareMerge(ctx)
+		if result != nil {
+			result.needSyncData = true
+		}
+		return resp, result, err
+	case raftcmdpb.AdminCmdType_CommitMerge:
+		resp, result, err := d.doExecCommitMerge(ctx)
+		if result != nil {
+			result.needSyncData = true
+		}
+		return resp, result, err
+	case raftcmdpb.AdminCmdType_RollbackMerge:
+		resp, result, err := d.doExecRollbackMerge(ctx)
+		if result != nil {
+			result.needSyncData = true
+		}
+		return resp, result, err
+	case raftcmdpb.AdminCmdType_ComputeHash:
+		return d.doExecComputeHash(ctx)
+	case raftcmdpb.AdminCmdType_VerifyHash:
+		return d.doExecVerifyHash(ctx)
 	}
 
 	return nil, nil, nil
@@ -459,6 +482,206 @@ func (d *applyDelegate) doExecCompactRaftLog(ctx *applyContext) (*raftcmdpb.Raft
 	return rsp, result, nil
 }
 
+func (d *applyDelegate) doExecPrepareMerge(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	ctx.metrics.admin.merge++
+
+	req := ctx.req.AdminRequest.PrepareMerge
+	if d.mergeState != nil {
+		return nil, nil, fmt.Errorf("shard %d is already merging into shard %d",
+			d.shard.ID,
+			d.mergeState.Target.ID)
+	}
+
+	logger.Infof("shard %d do apply prepare merge into shard %d at epoch %+v, target epoch %+v",
+		d.shard.ID,
+		req.Target.ID,
+		d.shard.Epoch,
+		req.Target.Epoch)
+
+	// Increase the version and conf version to reject all the requests proposed before.
+	res := bhmetapb.Shard{}
+	protoc.MustUnmarshal(&res, protoc.MustMarshal(&d.shard))
+	res.Epoch.Version++
+	res.Epoch.ConfVer++
+
+	state := bhraftpb.MergeState{
+		Commit: ctx.index,
+		Target: req.Target,
+	}
+	d.store.updatePeerStateWithMerge(res, bhraftpb.PeerState_Merging, &state, ctx.raftWB)
+
+	d.shard = res
+	d.mergeState = &state
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_PrepareMerge, &raftcmdpb.PrepareMergeResponse{})
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_PrepareMerge,
+		prepareMerge: &prepareMergeResult{
+			shard: res,
+			state: state,
+		},
+	}
+
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecCommitMerge(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	req := ctx.req.AdminRequest.CommitMerge
+	source := req.Source
+
+	if d.mergeState != nil {
+		return nil, nil, fmt.Errorf("shard %d is merging into shard %d, can not commit merge",
+			d.shard.ID,
+			d.mergeState.Target.ID)
+	}
+
+	state, ok := d.store.getMergePrepared(source.ID)
+	if !ok || state.Commit != req.Commit {
+		return nil, nil, fmt.Errorf("shard %d prepare merge state %+v not match commit %d",
+			source.ID,
+			state,
+			req.Commit)
+	}
+
+	// The target shard must be not changed since the source shard applied the PrepareMerge,
+	// otherwise the source shard maybe rollback.
+	if state.Target.ID != d.shard.ID ||
+		state.Target.Epoch.Version != d.shard.Epoch.Version {
+		return nil, nil, fmt.Errorf("shard %d changed since prepare merge, current=<%+v> prepare=<%+v>",
+			d.shard.ID,
+			d.shard.Epoch,
+			state.Target.Epoch)
+	}
+
+	res := bhmetapb.Shard{}
+	protoc.MustUnmarshal(&res, protoc.MustMarshal(&d.shard))
+	if len(source.End) > 0 && bytes.Equal(source.End, res.Start) {
+		res.Start = source.Start
+	} else if len(res.End) > 0 && bytes.Equal(res.End, source.Start) {
+		res.End = source.End
+	} else {
+		return nil, nil, fmt.Errorf("shard %d is not adjacent to shard %d",
+			source.ID,
+			d.shard.ID)
+	}
+
+	res.Epoch.Version = source.Epoch.Version
+	if d.shard.Epoch.Version > res.Epoch.Version {
+		res.Epoch.Version = d.shard.Epoch.Version
+	}
+	res.Epoch.Version++
+
+	logger.Infof("shard %d do apply commit merge shard %d at epoch %+v, new range [%+v, %+v)",
+		d.shard.ID,
+		source.ID,
+		res.Epoch,
+		res.Start,
+		res.End)
+
+	// The target state and the source tombstone must be written atomically, the data of
+	// the source shard belongs to the target shard now.
+	d.store.updatePeerState(res, bhraftpb.PeerState_Normal, ctx.raftWB)
+	d.store.updatePeerStateWithMerge(source, bhraftpb.PeerState_Tombstone, &bhraftpb.MergeState{
+		Commit: req.Commit,
+		Target: res,
+	}, ctx.raftWB)
+	d.store.clearMergePrepared(source.ID)
+
+	d.shard = res
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_CommitMerge, &raftcmdpb.CommitMergeResponse{
+		Shard: res,
+	})
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_CommitMerge,
+		commitMerge: &commitMergeResult{
+			shard:  res,
+			source: source,
+		},
+	}
+
+	ctx.metrics.admin.mergeSucceed++
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecRollbackMerge(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	req := ctx.req.AdminRequest.RollbackMerge
+	if d.mergeState == nil || d.mergeState.Commit != req.Commit {
+		return nil, nil, fmt.Errorf("shard %d merge state %+v not match rollback commit %d",
+			d.shard.ID,
+			d.mergeState,
+			req.Commit)
+	}
+
+	logger.Infof("shard %d do apply rollback merge into shard %d at epoch %+v",
+		d.shard.ID,
+		d.mergeState.Target.ID,
+		d.shard.Epoch)
+
+	res := bhmetapb.Shard{}
+	protoc.MustUnmarshal(&res, protoc.MustMarshal(&d.shard))
+	res.Epoch.Version++
+
+	d.store.updatePeerState(res, bhraftpb.PeerState_Normal, ctx.raftWB)
+	d.store.clearMergePrepared(d.shard.ID)
+
+	d.shard = res
+	d.mergeState = nil
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_RollbackMerge, &raftcmdpb.RollbackMergeResponse{})
+	result := &execResult{
+		adminType: raftcmdpb.AdminCmdType_RollbackMerge,
+		rollbackMerge: &rollbackMergeResult{
+			shard: res,
+		},
+	}
+
+	ctx.metrics.admin.mergeRollback++
+	return rsp, result, nil
+}
+
+func (d *applyDelegate) doExecComputeHash(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	compute, err := d.store.DataStorageByGroup(d.shard.Group, d.shard.ID).PrepareComputeHash(encStartKey(&d.shard), encEndKey(&d.shard))
+	if err != nil {
+		logger.Errorf("shard %d compute hash at index %d failed with %+v",
+			d.shard.ID,
+			ctx.index,
+			err)
+		return nil, nil, err
+	}
+
+	d.hashCheck.reset(ctx.index)
+	err = d.store.computeHash(d.shard.ID, d.hashCheck, ctx.index, compute)
+	if err != nil {
+		logger.Errorf("shard %d add compute hash job at index %d failed with %+v",
+			d.shard.ID,
+			ctx.index,
+			err)
+	}
+
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_ComputeHash, &raftcmdpb.ComputeHashResponse{
+		Index: ctx.index,
+	})
+	return rsp, nil, nil
+}
+
+func (d *applyDelegate) doExecVerifyHash(ctx *applyContext) (*raftcmdpb.RaftCMDResponse, *execResult, error) {
+	req := ctx.req.AdminRequest.VerifyHash
+	if index := d.hashCheck.getIndex(); req.Index != index {
+		// The ComputeHash is applied before restart, or skipped by the snapshot
+		logger.Infof("shard %d skip verify hash at index %d, last compute hash index %d",
+			d.shard.ID,
+			req.Index,
+			index)
+		return nil, nil, nil
+	}
+
+	// the hash of the current replica may be still computing, it will be compared after computed
+	if ok, consistent := d.hashCheck.setExpected(req.Index, req.Hash); ok {
+		d.store.reportHashCheck(d.shard.ID, req.Index, consistent)
+	}
+
+	rsp := newAdminRaftCMDResponse(raftcmdpb.AdminCmdType_VerifyHash, &raftcmdpb.VerifyHashResponse{})
+	return rsp, nil, nil
+}
+
 func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raftcmdpb.RaftCMDResponse) {
 	writeBytes := uint64(0)
 	diffBytes := int64(0)
@@ -470,6 +693,15 @@ func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raf
 			logger.Debugf("%s exec", hex.EncodeToString(req.ID))
 		}
 		ctx.offset = idx
+		if err := d.store.intercept(command.ApplyStage, d.shard, req, ctx); err != nil {
+			rsp := pb.AcquireResponse()
+			rsp.Error = *err
+			rsp.OriginRequest = req
+			rsp.OriginRequest.Key = DecodeDataKey(req.Key)
+			resp.Responses = append(resp.Responses, rsp)
+			continue
+		}
+
 		if h, ok := d.store.writeHandlers[req.CustemType]; ok {
 			written, diff, rsp := h(d.shard, req, ctx)
 			if rsp.Stale {
@@ -479,9 +711,14 @@ func (d *applyDelegate) execWriteRequest(ctx *applyContext) (uint64, int64, *raf
 				rsp.OriginRequest.Key = DecodeDataKey(req.Key)
 			}
 
+			if !rsp.Stale {
+				ctx.executed = append(ctx.executed, idx)
+			}
+
 			resp.Responses = append(resp.Responses, rsp)
 			writeBytes += written
 			diffBytes += diff
+			d.loadSampler.record(req.Key, written)
 		} else {
 			logger.Fatalf("%s missing write handle func for type %d, registers %+v",
 				hex.EncodeToString(req.ID),
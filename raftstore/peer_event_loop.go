@@ -14,12 +14,15 @@
 package raftstore
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/util"
 	"go.etcd.io/etcd/raft"
@@ -32,20 +35,34 @@ const (
 )
 
 type action struct {
-	actionType actionType
-	splitKeys  [][]byte
-	splitIDs   []rpcpb.SplitID
-	epoch      metapb.ResourceEpoch
+	actionType  actionType
+	splitKeys   [][]byte
+	splitIDs    []rpcpb.SplitID
+	epoch       metapb.ResourceEpoch
+	mergeTarget bhmetapb.Shard
+	mergeSource bhmetapb.Shard
+	mergeState  bhraftpb.MergeState
+	hashIndex   uint64
+	hash        []byte
 }
 
 type actionType int
 
 const (
-	checkCompactAction = actionType(0)
-	doCampaignAction   = actionType(1)
-	checkSplitAction   = actionType(2)
-	doSplitAction      = actionType(3)
-	heartbeatAction    = actionType(4)
+	checkCompactAction     = actionType(0)
+	doCampaignAction       = actionType(1)
+	checkSplitAction       = actionType(2)
+	doSplitAction          = actionType(3)
+	heartbeatAction        = actionType(4)
+	doMergeAction          = actionType(5)
+	checkMergeAction       = actionType(6)
+	checkConsistencyAction = actionType(7)
+	checkLoadSplitAction   = actionType(8)
+	checkMergeTargetAction = actionType(9)
+	prepareMergeAction     = actionType(10)
+	commitMergeAction      = actionType(11)
+	rollbackMergeAction    = actionType(12)
+	verifyHashAction       = actionType(13)
 )
 
 func (pr *peerReplica) addRequest(req reqCtx) error {
@@ -54,6 +71,7 @@ func (pr *peerReplica) addRequest(req reqCtx) error {
 		return err
 	}
 
+	pr.wakeUp()
 	pr.addEvent()
 	return nil
 }
@@ -101,6 +119,10 @@ func (pr *peerReplica) step(msg raftpb.Message) {
 		return
 	}
 
+	// the followers respond the heartbeats of the hibernated leader
+	if msg.Type != raftpb.MsgHeartbeatResp {
+		pr.wakeUp()
+	}
 	pr.addEvent()
 }
 
@@ -111,7 +133,11 @@ func (pr *peerReplica) onAdmin(req *raftcmdpb.AdminRequest) error {
 }
 
 func (pr *peerReplica) onRaftTick(arg interface{}) {
-	if !pr.stopRaftTick {
+	if pr.isHibernated() {
+		pr.maybeWakeUpBySlot(time.Now())
+	}
+
+	if !pr.stopRaftTick && !pr.isHibernated() {
 		err := pr.ticks.Put(struct{}{})
 		if err != nil {
 			logger.Infof("shard %d raft tick stopped",
@@ -222,6 +248,24 @@ func (pr *peerReplica) handleAction(items []interface{}) {
 			}
 		case heartbeatAction:
 			pr.doHeartbeat()
+		case doMergeAction:
+			pr.doPrepareMerge(a.mergeTarget, a.epoch)
+		case checkMergeAction:
+			pr.doCheckMerge()
+		case checkMergeTargetAction:
+			pr.doCheckMergeTarget(a.mergeSource, a.mergeTarget, a.epoch)
+		case prepareMergeAction:
+			pr.proposePrepareMerge(a.mergeTarget, a.epoch)
+		case commitMergeAction:
+			pr.doCommitMerge(a.mergeSource, a.mergeState)
+		case rollbackMergeAction:
+			pr.proposeRollbackMerge(a.mergeState.Commit)
+		case verifyHashAction:
+			pr.proposeVerifyHash(a.hashIndex, a.hash)
+		case checkConsistencyAction:
+			pr.doCheckConsistency()
+		case checkLoadSplitAction:
+			pr.doCheckLoadSplit()
 		}
 	}
 
@@ -288,6 +332,12 @@ func (pr *peerReplica) handleTick(items []interface{}) {
 				pr.rn.Tick()
 			}
 		}
+
+		if !pr.isLeader() {
+			pr.pendingReads.tick(int(n), pr.store.cfg.Raft.ElectionTimeoutTicks, pr.getCurrentTerm())
+		}
+
+		pr.maybeHibernate(int(n))
 	}
 }
 
@@ -368,13 +418,32 @@ func (pr *peerReplica) doSplit(splitKeys [][]byte, splitIDs []rpcpb.SplitID, epo
 		Splits:  &raftcmdpb.BatchSplitRequest{},
 	}
 
+	prev := current.Start
 	for idx := range splitIDs {
+		splitKey := splitKeys[idx]
+		if fn := pr.store.splitKeyFunc; fn != nil {
+			key := fn(DecodeDataKey(splitKey))
+			if bytes.Compare(key, prev) <= 0 ||
+				(len(current.End) > 0 && bytes.Compare(key, current.End) >= 0) {
+				logger.Infof("shard %d skip split key %+v, adjusted to %+v",
+					pr.shardID,
+					splitKey,
+					key)
+				continue
+			}
+			splitKey = EncodeDataKey(current.Group, key)
+		}
+		prev = DecodeDataKey(splitKey)
+
 		req.Splits.Requests = append(req.Splits.Requests, raftcmdpb.SplitRequest{
-			SplitKey:   splitKeys[idx],
+			SplitKey:   splitKey,
 			NewShardID: splitIDs[idx].NewID,
 			NewPeerIDs: splitIDs[idx].NewPeerIDs,
 		})
 	}
+	if len(req.Splits.Requests) == 0 {
+		return
+	}
 	pr.onAdmin(req)
 }
 
@@ -457,33 +526,30 @@ func (pr *peerReplica) doCheckCompact() {
 
 	compactIdx--
 
-	if pr.store.cfg.Customize.CustomAdjustCompactFuncFactory != nil {
-		if fn := pr.store.cfg.Customize.CustomAdjustCompactFuncFactory(pr.ps.shard.Group); fn != nil {
-			idx, err := fn(pr.ps.shard, compactIdx)
-			if err != nil {
-				logger.Errorf("shard %d adjust compact idx %d failed with %+v",
-					pr.shardID,
-					compactIdx,
-					err)
-				return
-			}
+	idx, err := pr.store.adjustCompactFunc(pr.ps.shard.Group)(pr.ps.shard, compactIdx)
+	if err != nil {
+		logger.Errorf("shard %d adjust compact idx %d failed with %+v",
+			pr.shardID,
+			compactIdx,
+			err)
+		return
+	}
 
-			if idx > compactIdx {
-				logger.Fatalf("shard %d adjust compact idx %d failed, invalid adjust idx %d",
-					pr.shardID,
-					compactIdx,
-					idx,
-					err)
-				return
-			}
+	if idx > compactIdx {
+		logger.Fatalf("shard %d adjust compact idx %d failed, invalid adjust idx %d",
+			pr.shardID,
+			compactIdx,
+			idx,
+			err)
+		return
+	}
 
-			logger.Infof("shard %d compact idx %d updated to %d",
-				pr.shardID,
-				compactIdx,
-				idx,
-				err)
-			compactIdx = idx
-		}
+	if idx != compactIdx {
+		logger.Infof("shard %d compact idx %d updated to %d",
+			pr.shardID,
+			compactIdx,
+			idx)
+		compactIdx = idx
 	}
 
 	if compactIdx < firstIdx {
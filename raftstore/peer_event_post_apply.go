@@ -91,6 +91,12 @@ func (pr *peerReplica) doPostApplyResult(result asyncApplyResult) {
 		pr.doApplySplit(result.result.splitResult)
 	case raftcmdpb.AdminCmdType_CompactLog:
 		pr.doApplyCompactRaftLog(result.result.raftGCResult)
+	case raftcmdpb.AdminCmdType_PrepareMerge:
+		pr.doApplyPrepareMerge(result.result.prepareMerge)
+	case raftcmdpb.AdminCmdType_CommitMerge:
+		pr.doApplyCommitMerge(result.result.commitMerge)
+	case raftcmdpb.AdminCmdType_RollbackMerge:
+		pr.doApplyRollbackMerge(result.result.rollbackMerge)
 	}
 }
 
@@ -269,6 +275,70 @@ func (pr *peerReplica) doApplySplit(result *splitResult) {
 		result.shards)
 }
 
+func (pr *peerReplica) doApplyPrepareMerge(result *prepareMergeResult) {
+	logger.Infof("shard %d update to %+v by post apply prepare merge into shard %d",
+		pr.shardID,
+		result.shard.Epoch,
+		result.state.Target.ID)
+
+	state := result.state
+	pr.ps.shard = result.shard
+	pr.ps.mergeState = &state
+	pr.store.updateShardKeyRange(result.shard)
+
+	if pr.isLeader() {
+		pr.addAction(action{actionType: heartbeatAction})
+	}
+
+	// try to commit merge immediately
+	pr.addAction(action{actionType: checkMergeAction})
+}
+
+func (pr *peerReplica) doApplyCommitMerge(result *commitMergeResult) {
+	logger.Infof("shard %d update to %+v by post apply commit merge shard %d",
+		pr.shardID,
+		result.shard,
+		result.source.ID)
+
+	if source := pr.store.getPR(result.source.ID, false); source != nil {
+		pr.approximateSize += source.approximateSize
+		pr.approximateKeys += source.approximateKeys
+		source.mustDestroyByMerge()
+	}
+
+	pr.ps.shard = result.shard
+	pr.store.updateShardKeyRange(result.shard)
+	for _, p := range result.shard.Peers {
+		pr.store.peers.Store(p.ID, p)
+	}
+
+	if pr.isLeader() {
+		pr.addAction(action{actionType: heartbeatAction})
+	}
+
+	if pr.store.aware != nil {
+		pr.store.aware.Merged(result.shard, result.source)
+	}
+
+	logger.Infof("shard %d merge shard %d completed",
+		pr.shardID,
+		result.source.ID)
+}
+
+func (pr *peerReplica) doApplyRollbackMerge(result *rollbackMergeResult) {
+	logger.Infof("shard %d update to %+v by post apply rollback merge",
+		pr.shardID,
+		result.shard.Epoch)
+
+	pr.ps.shard = result.shard
+	pr.ps.mergeState = nil
+	pr.store.updateShardKeyRange(result.shard)
+
+	if pr.isLeader() {
+		pr.addAction(action{actionType: heartbeatAction})
+	}
+}
+
 func (pr *peerReplica) doApplyCompactRaftLog(result *raftGCResult) {
 	total := pr.ps.lastReadyIndex - result.firstIndex
 	remain := pr.ps.lastReadyIndex - result.state.Index - 1
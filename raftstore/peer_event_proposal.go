@@ -17,10 +17,15 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/fagongzi/util/hack"
 	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/metric"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/errorpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"go.etcd.io/etcd/raft/raftpb"
 	"go.etcd.io/etcd/raft/tracker"
@@ -68,9 +73,33 @@ func (pr *peerReplica) handleRequest(items []interface{}) {
 			return
 		}
 
+		now := time.Now().UnixNano()
 		for i := int64(0); i < n; i++ {
 			req := items[i].(reqCtx)
 			if req.req != nil {
+				// all the requests queued before the cancel request are handled
+				if req.req.Cancel {
+					pr.canceled.Delete(hack.SliceToString(req.req.ID))
+					continue
+				}
+
+				if _, ok := pr.canceled.Load(hack.SliceToString(req.req.ID)); ok {
+					respWithError(req.req, &errorpb.Error{Message: errRequestCanceled.Error()}, req.cb)
+					continue
+				}
+
+				// the client has given up the request after the deadline
+				if stopAt := pb.GetStopAt(req.req); stopAt > 0 && now >= stopAt {
+					respWithError(req.req, &errorpb.Error{Message: errRequestExpired.Error()}, req.cb)
+					continue
+				}
+
+				pr.proposalCtx.reset()
+				if err := pr.store.intercept(command.ProposalStage, pr.ps.shard, req.req, pr.proposalCtx); err != nil {
+					respWithError(req.req, err, req.cb)
+					continue
+				}
+
 				if h, ok := pr.store.localHandlers[req.req.CustemType]; ok {
 					rsp, err := h(pr.ps.shard, req.req)
 					if err != nil {
@@ -112,6 +141,11 @@ func (pr *peerReplica) propose(c cmd) {
 		return
 	}
 
+	if pr.ps.mergeState != nil && !isAllowedInMerging(c.req) {
+		c.respOtherError(errShardMerging)
+		return
+	}
+
 	// Note:
 	// The peer that is being checked is a leader. It might step down to be a follower later. It
 	// doesn't matter whether the peer is a leader or not. If it's not a leader, the proposing
@@ -154,11 +188,22 @@ func (pr *peerReplica) propose(c cmd) {
 
 func (pr *peerReplica) execReadIndex(c cmd) {
 	if !pr.isLeader() {
-		target, _ := pr.store.getPeer(pr.getLeaderPeerID())
-		c.respNotLeader(pr.shardID, target)
+		pr.execFollowerReadIndex(c)
 		return
 	}
 
+	if pr.store.cfg.Raft.EnableLeaseRead {
+		now := time.Now()
+		if pr.leaderLease.inLease(pr.getCurrentTerm(), now) && pr.readyToHandleRead() {
+			pr.metrics.propose.leaseReadHit++
+			pr.doExecReadCmd(c)
+			return
+		}
+
+		pr.metrics.propose.leaseReadMiss++
+		c.readIndexAt = now
+	}
+
 	lastPendingReadCount := pr.pendingReadCount()
 	lastReadyReadCount := pr.readyReadCount()
 
@@ -178,6 +223,21 @@ func (pr *peerReplica) execReadIndex(c cmd) {
 	pr.metrics.propose.readIndex++
 }
 
+// execFollowerReadIndex the follower or learner sends the read index request to the
+// leader, and the read will be executed after the returned index is applied.
+func (pr *peerReplica) execFollowerReadIndex(c cmd) {
+	leader := pr.getLeaderPeerID()
+	if !c.allowFollower() || leader == 0 {
+		target, _ := pr.store.getPeer(leader)
+		c.respNotLeader(pr.shardID, target)
+		return
+	}
+
+	pr.rn.ReadIndex(c.getUUID())
+	pr.pendingReads.push(c)
+	pr.metrics.propose.readIndex++
+}
+
 func (pr *peerReplica) proposeNormal(c cmd) bool {
 	if !pr.isLeader() {
 		target, _ := pr.store.getPeer(pr.getLeaderPeerID())
@@ -185,6 +245,9 @@ func (pr *peerReplica) proposeNormal(c cmd) bool {
 		return false
 	}
 
+	// fix the propose time in the header, so that all replicas use the same
+	// base time to compute the expire time of the TTL keys
+	c.req.Header.ProposeTime = time.Now().UnixNano() / int64(time.Millisecond)
 	data := protoc.MustMarshal(c.req)
 	size := len(data)
 	metric.ObserveProposalBytes(int64(size))
@@ -313,6 +376,8 @@ func (pr *peerReplica) proposeTransferLeader(c cmd) bool {
 	}
 
 	if pr.isTransferLeaderAllowed(req.Peer) {
+		// the new leader may be elected immediately
+		pr.leaderLease.expire()
 		pr.doTransferLeader(req.Peer)
 	} else {
 		logger.Infof("shard %d transfer leader ignored directly, req=<%+v>",
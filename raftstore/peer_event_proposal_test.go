@@ -71,3 +71,61 @@ func TestIssue90(t *testing.T) {
 	assert.Nil(t, resps["r2"].Header)
 	assert.Equal(t, "2", string(resps["r2"].Responses[0].Value))
 }
+
+func TestExpiredRequestNotProposed(t *testing.T) {
+	c := NewSingleTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler, DisableScheduleTestCluster)
+	c.Start()
+	defer c.Stop()
+
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	s := c.GetStore(0)
+
+	w1 := createTestWriteReq("w1", "key1", "1")
+	pb.SetStopAt(w1, time.Now().Add(-time.Millisecond).UnixNano())
+	w2 := createTestWriteReq("w2", "key2", "2")
+	pb.SetStopAt(w2, time.Now().Add(time.Second*10).UnixNano())
+	resps, err := sendTestReqs(s, time.Second*10, nil, nil, w1, w2)
+	assert.NoError(t, err)
+	assert.Equal(t, errRequestExpired.Error(), resps["w1"].Responses[0].Error.Message)
+	assert.Equal(t, "OK", string(resps["w2"].Responses[0].Value))
+
+	resps, err = sendTestReqs(s, time.Second*10, nil, nil, createTestReadReq("r1", "key1"))
+	assert.NoError(t, err)
+	assert.Empty(t, resps["r1"].Responses[0].Value)
+}
+
+func TestCanceledRequestNotProposed(t *testing.T) {
+	c := NewSingleTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler, DisableScheduleTestCluster)
+	c.Start()
+	defer c.Stop()
+
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	s := c.GetStore(0)
+	pr := s.(*store).getPR(c.GetShardByIndex(0).ID, false)
+	assert.NotNil(t, pr)
+
+	// w1 is canceled after queued
+	pr.canceled.Store("w1", struct{}{})
+	w1 := createTestWriteReq("w1", "key1", "1")
+	w2 := createTestWriteReq("w2", "key2", "2")
+	resps, err := sendTestReqs(s, time.Second*10, nil, nil, w1, w2)
+	assert.NoError(t, err)
+	assert.Equal(t, errRequestCanceled.Error(), resps["w1"].Responses[0].Error.Message)
+	assert.Equal(t, "OK", string(resps["w2"].Responses[0].Value))
+
+	resps, err = sendTestReqs(s, time.Second*10, nil, nil, createTestReadReq("r1", "key1"))
+	assert.NoError(t, err)
+	assert.Empty(t, resps["r1"].Responses[0].Value)
+
+	// the mark is removed when the cancel request is handled
+	cancel := createTestWriteReq("w1", "key1", "1")
+	cancel.Cancel = true
+	assert.NoError(t, s.OnRequest(cancel))
+	for i := 0; i < 100; i++ {
+		if _, ok := pr.canceled.Load("w1"); !ok {
+			return
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	assert.Fail(t, "the canceled mark is not removed")
+}
@@ -150,6 +150,16 @@ func (pr *peerReplica) handleRaftReadyAppend(ctx *readyContext, rd *raft.Ready)
 	pr.doSaveRaftState(ctx)
 	pr.doSaveApplyState(ctx)
 
+	// the entries must be persisted before the raft state
+	if len(rd.Entries) > 0 && !pr.store.cfg.Raft.RaftLog.DisableSync {
+		err := pr.store.logs.Sync()
+		if err != nil {
+			logger.Fatalf("shard %d sync raft log failure, errors\n %+v",
+				pr.shardID,
+				err)
+		}
+	}
+
 	err := pr.store.MetadataStorage().Write(ctx.wb, !pr.store.cfg.Raft.RaftLog.DisableSync)
 	if err != nil {
 		logger.Fatalf("shard %d handle raft ready failure, errors\n %+v",
@@ -157,6 +167,20 @@ func (pr *peerReplica) handleRaftReadyAppend(ctx *readyContext, rd *raft.Ready)
 			err)
 	}
 
+	// The entries before the snapshot are removed after the new raft state persisted, the entries
+	// in the MetaStorage are already removed with the raft state.
+	if !raft.IsEmptySnap(rd.Snapshot) {
+		err := pr.store.logs.Compact(pr.shardID, rd.Snapshot.Metadata.Index+1)
+		if err == nil {
+			err = pr.store.compactExecuted(pr.shardID, rd.Snapshot.Metadata.Index+1)
+		}
+		if err != nil {
+			logger.Fatalf("shard %d compact raft log to snapshot failure, errors\n %+v",
+				pr.shardID,
+				err)
+		}
+	}
+
 	metric.ObserveRaftLogAppendDuration(start)
 }
 
@@ -242,32 +266,24 @@ func (pr *peerReplica) doAppendEntries(ctx *readyContext, entries []raftpb.Entry
 		return nil
 	}
 
-	prevLastIndex := ctx.raftState.LastIndex
 	lastIndex := entries[c-1].Index
 	lastTerm := entries[c-1].Term
 
-	for _, e := range entries {
-		d := protoc.MustMarshal(&e)
-		err := ctx.wb.Set(getRaftLogKey(pr.shardID, e.Index), d)
-		if err != nil {
-			logger.Fatalf("shard %d append entry <%s> failed with %+v",
-				pr.shardID,
-				e.String(),
-				err)
-			return err
-		}
+	// The previously appended log entries which never committed are removed by the log storage.
+	// The default log storage writes the entries with the raft state in the same write batch.
+	var err error
+	if logs, ok := pr.store.logs.(*kvLogStorage); ok {
+		err = logs.appendTo(ctx.wb, pr.shardID, entries)
+	} else {
+		err = pr.store.logs.Append(pr.shardID, entries)
 	}
-
-	// Delete any previously appended log entries which never committed.
-	for index := lastIndex + 1; index < prevLastIndex+1; index++ {
-		err := ctx.wb.Delete(getRaftLogKey(pr.shardID, index))
-		if err != nil {
-			logger.Fatalf("shard %d delete any previously appended log entries %d failed with %+v",
-				pr.shardID,
-				index,
-				err)
-			return err
-		}
+	if err != nil {
+		logger.Fatalf("shard %d append entries [%d, %d] failed with %+v",
+			pr.shardID,
+			entries[0].Index,
+			lastIndex,
+			err)
+		return err
 	}
 
 	ctx.raftState.LastIndex = lastIndex
@@ -411,7 +427,9 @@ func (pr *peerReplica) applyCommittedEntries(rd *raft.Ready) {
 func (pr *peerReplica) doApplyReads(rd *raft.Ready) {
 	if pr.readyToHandleRead() {
 		for _, state := range rd.ReadStates {
-			pr.pendingReads.ready(state)
+			if start := pr.pendingReads.ready(state); !start.IsZero() {
+				pr.leaderLease.renew(pr.getCurrentTerm(), start)
+			}
 		}
 
 		if len(rd.ReadStates) > 0 {
@@ -423,6 +441,8 @@ func (pr *peerReplica) doApplyReads(rd *raft.Ready) {
 	// actually stale.
 	if rd.SoftState != nil {
 		if rd.SoftState.RaftState != raft.StateLeader {
+			pr.leaderLease.expire()
+
 			// all uncommitted reads will be dropped silently in raft.
 			for _, c := range pr.pendingReads.reads {
 				c.resp(errorStaleCMDResp(c.getUUID(), pr.getCurrentTerm()))
@@ -486,6 +506,7 @@ func (pr *peerReplica) sendRaftMsg(msg raftpb.Message) error {
 	}
 
 	sendMsg.Message = msg
+	sendMsg.Hibernate = pr.shouldSendHibernate(msg)
 	pr.store.trans.Send(sendMsg)
 
 	switch msg.Type {
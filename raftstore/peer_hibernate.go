@@ -0,0 +1,216 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+// hibernateState the idle shard stops ticking to save the cost of the raft heartbeats and the
+// event loop. The leader becomes idle if there is no requests and all the followers caught up
+// in an election timeout, then it sends the heartbeats with the hibernate flag, and stops ticking
+// after all the followers responded. The followers stop ticking after they received the flag from
+// the leader, so that no one campaigns while the leader is hibernated.
+//
+// The hibernated peer is waked up by the requests and the raft messages. All the hibernated peers
+// are also waked up at the same time in every wakeup interval aligned with the wall clock, the
+// leader sends the heartbeats again to detect the failed followers, and the followers campaign if
+// the leader is failed.
+type hibernateState struct {
+	// hibernated, slot and leader are accessed by the tick timer and the message handler
+	hibernated uint32
+	slot       int64
+	// leader is the peer id of the hibernated leader received by the follower
+	leader uint64
+
+	// the fields below are only accessed in the event loop
+	asleep         bool
+	idleTicks      int
+	pending        bool
+	sentHibernates bool
+	sentAt         time.Time
+	sleepAt        time.Time
+}
+
+func (pr *peerReplica) isHibernated() bool {
+	return atomic.LoadUint32(&pr.hibernate.hibernated) == 1
+}
+
+// wakeUp resumes the raft tick of the hibernated peer
+func (pr *peerReplica) wakeUp() {
+	if atomic.CompareAndSwapUint32(&pr.hibernate.hibernated, 1, 0) {
+		if logger.DebugEnabled() {
+			logger.Debugf("shard %d waked up", pr.shardID)
+		}
+	}
+}
+
+// maybeWakeUpBySlot wakes up the hibernated peer in every wakeup interval, the peer waits
+// for the heartbeats of the leader again before it hibernates.
+func (pr *peerReplica) maybeWakeUpBySlot(now time.Time) {
+	if pr.hibernateSlot(now) != atomic.LoadInt64(&pr.hibernate.slot) {
+		atomic.StoreUint64(&pr.hibernate.leader, 0)
+		pr.wakeUp()
+	}
+}
+
+func (pr *peerReplica) hibernateSlot(now time.Time) int64 {
+	return now.UnixNano() / int64(pr.store.cfg.Raft.HibernateWakeupInterval.Duration)
+}
+
+// onHibernateMessage records whether the message is sent by the hibernated leader. It is called
+// after the message is stepped, since every raft message received wakes up the peer except the
+// responses of the heartbeats.
+func (pr *peerReplica) onHibernateMessage(msg *bhraftpb.RaftMessage) {
+	if msg.Hibernate {
+		atomic.StoreUint64(&pr.hibernate.leader, msg.From.ID)
+		return
+	}
+
+	if msg.Message.Type != raftpb.MsgHeartbeatResp {
+		atomic.StoreUint64(&pr.hibernate.leader, 0)
+	}
+}
+
+// maybeHibernate is called in the event loop after the ticks handled
+func (pr *peerReplica) maybeHibernate(ticks int) {
+	h := &pr.hibernate
+	if h.asleep {
+		// waked up by the requests or the raft messages
+		h.asleep = false
+		h.resetIdle()
+	}
+
+	if !pr.store.cfg.Raft.EnableHibernate {
+		return
+	}
+
+	if !pr.isIdle() {
+		h.resetIdle()
+		return
+	}
+
+	h.idleTicks += ticks
+	if !pr.isLeader() {
+		leader := pr.getLeaderPeerID()
+		if leader != 0 && atomic.LoadUint64(&h.leader) == leader {
+			pr.doHibernate()
+		}
+		return
+	}
+
+	if h.idleTicks < pr.store.cfg.Raft.ElectionTimeoutTicks {
+		return
+	}
+
+	if !h.pending {
+		h.pending = true
+		return
+	}
+
+	// no heartbeats are sent if the shard has only one peer
+	if (h.sentHibernates || len(pr.ps.shard.Peers) == 1) &&
+		pr.allPeersRespondedAfter(h.sentAt) {
+		pr.doHibernate()
+	}
+}
+
+func (pr *peerReplica) doHibernate() {
+	h := &pr.hibernate
+	now := time.Now()
+	h.asleep = true
+	h.sleepAt = now
+	h.resetIdle()
+	atomic.StoreInt64(&h.slot, pr.hibernateSlot(now))
+	atomic.StoreUint32(&h.hibernated, 1)
+
+	if logger.DebugEnabled() {
+		logger.Debugf("shard %d hibernated, leader %t", pr.shardID, pr.isLeader())
+	}
+}
+
+func (h *hibernateState) resetIdle() {
+	h.idleTicks = 0
+	h.pending = false
+	h.sentHibernates = false
+}
+
+// shouldSendHibernate returns true if the heartbeat need to tell the followers that the leader
+// is going to hibernate
+func (pr *peerReplica) shouldSendHibernate(msg raftpb.Message) bool {
+	h := &pr.hibernate
+	if h.pending && msg.Type == raftpb.MsgHeartbeat {
+		if !h.sentHibernates {
+			h.sentHibernates = true
+			h.sentAt = time.Now()
+		}
+		return true
+	}
+
+	return false
+}
+
+// isIdle returns true if there is no requests and no pending raft works, and the leader also
+// requires that all the followers caught up. The raft ready is still handled after hibernated,
+// so it is not checked here, the heartbeats are also in the ready.
+func (pr *peerReplica) isIdle() bool {
+	if pr.stopRaftTick ||
+		pr.requests.Len() > 0 ||
+		pr.applyResults.Len() > 0 ||
+		!pr.batch.isEmpty() ||
+		len(pr.pendingReads.reads) > 0 ||
+		pr.pendingReadCount() > 0 ||
+		pr.readyReadCount() > 0 ||
+		!pr.ps.isApplyComplete() {
+		return false
+	}
+
+	if !pr.isLeader() {
+		return true
+	}
+
+	status := pr.rn.Status()
+	if status.LeadTransferee != 0 {
+		return false
+	}
+
+	lastIndex, _ := pr.ps.LastIndex()
+	if status.Commit != lastIndex {
+		return false
+	}
+	for _, p := range status.Progress {
+		if p.Match != lastIndex {
+			return false
+		}
+	}
+	return true
+}
+
+func (pr *peerReplica) allPeersRespondedAfter(t time.Time) bool {
+	for _, p := range pr.ps.shard.Peers {
+		if p.ID == pr.peer.ID {
+			continue
+		}
+
+		value, ok := pr.peerHeartbeatsMap.Load(p.ID)
+		if !ok || !value.(time.Time).After(t) {
+			return false
+		}
+	}
+	return true
+}
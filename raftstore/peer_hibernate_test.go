@@ -0,0 +1,96 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHibernateShard(t *testing.T) {
+	c := NewTestClusterStore(t,
+		DisableScheduleTestCluster,
+		SetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Raft.EnableHibernate = true
+			cfg.Raft.HibernateWakeupInterval = typeutil.NewDuration(time.Second * 3)
+			cfg.Replication.MaxPeerDownTime = typeutil.NewDuration(time.Millisecond * 500)
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	id := c.GetShardByIndex(0).ID
+
+	leader := waitShardHibernated(t, c, id)
+	// the hibernated followers are not down. The leader is waked up in every wakeup interval, so
+	// it's checked in the sleep that is not across the wakeup slot.
+	interval := leader.store.cfg.Raft.HibernateWakeupInterval.Duration
+	require.Eventually(t, func() bool {
+		now := time.Now()
+		remaining := interval - time.Duration(now.UnixNano()%int64(interval))
+		return leader.hibernate.asleep && remaining > time.Second
+	}, time.Second*10, time.Millisecond*10)
+	time.Sleep(time.Millisecond * 700)
+	require.True(t, leader.hibernate.asleep)
+	assert.Empty(t, leader.collectDownPeers())
+
+	// waked up by the requests
+	_, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	waitShardHibernated(t, c, id)
+
+	// the followers campaign after the wakeup interval if the hibernated leader is failed
+	for i, s := range c.stores {
+		if s.getPR(id, false) == leader {
+			c.StopNode(i)
+			break
+		}
+	}
+
+	timeoutC := time.After(time.Second * 20)
+	for {
+		for _, s := range c.stores {
+			if pr := s.getPR(id, false); pr != nil && pr != leader && pr.isLeader() {
+				return
+			}
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNow(t, "timeout wait new leader")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
+
+// waitShardHibernated waits all the replicas of the shard hibernated, and returns the leader
+func waitShardHibernated(t *testing.T, c *TestRaftCluster, id uint64) *peerReplica {
+	timeoutC := time.After(time.Second * 10)
+	for {
+		var leader *peerReplica
+		hibernated := 0
+		for _, s := range c.stores {
+			pr := s.getPR(id, false)
+			if pr != nil && pr.isHibernated() {
+				hibernated++
+				if pr.isLeader() {
+					leader = pr
+				}
+			}
+		}
+		if hibernated == len(c.stores) && leader != nil {
+			return leader
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNowf(t, "", "timeout wait shard %d hibernated", id)
+		case <-time.After(time.Millisecond * 20):
+		}
+	}
+}
@@ -14,6 +14,7 @@
 package raftstore
 
 import (
+	"math"
 	"time"
 
 	"github.com/fagongzi/util/protoc"
@@ -21,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
 	sn "github.com/matrixorigin/matrixcube/snapshot"
+	"github.com/matrixorigin/matrixcube/storage"
 	"go.etcd.io/etcd/raft/raftpb"
 )
 
@@ -44,7 +46,10 @@ func (pr *peerReplica) startRegistrationJob() {
 		term:             pr.getCurrentTerm(),
 		applyState:       pr.ps.raftApplyState,
 		appliedIndexTerm: pr.ps.appliedIndexTerm,
+		mergeState:       pr.ps.mergeState,
 		ctx:              newApplyContext(pr),
+		loadSampler:      pr.loadSampler,
+		hashCheck:        &hashCheck{},
 		syncData: pr.store.cfg.Customize.CustomAdjustInitAppliedIndexFactory != nil &&
 			pr.store.cfg.Customize.CustomAdjustInitAppliedIndexFactory(pr.ps.shard.Group) != nil,
 	}
@@ -113,6 +118,21 @@ func (pr *peerReplica) startSplitCheckJob() error {
 	return err
 }
 
+// startSplitJobWithPolicy finds the split key by the SCAN or APPROXIMATE policy which
+// is required by prophet, and splits the shard into two shards.
+func (pr *peerReplica) startSplitJobWithPolicy(policy metapb.CheckPolicy, epoch metapb.ResourceEpoch) error {
+	shard := pr.ps.shard
+	startKey := encStartKey(&shard)
+	endKey := encEndKey(&shard)
+
+	logger.Infof("shard %d start split job with policy %s",
+		pr.ps.shard.ID,
+		policy.String())
+	return pr.store.addSplitJob(func() error {
+		return pr.doSplitWithPolicy(policy, epoch, startKey, endKey)
+	})
+}
+
 func (ps *peerStorage) cancelApplyingSnapJob() bool {
 	ps.applySnapJobLock.RLock()
 	if ps.applySnapJob == nil {
@@ -248,6 +268,86 @@ func (ps *peerStorage) doGenerateSnapshotJob() error {
 	return nil
 }
 
+func (pr *peerReplica) doSplitWithPolicy(policy metapb.CheckPolicy, epoch metapb.ResourceEpoch, startKey, endKey []byte) error {
+	if !pr.isLeader() {
+		return nil
+	}
+
+	var splitKey []byte
+	var err error
+	ds := pr.store.DataStorageByGroup(pr.ps.shard.Group, pr.ps.shard.ID)
+	if policy == metapb.CheckPolicy_APPROXIMATE {
+		splitKey, err = ds.ApproximateSplitKey(startKey, endKey)
+		if err != nil {
+			logger.Errorf("shard %d approximate split key failed with %+v",
+				pr.shardID,
+				err)
+			return err
+		}
+	}
+
+	// the storage can not estimate the split key, scan to find the middle key
+	if len(splitKey) == 0 {
+		splitKey, err = scanMiddleKey(ds, startKey, endKey)
+		if err != nil {
+			logger.Errorf("shard %d scan split key failed with %+v",
+				pr.shardID,
+				err)
+			return err
+		}
+	}
+
+	if len(splitKey) == 0 {
+		logger.Infof("shard %d has no split key with policy %s",
+			pr.shardID,
+			policy.String())
+		return nil
+	}
+
+	logger.Infof("shard %d try to split with policy %s, split key %+v",
+		pr.shardID,
+		policy.String(),
+		splitKey)
+	return pr.askSplit(epoch, [][]byte{splitKey})
+}
+
+// askSplit asks prophet for the ids of the new shards, and splits the shard by the split keys
+func (pr *peerReplica) askSplit(epoch metapb.ResourceEpoch, splitKeys [][]byte) error {
+	current := pr.ps.shard
+	if current.Epoch.Version != epoch.Version {
+		logger.Infof("shard %d epoch changed, skip split, current=<%+v> split=<%+v>",
+			pr.shardID,
+			current.Epoch,
+			epoch)
+		return nil
+	}
+
+	newIDs, err := pr.store.pd.GetClient().AskBatchSplit(NewResourceAdapterWithShard(current), uint32(len(splitKeys)))
+	if err != nil {
+		logger.Errorf("shard %d ask batch split failed with %+v",
+			pr.shardID,
+			err)
+		return err
+	}
+
+	pr.addAction(action{actionType: doSplitAction, splitKeys: splitKeys, splitIDs: newIDs, epoch: epoch})
+	return nil
+}
+
+// scanMiddleKey returns the key that splits the bytes of [start, end) into two halves
+func scanMiddleKey(ds storage.DataStorage, start, end []byte) ([]byte, error) {
+	total, _, _, err := ds.SplitCheck(start, end, math.MaxUint64)
+	if err != nil || total == 0 {
+		return nil, err
+	}
+
+	_, _, splitKeys, err := ds.SplitCheck(start, end, (total+1)/2)
+	if err != nil || len(splitKeys) == 0 {
+		return nil, err
+	}
+	return splitKeys[0], nil
+}
+
 func (pr *peerReplica) doSplitCheck(epoch metapb.ResourceEpoch, startKey, endKey []byte) error {
 	if !pr.isLeader() {
 		return nil
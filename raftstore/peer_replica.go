@@ -24,6 +24,7 @@ import (
 	"github.com/fagongzi/util/format"
 	"github.com/fagongzi/util/hack"
 	"github.com/fagongzi/util/task"
+	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/metric"
@@ -109,9 +110,11 @@ type peerReplica struct {
 
 	peerHeartbeatsMap sync.Map
 	lastHBTime        uint64
+	hibernate         hibernateState
 
 	batch        *proposeBatch
 	pendingReads *readIndexQueue
+	leaderLease  leaderLease
 	ctx          context.Context
 	cancel       context.CancelFunc
 	items        []interface{}
@@ -122,6 +125,8 @@ type peerReplica struct {
 	applyResults *task.Queue
 	requests     *task.Queue
 	actions      *task.Queue
+	// canceled the ids of the canceled requests in the requests queue
+	canceled sync.Map
 
 	writtenKeys     uint64
 	writtenBytes    uint64
@@ -133,12 +138,19 @@ type peerReplica struct {
 	// TODO: setting on split check
 	approximateSize uint64
 	approximateKeys uint64
+	// the sampled load and the continuous hot checks of the load split
+	loadSampler   *loadSampler
+	loadSplitHits int
+	// the source leader waits for all the replicas appended the PrepareMerge
+	mergeWait mergeWaitState
 
 	metrics  localMetrics
 	stopOnce sync.Once
 	readyCtx *readyContext
 
 	readCtx *readContext
+	// proposalCtx the context of the interceptors before the requests proposed
+	proposalCtx *readContext
 }
 
 func createPeerReplica(store *store, shard *bhmetapb.Shard) (*peerReplica, error) {
@@ -204,6 +216,8 @@ func newPeerReplica(store *store, shard *bhmetapb.Shard, peer metapb.Peer) (*pee
 	}
 	pr.rn = rn
 	pr.readCtx = newReadContext(pr)
+	pr.proposalCtx = newReadContext(pr)
+	pr.loadSampler = newLoadSampler(store.cfg.Replication)
 	pr.events = task.NewRingBuffer(2)
 	pr.ticks = &task.Queue{}
 	pr.steps = &task.Queue{}
@@ -219,6 +233,9 @@ func newPeerReplica(store *store, shard *bhmetapb.Shard, peer metapb.Peer) (*pee
 	pr.pendingReads = &readIndexQueue{
 		shardID: shard.ID,
 	}
+	pr.leaderLease = leaderLease{
+		maxLease: store.cfg.Raft.GetLeaderLease(),
+	}
 
 	// If this shard has only one peer and I am the one, campaign directly.
 	if len(shard.Peers) == 1 && shard.Peers[0].ContainerID == store.meta.meta.ID {
@@ -276,9 +293,25 @@ func (pr *peerReplica) maybeCampaign() (bool, error) {
 }
 
 func (pr *peerReplica) mustDestroy() {
+	pr.doMustDestroy(false)
+}
+
+// mustDestroyByMerge destroy the source shard after it merged into the target shard.
+// The data of the source shard is owned by the target shard, so only the metadata
+// will be removed.
+func (pr *peerReplica) mustDestroyByMerge() {
+	if value, ok := pr.store.delegates.Load(pr.shardID); ok {
+		pr.store.delegates.Delete(pr.shardID)
+		value.(*applyDelegate).destroy()
+	}
+
+	pr.doMustDestroy(true)
+}
+
+func (pr *peerReplica) doMustDestroy(merged bool) {
 	if pr.ps.isApplyingSnapshot() {
 		util.DefaultTimeoutWheel().Schedule(time.Second*30, func(interface{}) {
-			pr.mustDestroy()
+			pr.doMustDestroy(merged)
 		}, nil)
 		logger.Infof("shard %d is applying snapshot, retry destory later", pr.shardID)
 		return
@@ -296,9 +329,16 @@ func (pr *peerReplica) mustDestroy() {
 	// When we restart store, we can see partially data, because Phase1 and Phase2 are not atomic.
 	// We will execute cleanup if we found the Tombstone key.
 
+	// The merged tombstone keeps the merge state, so the data will not be removed
+	// after restart.
+	var mergeState *bhraftpb.MergeState
+	if merged {
+		mergeState = pr.ps.mergeState
+	}
+
 	wb := util.NewWriteBatch()
 	pr.store.clearMeta(pr.shardID, wb)
-	pr.store.updatePeerState(pr.ps.shard, bhraftpb.PeerState_Tombstone, wb)
+	pr.store.updatePeerStateWithMerge(pr.ps.shard, bhraftpb.PeerState_Tombstone, mergeState, wb)
 	err := pr.store.MetadataStorage().Write(wb, false)
 	if err != nil {
 		logger.Fatal("shard %d do destroy failed with %+v",
@@ -306,7 +346,15 @@ func (pr *peerReplica) mustDestroy() {
 			err)
 	}
 
-	if pr.ps.isInitialized() {
+	// the remaining entries of the tombstone are removed in the next start if crashed
+	err = pr.store.logs.Remove(pr.shardID)
+	if err != nil {
+		logger.Fatalf("shard %d remove raft log failed with %+v",
+			pr.shardID,
+			err)
+	}
+
+	if pr.ps.isInitialized() && !merged {
 		err := pr.store.startClearDataJob(pr.ps.shard)
 		if err != nil {
 			logger.Fatal("shard %d do destroy failed with %+v",
@@ -315,8 +363,13 @@ func (pr *peerReplica) mustDestroy() {
 		}
 	}
 
+	pr.store.inconsistents.Delete(pr.shardID)
 	pr.cancel()
 
+	if pr.ps.isInitialized() {
+		pr.store.cdc.onRemoved(pr.ps.shard, merged)
+	}
+
 	if pr.ps.isInitialized() && !pr.store.removeShardKeyRange(pr.ps.shard) {
 		logger.Warningf("shard %d remove key range failed",
 			pr.shardID)
@@ -328,6 +381,13 @@ func (pr *peerReplica) mustDestroy() {
 }
 
 func (pr *peerReplica) onReq(req *raftcmdpb.Request, cb func(*raftcmdpb.RaftCMDResponse)) error {
+	// the request is marked canceled when the cancel request received, so it's dropped if it's
+	// still in the queue, and the mark is removed when the cancel request is dequeued
+	if req.Cancel {
+		pr.canceled.Store(string(req.ID), struct{}{})
+		return pr.addRequest(reqCtx{req: req})
+	}
+
 	metric.IncComandCount(hack.SliceToString(format.UInt64ToString(req.CustemType)))
 
 	r := reqCtx{}
@@ -357,10 +417,32 @@ func (pr *peerReplica) doExecReadCmd(c cmd) {
 		}
 		pr.readKeys++
 		pr.readCtx.offset = idx
+		if req.KeyRange != nil {
+			// the shard may has been split since the range request was routed
+			if err := checkKeyRangeInShard(req.KeyRange, &pr.ps.shard); err != nil {
+				rsp := pb.AcquireResponse()
+				rsp.Error = *err
+				rsp.OriginRequest = req
+				rsp.OriginRequest.Key = DecodeDataKey(req.Key)
+				resp.Responses = append(resp.Responses, rsp)
+				continue
+			}
+		}
+
+		if err := pr.store.intercept(command.ReadStage, pr.ps.shard, req, pr.readCtx); err != nil {
+			rsp := pb.AcquireResponse()
+			rsp.Error = *err
+			rsp.OriginRequest = req
+			rsp.OriginRequest.Key = DecodeDataKey(req.Key)
+			resp.Responses = append(resp.Responses, rsp)
+			continue
+		}
+
 		if h, ok := pr.store.readHandlers[req.CustemType]; ok {
 			rsp, readBytes := h(pr.ps.shard, req, pr.readCtx)
 			resp.Responses = append(resp.Responses, rsp)
 			pr.readBytes += readBytes
+			pr.loadSampler.record(req.Key, readBytes)
 			if logger.DebugEnabled() {
 				logger.Debugf("%s exec completed", hex.EncodeToString(req.ID))
 			}
@@ -401,6 +483,11 @@ func (pr *peerReplica) resetBatch() {
 
 func (pr *peerReplica) collectDownPeers() []metapb.PeerStats {
 	now := time.Now()
+	// all the followers responded before the leader hibernated, and they are checked again
+	// after the leader waked up
+	if pr.hibernate.asleep {
+		now = pr.hibernate.sleepAt
+	}
 	var downPeers []metapb.PeerStats
 	for _, p := range pr.ps.shard.Peers {
 		if p.ID == pr.peer.ID {
@@ -443,6 +530,12 @@ func (pr *peerReplica) collectPendingPeers() []metapb.Peer {
 }
 
 func (pr *peerReplica) readyToHandleRead() bool {
+	// The follower reads wait for the read index returned by the leader to be applied,
+	// that's enough.
+	if !pr.isLeader() {
+		return true
+	}
+
 	// If applied_index_term isn't equal to current term, there may be some values that are not
 	// applied by this leader yet but the old leader.
 	return pr.ps.appliedIndexTerm == pr.getCurrentTerm()
@@ -16,10 +16,10 @@ package raftstore
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 
-	"github.com/fagongzi/util/protoc"
 	"github.com/fagongzi/util/task"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
@@ -50,6 +50,8 @@ type peerStorage struct {
 	lastCompactIndex uint64
 	raftLocalState   bhraftpb.RaftLocalState
 	raftApplyState   bhraftpb.RaftApplyState
+	// mergeState is not nil if the shard applied the PrepareMerge
+	mergeState *bhraftpb.MergeState
 
 	genSnapJob       *task.Job
 	applySnapJob     *task.Job
@@ -165,24 +167,17 @@ func (ps *peerStorage) initLastTerm() error {
 		return nil
 	}
 
-	v, err := ps.store.MetadataStorage().Get(getRaftLogKey(ps.shard.ID, lastIndex))
-	if err != nil {
-		return err
-	}
-
-	if nil == v {
+	term, err := ps.store.logs.Term(ps.shard.ID, lastIndex)
+	if err == raft.ErrUnavailable {
 		return fmt.Errorf("shard %d entry at index<%d> doesn't exist, may lose data",
 			ps.shard.ID,
 			lastIndex)
 	}
-
-	s := &raftpb.Entry{}
-	err = s.Unmarshal(v)
 	if err != nil {
 		return err
 	}
 
-	ps.lastTerm = s.Term
+	ps.lastTerm = term
 	return nil
 }
 
@@ -264,22 +259,21 @@ func (ps *peerStorage) checkRange(low, high uint64) error {
 }
 
 func (ps *peerStorage) loadLogEntry(index uint64) (raftpb.Entry, error) {
-	key := getRaftLogKey(ps.shard.ID, index)
-	v, err := ps.store.MetadataStorage().Get(key)
-	if err != nil {
+	ents, err := ps.store.logs.Entries(ps.shard.ID, index, index+1, math.MaxUint64)
+	if err == raft.ErrUnavailable {
+		logger.Errorf("shard %d entry %d not found",
+			ps.shard.ID,
+			index)
+		return emptyEntry, fmt.Errorf("log entry at %d not found", index)
+	} else if err != nil {
 		logger.Errorf("shard %d load entry failed at %d with %+v",
 			ps.shard.ID,
 			index,
 			err)
 		return emptyEntry, err
-	} else if len(v) == 0 {
-		logger.Errorf("shard %d entry %d not found",
-			ps.shard.ID,
-			index)
-		return emptyEntry, fmt.Errorf("log entry at %d not found", index)
 	}
 
-	return ps.unmarshal(v, index)
+	return ents[0], nil
 }
 
 func (ps *peerStorage) loadShardLocalState(job *task.Job) (*bhraftpb.ShardLocalState, error) {
@@ -326,19 +320,6 @@ func (ps *peerStorage) loadRaftApplyState() (*bhraftpb.RaftApplyState, error) {
 	return applyState, err
 }
 
-func (ps *peerStorage) unmarshal(v []byte, expectIndex uint64) (raftpb.Entry, error) {
-	e := raftpb.Entry{}
-	protoc.MustUnmarshal(&e, v)
-	if e.Index != expectIndex {
-		logger.Fatalf("shard %d raft log index not match, logIndex %d expect %d",
-			ps.shard.ID,
-			e.Index,
-			expectIndex)
-	}
-
-	return e, nil
-}
-
 func compactRaftLog(shardID uint64, state *bhraftpb.RaftApplyState, compactIndex, compactTerm uint64) error {
 	logger.Debugf("shard %d compact log entries to index %d",
 		shardID,
@@ -418,69 +399,7 @@ func (ps *peerStorage) Entries(low, high, maxSize uint64) ([]raftpb.Entry, error
 		return nil, err
 	}
 
-	var ents []raftpb.Entry
-	if low == high {
-		return ents, nil
-	}
-
-	var totalSize uint64
-	nextIndex := low
-	exceededMaxSize := false
-
-	startKey := getRaftLogKey(ps.shard.ID, low)
-
-	if low+1 == high {
-		// If election happens in inactive shards, they will just try
-		// to fetch one empty log.
-		v, err := ps.store.MetadataStorage().Get(startKey)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(v) == 0 {
-			return nil, raft.ErrUnavailable
-		}
-
-		e, err := ps.unmarshal(v, low)
-		if err != nil {
-			return nil, err
-		}
-
-		ents = append(ents, e)
-		return ents, nil
-	}
-
-	endKey := getRaftLogKey(ps.shard.ID, high)
-	err = ps.store.MetadataStorage().Scan(startKey, endKey, func(key, value []byte) (bool, error) {
-		e := raftpb.Entry{}
-		protoc.MustUnmarshal(&e, value)
-
-		// May meet gap or has been compacted.
-		if e.Index != nextIndex {
-			return false, nil
-		}
-
-		nextIndex++
-		totalSize += uint64(len(value))
-
-		exceededMaxSize = totalSize > maxSize
-		if !exceededMaxSize || len(ents) == 0 {
-			ents = append(ents, e)
-		}
-
-		return !exceededMaxSize, nil
-	}, false)
-
-	if err != nil {
-		return nil, err
-	}
-
-	// If we get the correct number of entries the total size exceeds max_size, returns.
-	if len(ents) == int(high-low) || exceededMaxSize {
-		return ents, nil
-	}
-
-	return nil, raft.ErrUnavailable
+	return ps.store.logs.Entries(ps.shard.ID, low, high, maxSize)
 }
 
 func (ps *peerStorage) Term(idx uint64) (uint64, error) {
@@ -502,22 +421,7 @@ func (ps *peerStorage) Term(idx uint64) (uint64, error) {
 		return ps.lastTerm, nil
 	}
 
-	key := getRaftLogKey(ps.shard.ID, idx)
-	v, err := ps.store.MetadataStorage().Get(key)
-	if err != nil {
-		return 0, err
-	}
-
-	if v == nil {
-		return 0, raft.ErrUnavailable
-	}
-
-	e, err := ps.unmarshal(v, idx)
-	if err != nil {
-		return 0, err
-	}
-
-	return e.Term, nil
+	return ps.store.logs.Term(ps.shard.ID, idx)
 }
 
 func (ps *peerStorage) LastIndex() (uint64, error) {
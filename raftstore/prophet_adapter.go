@@ -238,7 +238,9 @@ func (ca *containerAdapter) Clone() metadata.Container {
 type prophetAdapter struct {
 }
 
-func newProphetAdapter() metadata.Adapter {
+// NewProphetAdapter returns the prophet adapter which uses shards as resources and stores
+// as containers
+func NewProphetAdapter() metadata.Adapter {
 	return &prophetAdapter{}
 }
 
@@ -250,6 +252,8 @@ func (pa *prophetAdapter) NewContainer() metadata.Container {
 	return newContainer()
 }
 
+// doShardHeartbeat the hibernated leaders keep sending the heartbeats without ticking, and the
+// hibernated followers are not reported as the down peers.
 func (s *store) doShardHeartbeat() {
 	s.foreachPR(func(pr *peerReplica) bool {
 		if pr.isLeader() {
@@ -335,6 +339,8 @@ func (s *store) doStoreHeartbeat(last time.Time) {
 		stats.ReadBytes += st.ReadBytes
 	})
 
+	stats.InconsistentResources = s.getInconsistentShards()
+
 	// TODO: is busy
 	stats.IsBusy = false
 	stats.Interval = &metapb.TimeInterval{
@@ -403,8 +409,13 @@ func (s *store) doResourceHeartbeatRsp(rsp rpcpb.ResourceHeartbeatRsp) {
 	} else if rsp.TransferLeader != nil {
 		pr.onAdmin(newTransferLeaderAdminReq(rsp))
 	} else if rsp.SplitResource != nil {
-		// currently, pd only support use keys to splits
 		switch rsp.SplitResource.Policy {
+		case metapb.CheckPolicy_SCAN, metapb.CheckPolicy_APPROXIMATE:
+			if err := pr.startSplitJobWithPolicy(rsp.SplitResource.Policy, rsp.ResourceEpoch); err != nil {
+				logger.Errorf("shard-%d start split job failed with %+v",
+					rsp.ResourceID,
+					err)
+			}
 		case metapb.CheckPolicy_USEKEY:
 			splitIDs, err := pr.store.pd.GetClient().AskBatchSplit(NewResourceAdapterWithShard(pr.ps.shard),
 				uint32(len(rsp.SplitResource.Keys)))
@@ -414,13 +425,36 @@ func (s *store) doResourceHeartbeatRsp(rsp rpcpb.ResourceHeartbeatRsp) {
 					err)
 				return
 			}
+			// the split keys of prophet are the origin keys
+			splitKeys := make([][]byte, 0, len(rsp.SplitResource.Keys))
+			for _, key := range rsp.SplitResource.Keys {
+				splitKeys = append(splitKeys, EncodeDataKey(pr.ps.shard.Group, key))
+			}
 			pr.addAction(action{
 				epoch:      rsp.ResourceEpoch,
 				actionType: doSplitAction,
-				splitKeys:  rsp.SplitResource.Keys,
+				splitKeys:  splitKeys,
 				splitIDs:   splitIDs,
 			})
 		}
+	} else if rsp.Merge != nil {
+		target := &resourceAdapter{}
+		err := target.Unmarshal(rsp.Merge.Target)
+		if err != nil {
+			logger.Errorf("shard-%d unmarshal merge target failed with %+v",
+				rsp.ResourceID,
+				err)
+			return
+		}
+
+		logger.Infof("shard-%d merge into shard %d",
+			rsp.ResourceID,
+			target.meta.ID)
+		pr.addAction(action{
+			epoch:       rsp.ResourceEpoch,
+			actionType:  doMergeAction,
+			mergeTarget: target.meta,
+		})
 	}
 }
 
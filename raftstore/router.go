@@ -38,6 +38,9 @@ type Router interface {
 	Every(group uint64, mustLeader bool, fn func(shard *bhmetapb.Shard, store bhmetapb.Store))
 	// ForeachShards foreach shards
 	ForeachShards(group uint64, fn func(shard *bhmetapb.Shard) bool)
+	// AscendRange asc iterator all shards which overlap with the key range [start, end) in key
+	// order until fn returns false, empty end means positive infinity
+	AscendRange(group uint64, start, end []byte, fn func(shard *bhmetapb.Shard) bool)
 
 	// LeaderStore return leader peer store
 	LeaderPeerStore(shardID uint64) bhmetapb.Store
@@ -144,6 +147,28 @@ func (r *defaultRouter) ForeachShards(group uint64, fn func(shard *bhmetapb.Shar
 	})
 }
 
+func (r *defaultRouter) AscendRange(group uint64, start, end []byte, fn func(shard *bhmetapb.Shard) bool) {
+	value, ok := r.keyRanges.Load(group)
+	if !ok {
+		logger.Debugf("missing group %d for range [%+v, %+v)", group, start, end)
+		return
+	}
+
+	tree := value.(*util.ShardTree)
+	// the shard which contains the start key may start before the start key
+	first := tree.Search(start)
+	if first.ID > 0 && !fn(&first) {
+		return
+	}
+
+	tree.AscendRange(start, end, func(shard *bhmetapb.Shard) bool {
+		if shard.ID == first.ID {
+			return true
+		}
+		return fn(shard)
+	})
+}
+
 func (r *defaultRouter) LeaderPeerStore(shardID uint64) bhmetapb.Store {
 	if value, ok := r.leaders.Load(shardID); ok {
 		return value.(bhmetapb.Store)
@@ -15,13 +15,23 @@ package raftstore
 
 import (
 	"encoding/hex"
+	"fmt"
+	"time"
 
 	"github.com/fagongzi/goetty"
 	"github.com/fagongzi/goetty/codec/length"
+	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 )
 
+var (
+	shardCMDRetries = 10
+	shardCMDTimeout = time.Second * 30
+)
+
 type defaultRPC struct {
 	store *store
 	app   goetty.NetApplication
@@ -33,7 +43,7 @@ func newRPC(store *store) *defaultRPC {
 	}
 
 	encoder, decoder := length.NewWithSize(rc, rc, 0, 0, 0, int(store.cfg.Raft.MaxEntryBytes)*2)
-	app, err := goetty.NewTCPApplication(store.cfg.ClientAddr, rpc.onMessage,
+	app, err := tlsutil.NewTCPApplication(store.cfg.Security, store.cfg.ClientAddr, rpc.onMessage,
 		goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder),
 			goetty.WithEnableAsyncWrite(16),
 			goetty.WithLogger(logger),
@@ -96,3 +106,79 @@ func (rpc *defaultRPC) onResp(header *raftcmdpb.RaftResponseHeader, rsp *raftcmd
 		pb.ReleaseResponse(rsp)
 	}
 }
+
+// execOnShardLeader executes the command on the leader of the shard by the rpc and returns
+// the value of the response, the command will be retried if the leader changed.
+func (s *store) execOnShardLeader(group, shardID uint64, cmdType raftcmdpb.CMDType, customType uint64, cmd []byte) ([]byte, error) {
+	var err error
+	var value []byte
+	for retry := 0; retry < shardCMDRetries; retry++ {
+		if retry > 0 {
+			time.Sleep(time.Millisecond * 200)
+		}
+
+		if value, err = s.doExecOnShardLeader(group, shardID, cmdType, customType, cmd); err == nil {
+			return value, nil
+		}
+		logger.Warningf("shard %d exec cmd %d on leader failed with %+v, retry later",
+			shardID,
+			customType,
+			err)
+	}
+	return nil, err
+}
+
+func (s *store) doExecOnShardLeader(group, shardID uint64, cmdType raftcmdpb.CMDType, customType uint64, cmd []byte) ([]byte, error) {
+	var shard bhmetapb.Shard
+	s.GetRouter().ForeachShards(group, func(v *bhmetapb.Shard) bool {
+		if v.ID == shardID {
+			shard = *v
+			return false
+		}
+		return true
+	})
+	if shard.ID == 0 {
+		return nil, fmt.Errorf("shard %d not found", shardID)
+	}
+
+	leader := s.GetRouter().LeaderPeerStore(shardID)
+	if leader.ClientAddr == "" {
+		return nil, fmt.Errorf("shard %d missing leader", shardID)
+	}
+
+	encoder, decoder := s.CreateRPCCliendSideCodec()
+	conn := tlsutil.NewIOSession(s.cfg.Security, encoder, decoder,
+		tlsutil.WithTimeout(shardCMDTimeout, shardCMDTimeout))
+	defer conn.Close()
+
+	if _, err := conn.Connect(leader.ClientAddr, shardCMDTimeout); err != nil {
+		return nil, err
+	}
+
+	req := pb.AcquireRequest()
+	req.ID = uuid.NewV4().Bytes()
+	req.Group = group
+	req.ToShard = shardID
+	req.Key = shard.Start
+	req.Type = cmdType
+	req.CustemType = customType
+	req.Cmd = cmd
+	err := conn.WriteAndFlush(req)
+	pb.ReleaseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := conn.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := value.(*raftcmdpb.Response)
+	defer pb.ReleaseResponse(rsp)
+	if rsp.Type == raftcmdpb.CMDType_RaftError || rsp.Error.Message != "" {
+		return nil, fmt.Errorf("exec cmd %d on shard %d failed with %s",
+			customType, shardID, rsp.Error.String())
+	}
+	return rsp.Value, nil
+}
@@ -16,7 +16,9 @@ package raftstore
 import (
 	"context"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -24,6 +26,7 @@ import (
 	"time"
 
 	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/util/protoc"
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
 	"github.com/matrixorigin/matrixcube/snapshot"
@@ -35,6 +38,7 @@ type defaultSnapshotManager struct {
 	sync.RWMutex
 
 	limiter          *rate.Limiter
+	sendLimiter      *rate.Limiter
 	s                *store
 	dir              string
 	registry         map[string]struct{}
@@ -102,6 +106,8 @@ func newDefaultSnapshotManager(s *store) snapshot.SnapshotManager {
 	return &defaultSnapshotManager{
 		limiter: rate.NewLimiter(rate.Every(time.Second/time.Duration(s.cfg.Snapshot.MaxConcurrencySnapChunks)),
 			int(s.cfg.Snapshot.MaxConcurrencySnapChunks)),
+		sendLimiter: util.NewBytesLimiter(uint64(s.cfg.Snapshot.MaxSendBytesPerSecond),
+			uint64(s.cfg.Snapshot.SnapChunkSize)),
 		dir:      dir,
 		s:        s,
 		registry: make(map[string]struct{}),
@@ -128,6 +134,12 @@ func (m *defaultSnapshotManager) getTmpPathOfSnapKeyGZ(msg *bhraftpb.SnapshotMes
 	return fmt.Sprintf("%s.tmp", m.getPathOfSnapKey(msg))
 }
 
+// getPathOfSnapKeyHeader the header of the snapshot is saved with the gz file, so the
+// checksums of the files can be verified when the snapshot applied after restart.
+func (m *defaultSnapshotManager) getPathOfSnapKeyHeader(msg *bhraftpb.SnapshotMessage) string {
+	return fmt.Sprintf("%s.header", m.getPathOfSnapKey(msg))
+}
+
 func (m *defaultSnapshotManager) Register(msg *bhraftpb.SnapshotMessage, step int) bool {
 	m.Lock()
 	defer m.Unlock()
@@ -157,29 +169,61 @@ func (m *defaultSnapshotManager) Create(msg *bhraftpb.SnapshotMessage) error {
 	end := encEndKey(&msg.Header.Shard)
 	db := m.s.DataStorageByGroup(msg.Header.Shard.Group, msg.Header.Shard.ID)
 
-	if !exist(gzPath) {
-		if !exist(path) {
-			err := db.CreateSnapshot(path, start, end)
-			if err != nil {
-				return err
-			}
+	header, err := m.loadHeader(msg)
+	if err != nil {
+		return err
+	}
+
+	if header == nil || !exist(gzPath) {
+		// the snapshot without header is not completed, create it again
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		if err := os.RemoveAll(gzPath); err != nil {
+			return err
+		}
+
+		err := db.CreateSnapshot(path, start, end)
+		if err != nil {
+			return err
+		}
 
-			if m.s.cfg.Customize.CustomSnapshotDataCreateFuncFactory != nil {
-				if fn := m.s.cfg.Customize.CustomSnapshotDataCreateFuncFactory(msg.Header.Shard.Group); fn != nil {
-					err := fn(path, msg.Header.Shard)
-					if err != nil {
-						return err
-					}
+		if m.s.cfg.Customize.CustomSnapshotDataCreateFuncFactory != nil {
+			if fn := m.s.cfg.Customize.CustomSnapshotDataCreateFuncFactory(msg.Header.Shard.Group); fn != nil {
+				err := fn(path, msg.Header.Shard)
+				if err != nil {
+					return err
 				}
 			}
 		}
-		err := util.GZIP(path)
+
+		// the receiver use the checksums to verify the snapshot file after received, and
+		// every file in it before apply
+		msg.Header.Files, err = dirChecksums(path)
+		if err != nil {
+			return err
+		}
+
+		err = util.GZIP(path)
+		if err != nil {
+			return err
+		}
+
+		msg.Header.Checksum, err = fileChecksum(gzPath)
+		if err != nil {
+			return err
+		}
+
+		err = m.saveHeader(msg)
 		if err != nil {
 			return err
 		}
+	} else {
+		msg.Header.Checksum = header.Checksum
+		msg.Header.Files = header.Files
 	}
 
-	info, err := os.Stat(fmt.Sprintf("%s.gz", path))
+	info, err := os.Stat(gzPath)
 	if err != nil {
 		return err
 	}
@@ -237,6 +281,12 @@ func (m *defaultSnapshotManager) WriteTo(msg *bhraftpb.SnapshotMessage, conn goe
 				return 0, err
 			}
 
+			throttled, err := util.WaitBytes(ctx, m.sendLimiter, nr)
+			if err != nil {
+				return 0, err
+			}
+			metric.AddSnapshotSendThrottled(throttled)
+
 			err = conn.WriteAndFlush(dst)
 			if err != nil {
 				return 0, err
@@ -284,6 +334,15 @@ func (m *defaultSnapshotManager) CleanSnap(msg *bhraftpb.SnapshotMessage) error
 		return err
 	}
 
+	header := m.getPathOfSnapKeyHeader(msg)
+	if exist(header) {
+		err = os.RemoveAll(header)
+	}
+
+	if err != nil {
+		return err
+	}
+
 	dir := m.getPathOfSnapKey(msg)
 	if exist(dir) {
 		logger.Infof("shard %d delete exists snap dir, file=<%s>, header=<%s>",
@@ -359,15 +418,29 @@ func (m *defaultSnapshotManager) Apply(msg *bhraftpb.SnapshotMessage) error {
 		return fmt.Errorf("missing snapshot file, path=%s", file)
 	}
 
+	header, err := m.loadHeader(msg)
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		return fmt.Errorf("missing snapshot header, path=%s", m.getPathOfSnapKeyHeader(msg))
+	}
+
 	defer m.CleanSnap(msg)
 
-	err := util.UnGZIP(file, m.dir)
+	err = util.UnGZIP(file, m.dir)
 	if err != nil {
 		return err
 	}
 	dir := m.getPathOfSnapKey(msg)
 	defer os.RemoveAll(dir)
 
+	// the files are ingested into the storage directly, so verify them first
+	err = verifyDirChecksums(dir, header.Files)
+	if err != nil {
+		return err
+	}
+
 	// apply snapshot of data
 	err = m.s.DataStorageByGroup(msg.Header.Shard.Group, msg.Header.Shard.ID).ApplySnapshot(dir)
 	if err != nil {
@@ -425,12 +498,146 @@ func (m *defaultSnapshotManager) check(msg *bhraftpb.SnapshotMessage) error {
 				file)
 		}
 
+		if msg.Header.Checksum == 0 {
+			os.RemoveAll(file)
+			return fmt.Errorf("snap file missing checksum, path=<%s>", file)
+		}
+
+		checksum, err := fileChecksum(file)
+		if err != nil {
+			return err
+		}
+
+		if checksum != msg.Header.Checksum {
+			os.RemoveAll(file)
+			return fmt.Errorf("snap file checksum not match, got=<%d> expect=<%d> path=<%s>",
+				checksum,
+				msg.Header.Checksum,
+				file)
+		}
+
+		// the header is saved before the gz file, so the received snapshot always has the header
+		err = m.saveHeader(msg)
+		if err != nil {
+			return err
+		}
+
 		return os.Rename(file, m.getPathOfSnapKeyGZ(msg))
 	}
 
 	return fmt.Errorf("missing snapshot file, path=%s", file)
 }
 
+// loadHeader returns the saved header of the snapshot, nil if the header is not saved
+func (m *defaultSnapshotManager) loadHeader(msg *bhraftpb.SnapshotMessage) (*bhraftpb.SnapshotMessageHeader, error) {
+	file := m.getPathOfSnapKeyHeader(msg)
+	if !exist(file) {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &bhraftpb.SnapshotMessageHeader{}
+	err = header.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (m *defaultSnapshotManager) saveHeader(msg *bhraftpb.SnapshotMessage) error {
+	file := m.getPathOfSnapKeyHeader(msg)
+	err := ioutil.WriteFile(file+".tmp", protoc.MustMarshal(&msg.Header), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file+".tmp", file)
+}
+
+// dirChecksums returns the checksums of all the files in the dir
+func dirChecksums(dir string) ([]bhraftpb.SnapshotFileChecksum, error) {
+	var files []bhraftpb.SnapshotFileChecksum
+	err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+
+		checksum, err := fileChecksum(file)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, bhraftpb.SnapshotFileChecksum{Name: name, Checksum: checksum})
+		return nil
+	})
+	return files, err
+}
+
+// verifyDirChecksums checks the files in the dir are the same as the expected, the file without
+// the checksum is treated as corrupted.
+func verifyDirChecksums(dir string, expected []bhraftpb.SnapshotFileChecksum) error {
+	files, err := dirChecksums(dir)
+	if err != nil {
+		return err
+	}
+
+	if len(files) != len(expected) {
+		return fmt.Errorf("snap files not match, got=<%d> expect=<%d> path=<%s>",
+			len(files),
+			len(expected),
+			dir)
+	}
+
+	checksums := make(map[string]uint32, len(expected))
+	for _, f := range expected {
+		checksums[f.Name] = f.Checksum
+	}
+
+	for _, f := range files {
+		checksum, ok := checksums[f.Name]
+		if !ok {
+			return fmt.Errorf("snap file %s missing checksum, path=<%s>",
+				f.Name,
+				dir)
+		}
+
+		if checksum != f.Checksum {
+			return fmt.Errorf("snap file %s checksum not match, got=<%d> expect=<%d> path=<%s>",
+				f.Name,
+				f.Checksum,
+				checksum,
+				dir)
+		}
+	}
+
+	return nil
+}
+
+func fileChecksum(file string) (uint32, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	h := crc32.NewIEEE()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return 0, err
+	}
+
+	return h.Sum32(), nil
+}
+
 func exist(name string) bool {
 	_, err := os.Stat(name)
 	return err == nil
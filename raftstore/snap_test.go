@@ -0,0 +1,32 @@
+package raftstore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyDirChecksums(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snap")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "db.sst"), []byte("data"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "custom"), []byte("custom"), 0644))
+
+	files, err := dirChecksums(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(files))
+	assert.NoError(t, verifyDirChecksums(dir, files))
+
+	// missing checksum
+	assert.Error(t, verifyDirChecksums(dir, files[:1]))
+
+	// corrupted file
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "db.sst"), []byte("date"), 0644))
+	assert.Error(t, verifyDirChecksums(dir, files))
+}
@@ -23,6 +23,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/fagongzi/goetty"
 	"github.com/fagongzi/goetty/codec"
 	"github.com/fagongzi/goetty/codec/length"
 	"github.com/fagongzi/log"
@@ -32,6 +33,7 @@ import (
 	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
@@ -59,12 +61,21 @@ type Store interface {
 	Meta() bhmetapb.Store
 	// GetRouter returns a router
 	GetRouter() Router
-	// RegisterReadFunc register read command handler
+	// RegisterReadFunc register read command handler, a custom type can be registered only
+	// once, the custom types used by the store itself are registered when it's created.
 	RegisterReadFunc(uint64, command.ReadCommandFunc)
-	// RegisterWriteFunc register write command handler
+	// RegisterWriteFunc register write command handler, a custom type can be registered only
+	// once, the custom types used by the store itself are registered when it's created.
 	RegisterWriteFunc(uint64, command.WriteCommandFunc)
-	// RegisterLocalFunc register local command handler
+	// RegisterLocalFunc register local command handler, a custom type can be registered only once.
 	RegisterLocalFunc(uint64, command.LocalCommandFunc)
+	// Use adds the interceptors to the command path, the interceptors are called in the order
+	// they added, it must be called before the store started.
+	Use(...command.Interceptor)
+	// RegisterSplitKeyFunc register the func to adjust the split keys, the shard is split at the
+	// returned key, and the split key is skipped if the returned key is out of the shard. It must
+	// be called before the store started.
+	RegisterSplitKeyFunc(func(key []byte) []byte)
 	// RegisterLocalRequestCB register local request cb to process response
 	RegisterLocalRequestCB(func(*raftcmdpb.RaftResponseHeader, *raftcmdpb.Response))
 	// RegisterRPCRequestCB register rpc request cb to process response
@@ -83,17 +94,30 @@ type Store interface {
 	Prophet() prophet.Prophet
 	// CreateRPCCliendSideCodec returns the rpc codec at client side
 	CreateRPCCliendSideCodec() (codec.Encoder, codec.Decoder)
+	// CreateRPCClientSideSession returns the rpc session at client side, the session uses
+	// TLS if the security is configured
+	CreateRPCClientSideSession() goetty.IOSession
 
 	// CreateResourcePool create resource pools, the resource pool will create shards,
 	// and try to maintain the number of shards in the pool not less than the `capacity`
 	// parameter. This is an idempotent operation.
 	CreateResourcePool(...metapb.ResourcePool) (ShardsPool, error)
+	// Backup creates a backup job to backup the data of all the shards to the path, the path
+	// must be accessible by all the stores.
+	Backup(path string) (metapb.Job, error)
+	// Restore creates a restore job to restore the data of all the shards from the backup path,
+	// the cluster must be bootstrapped by the `NewRestoreInitShardsFactory`.
+	Restore(path string) (metapb.Job, error)
+	// Subscribe subscribes the committed writes of the shards on the store in the group and
+	// key range, the changes are sent in the raft log order with the resolved watermarks.
+	Subscribe(ChangeSubscribeOptions) (ChangeSubscription, error)
 }
 
 const (
-	applyWorkerName      = "apply-%d-%d"
-	snapshotWorkerName   = "snapshot-%d"
-	splitCheckWorkerName = "split"
+	applyWorkerName            = "apply-%d-%d"
+	snapshotWorkerName         = "snapshot-%d"
+	splitCheckWorkerName       = "split"
+	consistencyCheckWorkerName = "consistency-check"
 )
 
 type store struct {
@@ -115,10 +139,18 @@ type store struct {
 	replicas        sync.Map // shard id -> *peerReplica
 	delegates       sync.Map // shard id -> *applyDelegate
 	droppedVoteMsgs sync.Map // shard id -> raftpb.Message
+	inconsistents   sync.Map // shard id -> the log index of the failed consistency check
+
+	// mergeLock protects mergePrepared and mergeWaiters
+	mergeLock     sync.Mutex
+	mergePrepared map[uint64]bhraftpb.MergeState // source shard id -> merge state
+	mergeWaiters  map[uint64]uint64              // source shard id -> target shard id
 
 	readHandlers  map[uint64]command.ReadCommandFunc
 	writeHandlers map[uint64]command.WriteCommandFunc
 	localHandlers map[uint64]command.LocalCommandFunc
+	interceptors  []command.Interceptor
+	splitKeyFunc  func([]byte) []byte
 
 	stopWG sync.WaitGroup
 	state  uint32
@@ -135,6 +167,10 @@ type store struct {
 
 	// shard pool processor
 	shardPool *dynamicShardsPool
+	// change feed of the committed writes
+	cdc *changeFeed
+	// logs the storage of the raft log entries
+	logs storage.LogStorage
 }
 
 // NewStore returns a raft store
@@ -149,6 +185,8 @@ func NewStore(cfg *config.Config) Store {
 		runner:        task.NewRunner(),
 		workReady:     newWorkReady(cfg.ShardGroups, cfg.Worker.RaftEventWorkers),
 		shardPool:     newDynamicShardsPool(&cfg.Prophet),
+		mergePrepared: make(map[uint64]bhraftpb.MergeState),
+		mergeWaiters:  make(map[uint64]uint64),
 	}
 
 	if s.cfg.Customize.CustomShardStateAwareFactory != nil {
@@ -161,6 +199,20 @@ func NewStore(cfg *config.Config) Store {
 		s.snapshotManager = newDefaultSnapshotManager(s)
 	}
 
+	if s.cfg.Storage.LogStorage != nil {
+		s.logs = s.cfg.Storage.LogStorage
+	} else {
+		s.logs = newKVLogStorage(s.cfg.Storage.MetaStorage)
+	}
+
+	s.shardPool.clearShardData = s.clearShardData
+	s.cdc = newChangeFeed(s)
+	s.RegisterWriteFunc(ClearShardDataCMD, s.execClearShardData)
+	s.RegisterReadFunc(BackupShardCMD, s.execBackupShard)
+	s.RegisterWriteFunc(RestoreShardCMD, s.execRestoreShard)
+	cfg.Prophet.RegisterJobProcessor(metapb.JobType_Backup, newBackupJobProcessor(s, false))
+	cfg.Prophet.RegisterJobProcessor(metapb.JobType_Restore, newBackupJobProcessor(s, true))
+
 	s.rpc = newRPC(s)
 	s.initWorkers()
 	return s
@@ -178,6 +230,7 @@ func (s *store) Start() {
 	s.startRaftWorkers()
 	logger.Infof("raft shards workers started")
 
+	s.migrateRaftLogs()
 	s.startShards()
 	logger.Infof("shards started")
 
@@ -240,17 +293,60 @@ func (s *store) Meta() bhmetapb.Store {
 }
 
 func (s *store) RegisterReadFunc(ct uint64, handler command.ReadCommandFunc) {
+	if _, ok := s.readHandlers[ct]; ok {
+		logger.Fatalf("read command of custom type %d already registered", ct)
+	}
 	s.readHandlers[ct] = handler
 }
 
 func (s *store) RegisterWriteFunc(ct uint64, handler command.WriteCommandFunc) {
+	if _, ok := s.writeHandlers[ct]; ok {
+		logger.Fatalf("write command of custom type %d already registered", ct)
+	}
 	s.writeHandlers[ct] = handler
 }
 
 func (s *store) RegisterLocalFunc(ct uint64, handler command.LocalCommandFunc) {
+	if _, ok := s.localHandlers[ct]; ok {
+		logger.Fatalf("local command of custom type %d already registered", ct)
+	}
 	s.localHandlers[ct] = handler
 }
 
+func (s *store) Use(interceptors ...command.Interceptor) {
+	s.interceptors = append(s.interceptors, interceptors...)
+}
+
+func (s *store) RegisterSplitKeyFunc(fn func(key []byte) []byte) {
+	s.splitKeyFunc = fn
+}
+
+func (s *store) intercept(stage command.Stage, shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) *errorpb.Error {
+	if len(s.interceptors) == 0 {
+		return nil
+	}
+
+	// the key is encoded after proposed, the interceptors always see the user key
+	if stage != command.ProposalStage {
+		encoded := req.Key
+		req.Key = DecodeDataKey(encoded)
+		defer func() {
+			if bytes.Equal(req.Key, DecodeDataKey(encoded)) {
+				req.Key = encoded
+			} else {
+				req.Key = EncodeDataKey(shard.Group, req.Key)
+			}
+		}()
+	}
+
+	for _, fn := range s.interceptors {
+		if err := fn(stage, shard, req, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *store) RegisterLocalRequestCB(cb func(*raftcmdpb.RaftResponseHeader, *raftcmdpb.Response)) {
 	s.localCB = cb
 }
@@ -268,6 +364,12 @@ func (s *store) onRequestWithCB(req *raftcmdpb.Request, cb func(resp *raftcmdpb.
 		logger.Debugf("%s store received", hex.EncodeToString(req.ID))
 	}
 
+	// the cancel request is never responded
+	if req.Cancel {
+		s.onCancel(req)
+		return nil
+	}
+
 	var pr *peerReplica
 	var err error
 	if req.ToShard > 0 {
@@ -291,6 +393,21 @@ func (s *store) onRequestWithCB(req *raftcmdpb.Request, cb func(resp *raftcmdpb.
 	return pr.onReq(req, cb)
 }
 
+// onCancel drops the canceled request if it's still queued in the shard, the cancel request
+// is ignored if the shard is not found, the request is not queued in this store either.
+func (s *store) onCancel(req *raftcmdpb.Request) {
+	var pr *peerReplica
+	if req.ToShard > 0 {
+		pr = s.getPR(req.ToShard, false)
+	} else {
+		pr, _ = s.selectShard(req.Group, req.Key)
+	}
+
+	if pr != nil {
+		pr.onReq(req, nil)
+	}
+}
+
 func (s *store) MetadataStorage() storage.MetadataStorage {
 	return s.cfg.Storage.MetaStorage
 }
@@ -333,6 +450,11 @@ func (s *store) CreateRPCCliendSideCodec() (codec.Encoder, codec.Decoder) {
 	return length.NewWithSize(v, v, 0, 0, 0, int(s.cfg.Raft.MaxEntryBytes)*2)
 }
 
+func (s *store) CreateRPCClientSideSession() goetty.IOSession {
+	encoder, decoder := s.CreateRPCCliendSideCodec()
+	return tlsutil.NewIOSession(s.cfg.Security, encoder, decoder)
+}
+
 func (s *store) initWorkers() {
 	for g := uint64(0); g < s.cfg.ShardGroups; g++ {
 		s.applyWorkers = append(s.applyWorkers, make(map[string]int))
@@ -350,12 +472,13 @@ func (s *store) initWorkers() {
 	}
 
 	s.runner.AddNamedWorker(splitCheckWorkerName)
+	s.runner.AddNamedWorker(consistencyCheckWorkerName)
 }
 
 func (s *store) startProphet() {
 	logger.Infof("begin to start prophet")
 
-	s.cfg.Prophet.Adapter = newProphetAdapter()
+	s.cfg.Prophet.Adapter = NewProphetAdapter()
 	s.cfg.Prophet.Handler = s
 	s.cfg.Prophet.Adjust(nil, false)
 
@@ -370,6 +493,8 @@ func (s *store) startTransport() {
 	if s.cfg.Customize.CustomTransportFactory != nil {
 		s.trans = s.cfg.Customize.CustomTransportFactory()
 	} else {
+		// the compression is checked in config validation
+		compression, _ := transport.ParseCompression(s.cfg.Raft.Compression)
 		s.trans = transport.NewDefaultTransport(s.Meta().ID,
 			s.cfg.RaftAddr,
 			s.snapshotManager,
@@ -379,16 +504,22 @@ func (s *store) startTransport() {
 			transport.WithTimeout(3*time.Duration(s.cfg.Raft.HeartbeatTicks)*s.cfg.Raft.TickInterval.Duration, time.Minute),
 			transport.WithSendBatch(int64(s.cfg.Raft.SendRaftBatchSize)),
 			transport.WithWorkerCount(s.cfg.Worker.SendRaftMsgWorkerCount, s.cfg.Snapshot.MaxConcurrencySnapChunks),
-			transport.WithErrorHandler(func(msg *bhraftpb.RaftMessage, err error) {
-				if pr := s.getPR(msg.ShardID, true); pr != nil {
-					pr.addReport(msg.Message)
-				}
-			}))
+			transport.WithSecurity(s.cfg.Security),
+			transport.WithCompression(compression, int(s.cfg.Raft.CompressionThreshold)),
+			transport.WithSnapshotReceiveLimit(uint64(s.cfg.Snapshot.MaxReceiveBytesPerSecond), uint64(s.cfg.Snapshot.SnapChunkSize)),
+			transport.WithErrorHandler(s.onSendRaftMessageFailed))
 	}
 
 	s.trans.Start()
 }
 
+// onSendRaftMessageFailed reports the unreachable peer to the raft
+func (s *store) onSendRaftMessageFailed(msg *bhraftpb.RaftMessage, err error) {
+	if pr := s.getPR(msg.ShardID, true); pr != nil {
+		pr.addReport(msg.Message)
+	}
+}
+
 func (s *store) startRaftWorkers() {
 	for i := uint64(0); i < s.cfg.ShardGroups; i++ {
 		s.eventWorkers = append(s.eventWorkers, make(map[uint64]int))
@@ -448,6 +579,7 @@ func (s *store) startShards() {
 	tomebstoneCount := 0
 	applyingCount := 0
 	var tomebstoneShards []bhmetapb.Shard
+	var tomebstoneIDs []uint64
 
 	wb := util.NewWriteBatch()
 	err := s.MetadataStorage().Scan(metaMinKey, metaMaxKey, func(key, value []byte) (bool, error) {
@@ -470,8 +602,17 @@ func (s *store) startShards() {
 		}
 
 		if localState.State == bhraftpb.PeerState_Tombstone {
-			tomebstoneShards = append(tomebstoneShards, localState.Shard)
 			tomebstoneCount++
+			tomebstoneIDs = append(tomebstoneIDs, shardID)
+			// the data of the merged shard is owned by the target shard
+			if localState.MergeState != nil {
+				logger.Infof("shard %d is tombstone in store, merged into shard %d",
+					shardID,
+					localState.MergeState.Target.ID)
+				return true, nil
+			}
+
+			tomebstoneShards = append(tomebstoneShards, localState.Shard)
 			logger.Infof("shard %d is tombstone in store",
 				shardID)
 			return true, nil
@@ -488,6 +629,14 @@ func (s *store) startShards() {
 			pr.startApplyingSnapJob()
 		}
 
+		if localState.State == bhraftpb.PeerState_Merging {
+			logger.Infof("shard %d is merging into shard %d in store",
+				shardID,
+				localState.MergeState.Target.ID)
+			pr.ps.mergeState = localState.MergeState
+			s.markMergePrepared(shardID, *localState.MergeState)
+		}
+
 		pr.startRegistrationJob()
 
 		s.updateShardKeyRange(localState.Shard)
@@ -505,6 +654,14 @@ func (s *store) startShards() {
 		logger.Fatalf("init store failed with %+v", err)
 	}
 
+	// the raft logs are removed after the shard tombstone is written, and they may be left if
+	// the store is crashed
+	for _, id := range tomebstoneIDs {
+		if err := s.logs.Remove(id); err != nil {
+			logger.Fatalf("shard %d remove raft logs failed with %+v", id, err)
+		}
+	}
+
 	logger.Infof("starts with %d shards, including %d tombstones and %d applying shards",
 		totalCount,
 		tomebstoneCount,
@@ -526,6 +683,15 @@ func (s *store) startTimerTasks() {
 		stateCheckTicker := time.NewTicker(s.cfg.Replication.ShardStateCheckDuration.Duration)
 		defer stateCheckTicker.Stop()
 
+		mergeCheckTicker := time.NewTicker(s.cfg.Replication.ShardMergeCheckDuration.Duration)
+		defer mergeCheckTicker.Stop()
+
+		consistencyCheckTicker := time.NewTicker(s.cfg.Replication.ConsistencyCheckDuration.Duration)
+		defer consistencyCheckTicker.Stop()
+
+		loadSplitCheckTicker := time.NewTicker(s.cfg.Replication.LoadSplitCheckDuration.Duration)
+		defer loadSplitCheckTicker.Stop()
+
 		shardLeaderheartbeatTicker := time.NewTicker(s.cfg.Replication.ShardHeartbeatDuration.Duration)
 		defer shardLeaderheartbeatTicker.Stop()
 
@@ -545,6 +711,16 @@ func (s *store) startTimerTasks() {
 				}
 			case <-stateCheckTicker.C:
 				s.handleShardStateCheck()
+			case <-mergeCheckTicker.C:
+				s.handleMergeCheck()
+			case <-consistencyCheckTicker.C:
+				if !s.cfg.Replication.DisableConsistencyCheck {
+					s.handleConsistencyCheck()
+				}
+			case <-loadSplitCheckTicker.C:
+				if s.cfg.Replication.EnableLoadSplit() {
+					s.handleLoadSplitCheck()
+				}
 			case <-shardLeaderheartbeatTicker.C:
 				s.doShardHeartbeat()
 			case <-storeLeaderheartbeatTicker.C:
@@ -687,6 +863,10 @@ func (s *store) addSplitJob(task func() error) error {
 	return s.addNamedJob("", splitCheckWorkerName, task)
 }
 
+func (s *store) addConsistencyCheckJob(task func() error) error {
+	return s.addNamedJob("", consistencyCheckWorkerName, task)
+}
+
 func (s *store) addNamedJob(desc, worker string, task func() error) error {
 	return s.runner.RunJobWithNamedWorker(desc, worker, task)
 }
@@ -864,13 +1044,19 @@ func checkEpoch(shard bhmetapb.Shard, req *raftcmdpb.RaftCMDRequest) bool {
 
 	if req.AdminRequest != nil {
 		switch req.AdminRequest.CmdType {
-		case raftcmdpb.AdminCmdType_BatchSplit:
+		case raftcmdpb.AdminCmdType_BatchSplit,
+			raftcmdpb.AdminCmdType_ComputeHash:
 			checkVer = true
 		case raftcmdpb.AdminCmdType_ChangePeer:
 			checkConfVer = true
 		case raftcmdpb.AdminCmdType_TransferLeader:
 			checkVer = true
 			checkConfVer = true
+		case raftcmdpb.AdminCmdType_PrepareMerge,
+			raftcmdpb.AdminCmdType_CommitMerge,
+			raftcmdpb.AdminCmdType_RollbackMerge:
+			checkVer = true
+			checkConfVer = true
 		}
 	} else {
 		// for redis command, we don't care conf version.
@@ -919,6 +1105,16 @@ func newAdminRaftCMDResponse(adminType raftcmdpb.AdminCmdType, rsp protoc.PB) *r
 		adminResp.CompactLog = rsp.(*raftcmdpb.CompactLogResponse)
 	case raftcmdpb.AdminCmdType_BatchSplit:
 		adminResp.Splits = rsp.(*raftcmdpb.BatchSplitResponse)
+	case raftcmdpb.AdminCmdType_PrepareMerge:
+		adminResp.PrepareMerge = rsp.(*raftcmdpb.PrepareMergeResponse)
+	case raftcmdpb.AdminCmdType_CommitMerge:
+		adminResp.CommitMerge = rsp.(*raftcmdpb.CommitMergeResponse)
+	case raftcmdpb.AdminCmdType_RollbackMerge:
+		adminResp.RollbackMerge = rsp.(*raftcmdpb.RollbackMergeResponse)
+	case raftcmdpb.AdminCmdType_ComputeHash:
+		adminResp.ComputeHash = rsp.(*raftcmdpb.ComputeHashResponse)
+	case raftcmdpb.AdminCmdType_VerifyHash:
+		adminResp.VerifyHash = rsp.(*raftcmdpb.VerifyHashResponse)
 	}
 
 	resp := pb.AcquireRaftCMDResponse()
@@ -980,9 +1176,14 @@ func (s *store) nextShard(shard bhmetapb.Shard) *bhmetapb.Shard {
 }
 
 func (s *store) updatePeerState(shard bhmetapb.Shard, state bhraftpb.PeerState, wb *util.WriteBatch) error {
+	return s.updatePeerStateWithMerge(shard, state, nil, wb)
+}
+
+func (s *store) updatePeerStateWithMerge(shard bhmetapb.Shard, state bhraftpb.PeerState, mergeState *bhraftpb.MergeState, wb *util.WriteBatch) error {
 	shardState := &bhraftpb.ShardLocalState{}
 	shardState.State = state
 	shardState.Shard = shard
+	shardState.MergeState = mergeState
 
 	if wb != nil {
 		return wb.Set(getShardLocaleStateKey(shard.ID), protoc.MustMarshal(shardState))
@@ -0,0 +1,484 @@
+package raftstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
+	"github.com/matrixorigin/matrixcube/components/prophet/config"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+)
+
+const (
+	// BackupShardCMD the custom type of the read command used by the backup job to create
+	// the snapshot of the shard, it's reserved by the store like the ClearShardDataCMD.
+	BackupShardCMD uint64 = math.MaxUint64 - 3
+	// RestoreShardCMD the custom type of the write command used by the restore job to apply
+	// the snapshot of the shard, it's reserved by the store like the ClearShardDataCMD.
+	RestoreShardCMD uint64 = math.MaxUint64 - 4
+
+	backupMetaFile = "backup.meta"
+)
+
+var (
+	errBackupShardsChanged = errors.New("shards changed during the backup")
+)
+
+// Backup creates a backup job in the prophet, the job takes a snapshot of every shard at the
+// applied index on the shard leader, and writes the snapshots together with the shards
+// metadata to the path. The path must be accessible by all the stores, the job can be
+// monitored by the returned job id.
+func (s *store) Backup(path string) (metapb.Job, error) {
+	return s.pd.GetClient().CreateJob(metapb.Job{Type: metapb.JobType_Backup,
+		Content: protoc.MustMarshal(&metapb.BackupJob{Path: path})})
+}
+
+// Restore creates a restore job in the prophet, the job applies the snapshots in the backup
+// path to the shards of the cluster. The cluster must be bootstrapped by the init shards
+// returned by `NewRestoreInitShardsFactory` with the same path.
+func (s *store) Restore(path string) (metapb.Job, error) {
+	return s.pd.GetClient().CreateJob(metapb.Job{Type: metapb.JobType_Restore,
+		Content: protoc.MustMarshal(&metapb.RestoreJob{Path: path})})
+}
+
+// NewRestoreInitShardsFactory returns a factory func to provide the init shards of the backup
+// to bootstrap a fresh cluster, it is used as the `CustomInitShardsFactory`.
+func NewRestoreInitShardsFactory(path string) func() []bhmetapb.Shard {
+	return func() []bhmetapb.Shard {
+		meta, err := loadBackupMeta(path)
+		if err != nil {
+			logger.Fatalf("load backup metadata from %s failed with %+v", path, err)
+		}
+
+		var shards []bhmetapb.Shard
+		for _, backup := range meta.Shards {
+			shards = append(shards, bhmetapb.Shard{
+				Group:        backup.Shard.Group,
+				Start:        backup.Shard.Start,
+				End:          backup.Shard.End,
+				Unique:       backup.Shard.Unique,
+				RuleGroups:   backup.Shard.RuleGroups,
+				DisableSplit: backup.Shard.DisableSplit,
+				Data:         backup.Shard.Data,
+			})
+		}
+		return shards
+	}
+}
+
+// backupJobProcessor the processor of the backup and restore jobs
+type backupJobProcessor struct {
+	store   *store
+	restore bool
+
+	mu struct {
+		sync.Mutex
+
+		reporter config.JobReporter
+		cancels  map[uint64]context.CancelFunc
+	}
+}
+
+func newBackupJobProcessor(s *store, restore bool) *backupJobProcessor {
+	p := &backupJobProcessor{store: s, restore: restore}
+	p.mu.cancels = make(map[uint64]context.CancelFunc)
+	return p
+}
+
+func (p *backupJobProcessor) SetJobReporter(reporter config.JobReporter) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mu.reporter = reporter
+}
+
+func (p *backupJobProcessor) Start(job metapb.Job, store storage.JobStorage, aware config.ResourcesAware) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.mu.cancels[job.ID]; ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.mu.cancels[job.ID] = cancel
+	go p.run(ctx, job, p.mu.reporter)
+}
+
+func (p *backupJobProcessor) Stop(job metapb.Job, store storage.JobStorage, aware config.ResourcesAware) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cancel, ok := p.mu.cancels[job.ID]; ok {
+		cancel()
+		delete(p.mu.cancels, job.ID)
+	}
+}
+
+func (p *backupJobProcessor) Remove(job metapb.Job, store storage.JobStorage, aware config.ResourcesAware) {
+	p.Stop(job, store, aware)
+}
+
+func (p *backupJobProcessor) Execute([]byte, storage.JobStorage, config.ResourcesAware) ([]byte, error) {
+	return nil, errors.New("not support")
+}
+
+func (p *backupJobProcessor) run(ctx context.Context, job metapb.Job, reporter config.JobReporter) {
+	progress := func(value uint64) {
+		if reporter != nil {
+			reporter.Progress(job.ID, value)
+		}
+	}
+
+	var err error
+	if p.restore {
+		content := &metapb.RestoreJob{}
+		protoc.MustUnmarshal(content, job.Content)
+		logger.Infof("restore job %d started from %s", job.ID, content.Path)
+		err = p.store.doRestore(ctx, content.Path, progress)
+	} else {
+		content := &metapb.BackupJob{}
+		protoc.MustUnmarshal(content, job.Content)
+		logger.Infof("backup job %d started to %s", job.ID, content.Path)
+		err = p.store.doBackup(ctx, content.Path, progress)
+	}
+
+	// the job is stopped
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.mu.Lock()
+	delete(p.mu.cancels, job.ID)
+	p.mu.Unlock()
+
+	if reporter == nil {
+		return
+	}
+	if err != nil {
+		logger.Errorf("job %d failed with %+v", job.ID, err)
+		reporter.Fail(job.ID, err)
+		return
+	}
+	logger.Infof("job %d completed", job.ID)
+	reporter.Complete(job.ID)
+}
+
+func (s *store) doBackup(ctx context.Context, path string, progress func(uint64)) error {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	shards := s.getAllShards()
+	meta := &bhmetapb.BackupMeta{CreatedAt: time.Now().Unix()}
+	for idx, shard := range shards {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("%d", shard.ID)
+		value, err := s.execOnShardLeader(shard.Group, shard.ID, raftcmdpb.CMDType_Read, BackupShardCMD,
+			protoc.MustMarshal(&bhmetapb.BackupCmd{Path: filepath.Join(path, name)}))
+		if err != nil {
+			return err
+		}
+
+		backup := bhmetapb.BackupShard{}
+		protoc.MustUnmarshal(&backup, value)
+		backup.Path = name
+		meta.Shards = append(meta.Shards, backup)
+		progress(uint64(idx+1) * 99 / uint64(len(shards)))
+	}
+
+	// the snapshots must cover the shards of the cluster, the job will be retried if the shards
+	// changed by the split or merge during the backup
+	backups := make(map[uint64]bhmetapb.Shard, len(meta.Shards))
+	for _, backup := range meta.Shards {
+		backups[backup.Shard.ID] = backup.Shard
+	}
+	for _, shard := range s.getAllShards() {
+		backup, ok := backups[shard.ID]
+		if !ok || backup.Epoch.Version != shard.Epoch.Version {
+			return errBackupShardsChanged
+		}
+	}
+
+	file := filepath.Join(path, backupMetaFile)
+	if err := ioutil.WriteFile(file+".tmp", protoc.MustMarshal(meta), 0644); err != nil {
+		return err
+	}
+	return os.Rename(file+".tmp", file)
+}
+
+func (s *store) doRestore(ctx context.Context, path string, progress func(uint64)) error {
+	meta, err := loadBackupMeta(path)
+	if err != nil {
+		return err
+	}
+
+	for idx, backup := range meta.Shards {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var id uint64
+		s.GetRouter().ForeachShards(backup.Shard.Group, func(shard *bhmetapb.Shard) bool {
+			if bytes.Equal(shard.Start, backup.Shard.Start) &&
+				bytes.Equal(shard.End, backup.Shard.End) {
+				id = shard.ID
+				return false
+			}
+			return true
+		})
+		if id == 0 {
+			return fmt.Errorf("missing shard [%+v, %+v) of the backup shard %d in the cluster",
+				backup.Shard.Start,
+				backup.Shard.End,
+				backup.Shard.ID)
+		}
+
+		// the snapshot is verified before proposed, the restore command is never applied with a
+		// corrupted backup
+		snapPath := filepath.Join(path, backup.Path)
+		if err := checkSnapshotFiles(snapPath, backup.Files); err != nil {
+			return fmt.Errorf("check snapshot of the backup shard %d failed with %+v", backup.Shard.ID, err)
+		}
+
+		data := protoc.MustMarshal(&bhmetapb.RestoreCmd{
+			Path:  snapPath,
+			Files: backup.Files,
+			Epoch: backup.Shard.Epoch,
+			Index: backup.Index,
+		})
+		_, err = s.execOnShardLeader(backup.Shard.Group, id, raftcmdpb.CMDType_Write, RestoreShardCMD, data)
+		if err != nil {
+			return err
+		}
+		progress(uint64(idx+1) * 99 / uint64(len(meta.Shards)))
+	}
+	return nil
+}
+
+func (s *store) getAllShards() []bhmetapb.Shard {
+	var shards []bhmetapb.Shard
+	for g := uint64(0); g < s.cfg.ShardGroups; g++ {
+		s.GetRouter().ForeachShards(g, func(shard *bhmetapb.Shard) bool {
+			shards = append(shards, *shard)
+			return true
+		})
+	}
+	return shards
+}
+
+// execBackupShard the read handler of the BackupShardCMD, it's executed by the shard leader
+// after the read index confirmed, so the snapshot contains all the writes committed before,
+// and it's never replayed from the raft log. The snapshot is created by the apply worker of the
+// shard, so the recorded applied index is exactly the index of the snapshot.
+func (s *store) execBackupShard(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (*raftcmdpb.Response, uint64) {
+	cmd := &bhmetapb.BackupCmd{}
+	protoc.MustUnmarshal(cmd, req.Cmd)
+
+	rsp := pb.AcquireResponse()
+	backup, err := s.backupShard(shard, cmd.Path)
+	if err != nil {
+		logger.Errorf("shard %d create backup snapshot failed with %+v", shard.ID, err)
+		rsp.Error.Message = err.Error()
+		return rsp, 0
+	}
+
+	rsp.Value = protoc.MustMarshal(backup)
+	return rsp, 0
+}
+
+// backupShard creates the snapshot in the apply worker and waits for it, no entries are applied
+// during the snapshot creating.
+func (s *store) backupShard(shard bhmetapb.Shard, path string) (*bhmetapb.BackupShard, error) {
+	pr := s.getPR(shard.ID, false)
+	if pr == nil {
+		return nil, fmt.Errorf("shard %d not found", shard.ID)
+	}
+
+	var backup *bhmetapb.BackupShard
+	c := make(chan error, 1)
+	err := s.addApplyJob(pr.applyWorker, "doBackupShard", func() error {
+		value, ok := s.delegates.Load(shard.ID)
+		if !ok {
+			c <- fmt.Errorf("shard %d missing delegate", shard.ID)
+			return nil
+		}
+
+		var err error
+		backup, err = s.doBackupShard(value.(*applyDelegate), path)
+		c <- err
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case err = <-c:
+		return backup, err
+	case <-pr.ctx.Done():
+		return nil, fmt.Errorf("shard %d stopped", shard.ID)
+	}
+}
+
+// doBackupShard the snapshot is created in a temp dir and moved to the path, so the path never
+// has a partial snapshot.
+func (s *store) doBackupShard(d *applyDelegate, path string) (*bhmetapb.BackupShard, error) {
+	backup := &bhmetapb.BackupShard{Shard: d.shard, Index: d.applyState.AppliedIndex}
+	tmp := path + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return nil, err
+	}
+
+	ds := s.DataStorageByGroup(d.shard.Group, d.shard.ID)
+	if err := ds.CreateSnapshot(tmp, encStartKey(&d.shard), encEndKey(&d.shard)); err != nil {
+		return nil, err
+	}
+
+	files, err := dirChecksums(tmp)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		backup.Files = append(backup.Files, bhmetapb.SnapshotFile{Name: f.Name, Checksum: f.Checksum})
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+// execRestoreShard the write handler of the RestoreShardCMD, the snapshot files are copied from
+// the backup path to the local snapshot dir, and applied after verified. The data is replaced by
+// the same snapshot if the command is applied again after restart, so it's safe to replay. The
+// backup is checked before proposed, an error response is returned if it's changed after that.
+func (s *store) execRestoreShard(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	cmd := &bhmetapb.RestoreCmd{}
+	protoc.MustUnmarshal(cmd, req.Cmd)
+
+	if err := flushWriteBatch(ctx); err != nil {
+		logger.Fatalf("shard %d flush write batch failed with %+v", shard.ID, err)
+	}
+
+	// the ingested files are removed by the storage, so the backup is never applied directly
+	tmp := filepath.Join(s.cfg.SnapshotDir(), fmt.Sprintf("restore-%d-%d", shard.ID, ctx.LogIndex()))
+	defer os.RemoveAll(tmp)
+
+	rsp := pb.AcquireResponse()
+	err := copySnapshotFiles(cmd.Path, tmp, cmd.Files)
+	if err == nil {
+		err = checkSnapshotFiles(tmp, cmd.Files)
+	}
+	if err == nil {
+		err = ctx.DataStorage().ApplySnapshot(tmp)
+	}
+	if err != nil {
+		logger.Errorf("shard %d apply the restore snapshot %s of epoch %s at index %d failed with %+v",
+			shard.ID,
+			cmd.Path,
+			cmd.Epoch.String(),
+			cmd.Index,
+			err)
+		rsp.Error.Message = err.Error()
+		return 0, 0, rsp
+	}
+
+	logger.Infof("shard %d restored from snapshot %s of epoch %s at index %d",
+		shard.ID,
+		cmd.Path,
+		cmd.Epoch.String(),
+		cmd.Index)
+	return 0, 0, rsp
+}
+
+func loadBackupMeta(path string) (*bhmetapb.BackupMeta, error) {
+	data, err := ioutil.ReadFile(filepath.Join(path, backupMetaFile))
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &bhmetapb.BackupMeta{}
+	protoc.MustUnmarshal(meta, data)
+	return meta, nil
+}
+
+// checkSnapshotFiles checks the files of the snapshot are readable and not changed, the
+// snapshot without the checksums is treated as corrupted.
+func checkSnapshotFiles(path string, files []bhmetapb.SnapshotFile) error {
+	if len(files) == 0 {
+		return fmt.Errorf("snapshot %s missing checksums", path)
+	}
+
+	for _, file := range files {
+		checksum, err := fileChecksum(filepath.Join(path, file.Name))
+		if err != nil {
+			return err
+		}
+
+		if checksum != file.Checksum {
+			return fmt.Errorf("snapshot file %s checksum not match, got=<%d> expect=<%d> path=<%s>",
+				file.Name,
+				checksum,
+				file.Checksum,
+				path)
+		}
+	}
+	return nil
+}
+
+func copySnapshotFiles(from, to string, files []bhmetapb.SnapshotFile) error {
+	if err := os.RemoveAll(to); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		target := filepath.Join(to, file.Name)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		if err := copyFile(filepath.Join(from, file.Name), target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(from, to string) error {
+	src, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return err
+	}
+	return dst.Sync()
+}
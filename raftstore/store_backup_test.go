@@ -0,0 +1,121 @@
+package raftstore
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := NewTestClusterStore(t)
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	var keys [][]byte
+	for i := 0; i < 10; i++ {
+		keys = append(keys, EncodeDataKey(0, []byte(fmt.Sprintf("key-%d", i))))
+	}
+	for _, ds := range c.dataStorages {
+		for _, key := range keys {
+			assert.NoError(t, ds.(storage.KVStorage).Set(key, key))
+		}
+	}
+
+	job, err := c.stores[0].Backup(dir)
+	assert.NoError(t, err)
+	waitJobCompleted(t, c, job.ID)
+	c.Stop()
+
+	meta, err := loadBackupMeta(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(meta.Shards))
+	assert.True(t, meta.Shards[0].Index > 0)
+	assert.NotEmpty(t, meta.Shards[0].Files)
+
+	c = NewTestClusterStore(t, WithTestClusterUseDisk(), WithAppendTestClusterAdjustConfigFunc(func(i int, cfg *config.Config) {
+		cfg.Customize.CustomInitShardsFactory = NewRestoreInitShardsFactory(dir)
+	}))
+	defer c.Stop()
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	job, err = c.stores[0].Restore(dir)
+	assert.NoError(t, err)
+	waitJobCompleted(t, c, job.ID)
+	checkRestoredData(t, c, keys)
+
+	// the corrupted backup is rejected before proposed, and by the replicas if it's changed
+	// after proposed
+	s := c.stores[0]
+	backup := meta.Shards[0]
+	snapPath := filepath.Join(dir, backup.Path)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(snapPath, backup.Files[0].Name), []byte("corrupted"), 0644))
+	assert.Error(t, s.doRestore(context.Background(), dir, func(uint64) {}))
+	shard := c.GetShardByIndex(0)
+	_, err = s.execOnShardLeader(shard.Group, shard.ID, raftcmdpb.CMDType_Write, RestoreShardCMD,
+		protoc.MustMarshal(&bhmetapb.RestoreCmd{Path: snapPath, Files: backup.Files}))
+	assert.Error(t, err)
+	checkRestoredData(t, c, keys)
+
+	// the restored data is kept after restart without the backup
+	assert.NoError(t, os.RemoveAll(dir))
+	c.Restart()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	checkRestoredData(t, c, keys)
+}
+
+func checkRestoredData(t *testing.T, c *TestRaftCluster, keys [][]byte) {
+	for _, ds := range c.dataStorages {
+		timeoutC := time.After(time.Second * 10)
+		for _, key := range keys {
+			for {
+				value, err := ds.(storage.KVStorage).Get(key)
+				assert.NoError(t, err)
+				if len(value) > 0 {
+					assert.Equal(t, key, value)
+					break
+				}
+
+				select {
+				case <-timeoutC:
+					assert.FailNow(t, "timeout wait shard data restored")
+				case <-time.After(time.Millisecond * 100):
+				}
+			}
+		}
+	}
+}
+
+func waitJobCompleted(t *testing.T, c *TestRaftCluster, id uint64) {
+	timeoutC := time.After(time.Second * 30)
+	for {
+		job, err := c.GetProphet().GetClient().GetJob(id)
+		assert.NoError(t, err)
+		if job.State == metapb.JobState_Completed {
+			assert.Equal(t, uint64(100), job.Progress)
+			return
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNowf(t, "", "timeout wait job %d completed, current %+v", id, job)
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
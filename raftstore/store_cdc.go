@@ -0,0 +1,656 @@
+package raftstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+var (
+	// ErrChangeSubscriptionClosed the change subscription is closed by the application
+	ErrChangeSubscriptionClosed = errors.New("change subscription closed")
+
+	defaultChangeEventBufferSize = 1024
+	// maxPendingChangeEntries the max number of the pending raft entries of a shard kept in the
+	// memory for a subscription, the changes will be loaded from the raft log if exceeded.
+	maxPendingChangeEntries = 4096
+	// maxRetainedChangeEntries the max number of the raft entries kept for the changes not sent, the
+	// slow subscriptions are closed with the compacted error if exceeded.
+	maxRetainedChangeEntries uint64 = 100000
+	changeFeedCheckInterval         = time.Second
+)
+
+// ChangeEventType the type of the change event
+type ChangeEventType int
+
+const (
+	// ChangeEventWrite a committed write request of the shard
+	ChangeEventWrite ChangeEventType = iota
+	// ChangeEventResolved the resolved watermark of the shard, all the changes of the shard whose
+	// raft index is not greater than the index have been sent
+	ChangeEventResolved
+	// ChangeEventShardRemoved the replica of the shard is removed from the store, all the changes of
+	// the shard whose raft index is not greater than the index have been sent, and the later changes
+	// need to be subscribed on the other stores of the shard from the index.
+	ChangeEventShardRemoved
+	// ChangeEventShardSnapshot the replica of the shard is created or caught up by the snapshot at the
+	// index, the changes between the last sent and the index are not sent, they need to be subscribed
+	// on the other stores of the shard. The changes after the index are sent.
+	ChangeEventShardSnapshot
+)
+
+// ChangeEvent the change event of a shard
+type ChangeEvent struct {
+	Type    ChangeEventType
+	Group   uint64
+	ShardID uint64
+	// Index the raft index of the write request or the resolved index
+	Index uint64
+	// Request the committed write request, the key is the original key of the application
+	Request *raftcmdpb.Request
+}
+
+// ChangeSubscribeOptions the options of the change subscription
+type ChangeSubscribeOptions struct {
+	// Group the shard group to subscribe
+	Group uint64
+	// Start and End the key range [start, end) to subscribe, empty means no limit
+	Start, End []byte
+	// Positions the raft index of the shards to start the subscription, the changes after the index
+	// are sent. The shards not in the positions start at the applied index when subscribed, and the
+	// shards created by the split after subscribed start at the first raft index. Returns error if
+	// the raft log after the position has been compacted.
+	Positions map[uint64]uint64
+	// BufferSize the size of the events channel
+	BufferSize int
+}
+
+// ChangeSubscription a subscription of the committed writes. The changes of every shard are sent in
+// the raft log order, the changes are fed by the replicas of the shards on the store, so the
+// subscription is not affected by the leader changes. The raft log of the shard is kept until the
+// changes are sent if the store is the leader of the shard, but only a bounded number of the
+// entries are kept for a slow subscription. The subscription is closed with an error if the changes can not be
+// loaded from the raft log. The changes of the replicas moved in or out of the store are never
+// skipped silently, see ChangeEventShardRemoved and ChangeEventShardSnapshot.
+type ChangeSubscription interface {
+	// Events returns the channel of the change events, the channel is closed if the subscription closed
+	Events() <-chan ChangeEvent
+	// Err returns the error that the subscription is closed by
+	Err() error
+	// Close close the subscription
+	Close()
+}
+
+// Subscribe subscribes the committed writes of the shards on the store in the group and key range
+func (s *store) Subscribe(opts ChangeSubscribeOptions) (ChangeSubscription, error) {
+	return s.cdc.subscribe(opts)
+}
+
+// adjustCompactFunc returns the func to adjust the compact index of the raft log, the index is
+// adjusted by the `CustomAdjustCompactFuncFactory` first, and then by the change feed to keep the
+// raft log of the changes not sent to the subscriptions.
+func (s *store) adjustCompactFunc(group uint64) func(shard bhmetapb.Shard, compactIndex uint64) (uint64, error) {
+	var fn func(shard bhmetapb.Shard, compactIndex uint64) (uint64, error)
+	if s.cfg.Customize.CustomAdjustCompactFuncFactory != nil {
+		fn = s.cfg.Customize.CustomAdjustCompactFuncFactory(group)
+	}
+
+	return func(shard bhmetapb.Shard, compactIndex uint64) (uint64, error) {
+		if fn != nil {
+			idx, err := fn(shard, compactIndex)
+			if err != nil {
+				return 0, err
+			}
+			compactIndex = idx
+		}
+
+		return s.cdc.adjustCompactIndex(shard, compactIndex), nil
+	}
+}
+
+type changeEntry struct {
+	index    uint64
+	requests []*raftcmdpb.Request
+}
+
+type changeShardState struct {
+	shard    bhmetapb.Shard
+	next     uint64
+	applied  uint64
+	resolved uint64
+	lagging  bool
+	pending  []changeEntry
+	// snapshot the index of the snapshot not notified
+	snapshot uint64
+	// removed the replica is removed from the store, merged means it's merged into the target shard
+	removed bool
+	merged  bool
+}
+
+// changeFeed the change feed of the store, the changes are fed by the apply path
+type changeFeed struct {
+	store *store
+	count int32
+
+	mu struct {
+		sync.RWMutex
+
+		seq  uint64
+		subs map[uint64]*changeSubscription
+	}
+}
+
+func newChangeFeed(s *store) *changeFeed {
+	f := &changeFeed{store: s}
+	f.mu.subs = make(map[uint64]*changeSubscription)
+	return f
+}
+
+func (f *changeFeed) hasSubscribers() bool {
+	return atomic.LoadInt32(&f.count) > 0
+}
+
+func (f *changeFeed) subscribe(opts ChangeSubscribeOptions) (ChangeSubscription, error) {
+	if len(opts.End) > 0 && bytes.Compare(opts.Start, opts.End) >= 0 {
+		return nil, fmt.Errorf("invalid key range [%+v, %+v)", opts.Start, opts.End)
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = defaultChangeEventBufferSize
+	}
+
+	sub := &changeSubscription{
+		feed:    f,
+		opts:    opts,
+		c:       make(chan ChangeEvent, opts.BufferSize),
+		notifyC: make(chan struct{}, 1),
+		stopC:   make(chan struct{}),
+	}
+	sub.mu.shards = make(map[uint64]*changeShardState)
+
+	var err error
+	f.store.foreachPR(func(pr *peerReplica) bool {
+		shard := pr.ps.shard
+		if !sub.matchShard(shard) {
+			return true
+		}
+
+		state := &bhraftpb.RaftApplyState{}
+		var v []byte
+		v, err = f.store.MetadataStorage().Get(getRaftApplyStateKey(shard.ID))
+		if err != nil {
+			return false
+		}
+		if len(v) > 0 {
+			protoc.MustUnmarshal(state, v)
+		}
+
+		next := state.AppliedIndex + 1
+		if pos, ok := opts.Positions[shard.ID]; ok && pos < state.AppliedIndex {
+			if pos < state.TruncatedState.Index {
+				err = fmt.Errorf("changes of shard %d after index %d are compacted, first index %d",
+					shard.ID,
+					pos,
+					state.TruncatedState.Index+1)
+				return false
+			}
+			next = pos + 1
+		}
+		sub.mu.shards[shard.ID] = &changeShardState{
+			shard:    shard,
+			next:     next,
+			applied:  state.AppliedIndex,
+			resolved: next - 1,
+			lagging:  next <= state.AppliedIndex,
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	f.mu.seq++
+	sub.id = f.mu.seq
+	f.mu.subs[sub.id] = sub
+	atomic.AddInt32(&f.count, 1)
+	f.mu.Unlock()
+
+	go sub.run()
+	sub.notify()
+	return sub, nil
+}
+
+func (f *changeFeed) remove(sub *changeSubscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.mu.subs[sub.id]; ok {
+		delete(f.mu.subs, sub.id)
+		atomic.AddInt32(&f.count, -1)
+	}
+}
+
+// onApplied is called by the apply path after the committed entries applied
+func (f *changeFeed) onApplied(shard bhmetapb.Shard, entries []changeEntry, applied uint64) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, sub := range f.mu.subs {
+		if sub.matchShard(shard) {
+			sub.onApplied(shard, entries, applied)
+		}
+	}
+}
+
+// onSnapshotApplied is called after the snapshot applied to the replica, the changes before the
+// snapshot are not in the raft log of the replica.
+func (f *changeFeed) onSnapshotApplied(shard bhmetapb.Shard, index uint64) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, sub := range f.mu.subs {
+		if sub.matchShard(shard) {
+			sub.onSnapshotApplied(shard, index)
+		}
+	}
+}
+
+// onRemoved is called after the replica removed from the store
+func (f *changeFeed) onRemoved(shard bhmetapb.Shard, merged bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, sub := range f.mu.subs {
+		sub.onRemoved(shard.ID, merged)
+	}
+}
+
+// adjustCompactIndex returns the compact index that keeps the raft log of the changes not sent,
+// at most maxRetainedChangeEntries entries are kept.
+func (f *changeFeed) adjustCompactIndex(shard bhmetapb.Shard, compactIndex uint64) uint64 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	adjusted := compactIndex
+	for _, sub := range f.mu.subs {
+		if next, ok := sub.getNext(shard.ID); ok && next > 0 && next-1 < adjusted {
+			adjusted = next - 1
+		}
+	}
+
+	if compactIndex > maxRetainedChangeEntries && adjusted < compactIndex-maxRetainedChangeEntries {
+		adjusted = compactIndex - maxRetainedChangeEntries
+	}
+	return adjusted
+}
+
+type changeSubscription struct {
+	id      uint64
+	feed    *changeFeed
+	opts    ChangeSubscribeOptions
+	c       chan ChangeEvent
+	notifyC chan struct{}
+	stopC   chan struct{}
+	once    sync.Once
+
+	mu struct {
+		sync.Mutex
+
+		err    error
+		shards map[uint64]*changeShardState
+	}
+}
+
+func (sub *changeSubscription) Events() <-chan ChangeEvent {
+	return sub.c
+}
+
+func (sub *changeSubscription) Err() error {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	return sub.mu.err
+}
+
+func (sub *changeSubscription) Close() {
+	sub.closeWithError(ErrChangeSubscriptionClosed)
+}
+
+func (sub *changeSubscription) closeWithError(err error) {
+	sub.once.Do(func() {
+		sub.mu.Lock()
+		sub.mu.err = err
+		sub.mu.Unlock()
+
+		sub.feed.remove(sub)
+		close(sub.stopC)
+	})
+}
+
+func (sub *changeSubscription) matchShard(shard bhmetapb.Shard) bool {
+	if shard.Group != sub.opts.Group {
+		return false
+	}
+
+	return (len(sub.opts.End) == 0 || bytes.Compare(shard.Start, sub.opts.End) < 0) &&
+		(len(shard.End) == 0 || bytes.Compare(sub.opts.Start, shard.End) < 0)
+}
+
+func (sub *changeSubscription) matchKey(key []byte) bool {
+	return bytes.Compare(key, sub.opts.Start) >= 0 &&
+		(len(sub.opts.End) == 0 || bytes.Compare(key, sub.opts.End) < 0)
+}
+
+func (sub *changeSubscription) notify() {
+	select {
+	case sub.notifyC <- struct{}{}:
+	default:
+	}
+}
+
+func (sub *changeSubscription) getNext(shardID uint64) (uint64, bool) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if state, ok := sub.mu.shards[shardID]; ok {
+		return state.next, true
+	}
+	return 0, false
+}
+
+func (sub *changeSubscription) onApplied(shard bhmetapb.Shard, entries []changeEntry, applied uint64) {
+	sub.mu.Lock()
+	state, ok := sub.mu.shards[shard.ID]
+	if !ok {
+		// the new shard created by the split
+		next := applied + 1
+		if len(entries) > 0 {
+			next = entries[0].index
+		}
+		state = &changeShardState{next: next, resolved: next - 1}
+		sub.mu.shards[shard.ID] = state
+	}
+
+	state.shard = shard
+	state.applied = applied
+	if !state.lagging {
+		for _, entry := range entries {
+			if entry.index >= state.next {
+				state.pending = append(state.pending, entry)
+			}
+		}
+
+		// too slow, the changes will be loaded from the raft log
+		if len(state.pending) > maxPendingChangeEntries {
+			state.pending = nil
+			state.lagging = true
+		}
+	}
+	sub.mu.Unlock()
+
+	sub.notify()
+}
+
+func (sub *changeSubscription) onSnapshotApplied(shard bhmetapb.Shard, index uint64) {
+	sub.mu.Lock()
+	state, ok := sub.mu.shards[shard.ID]
+	if !ok {
+		// the replica is created by the snapshot
+		state = &changeShardState{}
+		sub.mu.shards[shard.ID] = state
+	}
+
+	state.shard = shard
+	state.removed = false
+	if state.next <= index {
+		state.next = index + 1
+		state.resolved = index
+		state.snapshot = index
+		state.lagging = false
+		state.pending = nil
+	}
+	if state.applied < index {
+		state.applied = index
+	}
+	sub.mu.Unlock()
+
+	sub.notify()
+}
+
+func (sub *changeSubscription) onRemoved(shardID uint64, merged bool) {
+	sub.mu.Lock()
+	if state, ok := sub.mu.shards[shardID]; ok {
+		state.removed = true
+		state.merged = merged
+	}
+	sub.mu.Unlock()
+
+	sub.notify()
+}
+
+func (sub *changeSubscription) run() {
+	defer close(sub.c)
+
+	ticker := time.NewTicker(changeFeedCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sub.stopC:
+			return
+		case <-ticker.C:
+		case <-sub.notifyC:
+		}
+
+		if err := sub.sendChanges(); err != nil {
+			if err != ErrChangeSubscriptionClosed {
+				logger.Errorf("change subscription %d closed with %+v", sub.id, err)
+			}
+			sub.closeWithError(err)
+			return
+		}
+	}
+}
+
+func (sub *changeSubscription) sendChanges() error {
+	sub.mu.Lock()
+	ids := make([]uint64, 0, len(sub.mu.shards))
+	for id := range sub.mu.shards {
+		ids = append(ids, id)
+	}
+	sub.mu.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if err := sub.sendShardChanges(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sub *changeSubscription) sendShardChanges(id uint64) error {
+	sub.mu.Lock()
+	state := sub.mu.shards[id]
+	shard := state.shard
+	next := state.next
+	applied := state.applied
+	resolved := state.resolved
+	pending := state.pending
+	snapshot := state.snapshot
+	state.snapshot = 0
+	sub.mu.Unlock()
+
+	if snapshot > 0 {
+		if err := sub.send(ChangeEvent{Type: ChangeEventShardSnapshot, Group: shard.Group, ShardID: id, Index: snapshot}); err != nil {
+			return err
+		}
+	}
+
+	for ; next <= applied; next++ {
+		var requests []*raftcmdpb.Request
+		if len(pending) > 0 && pending[0].index == next {
+			requests = pending[0].requests
+			pending = pending[1:]
+		} else {
+			var err error
+			requests, err = sub.loadRequests(shard.ID, next)
+			if err != nil {
+				// the raft log is removed with the replica
+				if sub.isRemoved(id) {
+					break
+				}
+				return err
+			}
+		}
+
+		for _, req := range requests {
+			if !sub.matchKey(req.Key) {
+				continue
+			}
+
+			if err := sub.send(ChangeEvent{Type: ChangeEventWrite, Group: shard.Group, ShardID: id, Index: next, Request: req}); err != nil {
+				return err
+			}
+		}
+
+		sub.mu.Lock()
+		// the replica is caught up by a snapshot, the changes are sent in the next round
+		moved := state.next != next
+		if !moved {
+			state.next = next + 1
+			for len(state.pending) > 0 && state.pending[0].index <= next {
+				state.pending = state.pending[1:]
+			}
+			if len(state.pending) == 0 && state.lagging && next == state.applied {
+				state.lagging = false
+			}
+		}
+		sub.mu.Unlock()
+
+		if moved {
+			return nil
+		}
+	}
+
+	if sub.isRemoved(id) {
+		sub.mu.Lock()
+		merged := state.merged
+		delete(sub.mu.shards, id)
+		sub.mu.Unlock()
+
+		// the changes after merged are sent by the target shard
+		if merged && next > applied {
+			return nil
+		}
+		return sub.send(ChangeEvent{Type: ChangeEventShardRemoved, Group: shard.Group, ShardID: id, Index: next - 1})
+	}
+
+	if resolved < applied {
+		if err := sub.send(ChangeEvent{Type: ChangeEventResolved, Group: shard.Group, ShardID: id, Index: applied}); err != nil {
+			return err
+		}
+
+		sub.mu.Lock()
+		state.resolved = applied
+		sub.mu.Unlock()
+	}
+	return nil
+}
+
+func (sub *changeSubscription) isRemoved(shardID uint64) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	state, ok := sub.mu.shards[shardID]
+	return ok && state.removed
+}
+
+func (sub *changeSubscription) send(event ChangeEvent) error {
+	select {
+	case <-sub.stopC:
+		return ErrChangeSubscriptionClosed
+	case sub.c <- event:
+		return nil
+	}
+}
+
+// loadRequests loads the committed write requests of the raft entry from the raft log
+func (sub *changeSubscription) loadRequests(shardID, index uint64) ([]*raftcmdpb.Request, error) {
+	entries, err := sub.feed.store.logs.Entries(shardID, index, index+1, math.MaxUint64)
+	if err == raft.ErrUnavailable {
+		return nil, fmt.Errorf("changes of shard %d at index %d are compacted", shardID, index)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry := entries[0]
+	if entry.Type != raftpb.EntryNormal || len(entry.Data) == 0 {
+		return nil, nil
+	}
+
+	executed, err := sub.feed.store.MetadataStorage().Get(getExecutedKey(shardID, index))
+	if err != nil {
+		return nil, err
+	}
+
+	req := &raftcmdpb.RaftCMDRequest{}
+	protoc.MustUnmarshal(req, entry.Data)
+	return getChangeRequests(req, executed), nil
+}
+
+// getChangeRequests returns the executed write requests of the raft cmd with the original keys,
+// all the requests are executed if the executed bitmap is empty.
+func getChangeRequests(req *raftcmdpb.RaftCMDRequest, executed []byte) []*raftcmdpb.Request {
+	if req.AdminRequest != nil {
+		return nil
+	}
+
+	var requests []*raftcmdpb.Request
+	for idx, r := range req.Requests {
+		if r.Type != raftcmdpb.CMDType_Write {
+			continue
+		}
+		if len(executed) > 0 && !isExecuted(executed, idx) {
+			continue
+		}
+
+		v := &raftcmdpb.Request{}
+		protoc.MustUnmarshal(v, protoc.MustMarshal(r))
+		v.Key = DecodeDataKey(v.Key)
+		requests = append(requests, v)
+	}
+	return requests
+}
+
+// encodeExecuted encodes the offsets of the executed requests of the raft cmd to a bitmap
+func encodeExecuted(executed []int, total int) []byte {
+	// the bitmap is never empty, so it's different from the all executed
+	v := make([]byte, total/8+1)
+	for _, idx := range executed {
+		v[idx/8] |= 1 << uint(idx%8)
+	}
+	return v
+}
+
+func isExecuted(executed []byte, idx int) bool {
+	return idx/8 < len(executed) && executed[idx/8]&(1<<uint(idx%8)) != 0
+}
+
+// compactExecuted removes the executed records of the compacted raft logs
+func (s *store) compactExecuted(shardID, compactIndex uint64) error {
+	return s.MetadataStorage().RangeDelete(getExecutedKey(shardID, 0), getExecutedKey(shardID, compactIndex))
+}
@@ -0,0 +1,221 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/command"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/errorpb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeSubscription(t *testing.T) {
+	c := NewSingleTestClusterStore(t,
+		DisableScheduleTestCluster,
+		SetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+			cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	all, err := c.stores[0].Subscribe(ChangeSubscribeOptions{})
+	assert.NoError(t, err)
+	defer all.Close()
+	ranged, err := c.stores[0].Subscribe(ChangeSubscribeOptions{Start: []byte("key3")})
+	assert.NoError(t, err)
+	defer ranged.Close()
+
+	// split to 2 shards [nil, key2), [key2, nil)
+	_, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value11"),
+		createTestWriteReq("w2", "key2", "value22"))
+	assert.NoError(t, err)
+	c.WaitLeadersByCount(t, 2, time.Second*10)
+
+	// write to the new shard
+	_, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w3", "key3", "value33"))
+	assert.NoError(t, err)
+
+	events := waitChangeEvents(t, all, "key1", "key2", "key3")
+	assert.Equal(t, "value11", string(events["key1"].Request.Cmd))
+	assert.Equal(t, events["key1"].ShardID, events["key2"].ShardID)
+	assert.NotEqual(t, events["key1"].ShardID, events["key3"].ShardID)
+
+	events = waitChangeEvents(t, ranged, "key3")
+	assert.NotContains(t, events, "key1")
+	assert.NotContains(t, events, "key2")
+
+	// subscribe from the raft index, the changes are loaded from the raft log
+	_, err = c.stores[0].Subscribe(ChangeSubscribeOptions{
+		Positions: map[uint64]uint64{events["key3"].ShardID: 0},
+	})
+	assert.Error(t, err)
+	replay, err := c.stores[0].Subscribe(ChangeSubscribeOptions{
+		Positions: map[uint64]uint64{events["key3"].ShardID: events["key3"].Index - 1},
+		Start:     []byte("key3"),
+	})
+	assert.NoError(t, err)
+	events = waitChangeEvents(t, replay, "key3")
+	assert.Equal(t, "value33", string(events["key3"].Request.Cmd))
+
+	replay.Close()
+	for range replay.Events() {
+	}
+	assert.Equal(t, ErrChangeSubscriptionClosed, replay.Err())
+}
+
+func TestChangeSubscriptionWithRejectedRequests(t *testing.T) {
+	c := NewSingleTestClusterStore(t, DisableScheduleTestCluster, SetCMDTestClusterHandler)
+	defer c.Stop()
+
+	c.EveryStore(func(i int, s Store) {
+		s.Use(func(stage command.Stage, shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) *errorpb.Error {
+			if stage == command.ApplyStage && string(req.Key) == "rejected" {
+				return &errorpb.Error{Message: "rejected"}
+			}
+			return nil
+		})
+	})
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	id := c.GetShardByIndex(0).ID
+	applied := c.stores[0].getPR(id, false).ps.getAppliedIndex()
+	all, err := c.stores[0].Subscribe(ChangeSubscribeOptions{})
+	assert.NoError(t, err)
+	defer all.Close()
+
+	_, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "rejected", "value"),
+		createTestWriteReq("w2", "key1", "value1"))
+	assert.NoError(t, err)
+	_, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w3", "key2", "value2"))
+	assert.NoError(t, err)
+
+	events := waitChangeEvents(t, all, "key1", "key2")
+	assert.NotContains(t, events, "rejected")
+
+	// the rejected requests are skipped when the changes are loaded from the raft log
+	replay, err := c.stores[0].Subscribe(ChangeSubscribeOptions{Positions: map[uint64]uint64{id: applied}})
+	assert.NoError(t, err)
+	defer replay.Close()
+	events = waitChangeEvents(t, replay, "key1", "key2")
+	assert.NotContains(t, events, "rejected")
+}
+
+func TestChangeSubscriptionWithMovedShard(t *testing.T) {
+	c := NewSingleTestClusterStore(t, DisableScheduleTestCluster, SetCMDTestClusterHandler)
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	s := c.stores[0]
+	shard := c.GetShardByIndex(0)
+	sub, err := s.Subscribe(ChangeSubscribeOptions{})
+	assert.NoError(t, err)
+	defer sub.Close()
+
+	_, err = sendTestReqs(s, time.Second*10, nil, nil, createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	events := waitChangeEvents(t, sub, "key1")
+
+	// the changes before the snapshot are skipped with the explicit event
+	index := events["key1"].Index + 100
+	s.cdc.onSnapshotApplied(shard, index)
+	event := waitChangeEvent(t, sub, ChangeEventShardSnapshot)
+	assert.Equal(t, shard.ID, event.ShardID)
+	assert.Equal(t, index, event.Index)
+
+	// the subscription of the removed replica is ended with the explicit event
+	s.cdc.onRemoved(shard, false)
+	event = waitChangeEvent(t, sub, ChangeEventShardRemoved)
+	assert.Equal(t, shard.ID, event.ShardID)
+	assert.Equal(t, index, event.Index)
+	_, ok := sub.(*changeSubscription).getNext(shard.ID)
+	assert.False(t, ok)
+}
+
+func TestAdjustCompactIndex(t *testing.T) {
+	c := NewSingleTestClusterStore(t, DisableScheduleTestCluster)
+	defer c.Stop()
+
+	c.Start()
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	s := c.stores[0]
+	shard := c.GetShardByIndex(0)
+	sub, err := s.Subscribe(ChangeSubscribeOptions{})
+	assert.NoError(t, err)
+	defer sub.Close()
+
+	next, ok := sub.(*changeSubscription).getNext(shard.ID)
+	assert.True(t, ok)
+	assert.Equal(t, next-1, s.cdc.adjustCompactIndex(shard, next+10))
+	// the slow subscription can not hold the raft log more than maxRetainedChangeEntries
+	compactIndex := next + maxRetainedChangeEntries + 10
+	assert.Equal(t, compactIndex-maxRetainedChangeEntries, s.cdc.adjustCompactIndex(shard, compactIndex))
+}
+
+// waitChangeEvent waits for the first event of the type
+func waitChangeEvent(t *testing.T, sub ChangeSubscription, eventType ChangeEventType) ChangeEvent {
+	timeoutC := time.After(time.Second * 10)
+	for {
+		select {
+		case <-timeoutC:
+			assert.FailNowf(t, "", "timeout wait change event %d", eventType)
+		case event, ok := <-sub.Events():
+			assert.True(t, ok)
+			if event.Type == eventType {
+				return event
+			}
+		}
+	}
+}
+
+// waitChangeEvents waits for the write events of the keys, and checks the events of every shard are
+// in the raft log order and after the resolved index
+func waitChangeEvents(t *testing.T, sub ChangeSubscription, keys ...string) map[string]ChangeEvent {
+	events := make(map[string]ChangeEvent)
+	indexes := make(map[uint64]uint64)
+	resolved := make(map[uint64]uint64)
+	timeoutC := time.After(time.Second * 10)
+	for {
+		done := true
+		for _, key := range keys {
+			if _, ok := events[key]; !ok {
+				done = false
+			}
+		}
+		if done {
+			return events
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNowf(t, "", "timeout wait change events, current %+v", events)
+		case event, ok := <-sub.Events():
+			assert.True(t, ok)
+			switch event.Type {
+			case ChangeEventWrite:
+				assert.True(t, event.Index >= indexes[event.ShardID])
+				assert.True(t, event.Index > resolved[event.ShardID])
+				indexes[event.ShardID] = event.Index
+				events[string(event.Request.Key)] = event
+			case ChangeEventResolved:
+				assert.True(t, event.Index >= resolved[event.ShardID])
+				resolved[event.ShardID] = event.Index
+			}
+		}
+	}
+}
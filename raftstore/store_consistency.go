@@ -0,0 +1,184 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/matrixorigin/matrixcube/metric"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+)
+
+// Consistency check has 2 admin commands:
+// 1. ComputeHash, proposed by the shard leader periodically. Every replica takes a snapshot
+//    of the shard data when applying it, so all the hashes are computed at the same log
+//    index. The hash is computed by the consistency check worker in the background, so
+//    it never blocks the apply worker.
+// 2. VerifyHash, proposed by the shard leader with the hash computed by itself. Every
+//    replica compares the hash with its own at the same index, either when applying it
+//    or when its own hash is computed later.
+//
+// The inconsistent replicas are reported to prophet by the store heartbeat, and prophet
+// can rebuild them.
+
+// handleConsistencyCheck start the consistency check on all the shard leaders
+func (s *store) handleConsistencyCheck() {
+	s.foreachPR(func(pr *peerReplica) bool {
+		if pr.isLeader() && pr.ps.mergeState == nil {
+			pr.addAction(action{actionType: checkConsistencyAction})
+		}
+		return true
+	})
+}
+
+func (s *store) getInconsistentShards() []uint64 {
+	var shards []uint64
+	s.inconsistents.Range(func(key, value interface{}) bool {
+		shards = append(shards, key.(uint64))
+		return true
+	})
+	return shards
+}
+
+func (pr *peerReplica) doCheckConsistency() {
+	if !pr.isLeader() {
+		return
+	}
+
+	logger.Debugf("shard %d propose compute hash",
+		pr.shardID)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_ComputeHash,
+	})
+}
+
+// hashCheck is the consistency check of the last ComputeHash of a replica, it's updated by
+// the apply worker and the consistency check worker.
+type hashCheck struct {
+	sync.Mutex
+	index uint64
+	// the hash of the current replica, nil if not computed yet
+	hash []byte
+	// the hash of the leader, nil if the VerifyHash is not applied yet
+	expected []byte
+}
+
+// reset starts a new check at the index of the ComputeHash
+func (c *hashCheck) reset(index uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.index = index
+	c.hash = nil
+	c.expected = nil
+}
+
+// setHash sets the hash of the current replica, returns true and the comparison result
+// if the hash of the leader is ready.
+func (c *hashCheck) setHash(index uint64, hash []byte) (bool, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.index != index {
+		return false, false
+	}
+
+	c.hash = hash
+	return c.compareLocked()
+}
+
+// setExpected sets the hash of the leader, returns true and the comparison result if the
+// hash of the current replica is ready.
+func (c *hashCheck) setExpected(index uint64, hash []byte) (bool, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.index != index {
+		return false, false
+	}
+
+	c.expected = hash
+	return c.compareLocked()
+}
+
+func (c *hashCheck) getIndex() uint64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.index
+}
+
+func (c *hashCheck) compareLocked() (bool, bool) {
+	if c.hash == nil || c.expected == nil {
+		return false, false
+	}
+
+	return true, bytes.Equal(c.hash, c.expected)
+}
+
+// computeHash computes the hash in the consistency check worker, and lets the leader
+// propose the VerifyHash after computed.
+func (s *store) computeHash(shardID uint64, check *hashCheck, index uint64, compute func() ([]byte, error)) error {
+	return s.addConsistencyCheckJob(func() error {
+		hash, err := compute()
+		if err != nil {
+			logger.Errorf("shard %d compute hash at index %d failed with %+v",
+				shardID,
+				index,
+				err)
+			return nil
+		}
+
+		if ok, consistent := check.setHash(index, hash); ok {
+			s.reportHashCheck(shardID, index, consistent)
+		}
+
+		if pr := s.getPR(shardID, true); pr != nil {
+			pr.addAction(action{actionType: verifyHashAction, hashIndex: index, hash: hash})
+		}
+		return nil
+	})
+}
+
+func (s *store) reportHashCheck(shardID uint64, index uint64, consistent bool) {
+	metric.AddRaftAdminCommandVerifyHashCount(1)
+	if consistent {
+		s.inconsistents.Delete(shardID)
+		return
+	}
+
+	metric.AddRaftAdminCommandVerifyHashFailedCount(1)
+	logger.Errorf("shard %d verify hash at index %d failed, report to prophet",
+		shardID,
+		index)
+	s.inconsistents.Store(shardID, index)
+}
+
+func (pr *peerReplica) proposeVerifyHash(index uint64, hash []byte) {
+	if !pr.isLeader() {
+		return
+	}
+
+	logger.Debugf("shard %d propose verify hash at index %d",
+		pr.shardID,
+		index)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_VerifyHash,
+		VerifyHash: &raftcmdpb.VerifyHashRequest{
+			Index: index,
+			Hash:  hash,
+		},
+	})
+}
@@ -124,6 +124,7 @@ func (s *store) onRaftMessage(msg *bhraftpb.RaftMessage) {
 	s.peers.Store(msg.From.ID, msg.From)
 	pr := s.getPR(msg.ShardID, false)
 	pr.step(msg.Message)
+	pr.onHibernateMessage(msg)
 	pr.notifyWorker()
 }
 
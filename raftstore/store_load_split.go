@@ -0,0 +1,185 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/metric"
+)
+
+// Load split splits the hot shard whose traffic exceeds one store's capacity, moving the hot
+// peers can not relieve it. Every replica samples the keys on the read and write paths, the
+// shard leader checks the sampled load periodically. If the shard stays above the QPS or the
+// bytes threshold, the leader splits it by the sampled key which balances the requests of the
+// two new shards.
+
+const (
+	loadSampleSize = 64
+)
+
+// loadSampler records the count and bytes of the requests, and keeps a fixed size of the
+// request keys by the reservoir sampling. The reads are recorded by the event loop, and the
+// writes are recorded by the apply worker, so it is guarded by a lock.
+type loadSampler struct {
+	sync.Mutex
+	rnd     *rand.Rand
+	count   uint64
+	bytes   uint64
+	samples [][]byte
+}
+
+func newLoadSampler(cfg config.ReplicationConfig) *loadSampler {
+	if !cfg.EnableLoadSplit() {
+		return nil
+	}
+
+	return &loadSampler{
+		rnd:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		samples: make([][]byte, 0, loadSampleSize),
+	}
+}
+
+// record records a request, nil sampler means the load split is disabled
+func (s *loadSampler) record(key []byte, n uint64) {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	s.count++
+	s.bytes += n
+	if len(s.samples) < loadSampleSize {
+		s.samples = append(s.samples, append([]byte(nil), key...))
+	} else if idx := s.rnd.Uint64() % s.count; idx < loadSampleSize {
+		s.samples[idx] = append(s.samples[idx][:0], key...)
+	}
+	s.Unlock()
+}
+
+// take returns the recorded load since the last take and resets the sampler
+func (s *loadSampler) take() (count uint64, size uint64, samples [][]byte) {
+	if s == nil {
+		return 0, 0, nil
+	}
+
+	s.Lock()
+	count, size, samples = s.count, s.bytes, s.samples
+	s.count, s.bytes = 0, 0
+	s.samples = make([][]byte, 0, loadSampleSize)
+	s.Unlock()
+	return
+}
+
+// findLoadSplitKey returns the sampled key in (start, end) which splits the samples into two
+// most balanced parts, returns nil if no such key, e.g. all the requests are on a single key.
+func findLoadSplitKey(samples [][]byte, start, end []byte) []byte {
+	keys := make([][]byte, 0, len(samples))
+	for _, key := range samples {
+		if bytes.Compare(key, start) > 0 && bytes.Compare(key, end) < 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	// the samples in [start, key) are requests of the left shard
+	var splitKey []byte
+	best := len(keys)
+	for i := 1; i < len(keys); i++ {
+		if bytes.Equal(keys[i], keys[i-1]) {
+			continue
+		}
+
+		diff := len(keys) - 2*i
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < best {
+			best = diff
+			splitKey = keys[i]
+		}
+	}
+	return splitKey
+}
+
+// handleLoadSplitCheck check the sampled load of all the shards
+func (s *store) handleLoadSplitCheck() {
+	s.foreachPR(func(pr *peerReplica) bool {
+		pr.addAction(action{actionType: checkLoadSplitAction})
+		return true
+	})
+}
+
+func (pr *peerReplica) doCheckLoadSplit() {
+	count, size, samples := pr.loadSampler.take()
+	if !pr.isLeader() || !pr.supportSplit() {
+		pr.loadSplitHits = 0
+		return
+	}
+
+	cfg := pr.store.cfg.Replication
+	seconds := cfg.LoadSplitCheckDuration.Duration.Seconds()
+	qps := float64(count) / seconds
+	bps := float64(size) / seconds
+	if !(cfg.LoadSplitQPSThreshold > 0 && qps >= float64(cfg.LoadSplitQPSThreshold)) &&
+		!(cfg.LoadSplitBytesThreshold > 0 && bps >= float64(cfg.LoadSplitBytesThreshold)) {
+		pr.loadSplitHits = 0
+		return
+	}
+
+	pr.loadSplitHits++
+	logger.Debugf("shard %d is hot in %d checks, qps %.2f, bytes %.2f/s",
+		pr.shardID,
+		pr.loadSplitHits,
+		qps,
+		bps)
+	if pr.loadSplitHits < cfg.LoadSplitCheckTimes {
+		return
+	}
+	pr.loadSplitHits = 0
+
+	shard := pr.ps.shard
+	splitKey := findLoadSplitKey(samples, encStartKey(&shard), encEndKey(&shard))
+	if len(splitKey) == 0 {
+		metric.IncLoadSplitNoKeyCount()
+		logger.Infof("shard %d is hot, but no balanced split key found in %d samples",
+			pr.shardID,
+			len(samples))
+		return
+	}
+
+	logger.Infof("shard %d is hot, qps %.2f, bytes %.2f/s, try to split by key %+v",
+		pr.shardID,
+		qps,
+		bps,
+		splitKey)
+	epoch := shard.Epoch
+	err := pr.store.addSplitJob(func() error {
+		return pr.askSplit(epoch, [][]byte{splitKey})
+	})
+	if err != nil {
+		logger.Errorf("shard %d add load split job failed with %+v",
+			pr.shardID,
+			err)
+		return
+	}
+	metric.IncLoadSplitCount()
+}
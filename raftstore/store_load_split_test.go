@@ -0,0 +1,105 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSampler(t *testing.T) {
+	cfg := config.ReplicationConfig{}
+	s := newLoadSampler(cfg)
+	assert.Nil(t, s, "load split disabled")
+	s.record([]byte("k1"), 1)
+	count, size, samples := s.take()
+	assert.Equal(t, uint64(0), count)
+	assert.Equal(t, uint64(0), size)
+	assert.Empty(t, samples)
+
+	cfg.LoadSplitQPSThreshold = 1
+	s = newLoadSampler(cfg)
+	for i := 0; i < loadSampleSize*2; i++ {
+		s.record([]byte(fmt.Sprintf("k%d", i)), 10)
+	}
+	count, size, samples = s.take()
+	assert.Equal(t, uint64(loadSampleSize*2), count)
+	assert.Equal(t, uint64(loadSampleSize*20), size)
+	assert.Equal(t, loadSampleSize, len(samples))
+
+	count, _, samples = s.take()
+	assert.Equal(t, uint64(0), count)
+	assert.Empty(t, samples)
+}
+
+func TestFindLoadSplitKey(t *testing.T) {
+	keys := func(values ...string) [][]byte {
+		var v [][]byte
+		for _, value := range values {
+			v = append(v, []byte(value))
+		}
+		return v
+	}
+
+	cases := []struct {
+		samples    [][]byte
+		start, end string
+		splitKey   []byte
+	}{
+		{keys("b", "c", "d", "e"), "a", "z", []byte("d")},
+		{keys("e", "d", "c", "b"), "a", "z", []byte("d")},
+		{keys("b", "c", "c", "c", "c", "d"), "a", "z", []byte("c")},
+		{keys("b", "b", "b", "c"), "a", "z", []byte("c")},
+		{keys("b", "b", "b", "b"), "a", "z", nil},
+		{keys("a", "b", "c", "x", "y", "z"), "b", "y", []byte("x")},
+		{nil, "a", "z", nil},
+	}
+
+	for i, c := range cases {
+		assert.Equal(t, c.splitKey, findLoadSplitKey(c.samples, []byte(c.start), []byte(c.end)), "index %d", i)
+	}
+}
+
+func TestLoadSplit(t *testing.T) {
+	c := NewSingleTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+		cfg.Replication.LoadSplitQPSThreshold = 10
+		cfg.Replication.LoadSplitCheckDuration.Duration = time.Millisecond * 100
+		cfg.Replication.LoadSplitCheckTimes = 2
+	}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	pr := c.stores[0].getPR(c.GetShardByIndex(0).ID, true)
+	assert.NotNil(t, pr)
+	go func() {
+		// keep the shard hot until it is split
+		for c.GetPRCount(0) == 1 {
+			for _, key := range []string{"key1", "key2", "key3", "key4"} {
+				pr.loadSampler.record(EncodeDataKey(0, []byte(key)), 10)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	c.WaitShardByCount(t, 2, time.Second*10)
+	shard := c.GetShardByIndex(1)
+	assert.Contains(t, []string{"key2", "key3", "key4"}, string(shard.Start))
+}
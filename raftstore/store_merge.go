@@ -0,0 +1,457 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftstore
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+)
+
+// Shard merge has 3 admin commands:
+// 1. PrepareMerge, proposed by the source shard leader after received the merge
+//    operator from prophet. After applied, the source shard's epoch is increased,
+//    and all the following write requests will be rejected.
+// 2. CommitMerge, proposed by the target shard leader on the store which the source
+//    shard leader located, after the source leader found all the source replicas have
+//    appended the PrepareMerge. Every target replica applies the CommitMerge after
+//    the source replica on the same store applied the PrepareMerge, so the data of the
+//    source shard is completed. After applied, the source range is merged into the target
+//    shard, and the source shard is destroyed without removing data.
+// 3. RollbackMerge, proposed by the source shard leader if the target shard is changed
+//    and the CommitMerge can never be applied, or not all the source replicas appended
+//    the PrepareMerge in the ShardMergeTimeout. The timeout rollback is only proposed by
+//    the leader which proposed the PrepareMerge and never asked the target to commit, so
+//    it never races with a CommitMerge.
+//
+// The source and target shards must be in the same group, adjacent, and the peers of
+// them must be on the same stores with the same DataStorage. The replicas of the source
+// and the target shards run in different event loops, so they never read the state of
+// each other, but exchange the checks by the actions.
+
+// mergeWaitState is the merge commit waiting state of the source leader
+type mergeWaitState struct {
+	commit uint64
+	start  time.Time
+	// the CommitMerge may be proposed by the target leader, can not rollback by timeout
+	committing bool
+}
+
+// handleMergeCheck check all the merging shards to commit or rollback
+func (s *store) handleMergeCheck() {
+	s.mergeLock.Lock()
+	sources := make([]uint64, 0, len(s.mergePrepared))
+	for source := range s.mergePrepared {
+		sources = append(sources, source)
+	}
+	s.mergeLock.Unlock()
+
+	for _, source := range sources {
+		if pr := s.getPR(source, false); pr != nil {
+			pr.addAction(action{actionType: checkMergeAction})
+		}
+	}
+}
+
+// markMergePrepared is called after the source shard applied the PrepareMerge, and
+// resume the target shard which is waiting for it.
+func (s *store) markMergePrepared(source uint64, state bhraftpb.MergeState) {
+	s.mergeLock.Lock()
+	s.mergePrepared[source] = state
+	target, ok := s.mergeWaiters[source]
+	delete(s.mergeWaiters, source)
+	s.mergeLock.Unlock()
+
+	if ok {
+		s.resumeCommitMerge(target)
+	}
+}
+
+func (s *store) clearMergePrepared(source uint64) {
+	s.mergeLock.Lock()
+	delete(s.mergePrepared, source)
+	delete(s.mergeWaiters, source)
+	s.mergeLock.Unlock()
+}
+
+func (s *store) getMergePrepared(source uint64) (bhraftpb.MergeState, bool) {
+	s.mergeLock.Lock()
+	defer s.mergeLock.Unlock()
+
+	state, ok := s.mergePrepared[source]
+	return state, ok
+}
+
+// waitMergePrepared returns true if the source shard has not applied the PrepareMerge
+// with the commit index, the target shard will be resumed after that.
+func (s *store) waitMergePrepared(source, commit, target uint64) bool {
+	s.mergeLock.Lock()
+	defer s.mergeLock.Unlock()
+
+	if state, ok := s.mergePrepared[source]; ok && state.Commit >= commit {
+		return false
+	}
+
+	s.mergeWaiters[source] = target
+	return true
+}
+
+func (s *store) resumeCommitMerge(target uint64) {
+	pr := s.getPR(target, false)
+	if pr == nil {
+		return
+	}
+
+	err := s.addApplyJob(pr.applyWorker, "doResumeCommitMerge", func() error {
+		value, ok := s.delegates.Load(target)
+		if !ok {
+			return fmt.Errorf("shard %d missing delegate", target)
+		}
+
+		value.(*applyDelegate).resumeCommitMerge()
+		return nil
+	}, nil)
+	if err != nil {
+		logger.Errorf("shard %d add resume commit merge job failed with %+v",
+			target,
+			err)
+	}
+}
+
+func isAllowedInMerging(req *raftcmdpb.RaftCMDRequest) bool {
+	if req.AdminRequest == nil {
+		for _, r := range req.Requests {
+			if r.Type == raftcmdpb.CMDType_Write {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch req.AdminRequest.CmdType {
+	case raftcmdpb.AdminCmdType_CompactLog,
+		raftcmdpb.AdminCmdType_TransferLeader,
+		raftcmdpb.AdminCmdType_RollbackMerge:
+		return true
+	}
+
+	return false
+}
+
+// doPrepareMerge checks the source shard, and asks the target replica on the current
+// store to check the target shard before proposing the PrepareMerge.
+func (pr *peerReplica) doPrepareMerge(target bhmetapb.Shard, epoch metapb.ResourceEpoch) {
+	if err := pr.checkMergeSource(target, epoch); err != nil {
+		logger.Infof("shard %d can not merge into shard %d, %+v",
+			pr.shardID,
+			target.ID,
+			err)
+		return
+	}
+
+	targetPR := pr.store.getPR(target.ID, false)
+	if targetPR == nil {
+		logger.Errorf("shard %d can not merge into shard %d, missing target shard on the current store",
+			pr.shardID,
+			target.ID)
+		return
+	}
+
+	targetPR.addAction(action{
+		actionType:  checkMergeTargetAction,
+		epoch:       epoch,
+		mergeTarget: target,
+		mergeSource: pr.ps.shard,
+	})
+}
+
+// doCheckMergeTarget is called on the target replica, and let the source replica propose
+// the PrepareMerge if the local target shard is the same as the merge target.
+func (pr *peerReplica) doCheckMergeTarget(source, target bhmetapb.Shard, epoch metapb.ResourceEpoch) {
+	local := pr.ps.shard
+	if local.Epoch.Version != target.Epoch.Version ||
+		local.Epoch.ConfVer != target.Epoch.ConfVer {
+		logger.Errorf("shard %d can not merge into shard %d, target epoch not match, local=<%+v> prophet=<%+v>",
+			source.ID,
+			target.ID,
+			local.Epoch,
+			target.Epoch)
+		return
+	}
+
+	if pr.ps.mergeState != nil {
+		logger.Errorf("shard %d can not merge into shard %d, target shard is merging",
+			source.ID,
+			target.ID)
+		return
+	}
+
+	sourcePR := pr.store.getPR(source.ID, false)
+	if sourcePR == nil {
+		return
+	}
+
+	sourcePR.addAction(action{
+		actionType:  prepareMergeAction,
+		epoch:       epoch,
+		mergeTarget: target,
+	})
+}
+
+func (pr *peerReplica) proposePrepareMerge(target bhmetapb.Shard, epoch metapb.ResourceEpoch) {
+	// the source shard may be changed after the target checked
+	if err := pr.checkMergeSource(target, epoch); err != nil {
+		logger.Infof("shard %d can not merge into shard %d, %+v",
+			pr.shardID,
+			target.ID,
+			err)
+		return
+	}
+
+	logger.Infof("shard %d propose prepare merge into shard %d",
+		pr.shardID,
+		target.ID)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_PrepareMerge,
+		PrepareMerge: &raftcmdpb.PrepareMergeRequest{
+			Target: target,
+		},
+	})
+}
+
+func (pr *peerReplica) checkMergeSource(target bhmetapb.Shard, epoch metapb.ResourceEpoch) error {
+	if !pr.isLeader() {
+		return fmt.Errorf("not leader")
+	}
+
+	if pr.ps.mergeState != nil {
+		return fmt.Errorf("already merging into shard %d", pr.ps.mergeState.Target.ID)
+	}
+
+	source := pr.ps.shard
+	if source.Epoch.Version != epoch.Version {
+		return fmt.Errorf("epoch changed, current=<%+v> merge=<%+v>",
+			source.Epoch,
+			epoch)
+	}
+
+	if source.Group != target.Group {
+		return fmt.Errorf("group not match, source %d, target %d",
+			source.Group,
+			target.Group)
+	}
+
+	if !isAdjacentShards(source, target) {
+		return fmt.Errorf("shards are not adjacent")
+	}
+
+	if pr.rn.PendingConfIndex() > pr.ps.getAppliedIndex() {
+		return fmt.Errorf("there is a pending conf change")
+	}
+
+	if _, ok := pr.store.getMergePrepared(target.ID); ok {
+		return fmt.Errorf("target shard is merging")
+	}
+
+	if !isSamePeerStores(source, target) {
+		return fmt.Errorf("peers are not on the same stores, source=<%+v> target=<%+v>",
+			source.Peers,
+			target.Peers)
+	}
+
+	if pr.store.DataStorageByGroup(source.Group, source.ID) !=
+		pr.store.DataStorageByGroup(target.Group, target.ID) {
+		return fmt.Errorf("data storage not match")
+	}
+
+	return nil
+}
+
+// doCheckMerge is called on the source leader. If all the source replicas appended the
+// PrepareMerge, asks the target replica on the current store to commit the merge, and
+// transfers the source leader to the store of the target leader if the target leader
+// is on the other store. Otherwise, proposes RollbackMerge if waited too long.
+func (pr *peerReplica) doCheckMerge() {
+	state := pr.ps.mergeState
+	if state == nil || !pr.isLeader() {
+		return
+	}
+
+	if pr.mergeWait.commit != state.Commit {
+		pr.mergeWait = mergeWaitState{
+			commit: state.Commit,
+			start:  time.Now(),
+		}
+	}
+
+	if !pr.isMergePrepareReplicated(state.Commit) {
+		pr.maybeRollbackMergeByTimeout(*state)
+		return
+	}
+
+	targetStore := pr.store.router.LeaderPeerStore(state.Target.ID)
+	if targetStore.ID != 0 && targetStore.ID != pr.store.Meta().ID {
+		if p := findPeer(&pr.ps.shard, targetStore.ID); p != nil {
+			logger.Infof("shard %d transfer leader to store %d to commit merge into shard %d",
+				pr.shardID,
+				targetStore.ID,
+				state.Target.ID)
+			pr.onAdmin(&raftcmdpb.AdminRequest{
+				CmdType: raftcmdpb.AdminCmdType_TransferLeader,
+				TransferLeader: &raftcmdpb.TransferLeaderRequest{
+					Peer: *p,
+				},
+			})
+		}
+		return
+	}
+
+	targetPR := pr.store.getPR(state.Target.ID, false)
+	if targetPR == nil {
+		logger.Warningf("shard %d missing target shard %d on the current store",
+			pr.shardID,
+			state.Target.ID)
+		return
+	}
+
+	pr.mergeWait.committing = true
+	targetPR.addAction(action{
+		actionType:  commitMergeAction,
+		mergeSource: pr.ps.shard,
+		mergeState:  *state,
+	})
+}
+
+// isMergePrepareReplicated returns true if all the source replicas appended the PrepareMerge,
+// so every source replica can apply the PrepareMerge without the current leader.
+func (pr *peerReplica) isMergePrepareReplicated(commit uint64) bool {
+	status := pr.rn.Status()
+	for _, p := range pr.ps.shard.Peers {
+		if progress, ok := status.Progress[p.ID]; !ok || progress.Match < commit {
+			return false
+		}
+	}
+	return true
+}
+
+func (pr *peerReplica) maybeRollbackMergeByTimeout(state bhraftpb.MergeState) {
+	if pr.mergeWait.committing ||
+		time.Since(pr.mergeWait.start) < pr.store.cfg.Replication.ShardMergeTimeout.Duration {
+		return
+	}
+
+	// The previous leaders may already asked the target to commit the merge, only the
+	// leader which proposed the PrepareMerge can decide to rollback.
+	term, err := pr.ps.Term(state.Commit)
+	if err != nil || term != pr.rn.BasicStatus().Term {
+		logger.Warningf("shard %d not all replicas appended the prepare merge, wait for them",
+			pr.shardID)
+		return
+	}
+
+	logger.Infof("shard %d not all replicas appended the prepare merge in %s, propose rollback merge",
+		pr.shardID,
+		pr.store.cfg.Replication.ShardMergeTimeout.Duration)
+	pr.proposeRollbackMerge(state.Commit)
+}
+
+// doCommitMerge is called on the target replica, proposes CommitMerge if the current replica
+// is the target leader, or asks the source leader to rollback if the target shard changed.
+func (pr *peerReplica) doCommitMerge(source bhmetapb.Shard, state bhraftpb.MergeState) {
+	target := pr.ps.shard
+	if target.Epoch.Version == state.Target.Epoch.Version {
+		if pr.isLeader() {
+			logger.Infof("shard %d propose commit merge shard %d",
+				target.ID,
+				source.ID)
+			pr.onAdmin(&raftcmdpb.AdminRequest{
+				CmdType: raftcmdpb.AdminCmdType_CommitMerge,
+				CommitMerge: &raftcmdpb.CommitMergeRequest{
+					Source: source,
+					Commit: state.Commit,
+				},
+			})
+		}
+		return
+	}
+
+	// merge committed, wait for the target to destroy the source shard
+	if isShardRangeCovered(target, source) {
+		return
+	}
+
+	logger.Infof("shard %d target shard %d changed, current=<%+v> prepare=<%+v>",
+		source.ID,
+		target.ID,
+		target.Epoch,
+		state.Target.Epoch)
+	if sourcePR := pr.store.getPR(source.ID, false); sourcePR != nil {
+		sourcePR.addAction(action{
+			actionType: rollbackMergeAction,
+			mergeState: state,
+		})
+	}
+}
+
+func (pr *peerReplica) proposeRollbackMerge(commit uint64) {
+	state := pr.ps.mergeState
+	if state == nil || state.Commit != commit || !pr.isLeader() {
+		return
+	}
+
+	logger.Infof("shard %d propose rollback merge into shard %d",
+		pr.shardID,
+		state.Target.ID)
+	pr.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType: raftcmdpb.AdminCmdType_RollbackMerge,
+		RollbackMerge: &raftcmdpb.RollbackMergeRequest{
+			Commit: commit,
+		},
+	})
+}
+
+func isAdjacentShards(a, b bhmetapb.Shard) bool {
+	return (len(a.End) > 0 && bytes.Equal(a.End, b.Start)) ||
+		(len(b.End) > 0 && bytes.Equal(b.End, a.Start))
+}
+
+// isShardRangeCovered returns true if the range of the shard b is in the range of the shard a
+func isShardRangeCovered(a, b bhmetapb.Shard) bool {
+	return bytes.Compare(a.Start, b.Start) <= 0 &&
+		(len(a.End) == 0 || (len(b.End) > 0 && bytes.Compare(b.End, a.End) <= 0))
+}
+
+func isSamePeerStores(a, b bhmetapb.Shard) bool {
+	if len(a.Peers) != len(b.Peers) {
+		return false
+	}
+
+	for _, p := range a.Peers {
+		if p.Role != metapb.PeerRole_Voter {
+			return false
+		}
+
+		o := findPeer(&b, p.ContainerID)
+		if o == nil || o.Role != metapb.PeerRole_Voter {
+			return false
+		}
+	}
+
+	return true
+}
@@ -5,22 +5,39 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/fagongzi/util/format"
 	"github.com/fagongzi/util/protoc"
+	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet"
 	"github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/storage"
+	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+)
+
+const (
+	// ClearShardDataCMD the custom type of the write command used by the shards pool
+	// to clear the data of the released shards, it's registered when the store created,
+	// so the application can't register it for its own commands.
+	ClearShardDataCMD uint64 = math.MaxUint64
+
+	// maxProcessedKeys the max number of the idempotency keys kept by the shards pool
+	maxProcessedKeys = 1024
 )
 
 var (
 	errNoIdleShard = errors.New("no idle shard")
+
+	shardsPoolExecuteRetries = 1
 )
 
 // ShardsPool is a shards pool, it will always create shards until the number of available shards reaches the
@@ -33,10 +50,20 @@ type ShardsPool interface {
 	// Alloc alloc a shard from shards pool, returns error if no idle shards left. The `purpose` is used to avoid
 	// duplicate allocation.
 	Alloc(group uint64, purpose []byte) (bhmetapb.AllocatedShard, error)
+	// Release release the allocated shard, the data of the shard will be cleared and
+	// the shard will be returned to the idle shards to be allocated again. Release the
+	// same shard more than once is ok.
+	Release(group, shardID uint64) error
+	// UpdatePool update the capacity of the pool, the pool will be created if not exists.
+	// The range prefix of a existing pool can not be changed.
+	UpdatePool(pool metapb.ResourcePool) error
+	// RemovePool remove the pool, no more shards will be created or allocated by the pool.
+	// The shards already created by the pool are kept.
+	RemovePool(group uint64) error
 }
 
 func (s *store) CreateResourcePool(pools ...metapb.ResourcePool) (ShardsPool, error) {
-	err := s.pd.GetClient().CreateJob(metapb.Job{Type: metapb.JobType_CreateResourcePool, Content: protoc.MustMarshal(&metapb.ResourcePoolJob{
+	_, err := s.pd.GetClient().CreateJob(metapb.Job{Type: metapb.JobType_CreateResourcePool, Content: protoc.MustMarshal(&metapb.ResourcePoolJob{
 		Pools: pools,
 	})})
 	if err != nil {
@@ -53,6 +80,8 @@ type dynamicShardsPool struct {
 	cancel context.CancelFunc
 	pd     prophet.Client
 	pdC    chan struct{}
+	// clearShardData clear the data of the shard by the raft
+	clearShardData func(group, shardID uint64) error
 
 	mu struct {
 		sync.RWMutex
@@ -113,6 +142,72 @@ func (dsp *dynamicShardsPool) Alloc(group uint64, purpose []byte) (bhmetapb.Allo
 	}
 }
 
+func (dsp *dynamicShardsPool) Release(group, shardID uint64) error {
+	cmd := &bhmetapb.ShardsPoolCmd{
+		Type: bhmetapb.ShardsPoolCmdType_ReleaseShard,
+		Release: &bhmetapb.ShardsPoolReleaseCmd{
+			Group:   group,
+			ShardID: shardID,
+			Key:     uuid.NewV4().Bytes(),
+		},
+	}
+	v, err := dsp.execute(cmd)
+	if err != nil {
+		return err
+	}
+
+	// already released
+	if len(v) == 0 {
+		return nil
+	}
+
+	if err := dsp.clearShardData(group, shardID); err != nil {
+		return err
+	}
+
+	cmd.Release.Cleared = true
+	_, err = dsp.execute(cmd)
+	return err
+}
+
+func (dsp *dynamicShardsPool) UpdatePool(pool metapb.ResourcePool) error {
+	_, err := dsp.execute(&bhmetapb.ShardsPoolCmd{
+		Type: bhmetapb.ShardsPoolCmdType_UpdatePool,
+		Update: &bhmetapb.ShardsPoolUpdateCmd{
+			Group:       pool.Group,
+			Capacity:    pool.Capacity,
+			RangePrefix: pool.RangePrefix,
+			Key:         uuid.NewV4().Bytes(),
+		},
+	})
+	return err
+}
+
+func (dsp *dynamicShardsPool) RemovePool(group uint64) error {
+	_, err := dsp.execute(&bhmetapb.ShardsPoolCmd{
+		Type: bhmetapb.ShardsPoolCmdType_RemovePool,
+		Remove: &bhmetapb.ShardsPoolRemoveCmd{
+			Group: group,
+			Key:   uuid.NewV4().Bytes(),
+		},
+	})
+	return err
+}
+
+// execute execute the cmd by the shards pool job, the cmd will be retried with the same
+// idempotency key if failed.
+func (dsp *dynamicShardsPool) execute(cmd *bhmetapb.ShardsPoolCmd) ([]byte, error) {
+	data := protoc.MustMarshal(cmd)
+	for retry := 0; ; retry++ {
+		v, err := dsp.pd.ExecuteJob(metapb.Job{Type: metapb.JobType_CreateResourcePool}, data)
+		if err == nil || retry >= shardsPoolExecuteRetries {
+			return v, err
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
 func (dsp *dynamicShardsPool) Start(job metapb.Job, store storage.JobStorage, aware config.ResourcesAware) {
 	dsp.mu.Lock()
 	defer dsp.mu.Unlock()
@@ -165,6 +260,11 @@ func (dsp *dynamicShardsPool) Stop(job metapb.Job, store storage.JobStorage, awa
 	}
 }
 
+// Exclusive only one shards pool job is running, the pools are created by the first job
+func (dsp *dynamicShardsPool) Exclusive() bool {
+	return true
+}
+
 func (dsp *dynamicShardsPool) Remove(job metapb.Job, store storage.JobStorage, aware config.ResourcesAware) {
 	dsp.Stop(job, store, aware)
 }
@@ -187,6 +287,12 @@ func (dsp *dynamicShardsPool) Execute(data []byte, store storage.JobStorage, awa
 	switch cmd.Type {
 	case bhmetapb.ShardsPoolCmdType_AllocShard:
 		return dsp.doAllocLocked(cmd.Alloc, store, aware)
+	case bhmetapb.ShardsPoolCmdType_ReleaseShard:
+		return dsp.doReleaseLocked(cmd.Release, store, aware)
+	case bhmetapb.ShardsPoolCmdType_UpdatePool:
+		return nil, dsp.doUpdatePoolLocked(cmd.Update, store)
+	case bhmetapb.ShardsPoolCmdType_RemovePool:
+		return nil, dsp.doRemovePoolLocked(cmd.Remove, store)
 	default:
 		return nil, fmt.Errorf("invalid execute cmd %d", cmd.Type)
 	}
@@ -194,10 +300,13 @@ func (dsp *dynamicShardsPool) Execute(data []byte, store storage.JobStorage, awa
 
 func (dsp *dynamicShardsPool) doAllocLocked(cmd *bhmetapb.ShardsPoolAllocCmd, store storage.JobStorage, aware config.ResourcesAware) ([]byte, error) {
 	group := cmd.Group
-	p := dsp.mu.pools.Pools[group]
+	p, err := dsp.getPoolLocked(group)
+	if err != nil {
+		return nil, err
+	}
 
 	// no idle shard left, trigger create, and return nil, client need to retry later
-	if p.Seq-p.AllocatedOffset == 0 {
+	if dsp.idleLocked(p) == 0 {
 		dsp.triggerCreateLocked()
 		return nil, nil
 	}
@@ -213,27 +322,35 @@ func (dsp *dynamicShardsPool) doAllocLocked(cmd *bhmetapb.ShardsPoolAllocCmd, st
 	}
 
 	old := dsp.cloneDataLocked()
-	id := uint64(0)
-	p.AllocatedOffset++
-	unique := dsp.unique(group, p.AllocatedOffset)
-	fn := func(res metadata.Resource) {
-		shard := res.(*resourceAdapter).meta
-		if shard.Unique == unique {
-			id = shard.ID
+	var allocated *bhmetapb.AllocatedShard
+	// the recycled shards are allocated first
+	if len(p.RecycledShards) > 0 {
+		allocated = p.RecycledShards[0]
+		allocated.Purpose = cmd.Purpose
+		p.RecycledShards = p.RecycledShards[1:]
+	} else {
+		id := uint64(0)
+		p.AllocatedOffset++
+		unique := dsp.unique(group, p.AllocatedOffset)
+		fn := func(res metadata.Resource) {
+			shard := res.(*resourceAdapter).meta
+			if shard.Unique == unique {
+				id = shard.ID
+			}
+		}
+		aware.ForeachWaittingCreateResources(fn)
+		if id == 0 {
+			aware.ForeachResources(group, fn)
+		}
+		if id == 0 {
+			logger.Fatalf("BUG: missing alloced shard")
 		}
-	}
-	aware.ForeachWaittingCreateResources(fn)
-	if id == 0 {
-		aware.ForeachResources(group, fn)
-	}
-	if id == 0 {
-		logger.Fatalf("BUG: missing alloced shard")
-	}
 
-	allocated := &bhmetapb.AllocatedShard{
-		ShardID:     id,
-		AllocatedAt: p.AllocatedOffset,
-		Purpose:     cmd.Purpose,
+		allocated = &bhmetapb.AllocatedShard{
+			ShardID:     id,
+			AllocatedAt: p.AllocatedOffset,
+			Purpose:     cmd.Purpose,
+		}
 	}
 	p.AllocatedShards = append(p.AllocatedShards, allocated)
 	dsp.mu.pools.Pools[group] = p
@@ -249,6 +366,118 @@ func (dsp *dynamicShardsPool) doAllocLocked(cmd *bhmetapb.ShardsPoolAllocCmd, st
 	return protoc.MustMarshal(allocated), nil
 }
 
+func (dsp *dynamicShardsPool) doReleaseLocked(cmd *bhmetapb.ShardsPoolReleaseCmd, store storage.JobStorage, aware config.ResourcesAware) ([]byte, error) {
+	if dsp.isProcessedLocked(cmd.Key) {
+		return nil, nil
+	}
+
+	p, err := dsp.getPoolLocked(cmd.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	if idx := indexOfAllocatedShard(p.RecycledShards, cmd.ShardID); idx >= 0 {
+		return nil, nil
+	}
+
+	old := dsp.cloneDataLocked()
+	if idx := indexOfAllocatedShard(p.ReleasingShards, cmd.ShardID); idx >= 0 {
+		releasing := p.ReleasingShards[idx]
+		// the data is clearing, the client need to clear the data and release again
+		if !cmd.Cleared {
+			return protoc.MustMarshal(releasing), nil
+		}
+
+		p.ReleasingShards = append(p.ReleasingShards[:idx], p.ReleasingShards[idx+1:]...)
+		p.RecycledShards = append(p.RecycledShards, releasing)
+		dsp.addProcessedKeyLocked(cmd.Key)
+		if err := dsp.saveLocked(store); err != nil {
+			dsp.mu.pools = old
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	if cmd.Cleared {
+		return nil, fmt.Errorf("shard %d is not releasing", cmd.ShardID)
+	}
+
+	seq := uint64(0)
+	aware.ForeachResources(cmd.Group, func(res metadata.Resource) {
+		shard := res.(*resourceAdapter).meta
+		if shard.ID == cmd.ShardID {
+			seq = dsp.parseUnique(cmd.Group, shard.Unique)
+		}
+	})
+	if seq == 0 || seq > p.AllocatedOffset {
+		return nil, fmt.Errorf("shard %d is not allocated from the pool %d", cmd.ShardID, cmd.Group)
+	}
+
+	releasing := &bhmetapb.AllocatedShard{ShardID: cmd.ShardID, AllocatedAt: seq}
+	if idx := indexOfAllocatedShard(p.AllocatedShards, cmd.ShardID); idx >= 0 {
+		p.AllocatedShards = append(p.AllocatedShards[:idx], p.AllocatedShards[idx+1:]...)
+	}
+	p.ReleasingShards = append(p.ReleasingShards, releasing)
+	if err := dsp.saveLocked(store); err != nil {
+		dsp.mu.pools = old
+		return nil, err
+	}
+	return protoc.MustMarshal(releasing), nil
+}
+
+func (dsp *dynamicShardsPool) doUpdatePoolLocked(cmd *bhmetapb.ShardsPoolUpdateCmd, store storage.JobStorage) error {
+	if dsp.isProcessedLocked(cmd.Key) {
+		return nil
+	}
+
+	old := dsp.cloneDataLocked()
+	if dsp.mu.pools.Pools == nil {
+		dsp.mu.pools.Pools = make(map[uint64]*bhmetapb.ShardPool)
+	}
+	if p, ok := dsp.mu.pools.Pools[cmd.Group]; ok {
+		if !bytes.Equal(p.RangePrefix, cmd.RangePrefix) {
+			return fmt.Errorf("the range prefix of the pool %d can not be changed", cmd.Group)
+		}
+
+		p.Capacity = cmd.Capacity
+		p.Removed = false
+	} else {
+		dsp.mu.pools.Pools[cmd.Group] = &bhmetapb.ShardPool{
+			Capacity:    cmd.Capacity,
+			RangePrefix: cmd.RangePrefix,
+		}
+	}
+
+	dsp.addProcessedKeyLocked(cmd.Key)
+	if err := dsp.saveLocked(store); err != nil {
+		dsp.mu.pools = old
+		return err
+	}
+
+	dsp.triggerCreateLocked()
+	return nil
+}
+
+func (dsp *dynamicShardsPool) doRemovePoolLocked(cmd *bhmetapb.ShardsPoolRemoveCmd, store storage.JobStorage) error {
+	if dsp.isProcessedLocked(cmd.Key) {
+		return nil
+	}
+
+	p, ok := dsp.mu.pools.Pools[cmd.Group]
+	if !ok || p.Removed {
+		return nil
+	}
+
+	old := dsp.cloneDataLocked()
+	p.Removed = true
+	dsp.addProcessedKeyLocked(cmd.Key)
+	if err := dsp.saveLocked(store); err != nil {
+		dsp.mu.pools = old
+		return err
+	}
+	return nil
+}
+
 func (dsp *dynamicShardsPool) startLocked(ctx context.Context, c chan struct{}, store storage.JobStorage, aware config.ResourcesAware) {
 	dsp.triggerCreateLocked()
 	go func(ctx context.Context, c chan struct{}) {
@@ -308,8 +537,12 @@ func (dsp *dynamicShardsPool) maybeCreate(store storage.JobStorage) {
 	old := dsp.cloneDataLocked()
 	var creates []metadata.Resource
 	for g, p := range dsp.mu.pools.Pools {
+		if p.Removed {
+			continue
+		}
+
 		if p.Seq == 0 ||
-			(int(p.Seq-p.AllocatedOffset) < int(p.Capacity) && len(creates) < 8) {
+			(int(dsp.idleLocked(p)) < int(p.Capacity) && len(creates) < 8) {
 			p.Seq++
 			creates = append(creates, NewResourceAdapterWithShard(bhmetapb.Shard{Group: g,
 				Start:  addPrefix(p.RangePrefix, p.Seq),
@@ -385,6 +618,43 @@ func (dsp *dynamicShardsPool) gcAllocating(store storage.JobStorage, aware confi
 	}
 }
 
+func (dsp *dynamicShardsPool) getPoolLocked(group uint64) (*bhmetapb.ShardPool, error) {
+	p, ok := dsp.mu.pools.Pools[group]
+	if !ok || p.Removed {
+		return nil, fmt.Errorf("shards pool %d not found", group)
+	}
+	return p, nil
+}
+
+// idleLocked returns the number of the idle shards, includes the recycled shards
+func (dsp *dynamicShardsPool) idleLocked(p *bhmetapb.ShardPool) uint64 {
+	return p.Seq - p.AllocatedOffset + uint64(len(p.RecycledShards))
+}
+
+func (dsp *dynamicShardsPool) isProcessedLocked(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+
+	for _, v := range dsp.mu.pools.ProcessedKeys {
+		if bytes.Equal(v, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (dsp *dynamicShardsPool) addProcessedKeyLocked(key []byte) {
+	if len(key) == 0 {
+		return
+	}
+
+	dsp.mu.pools.ProcessedKeys = append(dsp.mu.pools.ProcessedKeys, key)
+	if n := len(dsp.mu.pools.ProcessedKeys); n > maxProcessedKeys {
+		dsp.mu.pools.ProcessedKeys = dsp.mu.pools.ProcessedKeys[n-maxProcessedKeys:]
+	}
+}
+
 func (dsp *dynamicShardsPool) saveLocked(store storage.JobStorage) error {
 	err := store.PutJobData(dsp.job, protoc.MustMarshal(&dsp.mu.pools))
 	if err != nil {
@@ -403,6 +673,47 @@ func (dsp *dynamicShardsPool) unique(g, seq uint64) string {
 	return fmt.Sprintf("%d-%d-%d", dsp.job.Type, g, seq)
 }
 
+// parseUnique returns the seq of the shard created by the pool, 0 means the shard is not
+// created by the pool
+func (dsp *dynamicShardsPool) parseUnique(g uint64, unique string) uint64 {
+	var t metapb.JobType
+	var group, seq uint64
+	if n, err := fmt.Sscanf(unique, "%d-%d-%d", &t, &group, &seq); err != nil || n != 3 ||
+		t != dsp.job.Type || group != g {
+		return 0
+	}
+	return seq
+}
+
+func indexOfAllocatedShard(shards []*bhmetapb.AllocatedShard, id uint64) int {
+	for idx, shard := range shards {
+		if shard.ShardID == id {
+			return idx
+		}
+	}
+	return -1
+}
+
+// clearShardData clear the data of the shard by a raft write command on the leader of
+// the shard
+func (s *store) clearShardData(group, shardID uint64) error {
+	_, err := s.execOnShardLeader(group, shardID, raftcmdpb.CMDType_Write, ClearShardDataCMD, nil)
+	return err
+}
+
+// execClearShardData the write handler of the ClearShardDataCMD
+func (s *store) execClearShardData(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	err := flushWriteBatch(ctx)
+	if err == nil {
+		err = ctx.DataStorage().RemoveShardData(shard, encStartKey(&shard), encEndKey(&shard))
+	}
+	if err != nil {
+		logger.Fatalf("shard %d clear data failed with %+v", shard.ID, err)
+	}
+
+	return 0, 0, pb.AcquireResponse()
+}
+
 func addPrefix(prefix []byte, v uint64) []byte {
 	if len(prefix) == 0 {
 		return format.Uint64ToBytes(v)
@@ -11,6 +11,7 @@ import (
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -72,8 +73,14 @@ func TestShardPool(t *testing.T) {
 	// create 4 shards
 	c.WaitShardByCount(t, 5, time.Second*10)
 
+	jobs, err := c.GetProphet().GetClient().ListJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, metapb.JobType_CreateResourcePool, jobs[0].Type)
+	assert.Equal(t, metapb.JobState_Working, jobs[0].State)
+
 	store := c.GetProphet().GetStorage()
-	v, err := store.GetJobData(metapb.Job{Type: metapb.JobType_CreateResourcePool})
+	v, err := store.GetJobData(jobs[0])
 	assert.NoError(t, err)
 	sp := &bhmetapb.ShardsPool{}
 	protoc.MustUnmarshal(sp, v)
@@ -91,7 +98,7 @@ func TestShardPool(t *testing.T) {
 		}
 	}
 
-	v, err = store.GetJobData(metapb.Job{Type: metapb.JobType_CreateResourcePool})
+	v, err = store.GetJobData(jobs[0])
 	assert.NoError(t, err)
 	sp = &bhmetapb.ShardsPool{}
 	protoc.MustUnmarshal(sp, v)
@@ -99,3 +106,80 @@ func TestShardPool(t *testing.T) {
 	assert.Equal(t, uint64(2), sp.Pools[0].AllocatedOffset)
 	assert.Equal(t, 0, len(sp.Pools[0].AllocatedShards))
 }
+
+func TestShardPoolReleaseAndUpdate(t *testing.T) {
+	c := NewTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(i int, cfg *config.Config) {
+		cfg.Customize.CustomInitShardsFactory = func() []bhmetapb.Shard { return []bhmetapb.Shard{{Start: []byte("a"), End: []byte("b")}} }
+	}))
+	defer c.Stop()
+
+	c.Start()
+
+	p, err := c.stores[0].CreateResourcePool(metapb.ResourcePool{Group: 0, Capacity: 1, RangePrefix: []byte("b")})
+	assert.NoError(t, err)
+	c.WaitShardByCount(t, 2, time.Second*10)
+
+	allocated, err := p.Alloc(0, []byte("propose1"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), allocated.AllocatedAt)
+	c.WaitShardStateChangedTo(t, allocated.ShardID, metapb.ResourceState_Running, 10*time.Second)
+	c.WaitShardByCount(t, 3, time.Second*10)
+	c.WaitLeadersByCount(t, 3, time.Second*10)
+
+	key := EncodeDataKey(0, c.GetShardByID(allocated.ShardID).Start)
+	for _, ds := range c.dataStorages {
+		assert.NoError(t, ds.(storage.KVStorage).Set(key, []byte("value")))
+	}
+
+	assert.NoError(t, p.Release(0, allocated.ShardID))
+	assert.NoError(t, p.Release(0, allocated.ShardID))
+	for _, ds := range c.dataStorages {
+		timeoutC := time.After(time.Second * 10)
+		for {
+			value, err := ds.(storage.KVStorage).Get(key)
+			assert.NoError(t, err)
+			if len(value) == 0 {
+				break
+			}
+
+			select {
+			case <-timeoutC:
+				assert.FailNow(t, "timeout wait shard data cleared")
+			case <-time.After(time.Millisecond * 100):
+			}
+		}
+	}
+
+	// the recycled shard is allocated first
+	recycled, err := p.Alloc(0, []byte("propose2"))
+	assert.NoError(t, err)
+	assert.Equal(t, allocated.ShardID, recycled.ShardID)
+	assert.Equal(t, allocated.AllocatedAt, recycled.AllocatedAt)
+	assert.Equal(t, []byte("propose2"), recycled.Purpose)
+
+	// the init shard is not allocated from the pool
+	assert.Error(t, p.Release(0, c.GetShardByIndex(0).ID))
+
+	assert.NoError(t, p.UpdatePool(metapb.ResourcePool{Group: 0, Capacity: 3, RangePrefix: []byte("b")}))
+	c.WaitShardByCount(t, 5, time.Second*10)
+	assert.Error(t, p.UpdatePool(metapb.ResourcePool{Group: 0, Capacity: 3, RangePrefix: []byte("c")}))
+
+	assert.NoError(t, p.RemovePool(0))
+	assert.NoError(t, p.RemovePool(0))
+	_, err = p.Alloc(0, []byte("propose3"))
+	assert.Error(t, err)
+
+	jobs, err := c.GetProphet().GetClient().ListJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(jobs))
+	v, err := c.GetProphet().GetStorage().GetJobData(jobs[0])
+	assert.NoError(t, err)
+	sp := &bhmetapb.ShardsPool{}
+	protoc.MustUnmarshal(sp, v)
+	assert.True(t, sp.Pools[0].Removed)
+	assert.Equal(t, uint64(4), sp.Pools[0].Seq)
+	assert.Equal(t, uint64(3), sp.Pools[0].Capacity)
+	assert.Empty(t, sp.Pools[0].RecycledShards)
+	assert.Empty(t, sp.Pools[0].ReleasingShards)
+	assert.Equal(t, 3, len(sp.ProcessedKeys))
+}
@@ -16,19 +16,25 @@ package raftstore
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/metapb"
 	"github.com/matrixorigin/matrixcube/components/prophet/pb/rpcpb"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/errorpb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/matrixorigin/matrixcube/transport"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,6 +48,138 @@ func TestClusterStartAndStop(t *testing.T) {
 	c.CheckShardCount(t, 1)
 }
 
+func TestClusterWithTLS(t *testing.T) {
+	security, err := tlsutil.GenerateTestCerts("/tmp/cube-tls")
+	assert.NoError(t, err)
+	security.VerifyClientCert = true
+
+	c := NewTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Security = security
+			if node != 0 {
+				cfg.Prophet.EmbedEtcd.Join = "https://127.0.0.1:40000"
+			}
+			cfg.Prophet.EmbedEtcd.ClientUrls = fmt.Sprintf("https://127.0.0.1:4000%d", node)
+			cfg.Prophet.EmbedEtcd.PeerUrls = fmt.Sprintf("https://127.0.0.1:5000%d", node)
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shard := c.GetShardByIndex(0)
+	leader := c.GetShardLeaderStore(shard.ID)
+	resps, err := sendTestReqs(leader, time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	// the raft messages are replicated to the followers over TLS
+	for idx := range c.stores {
+		req := createTestReadReq("r1", "key1")
+		req.AllowFollower = true
+		resps, err = sendTestReqs(c.stores[idx], time.Second*10, nil, nil, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "value1", string(resps["r1"].Responses[0].Value))
+	}
+}
+
+func TestClusterWithCompression(t *testing.T) {
+	c := NewTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Raft.Compression = "snappy"
+			cfg.Raft.CompressionThreshold = 1
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shard := c.GetShardByIndex(0)
+	leader := c.GetShardLeaderStore(shard.ID)
+	resps, err := sendTestReqs(leader, time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	for idx := range c.stores {
+		req := createTestReadReq("r1", "key1")
+		req.AllowFollower = true
+		resps, err = sendTestReqs(c.stores[idx], time.Second*10, nil, nil, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "value1", string(resps["r1"].Responses[0].Value))
+	}
+}
+
+func TestClusterWithNetworkFaults(t *testing.T) {
+	c := NewTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler,
+		DisableScheduleTestCluster, WithTestClusterMemoryNetwork(1))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	id := c.GetShardByIndex(0).ID
+	waitLeader := func(excluded int) int {
+		timeoutC := time.After(time.Second * 10)
+		for {
+			for i, s := range c.stores {
+				if pr := s.getPR(id, false); i != excluded && pr != nil && pr.isLeader() {
+					return i
+				}
+			}
+
+			select {
+			case <-timeoutC:
+				assert.FailNow(t, "timeout wait leader")
+			case <-time.After(time.Millisecond * 100):
+			}
+		}
+	}
+	old := waitLeader(-1)
+
+	// the isolated leader can not commit, and a new leader is elected by the majority
+	c.Partition([]int{old})
+	leader := waitLeader(old)
+
+	resps, err := sendTestReqs(c.stores[leader], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	// the writes are still committed with the lossy network
+	c.Heal()
+	c.DropMessages(transport.MessageTypeRaft, 0.1)
+	c.DuplicateMessages(transport.MessageTypeRaft, 0.1)
+	c.DelayMessages(transport.MessageTypeRaft, 0.1, time.Millisecond*50)
+	resps, err = sendTestReqs(c.stores[leader], time.Second*10, nil, nil,
+		createTestWriteReq("w2", "key2", "value2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w2"].Responses[0].Value))
+
+	// the old leader catches up after healed
+	c.Heal()
+	timeoutC := time.After(time.Second * 10)
+	for {
+		req := createTestReadReq("r2", "key2")
+		req.AllowFollower = true
+		resps, err = sendTestReqs(c.stores[old], time.Second*10, nil, nil, req)
+		assert.NoError(t, err)
+		if string(resps["r2"].Responses[0].Value) == "value2" {
+			return
+		}
+
+		select {
+		case <-timeoutC:
+			assert.FailNow(t, "timeout wait old leader catch up")
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
+
 func TestAddAndRemoveShard(t *testing.T) {
 	c := NewTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(i int, cfg *config.Config) {
 		cfg.Customize.CustomInitShardsFactory = func() []bhmetapb.Shard { return []bhmetapb.Shard{{Start: []byte("a"), End: []byte("b")}} }
@@ -132,6 +270,336 @@ func TestSplit(t *testing.T) {
 	c.CheckShardRange(t, 2, []byte("key3"), nil)
 }
 
+func TestSplitWithPolicy(t *testing.T) {
+	c := NewSingleTestClusterStore(t)
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	c.set(EncodeDataKey(0, []byte("key1")), []byte("value11"))
+	c.set(EncodeDataKey(0, []byte("key2")), []byte("value22"))
+	c.set(EncodeDataKey(0, []byte("key3")), []byte("value33"))
+	c.set(EncodeDataKey(0, []byte("key4")), []byte("value44"))
+
+	shard := c.GetShardByIndex(0)
+	c.stores[0].doResourceHeartbeatRsp(rpcpb.ResourceHeartbeatRsp{
+		ResourceID:    shard.ID,
+		ResourceEpoch: shard.Epoch,
+		SplitResource: &rpcpb.SplitResource{Policy: metapb.CheckPolicy_SCAN},
+	})
+	c.WaitShardByCount(t, 2, time.Second*10)
+	c.CheckShardRange(t, 0, nil, []byte("key3"))
+	c.CheckShardRange(t, 1, []byte("key3"), nil)
+	c.WaitLeadersByCount(t, 2, time.Second*10)
+
+	// the memory storage has no size estimates, fallback to scan
+	shard = c.GetShardByIndex(1)
+	c.stores[0].doResourceHeartbeatRsp(rpcpb.ResourceHeartbeatRsp{
+		ResourceID:    shard.ID,
+		ResourceEpoch: shard.Epoch,
+		SplitResource: &rpcpb.SplitResource{Policy: metapb.CheckPolicy_APPROXIMATE},
+	})
+	c.WaitShardByCount(t, 3, time.Second*10)
+	c.CheckShardRange(t, 1, []byte("key3"), []byte("key4"))
+	c.CheckShardRange(t, 2, []byte("key4"), nil)
+}
+
+func TestMerge(t *testing.T) {
+	c := NewSingleTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+		cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+		cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		cfg.Replication.ShardMergeCheckDuration.Duration = time.Millisecond * 100
+	}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+
+	c.set(EncodeDataKey(0, []byte("key1")), []byte("value11"))
+	c.set(EncodeDataKey(0, []byte("key2")), []byte("value22"))
+	c.set(EncodeDataKey(0, []byte("key3")), []byte("value33"))
+
+	c.WaitShardByCount(t, 3, time.Second*10)
+	c.WaitLeadersByCount(t, 3, time.Second*10)
+
+	source := c.GetShardByIndex(1)
+	target := c.GetShardByIndex(2)
+	c.stores[0].doResourceHeartbeatRsp(rpcpb.ResourceHeartbeatRsp{
+		ResourceID:    source.ID,
+		ResourceEpoch: source.Epoch,
+		Merge:         &rpcpb.Merge{Target: protoc.MustMarshal(&target)},
+	})
+
+	timeoutC := time.After(time.Second * 10)
+	for c.awares[0].hasShard(source.ID) {
+		select {
+		case <-timeoutC:
+			assert.FailNowf(t, "", "wait shard %d merged timeout", source.ID)
+		default:
+			time.Sleep(time.Millisecond * 100)
+		}
+	}
+	c.CheckShardCount(t, 2)
+	shard := c.GetShardByID(target.ID)
+	assert.Equal(t, []byte("key2"), shard.Start)
+	assert.Empty(t, shard.End)
+	assert.True(t, shard.Epoch.Version > target.Epoch.Version)
+
+	value, err := c.stores[0].DataStorageByGroup(0, target.ID).(storage.KVStorage).Get(EncodeDataKey(0, []byte("key2")))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value22"), value)
+}
+
+func TestMergeRollbackByTimeout(t *testing.T) {
+	c := NewTestClusterStore(t, SetCMDTestClusterHandler, DisableScheduleTestCluster, WithTestClusterMemoryNetwork(1),
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.ShardMergeCheckDuration.Duration = time.Millisecond * 100
+			cfg.Replication.ShardMergeTimeout.Duration = time.Second
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+	assert.NoError(t, c.SplitShard(c.GetShardByIndex(0).ID, []byte("key2")))
+	c.WaitShardByCount(t, 2, time.Second*10)
+	c.WaitLeadersByCount(t, 2, time.Second*10)
+
+	sourceID, _ := c.stores[0].router.SelectShard(0, []byte("key1"))
+	targetID, _ := c.stores[0].router.SelectShard(0, []byte("key3"))
+	assert.NotEqual(t, sourceID, targetID)
+	leader := -1
+	for i, s := range c.stores {
+		if s.getPR(sourceID, true) != nil {
+			leader = i
+		}
+	}
+	assert.True(t, leader >= 0)
+
+	// the isolated source replica never appends the PrepareMerge
+	c.Partition([]int{(leader + 1) % len(c.stores)})
+	source := c.GetShardByID(sourceID)
+	target := c.GetShardByID(targetID)
+	c.stores[leader].doResourceHeartbeatRsp(rpcpb.ResourceHeartbeatRsp{
+		ResourceID:    source.ID,
+		ResourceEpoch: source.Epoch,
+		Merge:         &rpcpb.Merge{Target: protoc.MustMarshal(&target)},
+	})
+
+	waitMergePrepared := func(prepared bool) {
+		timeoutC := time.After(time.Second * 10)
+		for {
+			if _, ok := c.stores[leader].getMergePrepared(sourceID); ok == prepared {
+				return
+			}
+
+			select {
+			case <-timeoutC:
+				assert.FailNowf(t, "", "wait shard %d merge prepared %v timeout", sourceID, prepared)
+			case <-time.After(time.Millisecond * 10):
+			}
+		}
+	}
+	waitMergePrepared(true)
+	waitMergePrepared(false)
+
+	// the source shard accepts the writes again after rollback
+	resps, err := sendTestReqs(c.stores[leader], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+	assert.Equal(t, target.Epoch.Version, c.GetShardByID(targetID).Epoch.Version)
+	c.Heal()
+}
+
+func TestConsistencyCheck(t *testing.T) {
+	c := NewTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+		cfg.Replication.ConsistencyCheckDuration.Duration = time.Millisecond * 100
+	}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shard := c.GetShardByIndex(0)
+	c.set(EncodeDataKey(0, []byte("key1")), []byte("value1"))
+
+	follower := -1
+	for idx := range c.stores {
+		if !c.awares[idx].isLeader(shard.ID) {
+			follower = idx
+			break
+		}
+	}
+	assert.True(t, follower >= 0)
+	c.dataStorages[follower].(storage.KVStorage).Set(EncodeDataKey(0, []byte("key2")), []byte("value2"))
+
+	timeoutC := time.After(time.Second * 10)
+	for {
+		select {
+		case <-timeoutC:
+			assert.FailNowf(t, "", "wait shard %d inconsistent timeout", shard.ID)
+		default:
+		}
+
+		if len(c.stores[follower].getInconsistentShards()) > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	assert.Equal(t, []uint64{shard.ID}, c.stores[follower].getInconsistentShards())
+	for idx := range c.stores {
+		if idx != follower {
+			assert.Empty(t, c.stores[idx].getInconsistentShards())
+		}
+	}
+}
+
+func TestFollowerRead(t *testing.T) {
+	c := NewTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler)
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shard := c.GetShardByIndex(0)
+	leader := c.GetShardLeaderStore(shard.ID)
+	resps, err := sendTestReqs(leader, time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w1"].Responses[0].Value))
+
+	follower := -1
+	for idx := range c.stores {
+		if !c.awares[idx].isLeader(shard.ID) {
+			follower = idx
+			break
+		}
+	}
+	assert.True(t, follower >= 0)
+
+	resps, err = sendTestReqs(c.stores[follower], time.Second*10, nil, nil,
+		createTestReadReq("r1", "key1"))
+	assert.NoError(t, err)
+	assert.NotNil(t, resps["r1"].Header.Error.NotLeader)
+
+	req := createTestReadReq("r2", "key1")
+	req.AllowFollower = true
+	resps, err = sendTestReqs(c.stores[follower], time.Second*10, nil, nil, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", string(resps["r2"].Responses[0].Value))
+}
+
+func TestLeaseRead(t *testing.T) {
+	c := NewTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler,
+		WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Raft.EnableLeaseRead = true
+		}))
+	defer c.Stop()
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	shard := c.GetShardByIndex(0)
+	leader := c.GetShardLeaderStore(shard.ID)
+	resps, err := sendTestReqs(leader, time.Second*10, nil, nil,
+		createTestWriteReq("w1", "key1", "value1"),
+		createTestReadReq("r1", "key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", string(resps["r1"].Responses[0].Value))
+
+	// the lease is renewed by the first read index
+	pr := leader.(*store).getPR(shard.ID, false)
+	assert.True(t, pr.leaderLease.expireAt.After(time.Now()))
+
+	resps, err = sendTestReqs(leader, time.Second*10, nil, nil,
+		createTestWriteReq("w2", "key1", "value2"))
+	assert.NoError(t, err)
+	resps, err = sendTestReqs(leader, time.Second*10, nil, nil,
+		createTestReadReq("r2", "key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "value2", string(resps["r2"].Responses[0].Value))
+}
+
+func TestInterceptor(t *testing.T) {
+	c := NewSingleTestClusterStore(t, SetCMDTestClusterHandler, GetCMDTestClusterHandler)
+	defer c.Stop()
+
+	var lock sync.Mutex
+	stages := make(map[command.Stage]int)
+	keys := make(map[command.Stage][]string)
+	c.EveryStore(func(i int, s Store) {
+		s.Use(func(stage command.Stage, shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) *errorpb.Error {
+			// all the stages have the context to read the storage
+			assert.NotNil(t, ctx.DataStorage())
+			assert.Equal(t, s.Meta().ID, ctx.StoreID())
+
+			lock.Lock()
+			stages[stage]++
+			keys[stage] = append(keys[stage], string(req.Key))
+			lock.Unlock()
+
+			switch stage {
+			case command.ProposalStage:
+				if string(req.Key) == "deny" {
+					return &errorpb.Error{Message: "denied"}
+				}
+			case command.ApplyStage:
+				if string(req.Key) == "rewrite" {
+					req.Cmd = []byte("rewritten")
+				}
+			case command.ReadStage:
+				if string(req.Key) == "secret" {
+					return &errorpb.Error{Message: "denied"}
+				}
+			}
+			return nil
+		})
+	})
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	resps, err := sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w1", "deny", "value1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "denied", resps["w1"].Responses[0].Error.Message)
+	assert.Equal(t, raftcmdpb.CMDType_Invalid, resps["w1"].Responses[0].Type)
+
+	resps, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestWriteReq("w2", "rewrite", "value2"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resps["w2"].Responses[0].Value))
+
+	resps, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestReadReq("r1", "rewrite"))
+	assert.NoError(t, err)
+	assert.Empty(t, resps["r1"].Responses[0].Error.Message)
+	assert.Equal(t, "rewritten", string(resps["r1"].Responses[0].Value))
+
+	resps, err = sendTestReqs(c.stores[0], time.Second*10, nil, nil,
+		createTestReadReq("r2", "secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, "denied", resps["r2"].Responses[0].Error.Message)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 4, stages[command.ProposalStage])
+	assert.Equal(t, 1, stages[command.ApplyStage])
+	assert.Equal(t, 2, stages[command.ReadStage])
+	// all the stages see the user key
+	assert.Equal(t, []string{"deny", "rewrite", "rewrite", "secret"}, keys[command.ProposalStage])
+	assert.Equal(t, []string{"rewrite"}, keys[command.ApplyStage])
+	assert.Equal(t, []string{"rewrite", "secret"}, keys[command.ReadStage])
+}
+
 func TestCustomSplit(t *testing.T) {
 	target := EncodeDataKey(0, []byte("key2"))
 	c := NewSingleTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(i int, cfg *config.Config) {
@@ -176,6 +644,34 @@ func TestCustomSplit(t *testing.T) {
 	c.CheckShardRange(t, 1, []byte("key2"), nil)
 }
 
+func TestSplitKeyFunc(t *testing.T) {
+	c := NewSingleTestClusterStore(t)
+	defer c.Stop()
+
+	c.EveryStore(func(i int, s Store) {
+		s.RegisterSplitKeyFunc(func(key []byte) []byte {
+			if len(key) > 4 {
+				return key[:4]
+			}
+			return key
+		})
+	})
+
+	c.Start()
+	c.WaitShardByCount(t, 1, time.Second*10)
+	c.WaitLeadersByCount(t, 1, time.Second*10)
+
+	assert.NoError(t, c.SplitShard(c.GetShardByIndex(0).ID, []byte("key2abc")))
+	c.WaitShardByCount(t, 2, time.Second*10)
+	c.CheckShardRange(t, 0, nil, []byte("key2"))
+	c.CheckShardRange(t, 1, []byte("key2"), nil)
+
+	// the adjusted key is the start of the shard
+	assert.NoError(t, c.SplitShard(c.GetShardByIndex(1).ID, []byte("key2a")))
+	time.Sleep(time.Second)
+	c.CheckShardCount(t, 2)
+}
+
 func TestSpeedupAddShard(t *testing.T) {
 	c := NewTestClusterStore(t, WithAppendTestClusterAdjustConfigFunc(func(i int, cfg *config.Config) {
 		cfg.Raft.TickInterval = typeutil.NewDuration(time.Second * 2)
@@ -36,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixcube/storage"
 	"github.com/matrixorigin/matrixcube/storage/mem"
 	"github.com/matrixorigin/matrixcube/storage/pebble"
+	"github.com/matrixorigin/matrixcube/transport"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -83,6 +84,8 @@ type testClusterOptions struct {
 	logLevel           string
 	useDisk            bool
 	dataOpts, metaOpts *cpebble.Options
+	memoryNetwork      bool
+	networkSeed        int64
 
 	writeHandlers map[uint64]command.WriteCommandFunc
 	readHandlers  map[uint64]command.ReadCommandFunc
@@ -189,6 +192,15 @@ func WithMetadataStorageOption(metaOpts *cpebble.Options) TestClusterOption {
 	}
 }
 
+// WithTestClusterMemoryNetwork connects the nodes with the in-process memory transports, the
+// network faults are injected by the random source with the seed.
+func WithTestClusterMemoryNetwork(seed int64) TestClusterOption {
+	return func(opts *testClusterOptions) {
+		opts.memoryNetwork = true
+		opts.networkSeed = seed
+	}
+}
+
 // WithAppendTestClusterAdjustConfigFunc adjust config
 func WithAppendTestClusterAdjustConfigFunc(value func(node int, cfg *config.Config)) TestClusterOption {
 	return func(opts *testClusterOptions) {
@@ -393,6 +405,21 @@ func (ts *testShardAware) SnapshotApplied(shard bhmetapb.Shard) {
 	}
 }
 
+func (ts *testShardAware) Merged(target bhmetapb.Shard, source bhmetapb.Shard) {
+	ts.Lock()
+	defer ts.Unlock()
+
+	for idx := range ts.shards {
+		if ts.shards[idx].ID == target.ID {
+			ts.shards[idx] = target
+		}
+	}
+
+	if ts.wrapper != nil {
+		ts.wrapper.Merged(target, source)
+	}
+}
+
 // TestRaftCluster test cluster
 type TestRaftCluster struct {
 	sync.RWMutex
@@ -403,10 +430,16 @@ type TestRaftCluster struct {
 
 	// reset fields
 	opts             *testClusterOptions
+	configs          []*config.Config
+	awareFactories   []func() aware.ShardStateAware
 	stores           []*store
 	awares           []*testShardAware
 	dataStorages     []storage.DataStorage
 	metadataStorages []storage.MetadataStorage
+	// stopped the nodes stopped by StopNode, they are not stopped again when the cluster stopped
+	stopped map[int]bool
+	// network is kept after restart, so the faults are still injected to the restarted nodes
+	network *transport.MemoryNetwork
 }
 
 // NewSingleTestClusterStore create test cluster with 1 node
@@ -423,10 +456,13 @@ func NewTestClusterStore(t *testing.T, opts ...TestClusterOption) *TestRaftClust
 
 func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 	c.opts = newTestClusterOptions()
+	c.configs = nil
+	c.awareFactories = nil
 	c.stores = nil
 	c.awares = nil
 	c.dataStorages = nil
 	c.metadataStorages = nil
+	c.stopped = make(map[int]bool)
 
 	for _, opt := range opts {
 		opt(c.opts)
@@ -445,6 +481,10 @@ func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 		recreateTestTempDir(c.opts.tmpDir)
 	}
 
+	if c.opts.memoryNetwork && c.network == nil {
+		c.network = transport.NewMemoryNetwork(c.opts.networkSeed)
+	}
+
 	for i := 0; i < c.opts.nodes; i++ {
 		cfg := &config.Config{}
 		cfg.DataPath = fmt.Sprintf("%s/node-%d", c.opts.tmpDir, i)
@@ -509,29 +549,51 @@ func (c *TestRaftCluster) reset(opts ...TestClusterOption) {
 			c.dataStorages = append(c.dataStorages, dataStorage)
 		}
 
-		ts := newTestShardAware()
-		cfg.Customize.TestShardStateAware = ts
-
-		var s *store
-		if c.opts.storeFactory != nil {
-			s = c.opts.storeFactory(i, cfg).(*store)
-		} else {
-			s = NewStore(cfg).(*store)
-		}
-
-		for k, h := range c.opts.writeHandlers {
-			s.RegisterWriteFunc(k, h)
-		}
-
-		for k, h := range c.opts.readHandlers {
-			s.RegisterReadFunc(k, h)
+		if c.network != nil && cfg.Customize.CustomTransportFactory == nil {
+			node := i
+			cfg.Customize.CustomTransportFactory = func() transport.Transport {
+				// the transport is created when the store starts, use the current store of the node
+				s := c.stores[node]
+				return c.network.NewTransport(s.Meta().ID, s.snapshotManager, s.handle,
+					transport.WithErrorHandler(s.onSendRaftMessageFailed))
+			}
 		}
 
+		c.configs = append(c.configs, cfg)
+		c.awareFactories = append(c.awareFactories, cfg.Customize.CustomShardStateAwareFactory)
+		s, ts := c.newStore(i)
 		c.stores = append(c.stores, s)
 		c.awares = append(c.awares, ts)
 	}
 }
 
+// newStore creates the store of the node with the node config, the store is recreated with the
+// same config when the node is restarted.
+func (c *TestRaftCluster) newStore(node int) (*store, *testShardAware) {
+	cfg := c.configs[node]
+
+	// the factory is replaced with the previous aware when the config is adjusted
+	ts := newTestShardAware()
+	cfg.Customize.TestShardStateAware = ts
+	cfg.Customize.CustomShardStateAwareFactory = c.awareFactories[node]
+
+	var s *store
+	if c.opts.storeFactory != nil {
+		s = c.opts.storeFactory(node, cfg).(*store)
+	} else {
+		s = NewStore(cfg).(*store)
+	}
+
+	for k, h := range c.opts.writeHandlers {
+		s.RegisterWriteFunc(k, h)
+	}
+
+	for k, h := range c.opts.readHandlers {
+		s.RegisterReadFunc(k, h)
+	}
+	return s, ts
+}
+
 // EveryStore do every store, it can be used to init some store register
 func (c *TestRaftCluster) EveryStore(fn func(i int, store Store)) {
 	for idx, store := range c.stores {
@@ -552,11 +614,13 @@ func (c *TestRaftCluster) GetWatcher(node int) prophet.Watcher {
 // StartNode start node
 func (c *TestRaftCluster) StartNode(node int) {
 	c.stores[node].Start()
+	delete(c.stopped, node)
 }
 
-// StopNode start node
+// StopNode stop node
 func (c *TestRaftCluster) StopNode(node int) {
 	c.stores[node].Stop()
+	c.stopped[node] = true
 }
 
 // Start start the test raft cluster
@@ -600,10 +664,36 @@ func (c *TestRaftCluster) RestartWithFunc(beforeStartFunc func()) {
 	c.Start()
 }
 
+// RestartNode restart the node, the other nodes keep running
+func (c *TestRaftCluster) RestartNode(node int) {
+	c.RestartNodeWithFunc(node, nil)
+}
+
+// RestartNodeWithFunc restart the node, the beforeStartFunc is called after the new store of the
+// node is created.
+func (c *TestRaftCluster) RestartNodeWithFunc(node int, beforeStartFunc func()) {
+	if !c.stopped[node] {
+		c.stores[node].Stop()
+	}
+	delete(c.stopped, node)
+	c.stores[node], c.awares[node] = c.newStore(node)
+	if beforeStartFunc != nil {
+		beforeStartFunc()
+	}
+
+	if c.opts.nodeStartFunc != nil {
+		c.opts.nodeStartFunc(node, c.stores[node])
+	} else {
+		c.stores[node].Start()
+	}
+}
+
 // Stop stop the test cluster
 func (c *TestRaftCluster) Stop() {
-	for _, s := range c.stores {
-		s.Stop()
+	for i, s := range c.stores {
+		if !c.stopped[i] {
+			s.Stop()
+		}
 	}
 
 	for _, s := range c.dataStorages {
@@ -733,3 +823,85 @@ func (c *TestRaftCluster) WaitShardStateChangedTo(t *testing.T, id uint64, to me
 func (c *TestRaftCluster) GetProphet() prophet.Prophet {
 	return c.stores[0].pd
 }
+
+// TransferLeader transfers the leader of the shard to the node, it returns errNotLeader if the
+// shard has no leader or the node has no replica of the shard.
+func (c *TestRaftCluster) TransferLeader(shardID uint64, node int) error {
+	leader := c.getLeaderPR(shardID)
+	target := c.stores[node].getPR(shardID, false)
+	if leader == nil || target == nil {
+		return errNotLeader
+	}
+
+	return leader.onAdmin(&raftcmdpb.AdminRequest{
+		CmdType:        raftcmdpb.AdminCmdType_TransferLeader,
+		TransferLeader: &raftcmdpb.TransferLeaderRequest{Peer: target.peer},
+	})
+}
+
+// SplitShard splits the shard at the key, it returns errNotLeader if the shard has no leader
+func (c *TestRaftCluster) SplitShard(shardID uint64, key []byte) error {
+	leader := c.getLeaderPR(shardID)
+	if leader == nil {
+		return errNotLeader
+	}
+
+	shard := leader.ps.shard
+	return leader.askSplit(shard.Epoch, [][]byte{EncodeDataKey(shard.Group, key)})
+}
+
+func (c *TestRaftCluster) getLeaderPR(shardID uint64) *peerReplica {
+	for _, s := range c.stores {
+		if pr := s.getPR(shardID, true); pr != nil {
+			return pr
+		}
+	}
+	return nil
+}
+
+// Network returns the memory network of the cluster, it is nil if the cluster is not created with
+// WithTestClusterMemoryNetwork.
+func (c *TestRaftCluster) Network() *transport.MemoryNetwork {
+	return c.network
+}
+
+// Partition partitions the nodes into the groups, the nodes in the different groups can not
+// communicate with each other. The nodes not in any group are in the same group.
+func (c *TestRaftCluster) Partition(groups ...[]int) {
+	var values [][]uint64
+	for _, nodes := range groups {
+		var stores []uint64
+		for _, node := range nodes {
+			stores = append(stores, c.stores[node].Meta().ID)
+		}
+		values = append(values, stores)
+	}
+	c.mustGetNetwork().Partition(values...)
+}
+
+// DropMessages drops the messages of the type with the probability
+func (c *TestRaftCluster) DropMessages(msgType transport.MessageType, rate float64) {
+	c.mustGetNetwork().AddFault(transport.Fault{Type: msgType, DropRate: rate})
+}
+
+// DelayMessages delays the messages of the type with the probability
+func (c *TestRaftCluster) DelayMessages(msgType transport.MessageType, rate float64, delay time.Duration) {
+	c.mustGetNetwork().AddFault(transport.Fault{Type: msgType, DelayRate: rate, Delay: delay})
+}
+
+// DuplicateMessages delivers the messages of the type twice with the probability
+func (c *TestRaftCluster) DuplicateMessages(msgType transport.MessageType, rate float64) {
+	c.mustGetNetwork().AddFault(transport.Fault{Type: msgType, DuplicateRate: rate})
+}
+
+// Heal removes all the partitions and the message faults
+func (c *TestRaftCluster) Heal() {
+	c.mustGetNetwork().Heal()
+}
+
+func (c *TestRaftCluster) mustGetNetwork() *transport.MemoryNetwork {
+	if c.network == nil {
+		assert.FailNow(c.t, "the test cluster is not created with the memory network")
+	}
+	return c.network
+}
@@ -14,6 +14,7 @@
 package server
 
 import (
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/raftstore"
 )
 
@@ -23,4 +24,6 @@ type Cfg struct {
 	Store          raftstore.Store
 	Handler        Handler
 	ExternalServer bool
+	// Security the TLS config of the embed tcp server
+	Security tlsutil.Config
 }
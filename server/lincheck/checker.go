@@ -0,0 +1,258 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"sort"
+	"strings"
+)
+
+// Result the result of the linearizability check
+type Result struct {
+	// Linearizable is true if the history is linearizable
+	Linearizable bool
+	// Violation the minimal sub-history which is not linearizable, removing any operation of it
+	// makes it linearizable.
+	Violation []Operation
+}
+
+func (r Result) String() string {
+	if r.Linearizable {
+		return "linearizable"
+	}
+
+	var b strings.Builder
+	b.WriteString("not linearizable, minimal violation:")
+	for _, op := range r.Violation {
+		b.WriteString("\n\t")
+		b.WriteString(op.String())
+	}
+	return b.String()
+}
+
+// Check checks whether the operations are linearizable with the model. The first sub-history
+// which is not linearizable is minimized and returned.
+func Check(model Model, ops []Operation) Result {
+	partitions := [][]Operation{ops}
+	if model.Partition != nil {
+		partitions = model.Partition(ops)
+	}
+
+	for _, partition := range partitions {
+		if !linearizable(model, partition) {
+			return Result{Violation: minimize(model, partition)}
+		}
+	}
+	return Result{Linearizable: true}
+}
+
+// minimize removes the operations from the history which is not linearizable, until removing any
+// operation makes it linearizable or not valid. The operations are removed in the chunks first to
+// speed up the long histories, and one by one at last until nothing can be removed.
+func minimize(model Model, ops []Operation) []Operation {
+	for chunk := len(ops) / 2; chunk >= 1; chunk /= 2 {
+		for removed := true; removed; {
+			removed = false
+			for start := 0; start < len(ops); {
+				end := start + chunk
+				if end > len(ops) {
+					end = len(ops)
+				}
+
+				remain := make([]Operation, 0, len(ops)-(end-start))
+				remain = append(remain, ops[:start]...)
+				remain = append(remain, ops[end:]...)
+				if len(remain) > 0 && model.valid(remain) && !linearizable(model, remain) {
+					ops = remain
+					removed = true
+					continue
+				}
+				start = end
+			}
+
+			// the larger chunks are only tried once
+			if chunk > 1 {
+				break
+			}
+		}
+	}
+	return ops
+}
+
+// event the invocation or the response of the operation in the history
+type event struct {
+	op         int
+	call       bool
+	match      *event
+	prev, next *event
+}
+
+// linearizable checks the history with the algorithm of Wing & Gong with the state cache by
+// Lowe. It searches for an order of the operations that is consistent with the model and the
+// real time order, the search backtracks when an operation returns before it is linearized.
+func linearizable(model Model, ops []Operation) bool {
+	head := buildEvents(ops)
+	type frame struct {
+		e     *event
+		state interface{}
+	}
+
+	type cached struct {
+		linearized bitset
+		state      interface{}
+	}
+	cache := make(map[uint64][]cached)
+	seen := func(linearized bitset, state interface{}) bool {
+		for _, c := range cache[linearized.hash()] {
+			if c.linearized.equal(linearized) && model.equal(c.state, state) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var stack []frame
+	state := model.Init()
+	linearized := newBitset(len(ops))
+	e := head.next
+	for head.next != nil {
+		if !e.call {
+			// the operation returns before linearized
+			if len(stack) == 0 {
+				return false
+			}
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			state = top.state
+			linearized.clear(top.e.op)
+			unlift(top.e)
+			e = top.e.next
+			continue
+		}
+
+		op := ops[e.op]
+		ok, next := model.Step(state, op.Input, op.Output)
+		if ok {
+			linearized.set(e.op)
+			if !seen(linearized, next) {
+				h := linearized.hash()
+				cache[h] = append(cache[h], cached{linearized: linearized.clone(), state: next})
+				stack = append(stack, frame{e: e, state: state})
+				state = next
+				lift(e)
+				e = head.next
+				continue
+			}
+			linearized.clear(e.op)
+		}
+		e = e.next
+	}
+	return true
+}
+
+// buildEvents returns the head of the event list sorted by time, the invocations are before the
+// responses at the same time, so the operations are treated as concurrent.
+func buildEvents(ops []Operation) *event {
+	type timed struct {
+		e    *event
+		time int64
+	}
+
+	var events []timed
+	for idx, op := range ops {
+		call := &event{op: idx, call: true}
+		ret := &event{op: idx}
+		call.match = ret
+		events = append(events, timed{e: call, time: op.Call}, timed{e: ret, time: op.Return})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].time != events[j].time {
+			return events[i].time < events[j].time
+		}
+		return events[i].e.call && !events[j].e.call
+	})
+
+	head := &event{}
+	prev := head
+	for _, v := range events {
+		v.e.prev = prev
+		prev.next = v.e
+		prev = v.e
+	}
+	return head
+}
+
+// lift removes the invocation and the response of the operation from the list
+func lift(e *event) {
+	e.prev.next = e.next
+	if e.next != nil {
+		e.next.prev = e.prev
+	}
+
+	m := e.match
+	m.prev.next = m.next
+	if m.next != nil {
+		m.next.prev = m.prev
+	}
+}
+
+// unlift restores the operation removed by lift
+func unlift(e *event) {
+	m := e.match
+	m.prev.next = m
+	if m.next != nil {
+		m.next.prev = m
+	}
+
+	e.prev.next = e
+	if e.next != nil {
+		e.next.prev = e
+	}
+}
+
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b bitset) clear(i int) {
+	b[i/64] &^= 1 << uint(i%64)
+}
+
+func (b bitset) clone() bitset {
+	return append(bitset(nil), b...)
+}
+
+func (b bitset) equal(other bitset) bool {
+	for i := range b {
+		if b[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (b bitset) hash() uint64 {
+	var h uint64 = 14695981039346656037
+	for _, v := range b {
+		h ^= v
+		h *= 1099511628211
+	}
+	return h
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func write(client int, key, value string, call, ret int64) Operation {
+	return Operation{Client: client, Input: KVInput{Write: true, Key: key, Value: value}, Call: call, Return: ret}
+}
+
+func read(client int, key, value string, call, ret int64) Operation {
+	return Operation{Client: client, Input: KVInput{Key: key}, Output: value, Call: call, Return: ret}
+}
+
+func TestCheckLinearizable(t *testing.T) {
+	ops := []Operation{
+		write(0, "k", "1", 0, 10),
+		// concurrent with the write, both values are allowed
+		read(1, "k", "", 1, 5),
+		read(2, "k", "1", 2, 6),
+		write(0, "k", "2", 11, 20),
+		read(1, "k", "2", 21, 22),
+	}
+	assert.True(t, Check(RegisterModel(), ops).Linearizable)
+}
+
+func TestCheckStaleRead(t *testing.T) {
+	ops := []Operation{
+		write(0, "k", "1", 0, 10),
+		read(1, "k", "1", 1, 5),
+		read(2, "k", "1", 12, 13),
+		write(0, "k", "2", 11, 20),
+		read(1, "k", "2", 21, 22),
+		// stale read after the newer value is read
+		read(2, "k", "1", 23, 24),
+		write(0, "k", "3", 30, 40),
+	}
+	r := Check(RegisterModel(), ops)
+	assert.False(t, r.Linearizable)
+	assert.Equal(t, []Operation{ops[0], ops[3], ops[5]}, r.Violation)
+	assert.Contains(t, r.String(), "not linearizable")
+}
+
+func TestCheckUnknownWrite(t *testing.T) {
+	ops := []Operation{
+		write(0, "k", "1", 0, math.MaxInt64),
+		read(1, "k", "", 1, 2),
+		read(1, "k", "1", 100, 101),
+		read(2, "k", "1", 102, 103),
+	}
+	assert.True(t, Check(RegisterModel(), ops).Linearizable)
+
+	// the unknown write can not be reverted after it is read
+	ops = append(ops, read(1, "k", "", 104, 105))
+	assert.False(t, Check(RegisterModel(), ops).Linearizable)
+}
+
+func TestCheckKVModel(t *testing.T) {
+	ops := []Operation{
+		write(0, "k1", "1", 0, 10),
+		write(1, "k2", "2", 0, 10),
+		read(2, "k1", "1", 11, 12),
+		read(2, "k2", "2", 13, 14),
+	}
+	// the keys are independent registers
+	assert.False(t, Check(RegisterModel(), ops).Linearizable)
+	assert.True(t, Check(KVModel(), ops).Linearizable)
+
+	ops = append(ops, read(3, "k2", "", 15, 16))
+	r := Check(KVModel(), ops)
+	assert.False(t, r.Linearizable)
+	assert.Equal(t, []Operation{ops[1], ops[4]}, r.Violation)
+}
+
+func TestHistory(t *testing.T) {
+	h := NewHistory()
+	w := h.Invoke(0, KVInput{Write: true, Key: "k", Value: "1"})
+	r1 := h.Invoke(1, KVInput{Key: "k"})
+	r2 := h.Invoke(2, KVInput{Key: "k"})
+	h.Complete(r1, "1")
+	h.Discard(r2)
+
+	ops := h.Operations()
+	assert.Equal(t, 2, len(ops))
+	assert.True(t, ops[w].Unknown())
+	assert.False(t, ops[r1].Unknown())
+	assert.Equal(t, "1", ops[r1].Output)
+}
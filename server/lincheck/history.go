@@ -0,0 +1,111 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Operation an operation of the history with the invocation and the response time
+type Operation struct {
+	// Client the client which invoked the operation
+	Client int
+	// Input the input of the operation, e.g. KVInput
+	Input interface{}
+	// Output the output of the operation, it is nil if the operation is unknown
+	Output interface{}
+	// Call the invocation time of the operation in nanoseconds since the history started
+	Call int64
+	// Return the response time of the operation in nanoseconds since the history started, it is
+	// math.MaxInt64 if the operation is unknown, e.g. the write is timeout.
+	Return int64
+}
+
+// Unknown returns true if the result of the operation is unknown, the operation may take effect
+// at any time after the invocation, or never.
+func (op Operation) Unknown() bool {
+	return op.Return == math.MaxInt64
+}
+
+func (op Operation) String() string {
+	ret := "?"
+	if !op.Unknown() {
+		ret = fmt.Sprintf("%d", op.Return)
+	}
+	return fmt.Sprintf("client %d [%d, %s] %v -> %v", op.Client, op.Call, ret, op.Input, op.Output)
+}
+
+// History records the operations of the concurrent clients
+type History struct {
+	sync.Mutex
+	start     time.Time
+	ops       []Operation
+	discarded map[int]struct{}
+}
+
+// NewHistory returns an empty history
+func NewHistory() *History {
+	return &History{
+		start:     time.Now(),
+		discarded: make(map[int]struct{}),
+	}
+}
+
+// Invoke records the invocation of the operation, and returns the id of the operation
+func (h *History) Invoke(client int, input interface{}) int {
+	h.Lock()
+	defer h.Unlock()
+
+	h.ops = append(h.ops, Operation{
+		Client: client,
+		Input:  input,
+		Call:   int64(time.Since(h.start)),
+		Return: math.MaxInt64,
+	})
+	return len(h.ops) - 1
+}
+
+// Complete records the response of the operation
+func (h *History) Complete(id int, output interface{}) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.ops[id].Output = output
+	h.ops[id].Return = int64(time.Since(h.start))
+}
+
+// Discard removes the operation which never takes effect, e.g. a failed read
+func (h *History) Discard(id int) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.discarded[id] = struct{}{}
+}
+
+// Operations returns the recorded operations, the operations without responses are unknown
+func (h *History) Operations() []Operation {
+	h.Lock()
+	defer h.Unlock()
+
+	ops := make([]Operation, 0, len(h.ops))
+	for id, op := range h.ops {
+		if _, ok := h.discarded[id]; !ok {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"github.com/fagongzi/goetty/codec"
+	"github.com/matrixorigin/matrixcube/command"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/matrixorigin/matrixcube/server"
+	"github.com/matrixorigin/matrixcube/storage"
+)
+
+const (
+	writeCMD uint64 = 1
+	readCMD  uint64 = 2
+)
+
+// NewKVApplication returns the application which executes the KVInput commands, it can be used
+// as the application factory of the server.TestApplicationCluster.
+func NewKVApplication(i int, store raftstore.Store) *server.Application {
+	h := &kvHandler{store: store}
+	h.AddWriteFunc(writeCMD, h.write)
+	h.AddReadFunc(readCMD, h.read)
+	return server.NewApplication(server.Cfg{
+		Store:          store,
+		Handler:        h,
+		ExternalServer: true,
+	})
+}
+
+type kvHandler struct {
+	store raftstore.Store
+}
+
+func (h *kvHandler) BuildRequest(req *raftcmdpb.Request, msg interface{}) error {
+	in := msg.(KVInput)
+	req.Key = []byte(in.Key)
+	if in.Write {
+		req.CustemType = writeCMD
+		req.Type = raftcmdpb.CMDType_Write
+		req.Cmd = []byte(in.Value)
+		return nil
+	}
+
+	req.CustemType = readCMD
+	req.Type = raftcmdpb.CMDType_Read
+	return nil
+}
+
+func (h *kvHandler) Codec() (codec.Encoder, codec.Decoder) {
+	return nil, nil
+}
+
+func (h *kvHandler) AddReadFunc(cmdType uint64, cb command.ReadCommandFunc) {
+	h.store.RegisterReadFunc(cmdType, cb)
+}
+
+func (h *kvHandler) AddWriteFunc(cmdType uint64, cb command.WriteCommandFunc) {
+	h.store.RegisterWriteFunc(cmdType, cb)
+}
+
+func (h *kvHandler) write(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	resp := pb.AcquireResponse()
+	err := ctx.WriteBatch().Set(req.Key, req.Cmd)
+	if err != nil {
+		resp.Value = []byte(err.Error())
+		return 0, 0, resp
+	}
+
+	writtenBytes := uint64(len(req.Key) + len(req.Cmd))
+	resp.Value = []byte("OK")
+	return writtenBytes, int64(writtenBytes), resp
+}
+
+func (h *kvHandler) read(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (*raftcmdpb.Response, uint64) {
+	resp := pb.AcquireResponse()
+	value, err := ctx.DataStorage().(storage.KVStorage).Get(req.Key)
+	if err != nil {
+		resp.Value = []byte(err.Error())
+		return resp, 0
+	}
+
+	resp.Value = value
+	return resp, uint64(len(value))
+}
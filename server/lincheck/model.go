@@ -0,0 +1,122 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Model the sequential specification of the operations
+type Model struct {
+	// Partition splits the operations into the independent sub-histories which are checked
+	// separately, optional.
+	Partition func(ops []Operation) [][]Operation
+	// Init returns the initial state
+	Init func() interface{}
+	// Step applies the operation to the state, returns false if the output is not allowed by
+	// the state. The state must not be modified, a new state is returned.
+	Step func(state interface{}, input, output interface{}) (bool, interface{})
+	// Equal returns true if the states are equal, the states are compared with == if it is nil.
+	Equal func(s1, s2 interface{}) bool
+	// Valid returns false if the sub-history is not self-contained, e.g. a read returns the value
+	// written by a removed operation. The minimized violation is always valid, optional.
+	Valid func(ops []Operation) bool
+}
+
+func (m Model) valid(ops []Operation) bool {
+	return m.Valid == nil || m.Valid(ops)
+}
+
+func (m Model) equal(s1, s2 interface{}) bool {
+	if m.Equal != nil {
+		return m.Equal(s1, s2)
+	}
+	return s1 == s2
+}
+
+// KVInput the input of the register and the KV models
+type KVInput struct {
+	// Write is true for the write operation, otherwise it is a read
+	Write bool
+	Key   string
+	Value string
+}
+
+func (in KVInput) String() string {
+	if in.Write {
+		return fmt.Sprintf("write(%s, %s)", in.Key, in.Value)
+	}
+	return fmt.Sprintf("read(%s)", in.Key)
+}
+
+// RegisterModel the model of a single register, the key of the input is ignored. The output of
+// the read is the string value read, the initial value is empty.
+func RegisterModel() Model {
+	return Model{
+		Init: func() interface{} { return "" },
+		Step: func(state interface{}, input, output interface{}) (bool, interface{}) {
+			in := input.(KVInput)
+			if in.Write {
+				return true, in.Value
+			}
+
+			// the read of the unknown result never fails
+			return output == nil || output.(string) == state.(string), state
+		},
+		Valid: func(ops []Operation) bool {
+			written := map[string]struct{}{"": {}}
+			for _, op := range ops {
+				if in := op.Input.(KVInput); in.Write {
+					written[in.Value] = struct{}{}
+				}
+			}
+
+			for _, op := range ops {
+				if op.Output == nil {
+					continue
+				}
+				if _, ok := written[op.Output.(string)]; !ok {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// KVModel the model of a KV store, each key is an independent register
+func KVModel() Model {
+	m := RegisterModel()
+	m.Partition = func(ops []Operation) [][]Operation {
+		keys := make(map[string][]Operation)
+		for _, op := range ops {
+			key := op.Input.(KVInput).Key
+			keys[key] = append(keys[key], op)
+		}
+
+		var names []string
+		for key := range keys {
+			names = append(names, key)
+		}
+		sort.Strings(names)
+
+		var values [][]Operation
+		for _, key := range names {
+			values = append(values, keys[key])
+		}
+		return values
+	}
+	return m
+}
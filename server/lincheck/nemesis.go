@@ -0,0 +1,121 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"math/rand"
+
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/server"
+)
+
+// Nemesis injects the faults to the cluster while the clients are running
+type Nemesis interface {
+	// Name returns the name of the nemesis
+	Name() string
+	// Invoke injects the fault to the cluster
+	Invoke(c *server.TestApplicationCluster, rnd *rand.Rand)
+	// Recover recovers the cluster from the fault
+	Recover(c *server.TestApplicationCluster)
+}
+
+// LeaderTransferNemesis transfers the leader of a random shard to a random node
+func LeaderTransferNemesis() Nemesis {
+	return leaderTransferNemesis{}
+}
+
+// RestartNemesis restarts a random node
+func RestartNemesis() Nemesis {
+	return restartNemesis{}
+}
+
+// SplitNemesis splits the shard at a random key of the keys
+func SplitNemesis(keys []string) Nemesis {
+	return splitNemesis{keys: keys}
+}
+
+// PartitionNemesis isolates a random node from the others until recovered, the cluster must be
+// created with raftstore.WithTestClusterMemoryNetwork.
+func PartitionNemesis() Nemesis {
+	return partitionNemesis{}
+}
+
+type leaderTransferNemesis struct{}
+
+func (n leaderTransferNemesis) Name() string {
+	return "leader-transfer"
+}
+
+func (n leaderTransferNemesis) Invoke(c *server.TestApplicationCluster, rnd *rand.Rand) {
+	var shards []uint64
+	c.RaftCluster.GetStore(0).GetRouter().ForeachShards(0, func(shard *bhmetapb.Shard) bool {
+		shards = append(shards, shard.ID)
+		return true
+	})
+	if len(shards) == 0 {
+		return
+	}
+
+	c.RaftCluster.TransferLeader(shards[rnd.Intn(len(shards))], rnd.Intn(len(c.Applications)))
+}
+
+func (n leaderTransferNemesis) Recover(c *server.TestApplicationCluster) {}
+
+type restartNemesis struct{}
+
+func (n restartNemesis) Name() string {
+	return "restart"
+}
+
+func (n restartNemesis) Invoke(c *server.TestApplicationCluster, rnd *rand.Rand) {
+	c.RestartNode(rnd.Intn(len(c.Applications)))
+}
+
+func (n restartNemesis) Recover(c *server.TestApplicationCluster) {}
+
+type splitNemesis struct {
+	keys []string
+}
+
+func (n splitNemesis) Name() string {
+	return "split"
+}
+
+func (n splitNemesis) Invoke(c *server.TestApplicationCluster, rnd *rand.Rand) {
+	key := []byte(n.keys[rnd.Intn(len(n.keys))])
+	id, _ := c.RaftCluster.GetStore(0).GetRouter().SelectShard(0, key)
+	if id == 0 {
+		return
+	}
+
+	if err := c.RaftCluster.SplitShard(id, key); err != nil {
+		logger.Infof("split shard %d at %s failed with %+v", id, key, err)
+	}
+}
+
+func (n splitNemesis) Recover(c *server.TestApplicationCluster) {}
+
+type partitionNemesis struct{}
+
+func (n partitionNemesis) Name() string {
+	return "partition"
+}
+
+func (n partitionNemesis) Invoke(c *server.TestApplicationCluster, rnd *rand.Rand) {
+	c.RaftCluster.Partition([]int{rnd.Intn(len(c.Applications))})
+}
+
+func (n partitionNemesis) Recover(c *server.TestApplicationCluster) {
+	c.RaftCluster.Heal()
+}
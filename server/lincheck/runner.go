@@ -0,0 +1,172 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/log"
+	"github.com/matrixorigin/matrixcube/server"
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[lincheck]")
+)
+
+// Options the options of the Run
+type Options struct {
+	// Clients the number of the concurrent clients
+	Clients int
+	// Keys the number of the keys, the keys are k0, k1, ...
+	Keys int
+	// Duration how long the clients run
+	Duration time.Duration
+	// Timeout the timeout of each operation
+	Timeout time.Duration
+	// Nemeses the faults injected to the cluster, one of them is picked randomly every
+	// NemesisInterval.
+	Nemeses []Nemesis
+	// NemesisInterval the interval of the faults, the fault is recovered after half of it
+	NemesisInterval time.Duration
+	// Seed the seed of the random choices of the clients and the nemeses
+	Seed int64
+}
+
+func (opts *Options) adjust() {
+	if opts.Clients == 0 {
+		opts.Clients = 4
+	}
+	if opts.Keys == 0 {
+		opts.Keys = 4
+	}
+	if opts.Duration == 0 {
+		opts.Duration = time.Second * 10
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = time.Second * 2
+	}
+	if opts.NemesisInterval == 0 {
+		opts.NemesisInterval = time.Second * 2
+	}
+}
+
+// keys returns the keys used by the clients
+func (opts Options) keys() []string {
+	var keys []string
+	for i := 0; i < opts.Keys; i++ {
+		keys = append(keys, fmt.Sprintf("k%d", i))
+	}
+	return keys
+}
+
+// Run runs the concurrent clients against the started cluster with the nemeses, and returns the
+// recorded history. The cluster must use the applications created by NewKVApplication.
+func Run(c *server.TestApplicationCluster, opts Options) *History {
+	opts.adjust()
+	keys := opts.keys()
+	h := NewHistory()
+
+	// the nemesis holds the write lock while changing the applications of the cluster
+	var lock sync.RWMutex
+	stopC := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Clients; i++ {
+		wg.Add(1)
+		go func(client int) {
+			defer wg.Done()
+			runClient(c, &lock, h, client, keys, opts, stopC)
+		}(i)
+	}
+
+	if len(opts.Nemeses) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runNemesis(c, &lock, opts, stopC)
+		}()
+	}
+
+	time.Sleep(opts.Duration)
+	close(stopC)
+	wg.Wait()
+	return h
+}
+
+func runClient(c *server.TestApplicationCluster, lock *sync.RWMutex, h *History, client int,
+	keys []string, opts Options, stopC chan struct{}) {
+	rnd := rand.New(rand.NewSource(opts.Seed + int64(client) + 1))
+	seq := 0
+	for {
+		select {
+		case <-stopC:
+			return
+		default:
+		}
+
+		input := KVInput{Key: keys[rnd.Intn(len(keys))]}
+		if rnd.Intn(2) == 0 {
+			seq++
+			input.Write = true
+			input.Value = fmt.Sprintf("%d-%d", client, seq)
+		}
+
+		lock.RLock()
+		app := c.Applications[rnd.Intn(len(c.Applications))]
+		id := h.Invoke(client, input)
+		value, err := app.Exec(input, opts.Timeout)
+		lock.RUnlock()
+
+		switch {
+		case err == nil && input.Write:
+			h.Complete(id, nil)
+		case err == nil:
+			h.Complete(id, string(value))
+		case !input.Write:
+			// the failed read has no effect
+			h.Discard(id)
+		default:
+			// the failed write may take effect later, it is left unknown
+		}
+	}
+}
+
+func runNemesis(c *server.TestApplicationCluster, lock *sync.RWMutex, opts Options, stopC chan struct{}) {
+	rnd := rand.New(rand.NewSource(opts.Seed))
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-time.After(opts.NemesisInterval / 2):
+		}
+
+		n := opts.Nemeses[rnd.Intn(len(opts.Nemeses))]
+		logger.Infof("invoke nemesis %s", n.Name())
+		lock.Lock()
+		n.Invoke(c, rnd)
+		lock.Unlock()
+
+		select {
+		case <-stopC:
+		case <-time.After(opts.NemesisInterval / 2):
+		}
+
+		logger.Infof("recover nemesis %s", n.Name())
+		lock.Lock()
+		n.Recover(c)
+		lock.Unlock()
+	}
+}
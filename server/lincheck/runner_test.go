@@ -0,0 +1,54 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lincheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/matrixorigin/matrixcube/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunWithNemeses(t *testing.T) {
+	c := server.NewTestApplicationCluster(t, NewKVApplication,
+		raftstore.WithTestClusterMemoryNetwork(1))
+	defer c.Stop()
+
+	c.Start()
+	c.RaftCluster.WaitShardByCount(t, 1, time.Second*10)
+	c.RaftCluster.WaitLeadersByCount(t, 1, time.Second*10)
+
+	opts := Options{
+		Clients:         4,
+		Keys:            4,
+		Duration:        time.Second * 10,
+		NemesisInterval: time.Second * 2,
+		Seed:            1,
+	}
+	opts.Nemeses = []Nemesis{
+		LeaderTransferNemesis(),
+		RestartNemesis(),
+		SplitNemesis(opts.keys()),
+		PartitionNemesis(),
+	}
+
+	h := Run(c, opts)
+	ops := h.Operations()
+	assert.NotEmpty(t, ops)
+
+	r := Check(KVModel(), ops)
+	assert.True(t, r.Linearizable, r.String())
+}
@@ -0,0 +1,261 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/util/hack"
+	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/proxy"
+	"github.com/matrixorigin/matrixcube/util"
+)
+
+var (
+	// ErrNotReadCommand the range command is not a read command
+	ErrNotReadCommand = errors.New("range command must be a read command")
+)
+
+// ExecRange exec the read command on all the keys in the range [start, end) of the group,
+// empty end means positive infinity. See AsyncExecRange.
+func (s *Application) ExecRange(cmd interface{}, group uint64, start, end []byte, limit uint64, timeout time.Duration) ([][]byte, []byte, error) {
+	type result struct {
+		values  [][]byte
+		nextKey []byte
+		err     error
+	}
+
+	completeC := make(chan result, 1)
+	closed := uint32(0)
+	cb := func(arg interface{}, values [][]byte, nextKey []byte, err error) {
+		if atomic.CompareAndSwapUint32(&closed, 0, 1) {
+			completeC <- result{values: values, nextKey: nextKey, err: err}
+			close(completeC)
+		}
+	}
+
+	s.AsyncExecRange(cmd, group, start, end, limit, cb, timeout, nil)
+	value := <-completeC
+	return value.values, value.nextKey, value.err
+}
+
+// AsyncExecRange async exec the read command on all the keys in the range [start, end) of the
+// group, empty end means positive infinity. The range is split by the shards, and the sub
+// requests are executed shard by shard in key order, each sub request has a KeyRange which is
+// the intersection of the range and the shard. The read handler of the command must only handle
+// the keys in the KeyRange, returns at most KeyRange.Limit items, sets the Response.Count to the
+// count of the returned items and sets the Response.NextKey if it stopped before the end of the
+// KeyRange. If the shard is split after the sub request routed, the sub request will be routed
+// again with the new shards.
+//
+// The values passed to the cb are the response values of the shards in key order. At most limit
+// items are returned, 0 means no limit, and if the range is not completed by the limit, the
+// nextKey is the start key to continue the range.
+func (s *Application) AsyncExecRange(cmd interface{}, group uint64, start, end []byte, limit uint64,
+	cb func(interface{}, [][]byte, []byte, error), timeout time.Duration, arg interface{}) {
+	c := &rangeCtx{
+		app:      s,
+		cmd:      cmd,
+		group:    group,
+		start:    start,
+		end:      end,
+		limit:    limit,
+		hasLimit: limit > 0,
+		timeout:  timeout,
+		stopAt:   time.Now().Add(timeout),
+		arg:      arg,
+		cb:       cb,
+	}
+	c.next()
+}
+
+type rangeCtx struct {
+	sync.Mutex
+
+	app      *Application
+	cmd      interface{}
+	group    uint64
+	start    []byte
+	end      []byte
+	limit    uint64
+	hasLimit bool
+	timeout  time.Duration
+	stopAt   time.Time
+	values   [][]byte
+
+	// the clipped end key of the current sub request
+	current   []byte
+	completed bool
+
+	arg interface{}
+	cb  func(interface{}, [][]byte, []byte, error)
+}
+
+func (c *rangeCtx) next() {
+	req, to := c.nextRequest()
+	if req == nil {
+		return
+	}
+
+	// the response maybe returned in the DispatchTo, so it must be called without lock
+	err := c.app.shardsProxy.DispatchTo(req, req.ToShard, to)
+	if err != nil {
+		c.app.libaryCB.Delete(hack.SliceToString(req.ID))
+		pb.ReleaseRequest(req)
+		c.retryLater()
+	}
+}
+
+func (c *rangeCtx) nextRequest() (*raftcmdpb.Request, string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.completed {
+		return nil, ""
+	}
+
+	if c.timeout > 0 && !time.Now().Before(c.stopAt) {
+		c.doneLocked(nil, proxy.ErrTimeout)
+		return nil, ""
+	}
+
+	var target *bhmetapb.Shard
+	router := c.app.shardsProxy.Router()
+	router.AscendRange(c.group, c.start, c.end, func(shard *bhmetapb.Shard) bool {
+		value := *shard
+		target = &value
+		return false
+	})
+	if target == nil {
+		logger.Debugf("missing shard for range [%+v, %+v) of group %d, retry later",
+			c.start, c.end, c.group)
+		c.retryLater()
+		return nil, ""
+	}
+
+	end := target.End
+	if len(c.end) > 0 && (len(end) == 0 || bytes.Compare(c.end, end) < 0) {
+		end = c.end
+	}
+
+	req := pb.AcquireRequest()
+	req.ID = uuid.NewV4().Bytes()
+	req.Group = c.group
+	if c.timeout > 0 {
+		pb.SetStopAt(req, c.stopAt.UnixNano())
+	}
+	err := c.app.cfg.Handler.BuildRequest(req, c.cmd)
+	if err == nil && req.Type != raftcmdpb.CMDType_Read {
+		err = ErrNotReadCommand
+	}
+	if err != nil {
+		pb.ReleaseRequest(req)
+		c.doneLocked(nil, err)
+		return nil, ""
+	}
+
+	req.ToShard = target.ID
+	req.Key = c.start
+	req.KeyRange = &raftcmdpb.KeyRange{
+		Start: c.start,
+		End:   end,
+		Limit: c.limit,
+	}
+	c.current = end
+
+	c.app.libaryCB.Store(hack.SliceToString(req.ID), c)
+	if c.timeout > 0 {
+		util.DefaultTimeoutWheel().Schedule(time.Until(c.stopAt), c.app.execTimeout, req.ID)
+	}
+	return req, router.LeaderPeerStore(target.ID).ClientAddr
+}
+
+func (c *rangeCtx) onResp(resp *raftcmdpb.Response) {
+	c.Lock()
+	if c.completed {
+		c.Unlock()
+		return
+	}
+
+	c.values = append(c.values, append([]byte(nil), resp.Value...))
+	if c.hasLimit {
+		if resp.Count >= c.limit {
+			c.limit = 0
+		} else {
+			c.limit -= resp.Count
+		}
+	}
+
+	if len(resp.NextKey) > 0 {
+		c.doneLocked(append([]byte(nil), resp.NextKey...), nil)
+		c.Unlock()
+		return
+	}
+
+	if len(c.current) == 0 || (len(c.end) > 0 && bytes.Equal(c.current, c.end)) {
+		c.doneLocked(nil, nil)
+		c.Unlock()
+		return
+	}
+
+	if c.hasLimit && c.limit == 0 {
+		c.doneLocked(c.current, nil)
+		c.Unlock()
+		return
+	}
+
+	c.start = c.current
+	c.Unlock()
+	c.next()
+}
+
+// resp is used for errors and timeout of the sub requests
+func (c *rangeCtx) resp(value []byte, err error, appendOnly bool) {
+	if err == proxy.ErrKeyNotInShard {
+		c.retryLater()
+		return
+	}
+
+	c.Lock()
+	c.doneLocked(nil, err)
+	c.Unlock()
+}
+
+func (c *rangeCtx) retryLater() {
+	util.DefaultTimeoutWheel().Schedule(proxy.RetryInterval, c.doRetry, nil)
+}
+
+func (c *rangeCtx) doRetry(arg interface{}) {
+	c.next()
+}
+
+func (c *rangeCtx) doneLocked(nextKey []byte, err error) {
+	if c.completed {
+		return
+	}
+
+	c.completed = true
+	if err != nil {
+		c.cb(c.arg, nil, nil, err)
+		return
+	}
+	c.cb(c.arg, c.values, nextKey, nil)
+}
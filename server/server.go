@@ -14,8 +14,10 @@
 package server
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,10 +26,12 @@ import (
 	"github.com/fagongzi/log"
 	"github.com/fagongzi/util/hack"
 	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
 	"github.com/matrixorigin/matrixcube/proxy"
+	"github.com/matrixorigin/matrixcube/txn"
 	"github.com/matrixorigin/matrixcube/util"
 )
 
@@ -55,10 +59,11 @@ func NewApplicationWithDispatcher(cfg Cfg, dispatcher func(req *raftcmdpb.Reques
 		cfg:        cfg,
 		dispatcher: dispatcher,
 	}
+	txn.Register(cfg.Store)
 
 	if !cfg.ExternalServer {
 		encoder, decoder := cfg.Handler.Codec()
-		app, err := goetty.NewTCPApplication(cfg.Addr, s.onMessage,
+		app, err := tlsutil.NewTCPApplication(cfg.Security, cfg.Addr, s.onMessage,
 			goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder),
 				goetty.WithEnableAsyncWrite(16),
 				goetty.WithLogger(logger),
@@ -132,6 +137,90 @@ func (s *Application) ExecWithGroup(cmd interface{}, group uint64, timeout time.
 	}
 }
 
+// ExecContext exec the request command with the context, the request is retried until the
+// deadline of the context, and is cancelled when the context is done.
+func (s *Application) ExecContext(ctx context.Context, cmd interface{}) ([]byte, error) {
+	return s.ExecWithGroupContext(ctx, cmd, 0)
+}
+
+// ExecWithGroupContext exec the request command with the context, the request is retried until
+// the deadline of the context, and is cancelled when the context is done.
+func (s *Application) ExecWithGroupContext(ctx context.Context, cmd interface{}, group uint64) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// the request without deadline is retried until cancelled
+	deadline := int64(math.MaxInt64)
+	if d, ok := ctx.Deadline(); ok {
+		deadline = d.UnixNano()
+	}
+
+	completeC := make(chan interface{}, 1)
+	closed := uint32(0)
+	cb := func(cmd interface{}, resp []byte, err error) {
+		if atomic.CompareAndSwapUint32(&closed, 0, 1) {
+			if err != nil {
+				completeC <- err
+			} else {
+				completeC <- resp
+			}
+			close(completeC)
+		}
+	}
+
+	req := pb.AcquireRequest()
+	req.ID = uuid.NewV4().Bytes()
+	req.Group = group
+	pb.SetStopAt(req, deadline)
+
+	err := s.cfg.Handler.BuildRequest(req, cmd)
+	if err != nil {
+		pb.ReleaseRequest(req)
+		return nil, err
+	}
+
+	// the request may be released after dispatched
+	cancelReq := &raftcmdpb.Request{
+		ID:      req.ID,
+		Group:   req.Group,
+		Key:     append([]byte(nil), req.Key...),
+		ToShard: req.ToShard,
+	}
+	pb.SetStopAt(cancelReq, deadline)
+	s.asyncExecRequest(req, cmd, cb, 0, nil)
+
+	var value interface{}
+	select {
+	case value = <-completeC:
+	case <-ctx.Done():
+		if s.cancel(cancelReq) {
+			return nil, ctx.Err()
+		}
+
+		// the response is received before cancelled
+		value = <-completeC
+	}
+
+	switch v := value.(type) {
+	case error:
+		return nil, v
+	default:
+		return value.([]byte), nil
+	}
+}
+
+// cancel removes the callback of the request, stops retrying it and drops it if it's still
+// queued, returns false if the request is already completed.
+func (s *Application) cancel(req *raftcmdpb.Request) bool {
+	if _, ok := s.libaryCB.LoadAndDelete(hack.SliceToString(req.ID)); !ok {
+		return false
+	}
+
+	s.shardsProxy.Cancel(req)
+	return true
+}
+
 // AsyncExec async exec the request command
 func (s *Application) AsyncExec(cmd interface{}, cb func(interface{}, []byte, error), arg interface{}) {
 	s.AsyncExecWithTimeout(cmd, cb, 0, arg)
@@ -147,7 +236,7 @@ func (s *Application) AsyncExecWithGroupAndTimeout(cmd interface{}, group uint64
 	req := pb.AcquireRequest()
 	req.ID = uuid.NewV4().Bytes()
 	req.Group = group
-	req.StopAt = time.Now().Add(timeout).Unix()
+	pb.SetStopAt(req, stopAt(timeout))
 
 	err := s.cfg.Handler.BuildRequest(req, cmd)
 	if err != nil {
@@ -156,6 +245,12 @@ func (s *Application) AsyncExecWithGroupAndTimeout(cmd interface{}, group uint64
 		return
 	}
 
+	s.asyncExecRequest(req, cmd, cb, timeout, arg)
+}
+
+// asyncExecRequest dispatches the built request, the dispatcher is only used for the
+// request built from the application command.
+func (s *Application) asyncExecRequest(req *raftcmdpb.Request, cmd interface{}, cb func(interface{}, []byte, error), timeout time.Duration, arg interface{}) {
 	s.libaryCB.Store(hack.SliceToString(req.ID), ctx{
 		arg: arg,
 		cb:  cb,
@@ -164,7 +259,8 @@ func (s *Application) AsyncExecWithGroupAndTimeout(cmd interface{}, group uint64
 		util.DefaultTimeoutWheel().Schedule(timeout, s.execTimeout, req.ID)
 	}
 
-	if s.dispatcher != nil {
+	var err error
+	if s.dispatcher != nil && cmd != nil {
 		err = s.dispatcher(req, cmd, s.shardsProxy)
 	} else {
 		err = s.shardsProxy.Dispatch(req)
@@ -211,6 +307,14 @@ func (s *Application) execTimeout(arg interface{}) {
 	}
 }
 
+// stopAt returns the deadline of the request with the timeout in unix nanoseconds
+func stopAt(timeout time.Duration) int64 {
+	if timeout <= 0 {
+		return 0
+	}
+	return time.Now().Add(timeout).UnixNano()
+}
+
 func (s *Application) onMessage(conn goetty.IOSession, cmd interface{}, seq uint64) error {
 	req := pb.AcquireRequest()
 	req.ID = uuid.NewV4().Bytes()
@@ -250,6 +354,11 @@ func (s *Application) done(resp *raftcmdpb.Response) {
 		id := hack.SliceToString(resp.ID)
 		if value, ok := s.libaryCB.Load(hack.SliceToString(resp.ID)); ok {
 			s.libaryCB.Delete(id)
+			if c, ok := value.(*rangeCtx); ok {
+				c.onResp(resp)
+				return
+			}
+
 			value.(asyncCtx).resp(resp.Value, nil, resp.ContinueBroadcast)
 
 			if resp.ContinueBroadcast {
@@ -347,7 +456,7 @@ func (s *Application) doBroadcast(ctx *broadcastCtx, max uint64, shards []uint64
 		req := pb.AcquireRequest()
 		req.ID = uuid.NewV4().Bytes()
 		req.Group = ctx.group
-		req.StopAt = time.Now().Add(ctx.timeout).Unix()
+		pb.SetStopAt(req, stopAt(ctx.timeout))
 		req.ToShard = shard
 		req.AllowFollower = !ctx.mustLeader
 
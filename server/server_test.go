@@ -14,11 +14,14 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/fagongzi/log"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
 	"github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixcube/raftstore"
 	"github.com/matrixorigin/matrixcube/storage"
@@ -88,6 +91,102 @@ func TestIssue84(t *testing.T) {
 	}
 }
 
+func TestExecRange(t *testing.T) {
+	c, closer := createDiskDataStorageCluster(t,
+		raftstore.WithTestClusterNodeCount(1),
+		raftstore.WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+			cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		}))
+	defer closer()
+
+	app := c.Applications[0]
+	for _, key := range []string{"key1", "key2", "key3"} {
+		resp, err := app.Exec(&testRequest{
+			Op:    "SET",
+			Key:   key,
+			Value: "value11",
+		}, 10*time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", string(resp))
+	}
+	c.RaftCluster.WaitShardByCount(t, 3, time.Second*10)
+	c.RaftCluster.WaitLeadersByCount(t, 3, time.Second*10)
+
+	scan := &testRequest{Op: "SCAN"}
+	values, next, err := app.ExecRange(scan, 0, nil, nil, 0, 10*time.Second)
+	assert.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Equal(t, "key1,key2,key3", joinValues(values))
+
+	values, next, err = app.ExecRange(scan, 0, []byte("key1"), []byte("key3"), 0, 10*time.Second)
+	assert.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Equal(t, "key1,key2", joinValues(values))
+
+	values, next, err = app.ExecRange(scan, 0, nil, nil, 2, 10*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "key3", string(next))
+	assert.Equal(t, "key1,key2", joinValues(values))
+
+	values, next, err = app.ExecRange(scan, 0, next, nil, 2, 10*time.Second)
+	assert.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Equal(t, "key3", joinValues(values))
+
+	_, _, err = app.ExecRange(&testRequest{Op: "SET", Key: "key1"}, 0, nil, nil, 0, 10*time.Second)
+	assert.Equal(t, ErrNotReadCommand, err)
+}
+
+func TestExecContext(t *testing.T) {
+	c, closer := createDiskDataStorageCluster(t, raftstore.WithTestClusterNodeCount(1))
+	defer closer()
+
+	app := c.Applications[0]
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	resp, err := app.ExecContext(ctx, &testRequest{Op: "SET", Key: "key", Value: "value"})
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", string(resp))
+
+	value, err := app.ExecContext(ctx, &testRequest{Op: "GET", Key: "key"})
+	assert.NoError(t, err)
+	assert.Equal(t, "value", string(value))
+
+	// the group without shards is retried until the context is done
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(time.Millisecond*100, cancel)
+	_, err = app.ExecWithGroupContext(ctx, &testRequest{Op: "GET", Key: "key"}, 100)
+	assert.Equal(t, context.Canceled, err)
+
+	st := time.Now()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*200)
+	defer cancel()
+	_, err = app.ExecWithGroupContext(ctx, &testRequest{Op: "GET", Key: "key"}, 100)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(st) < time.Second)
+
+	_, err = app.ExecContext(ctx, &testRequest{Op: "GET", Key: "key"})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	pending := 0
+	app.libaryCB.Range(func(key, value interface{}) bool {
+		pending++
+		return true
+	})
+	assert.Equal(t, 0, pending)
+}
+
+func joinValues(values [][]byte) string {
+	var keys []string
+	for _, v := range values {
+		if len(v) > 0 {
+			keys = append(keys, string(v))
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
 func createDiskDataStorageCluster(t *testing.T, opts ...raftstore.TestClusterOption) (*TestApplicationCluster, func()) {
 	var storages []storage.DataStorage
 	var metaStorages []storage.MetadataStorage
@@ -116,6 +215,7 @@ func createDiskDataStorageCluster(t *testing.T, opts ...raftstore.TestClusterOpt
 		}
 		store.RegisterWriteFunc(1, h.set)
 		store.RegisterReadFunc(2, h.get)
+		store.RegisterReadFunc(3, h.scan)
 		return NewApplication(Cfg{
 			Addr:    fmt.Sprintf("127.0.0.1:808%d", i),
 			Store:   store,
@@ -51,6 +51,14 @@ func (c *TestApplicationCluster) Stop() {
 	}
 }
 
+// RestartNode restart the application of the node, the other applications keep running
+func (c *TestApplicationCluster) RestartNode(node int) {
+	c.Applications[node].Stop()
+	c.RaftCluster.RestartNodeWithFunc(node, func() {
+		c.Applications[node] = c.applicationFactory(node, c.RaftCluster.GetStore(node))
+	})
+}
+
 type testRequest struct {
 	Op    string `json:"json:op"`
 	Key   string `json:"key"`
@@ -66,7 +74,7 @@ func (h *testHandler) BuildRequest(req *raftcmdpb.Request, msg interface{}) erro
 
 	cmdName := strings.ToUpper(cmd.Op)
 
-	if cmdName != "SET" && cmdName != "GET" {
+	if cmdName != "SET" && cmdName != "GET" && cmdName != "SCAN" {
 		return fmt.Errorf("%s not support", cmd)
 	}
 
@@ -83,7 +91,9 @@ func (h *testHandler) BuildRequest(req *raftcmdpb.Request, msg interface{}) erro
 	case "GET":
 		req.CustemType = 2
 		req.Type = raftcmdpb.CMDType_Read
-
+	case "SCAN":
+		req.CustemType = 3
+		req.Type = raftcmdpb.CMDType_Read
 	}
 	req.Key = []byte(cmd.Key)
 	req.Cmd = data
@@ -143,3 +153,32 @@ func (h *testHandler) get(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx comm
 	resp.Value = value
 	return resp, uint64(len(value))
 }
+
+func (h *testHandler) scan(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (*raftcmdpb.Response, uint64) {
+	resp := pb.AcquireResponse()
+
+	start := raftstore.EncodeDataKey(shard.Group, req.KeyRange.Start)
+	end := raftstore.EncodeDataKey(shard.Group+1, nil)
+	if len(req.KeyRange.End) > 0 {
+		end = raftstore.EncodeDataKey(shard.Group, req.KeyRange.End)
+	}
+
+	var keys []string
+	err := h.store.DataStorageByGroup(0, 0).(storage.KVStorage).Scan(start, end, func(key, value []byte) (bool, error) {
+		if req.KeyRange.Limit > 0 && uint64(len(keys)) == req.KeyRange.Limit {
+			resp.NextKey = raftstore.DecodeDataKey(key)
+			return false, nil
+		}
+
+		keys = append(keys, string(raftstore.DecodeDataKey(key)))
+		return true, nil
+	}, false)
+	if err != nil {
+		resp.Value = []byte(err.Error())
+		return resp, 0
+	}
+
+	resp.Value = []byte(strings.Join(keys, ","))
+	resp.Count = uint64(len(keys))
+	return resp, uint64(len(resp.Value))
+}
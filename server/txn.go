@@ -0,0 +1,377 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/fagongzi/util/uuid"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/proxy"
+	"github.com/matrixorigin/matrixcube/txn"
+)
+
+var (
+	// ErrTxnClosed the transaction is committed or rolled back
+	ErrTxnClosed = errors.New("transaction is closed")
+	// ErrTxnKeyLocked the key is locked by other alive transaction
+	ErrTxnKeyLocked = errors.New("key is locked by other transaction")
+	// ErrTxnWriteConflict the key is written by other transaction after the transaction started
+	ErrTxnWriteConflict = errors.New("write conflict")
+	// ErrTxnAborted the transaction is rolled back by other transaction
+	ErrTxnAborted = errors.New("transaction is aborted")
+)
+
+var (
+	// defaultTxnLockTTL the default ttl of the transaction locks
+	defaultTxnLockTTL = time.Second * 3
+	// txnLockBackoff the interval of waiting for the alive lock
+	txnLockBackoff = time.Millisecond * 50
+)
+
+// Txn a distributed transaction across shards and groups based on the percolator model.
+// The writes are buffered in the Txn until Commit. The commit uses two phase commit, all
+// the keys are prewritten with locks, and the transaction is committed once the lock of
+// the primary key is committed, the other keys are committed asynchronously or resolved by
+// the readers. The locks left by the crashed coordinators are rolled back by the other
+// transactions after the locks expired. Txn is not thread safe.
+type Txn struct {
+	app       *Application
+	startTS   uint64
+	lockTTL   time.Duration
+	mutations map[string]*mutation
+	closed    bool
+}
+
+type mutation struct {
+	group  uint64
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// BeginTxn begin a transaction, the start timestamp is allocated from the prophet tso
+func (s *Application) BeginTxn() (*Txn, error) {
+	startTS, err := s.allocTimestamp()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Txn{
+		app:       s,
+		startTS:   startTS,
+		lockTTL:   defaultTxnLockTTL,
+		mutations: make(map[string]*mutation),
+	}, nil
+}
+
+// allocTimestamp returns a timestamp which is greater than all the timestamps allocated before
+func (s *Application) allocTimestamp() (uint64, error) {
+	return s.cfg.Store.Prophet().GetClient().GetTimestamp(1)
+}
+
+// StartTS returns the start timestamp of the transaction
+func (t *Txn) StartTS() uint64 {
+	return t.startTS
+}
+
+// SetLockTTL set the ttl of the locks, the locks can be rolled back by other transactions
+// after they expired.
+func (t *Txn) SetLockTTL(ttl time.Duration) {
+	t.lockTTL = ttl
+}
+
+// Set set the value of the key in the group
+func (t *Txn) Set(group uint64, key, value []byte) {
+	t.mutations[mutationKey(group, key)] = &mutation{group: group, key: key, value: value}
+}
+
+// Delete delete the key in the group
+func (t *Txn) Delete(group uint64, key []byte) {
+	t.mutations[mutationKey(group, key)] = &mutation{group: group, key: key, delete: true}
+}
+
+// Get returns the value of the key in the group at the start timestamp of the transaction,
+// the locks of the other transactions are resolved if necessary.
+func (t *Txn) Get(group uint64, key []byte, timeout time.Duration) ([]byte, bool, error) {
+	if t.closed {
+		return nil, false, ErrTxnClosed
+	}
+
+	if m, ok := t.mutations[mutationKey(group, key)]; ok {
+		return m.value, !m.delete, nil
+	}
+
+	stopAt := time.Now().Add(timeout)
+	for {
+		rsp, err := t.app.execTxn(group, key, &txnpb.TxnRequest{
+			Op:      txnpb.TxnOp_Get,
+			StartTS: t.startTS,
+		}, time.Until(stopAt))
+		if err != nil {
+			return nil, false, err
+		}
+
+		if rsp.Error.Type != txnpb.TxnErrorType_KeyLocked {
+			return rsp.Value, rsp.Found, nil
+		}
+
+		err = t.app.resolveLock(group, key, rsp.Error.Lock, stopAt)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+}
+
+// Commit commits the transaction
+func (t *Txn) Commit(timeout time.Duration) error {
+	if t.closed {
+		return ErrTxnClosed
+	}
+	t.closed = true
+
+	mutations := t.sortedMutations()
+	if len(mutations) == 0 {
+		return nil
+	}
+
+	stopAt := time.Now().Add(timeout)
+	primary := mutations[0]
+	for idx, m := range mutations {
+		err := t.prewrite(primary, m, stopAt)
+		if err != nil {
+			t.rollback(mutations[:idx+1], stopAt)
+			return err
+		}
+	}
+
+	commitTS, err := t.app.allocTimestamp()
+	if err != nil {
+		t.rollback(mutations, stopAt)
+		return err
+	}
+
+	// the transaction is committed after the primary committed
+	err = t.commit(primary, commitTS, stopAt)
+	if err != nil {
+		if err == ErrTxnAborted {
+			t.rollback(mutations[1:], stopAt)
+		}
+		return err
+	}
+
+	for _, m := range mutations[1:] {
+		if err := t.commit(m, commitTS, stopAt); err != nil {
+			logger.Warningf("transaction %d commit secondary key %+v failed with %+v, it will be resolved by readers",
+				t.startTS, m.key, err)
+		}
+	}
+	return nil
+}
+
+// Rollback discards all the writes of the transaction
+func (t *Txn) Rollback() {
+	t.closed = true
+	t.mutations = nil
+}
+
+func (t *Txn) prewrite(primary, m *mutation, stopAt time.Time) error {
+	for {
+		rsp, err := t.app.execTxn(m.group, m.key, &txnpb.TxnRequest{
+			Op:           txnpb.TxnOp_Prewrite,
+			StartTS:      t.startTS,
+			Primary:      primary.key,
+			PrimaryGroup: primary.group,
+			TTL:          uint64(t.lockTTL / time.Millisecond),
+			Value:        m.value,
+			Delete:       m.delete,
+		}, time.Until(stopAt))
+		if err != nil {
+			return err
+		}
+
+		if rsp.Error.Type != txnpb.TxnErrorType_KeyLocked {
+			return txnError(rsp.Error)
+		}
+
+		err = t.app.resolveLock(m.group, m.key, rsp.Error.Lock, stopAt)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (t *Txn) commit(m *mutation, commitTS uint64, stopAt time.Time) error {
+	rsp, err := t.app.execTxn(m.group, m.key, &txnpb.TxnRequest{
+		Op:       txnpb.TxnOp_Commit,
+		StartTS:  t.startTS,
+		CommitTS: commitTS,
+	}, time.Until(stopAt))
+	if err != nil {
+		return err
+	}
+	return txnError(rsp.Error)
+}
+
+func (t *Txn) rollback(mutations []*mutation, stopAt time.Time) {
+	for _, m := range mutations {
+		_, err := t.app.execTxn(m.group, m.key, &txnpb.TxnRequest{
+			Op:      txnpb.TxnOp_Rollback,
+			StartTS: t.startTS,
+		}, time.Until(stopAt))
+		if err != nil {
+			logger.Warningf("transaction %d rollback key %+v failed with %+v, it will be resolved by others",
+				t.startTS, m.key, err)
+		}
+	}
+}
+
+func (t *Txn) sortedMutations() []*mutation {
+	mutations := make([]*mutation, 0, len(t.mutations))
+	for _, m := range t.mutations {
+		mutations = append(mutations, m)
+	}
+	sort.Slice(mutations, func(i, j int) bool {
+		if mutations[i].group != mutations[j].group {
+			return mutations[i].group < mutations[j].group
+		}
+		return bytes.Compare(mutations[i].key, mutations[j].key) < 0
+	})
+	return mutations
+}
+
+// resolveLock resolves the lock of the key by the status of the primary key. It returns
+// nil if the lock is resolved or it's still alive and the caller should retry.
+func (s *Application) resolveLock(group uint64, key []byte, lock *txnpb.Lock, stopAt time.Time) error {
+	rsp, err := s.execTxn(lock.PrimaryGroup, lock.Primary, &txnpb.TxnRequest{
+		Op:      txnpb.TxnOp_CheckTxnStatus,
+		StartTS: lock.StartTS,
+	}, time.Until(stopAt))
+	if err != nil {
+		return err
+	}
+
+	switch rsp.Status {
+	case txnpb.TxnStatus_Locked:
+		if time.Until(stopAt) <= txnLockBackoff {
+			return ErrTxnKeyLocked
+		}
+		time.Sleep(txnLockBackoff)
+		return nil
+	case txnpb.TxnStatus_Committed:
+		rsp, err = s.execTxn(group, key, &txnpb.TxnRequest{
+			Op:       txnpb.TxnOp_Commit,
+			StartTS:  lock.StartTS,
+			CommitTS: rsp.CommitTS,
+		}, time.Until(stopAt))
+	default:
+		rsp, err = s.execTxn(group, key, &txnpb.TxnRequest{
+			Op:      txnpb.TxnOp_Rollback,
+			StartTS: lock.StartTS,
+		}, time.Until(stopAt))
+	}
+	if err != nil {
+		return err
+	}
+	return txnError(rsp.Error)
+}
+
+// TxnGC removes the versions of the transaction keys in the group which are invisible to the
+// transactions started after the safe point, the safe point must be less than the start
+// timestamp of all the alive transactions.
+func (s *Application) TxnGC(group uint64, safePoint uint64, timeout time.Duration) error {
+	stopAt := time.Now().Add(timeout)
+	var start []byte
+	for {
+		rsp, err := s.execTxnRequest(group, func(req *raftcmdpb.Request) {
+			txn.BuildGCRequest(req, start, safePoint)
+		}, time.Until(stopAt))
+		if err != nil {
+			return err
+		}
+
+		if len(rsp.NextKey) == 0 {
+			return nil
+		}
+		start = rsp.NextKey
+	}
+}
+
+func (s *Application) execTxn(group uint64, key []byte, txnReq *txnpb.TxnRequest, timeout time.Duration) (*txnpb.TxnResponse, error) {
+	return s.execTxnRequest(group, func(req *raftcmdpb.Request) {
+		txn.BuildRequest(req, key, txnReq)
+	}, timeout)
+}
+
+func (s *Application) execTxnRequest(group uint64, build func(*raftcmdpb.Request), timeout time.Duration) (*txnpb.TxnResponse, error) {
+	if timeout <= 0 {
+		return nil, proxy.ErrTimeout
+	}
+
+	req := pb.AcquireRequest()
+	req.ID = uuid.NewV4().Bytes()
+	req.Group = group
+	pb.SetStopAt(req, stopAt(timeout))
+	build(req)
+
+	completeC := make(chan interface{}, 1)
+	closed := uint32(0)
+	cb := func(arg interface{}, resp []byte, err error) {
+		if atomic.CompareAndSwapUint32(&closed, 0, 1) {
+			if err != nil {
+				completeC <- err
+			} else {
+				completeC <- resp
+			}
+			close(completeC)
+		}
+	}
+
+	s.asyncExecRequest(req, nil, cb, timeout, nil)
+	value := <-completeC
+	if err, ok := value.(error); ok {
+		return nil, err
+	}
+
+	rsp := &txnpb.TxnResponse{}
+	protoc.MustUnmarshal(rsp, value.([]byte))
+	return rsp, nil
+}
+
+func txnError(err txnpb.TxnError) error {
+	switch err.Type {
+	case txnpb.TxnErrorType_NoError:
+		return nil
+	case txnpb.TxnErrorType_KeyLocked:
+		return ErrTxnKeyLocked
+	case txnpb.TxnErrorType_WriteConflict:
+		return ErrTxnWriteConflict
+	case txnpb.TxnErrorType_TxnAborted:
+		return ErrTxnAborted
+	default:
+		return errors.New(err.Type.String())
+	}
+}
+
+func mutationKey(group uint64, key []byte) string {
+	return fmt.Sprintf("%d/%s", group, key)
+}
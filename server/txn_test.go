@@ -0,0 +1,108 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/components/prophet/util/typeutil"
+	"github.com/matrixorigin/matrixcube/config"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxn(t *testing.T) {
+	c, closer := createDiskDataStorageCluster(t,
+		raftstore.WithTestClusterNodeCount(1),
+		raftstore.WithAppendTestClusterAdjustConfigFunc(func(node int, cfg *config.Config) {
+			cfg.Replication.ShardCapacityBytes = typeutil.ByteSize(20)
+			cfg.Replication.ShardSplitCheckBytes = typeutil.ByteSize(10)
+		}))
+	defer closer()
+
+	app := c.Applications[0]
+	for _, key := range []string{"key1", "key2", "key3"} {
+		_, err := app.Exec(&testRequest{Op: "SET", Key: key, Value: "value11"}, 10*time.Second)
+		assert.NoError(t, err)
+	}
+	c.RaftCluster.WaitShardByCount(t, 3, time.Second*10)
+	c.RaftCluster.WaitLeadersByCount(t, 3, time.Second*10)
+
+	// key1a and key3a are in the different shards
+	key1, key3 := []byte("key1a"), []byte("key3a")
+	txn, err := app.BeginTxn()
+	assert.NoError(t, err)
+	txn.Set(0, key1, []byte("v1"))
+	txn.Set(0, key3, []byte("v3"))
+	assert.NoError(t, txn.Commit(10*time.Second))
+	assertTxnGet(t, app, key1, "v1")
+	assertTxnGet(t, app, key3, "v3")
+
+	// write conflict
+	txn1, err := app.BeginTxn()
+	assert.NoError(t, err)
+	txn2, err := app.BeginTxn()
+	assert.NoError(t, err)
+	txn2.Set(0, key1, []byte("v12"))
+	assert.NoError(t, txn2.Commit(10*time.Second))
+	txn1.Set(0, key1, []byte("v11"))
+	assert.Equal(t, ErrTxnWriteConflict, txn1.Commit(10*time.Second))
+	assertTxnGet(t, app, key1, "v12")
+
+	// the coordinator crashed after prewrite, the locks are rolled back after expired
+	txn, err = app.BeginTxn()
+	assert.NoError(t, err)
+	txn.SetLockTTL(time.Millisecond * 200)
+	txn.Set(0, key1, []byte("v13"))
+	txn.Set(0, key3, []byte("v33"))
+	mutations := txn.sortedMutations()
+	stopAt := time.Now().Add(10 * time.Second)
+	for _, m := range mutations {
+		assert.NoError(t, txn.prewrite(mutations[0], m, stopAt))
+	}
+	assertTxnGet(t, app, key3, "v3")
+	assertTxnGet(t, app, key1, "v12")
+
+	// the coordinator crashed after the primary committed, the secondary locks are committed
+	txn, err = app.BeginTxn()
+	assert.NoError(t, err)
+	txn.Set(0, key1, []byte("v14"))
+	txn.Set(0, key3, []byte("v34"))
+	mutations = txn.sortedMutations()
+	for _, m := range mutations {
+		assert.NoError(t, txn.prewrite(mutations[0], m, stopAt))
+	}
+	commitTS, err := app.allocTimestamp()
+	assert.NoError(t, err)
+	assert.NoError(t, txn.commit(mutations[0], commitTS, stopAt))
+	assertTxnGet(t, app, key3, "v34")
+	assertTxnGet(t, app, key1, "v14")
+
+	// the latest versions are kept after GC
+	safePoint, err := app.allocTimestamp()
+	assert.NoError(t, err)
+	assert.NoError(t, app.TxnGC(0, safePoint, 10*time.Second))
+	assertTxnGet(t, app, key3, "v34")
+	assertTxnGet(t, app, key1, "v14")
+}
+
+func assertTxnGet(t *testing.T, app *Application, key []byte, expect string) {
+	txn, err := app.BeginTxn()
+	assert.NoError(t, err)
+	value, ok, err := txn.Get(0, key, 10*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, expect, string(value))
+}
@@ -16,6 +16,7 @@ package mem
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
@@ -56,12 +57,15 @@ func (s *Storage) Set(key []byte, value []byte) error {
 
 // SetWithTTL put the key, value pair to the storage with a ttl in seconds
 func (s *Storage) SetWithTTL(key []byte, value []byte, ttl int32) error {
+	return s.setWithTTL(key, value, time.Second*time.Duration(ttl))
+}
+
+func (s *Storage) setWithTTL(key []byte, value []byte, ttl time.Duration) error {
 	atomic.AddUint64(&s.stats.WrittenKeys, 1)
 	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(value)+len(key)))
 	s.kv.Put(key, value)
 	if ttl > 0 {
-		after := time.Second * time.Duration(ttl)
-		util.DefaultTimeoutWheel().Schedule(after, func(arg interface{}) {
+		util.DefaultTimeoutWheel().Schedule(ttl, func(arg interface{}) {
 			s.Delete(arg.([]byte))
 		}, key)
 	}
@@ -176,6 +180,43 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 	return total, keys, splitKeys, nil
 }
 
+// ApproximateSplitKey the memory storage has no size estimates, the caller should scan the data
+func (s *Storage) ApproximateSplitKey(start []byte, end []byte) ([]byte, error) {
+	return nil, nil
+}
+
+// ComputeHash returns the hash of all the key-value pairs in [start, end)
+func (s *Storage) ComputeHash(start []byte, end []byte) ([]byte, error) {
+	h := crc32.NewIEEE()
+	size := make([]byte, 4)
+	err := s.kv.Scan(start, end, func(key, value []byte) (bool, error) {
+		binary.BigEndian.PutUint32(size, uint32(len(key)))
+		h.Write(size)
+		h.Write(key)
+		binary.BigEndian.PutUint32(size, uint32(len(value)))
+		h.Write(size)
+		h.Write(value)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// PrepareComputeHash computes the hash immediately, the memory storage has no snapshot
+func (s *Storage) PrepareComputeHash(start []byte, end []byte) (func() ([]byte, error), error) {
+	hash, err := s.ComputeHash(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() ([]byte, error) {
+		return hash, nil
+	}, nil
+}
+
 // Seek returns the first key-value that >= key
 func (s *Storage) Seek(key []byte) ([]byte, []byte, error) {
 	k, v := s.kv.Seek(key)
@@ -199,7 +240,16 @@ func (s *Storage) Write(wb *util.WriteBatch, sync bool) error {
 		case util.OpDelete:
 			s.Delete(wb.Keys[idx])
 		case util.OpSet:
-			s.SetWithTTL(wb.Keys[idx], wb.Values[idx], wb.TTLs[idx])
+			ttl := time.Second * time.Duration(wb.TTLs[idx])
+			if ttl > 0 && wb.Timestamp > 0 {
+				// the ttl is based on the write batch timestamp
+				ttl -= time.Duration(time.Now().UnixNano() - wb.Timestamp*int64(time.Millisecond))
+				if ttl <= 0 {
+					s.Delete(wb.Keys[idx])
+					continue
+				}
+			}
+			s.setWithTTL(wb.Keys[idx], wb.Values[idx], ttl)
 		}
 	}
 	return nil
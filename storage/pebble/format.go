@@ -0,0 +1,195 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// The format version of the storage is saved in the formatVersionKey. The storage created
+// by the old version has no format version, and all the values are stored without the
+// flag, they are rewritten with the flag when the storage is opened. The migration is
+// done in batches, and the last migrated key is saved in the formatMigrateKey with the
+// same batch, so it can be continued after restart.
+const (
+	// formatLegacy the values are stored as is
+	formatLegacy uint64 = 0
+	// formatTTL the values are stored with the ttl flag, see encodeValue
+	formatTTL uint64 = 1
+
+	currentFormat = formatTTL
+
+	migrateBatchSize = 1024
+)
+
+var (
+	formatVersionKey = []byte{0xff, 0xff, 0xff, 0xff, 'f', 'm', 't'}
+	formatMigrateKey = []byte{0xff, 0xff, 0xff, 0xff, 'f', 'm', 't', 'm'}
+)
+
+// isInternalKey returns true if the key is used by the storage itself, and it's invisible
+// to the users.
+func isInternalKey(key []byte) bool {
+	return isTTLIndexKey(key) || bytes.HasPrefix(key, formatVersionKey)
+}
+
+func (s *Storage) getFormatVersion() (uint64, bool, error) {
+	value, closer, err := s.db.Get(formatVersionKey)
+	if err == pebble.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	defer closer.Close()
+
+	v, _ := decodeValue(value)
+	return binary.BigEndian.Uint64(v), true, nil
+}
+
+func setFormatVersion(b *pebble.Batch, version uint64) error {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, version)
+	return b.Set(formatVersionKey, encodeValue(v, 0), nil)
+}
+
+// checkFormat migrates the values to the current format if the storage is created by the
+// old version, and the new storage is marked as the current format.
+func (s *Storage) checkFormat() error {
+	version, ok, err := s.getFormatVersion()
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		version = formatLegacy
+		if empty, err := s.isEmpty(); err != nil {
+			return err
+		} else if empty {
+			version = currentFormat
+		}
+	}
+
+	if version == formatLegacy {
+		if err := s.migrateLegacyValues(); err != nil {
+			return err
+		}
+		version = currentFormat
+	}
+
+	if ok && version == currentFormat {
+		return nil
+	}
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	if err := setFormatVersion(b, version); err != nil {
+		return err
+	}
+	if err := b.Delete(formatMigrateKey, nil); err != nil {
+		return err
+	}
+	return s.applyDurably(b)
+}
+
+// applyDurably applies the batch, and flushes the memtable if the WAL is disabled.
+func (s *Storage) applyDurably(b *pebble.Batch) error {
+	if !s.opts.DisableWAL {
+		return s.db.Apply(b, pebble.Sync)
+	}
+
+	if err := s.db.Apply(b, pebble.NoSync); err != nil {
+		return err
+	}
+	return s.db.Flush()
+}
+
+func (s *Storage) isEmpty() (bool, error) {
+	iter := s.db.NewIter(&pebble.IterOptions{})
+	defer iter.Close()
+
+	return !iter.First(), iter.Error()
+}
+
+// migrateLegacyValues adds the no ttl flag to all the values written by the old version.
+func (s *Storage) migrateLegacyValues() error {
+	var lower []byte
+	value, closer, err := s.db.Get(formatMigrateKey)
+	if err != nil && err != pebble.ErrNotFound {
+		return err
+	}
+	if err == nil {
+		lower = clone(value)
+		closer.Close()
+		logger.Infof("continue the legacy values migration after %+v", lower)
+	} else {
+		logger.Infof("start the legacy values migration")
+	}
+
+	total := 0
+	for {
+		n, last, err := s.migrateLegacyBatch(lower)
+		if err != nil {
+			return err
+		}
+
+		total += n
+		if n == 0 {
+			logger.Infof("%d legacy values migrated", total)
+			return nil
+		}
+		lower = last
+	}
+}
+
+// migrateLegacyBatch migrates a batch of the values after the key lower, and returns the
+// number and the last key of migrated.
+func (s *Storage) migrateLegacyBatch(lower []byte) (int, []byte, error) {
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: lower})
+	defer iter.Close()
+
+	n := 0
+	var last []byte
+	for valid := iter.First(); valid && n < migrateBatchSize; valid = iter.Next() {
+		key := iter.Key()
+		if bytes.Equal(key, lower) || isInternalKey(key) {
+			continue
+		}
+
+		if err := b.Set(key, encodeValue(iter.Value(), 0), nil); err != nil {
+			return 0, nil, err
+		}
+		last = clone(key)
+		n++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, nil, err
+	}
+
+	if n == 0 {
+		return 0, nil, nil
+	}
+
+	if err := b.Set(formatMigrateKey, last, nil); err != nil {
+		return 0, nil, err
+	}
+	return n, last, s.applyDurably(b)
+}
@@ -17,21 +17,42 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync"
 	"sync/atomic"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/sstable"
+	"github.com/fagongzi/log"
 	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
 	"github.com/matrixorigin/matrixcube/storage/stats"
 	"github.com/matrixorigin/matrixcube/util"
 )
 
+const (
+	snapshotMetaFile       = "db.meta"
+	snapshotDataFile       = "db.sst"
+	snapshotTTLIndexFile   = "db.ttl.sst"
+	snapshotLegacyDataFile = "db.data"
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[pebble]")
+)
+
 // Storage returns a kv storage based on badger
 type Storage struct {
 	db    *pebble.DB
+	opts  *pebble.Options
 	stats stats.Stats
+	// mu prevents the sweeper from removing the keys which are updating
+	mu      sync.RWMutex
+	stopC   chan struct{}
+	stopper sync.WaitGroup
 
 	// SyncCount number of `Sync` method called
 	SyncCount uint64
@@ -49,9 +70,17 @@ func NewStorageWithOptions(dir string, opts *pebble.Options) (*Storage, error) {
 		return nil, err
 	}
 
-	return &Storage{
-		db: db,
-	}, nil
+	s := &Storage{
+		db:    db,
+		opts:  opts.Clone().EnsureDefaults(),
+		stopC: make(chan struct{}),
+	}
+	if err := s.checkFormat(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	s.startSweeper(defaultSweepInterval)
+	return s, nil
 }
 
 func (s *Storage) Stats() stats.Stats {
@@ -62,12 +91,31 @@ func (s *Storage) Stats() stats.Stats {
 func (s *Storage) Set(key []byte, value []byte) error {
 	atomic.AddUint64(&s.stats.WrittenKeys, 1)
 	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(value)+len(key)))
-	return s.db.Set(key, value, pebble.NoSync)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.db.Set(key, encodeValue(value, 0), pebble.NoSync)
 }
 
 // SetWithTTL put the key, value pair to the storage with a ttl in seconds
 func (s *Storage) SetWithTTL(key []byte, value []byte, ttl int32) error {
-	return fmt.Errorf("pebble storage not support set key-value with TTL")
+	if ttl <= 0 {
+		return s.Set(key, value)
+	}
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	atomic.AddUint64(&s.stats.WrittenKeys, 1)
+	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(value)+len(key)))
+	err := set(b, key, value, expireAt(0, ttl))
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.db.Apply(b, pebble.NoSync)
 }
 
 // BatchSet batch set
@@ -81,10 +129,12 @@ func (s *Storage) BatchSet(pairs ...[]byte) error {
 
 	atomic.AddUint64(&s.stats.WrittenKeys, uint64(len(pairs)/2))
 	for i := 0; i < len(pairs)/2; i++ {
-		b.Set(pairs[2*i], pairs[2*i+1], nil)
+		b.Set(pairs[2*i], encodeValue(pairs[2*i+1], 0), nil)
 		atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(pairs[2*i])+len(pairs[2*i+1])))
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.db.Apply(b, pebble.NoSync)
 }
 
@@ -100,7 +150,8 @@ func (s *Storage) Get(key []byte) ([]byte, error) {
 	}
 
 	defer closer.Close()
-	if len(value) == 0 {
+	value, expireAt := decodeValue(value)
+	if len(value) == 0 || isExpired(expireAt, nowMS()) {
 		return nil, nil
 	}
 
@@ -154,7 +205,7 @@ func (s *Storage) BatchDelete(keys ...[]byte) error {
 func (s *Storage) RangeDelete(start, end []byte) error {
 	atomic.AddUint64(&s.stats.WrittenKeys, 2)
 	atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(start)+len(end)))
-	return s.db.DeleteRange(start, end, pebble.NoSync)
+	return s.deleteRange(start, end)
 }
 
 // Scan scans the key-value pairs in [start, end), and perform with a handler function, if the function
@@ -164,6 +215,7 @@ func (s *Storage) Scan(start, end []byte, handler func(key, value []byte) (bool,
 	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
 	defer iter.Close()
 
+	now := nowMS()
 	iter.First()
 	for iter.Valid() {
 		err := iter.Error()
@@ -171,7 +223,13 @@ func (s *Storage) Scan(start, end []byte, handler func(key, value []byte) (bool,
 			return err
 		}
 
-		ok, err := handler(clone(iter.Key()), clone(iter.Value()))
+		value, expireAt := decodeValue(iter.Value())
+		if isInternalKey(iter.Key()) || isExpired(expireAt, now) {
+			iter.Next()
+			continue
+		}
+
+		ok, err := handler(clone(iter.Key()), clone(value))
 		if err != nil {
 			return err
 		}
@@ -196,6 +254,7 @@ func (s *Storage) Scan(start, end []byte, handler func(key, value []byte) (bool,
 func (s *Storage) PrefixScan(prefix []byte, handler func(key, value []byte) (bool, error), pooledKey bool) error {
 	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: prefix})
 	defer iter.Close()
+	now := nowMS()
 	iter.First()
 	for iter.Valid() {
 		err := iter.Error()
@@ -205,7 +264,12 @@ func (s *Storage) PrefixScan(prefix []byte, handler func(key, value []byte) (boo
 		if ok := bytes.HasPrefix(iter.Key(), prefix); !ok {
 			break
 		}
-		ok, err := handler(clone(iter.Key()), clone(iter.Value()))
+		value, expireAt := decodeValue(iter.Value())
+		if isInternalKey(iter.Key()) || isExpired(expireAt, now) {
+			iter.Next()
+			continue
+		}
+		ok, err := handler(clone(iter.Key()), clone(value))
 		if err != nil {
 			return err
 		}
@@ -241,6 +305,7 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
 	defer iter.Close()
 
+	now := nowMS()
 	iter.First()
 	for iter.Valid() {
 		err := iter.Error()
@@ -252,13 +317,19 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 			break
 		}
 
+		value, expireAt := decodeValue(iter.Value())
+		if isExpired(expireAt, now) {
+			iter.Next()
+			continue
+		}
+
 		if appendSplitKey {
 			splitKeys = append(splitKeys, clone(iter.Key()))
 			appendSplitKey = false
 			sum = 0
 		}
 
-		n := uint64(len(iter.Key()) + len(iter.Value()))
+		n := uint64(len(iter.Key()) + len(value))
 		sum += n
 		total += n
 		keys++
@@ -276,6 +347,126 @@ func (s *Storage) SplitCheck(start []byte, end []byte, size uint64) (uint64, uin
 	return total, keys, splitKeys, nil
 }
 
+// ApproximateSplitKey uses the boundaries of the sstables in [start, end) as the candidates, and
+// returns the first candidate that the estimated disk usage of [start, candidate) reaches the half
+// of [start, end). The data in the memtables is not counted, returns nil if no candidate found.
+func (s *Storage) ApproximateSplitKey(start []byte, end []byte) ([]byte, error) {
+	total, err := s.db.EstimateDiskUsage(start, end)
+	if err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		return nil, nil
+	}
+
+	levels, err := s.db.SSTables()
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates [][]byte
+	addCandidate := func(key []byte) {
+		if !isInternalKey(key) && bytes.Compare(key, start) > 0 && bytes.Compare(key, end) < 0 {
+			candidates = append(candidates, key)
+		}
+	}
+	for _, tables := range levels {
+		for _, table := range tables {
+			addCandidate(table.Smallest.UserKey)
+			addCandidate(table.Largest.UserKey)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return bytes.Compare(candidates[i], candidates[j]) < 0
+	})
+
+	// the disk usage of [start, candidate) increases with the candidate
+	var searchErr error
+	idx := sort.Search(len(candidates), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+
+		size, err := s.db.EstimateDiskUsage(start, candidates[i])
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return size*2 >= total
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if idx == len(candidates) {
+		idx = len(candidates) - 1
+	}
+	return clone(candidates[idx]), nil
+}
+
+// ComputeHash returns the hash of all the key-value pairs in [start, end), the keys with
+// TTL are ignored, because the expired keys are removed by each replica independently.
+func (s *Storage) ComputeHash(start []byte, end []byte) ([]byte, error) {
+	compute, err := s.PrepareComputeHash(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return compute()
+}
+
+// PrepareComputeHash takes a snapshot of [start, end), and returns a function to compute the
+// hash of the snapshot, see ComputeHash.
+func (s *Storage) PrepareComputeHash(start []byte, end []byte) (func() ([]byte, error), error) {
+	snap := s.db.NewSnapshot()
+	return func() ([]byte, error) {
+		defer snap.Close()
+		return s.computeHash(snap, start, end)
+	}, nil
+}
+
+func (s *Storage) computeHash(snap *pebble.Snapshot, start []byte, end []byte) ([]byte, error) {
+	h := crc32.NewIEEE()
+	size := make([]byte, 4)
+
+	iter := snap.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	defer iter.Close()
+
+	iter.First()
+	for iter.Valid() {
+		err := iter.Error()
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Compare(iter.Key(), end) >= 0 {
+			break
+		}
+
+		// only the decoded values are hashed, the encoding includes the expire time
+		value, expireAt := decodeValue(iter.Value())
+		if isInternalKey(iter.Key()) || expireAt > 0 {
+			iter.Next()
+			continue
+		}
+
+		binary.BigEndian.PutUint32(size, uint32(len(iter.Key())))
+		h.Write(size)
+		h.Write(iter.Key())
+		binary.BigEndian.PutUint32(size, uint32(len(value)))
+		h.Write(size)
+		h.Write(value)
+
+		atomic.AddUint64(&s.stats.ReadKeys, 1)
+		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(iter.Value())))
+		iter.Next()
+	}
+
+	return h.Sum(nil), nil
+}
+
 // Seek returns the first key-value that >= key
 func (s *Storage) Seek(target []byte) ([]byte, []byte, error) {
 	var key, value []byte
@@ -283,18 +474,26 @@ func (s *Storage) Seek(target []byte) ([]byte, []byte, error) {
 	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: target})
 	defer iter.Close()
 
+	now := nowMS()
 	iter.First()
-	if iter.Valid() {
+	for iter.Valid() {
 		err := iter.Error()
 		if err != nil {
 			return nil, nil, err
 		}
 
+		v, expireAt := decodeValue(iter.Value())
+		if isInternalKey(iter.Key()) || isExpired(expireAt, now) {
+			iter.Next()
+			continue
+		}
+
 		key = clone(iter.Key())
-		value = clone(iter.Value())
+		value = clone(v)
 
 		atomic.AddUint64(&s.stats.ReadKeys, 1)
 		atomic.AddUint64(&s.stats.ReadBytes, uint64(len(iter.Key())+len(iter.Value())))
+		break
 	}
 
 	return key, value, nil
@@ -317,12 +516,8 @@ func (s *Storage) Write(wb *util.WriteBatch, sync bool) error {
 			atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(wb.Keys[idx])))
 			err = b.Delete(wb.Keys[idx], nil)
 		case util.OpSet:
-			if wb.TTLs[idx] > 0 {
-				return fmt.Errorf("pebble storage not support set key-value with TTL")
-			}
-
 			atomic.AddUint64(&s.stats.WrittenBytes, uint64(len(wb.Keys[idx])+len(wb.Values[idx])))
-			err = b.Set(wb.Keys[idx], wb.Values[idx], nil)
+			err = set(b, wb.Keys[idx], wb.Values[idx], expireAt(wb.Timestamp, wb.TTLs[idx]))
 		}
 
 		if err != nil {
@@ -335,6 +530,8 @@ func (s *Storage) Write(wb *util.WriteBatch, sync bool) error {
 		opts = pebble.Sync
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.db.Apply(b, opts)
 }
 
@@ -344,15 +541,16 @@ func (s *Storage) Sync() error {
 	return s.db.Flush()
 }
 
-// CreateSnapshot create a snapshot file under the giving path
+// CreateSnapshot create a snapshot file under the giving path. The snapshot contains
+// a meta file with the range, and the sst files of the data and the ttl index, which
+// can be ingested directly by the applier.
 func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 	err := os.MkdirAll(path, 0755)
 	if err != nil {
 		return err
 	}
 
-	file := filepath.Join(path, "db.data")
-	f, err := os.Create(file)
+	f, err := os.Create(filepath.Join(path, snapshotMetaFile))
 	if err != nil {
 		return err
 	}
@@ -368,12 +566,19 @@ func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 		return err
 	}
 
+	err = f.Sync()
+	if err != nil {
+		return err
+	}
+
 	snap := s.db.NewSnapshot()
 	defer snap.Close()
 
 	iter := snap.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
 	defer iter.Close()
 
+	var w *sstable.Writer
+	var indexKeys [][]byte
 	iter.First()
 	for iter.Valid() {
 		err := iter.Error()
@@ -385,16 +590,33 @@ func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 			break
 		}
 
-		err = writeBytes(f, iter.Key())
-		if err != nil {
-			return err
+		// the ttl index is rebuilt from the values
+		if isInternalKey(iter.Key()) {
+			iter.Next()
+			continue
+		}
+
+		if w == nil {
+			w, err = s.newSSTWriter(filepath.Join(path, snapshotDataFile))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if w != nil {
+					w.Close()
+				}
+			}()
 		}
 
-		err = writeBytes(f, iter.Value())
+		err = w.Set(iter.Key(), iter.Value())
 		if err != nil {
 			return err
 		}
 
+		if _, expireAt := decodeValue(iter.Value()); expireAt > 0 {
+			indexKeys = append(indexKeys, encodeTTLIndexKey(iter.Key(), expireAt))
+		}
+
 		n := uint64(len(iter.Key()) + len(iter.Value()))
 		atomic.AddUint64(&s.stats.ReadKeys, 1)
 		atomic.AddUint64(&s.stats.ReadBytes, n)
@@ -403,12 +625,105 @@ func (s *Storage) CreateSnapshot(path string, start, end []byte) error {
 		iter.Next()
 	}
 
-	return nil
+	if w != nil {
+		err = w.Close()
+		w = nil
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(indexKeys) == 0 {
+		return nil
+	}
+
+	// the ttl index is ordered by the expire time, so it needs to be sorted
+	sort.Slice(indexKeys, func(i, j int) bool {
+		return bytes.Compare(indexKeys[i], indexKeys[j]) < 0
+	})
+	iw, err := s.newSSTWriter(filepath.Join(path, snapshotTTLIndexFile))
+	if err != nil {
+		return err
+	}
+	for _, key := range indexKeys {
+		err = iw.Set(key, nil)
+		if err != nil {
+			iw.Close()
+			return err
+		}
+	}
+	return iw.Close()
 }
 
 // ApplySnapshot apply a snapshort file from giving path
 func (s *Storage) ApplySnapshot(path string) error {
-	f, err := os.Open(filepath.Join(path, "db.data"))
+	// the snapshot created by the old version
+	if exist(filepath.Join(path, snapshotLegacyDataFile)) {
+		return s.applyLegacySnapshot(path)
+	}
+
+	f, err := os.Open(filepath.Join(path, snapshotMetaFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	start, err := readBytes(f)
+	if err != nil {
+		return err
+	}
+	if len(start) == 0 {
+		return fmt.Errorf("error format, missing start field")
+	}
+
+	end, err := readBytes(f)
+	if err != nil {
+		return err
+	}
+	if len(end) == 0 {
+		return fmt.Errorf("error format, missing end field")
+	}
+
+	err = s.deleteRange(start, end)
+	if err != nil {
+		return err
+	}
+
+	var files []string
+	for _, name := range []string{snapshotDataFile, snapshotTTLIndexFile} {
+		file := filepath.Join(path, name)
+		if !exist(file) {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		atomic.AddUint64(&s.stats.WrittenBytes, uint64(info.Size()))
+		files = append(files, file)
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	return s.db.Ingest(files)
+}
+
+func (s *Storage) newSSTWriter(file string) (*sstable.Writer, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return sstable.NewWriter(f, s.opts.MakeWriterOptions(0)), nil
+}
+
+// applyLegacySnapshot apply the snapshot which all the key-value pairs are in a single
+// data file, keep it for rolling upgrades.
+func (s *Storage) applyLegacySnapshot(path string) error {
+	f, err := os.Open(filepath.Join(path, snapshotLegacyDataFile))
 	if err != nil {
 		return err
 	}
@@ -430,7 +745,7 @@ func (s *Storage) ApplySnapshot(path string) error {
 		return fmt.Errorf("error format, missing end field")
 	}
 
-	err = s.db.DeleteRange(start, end, pebble.NoSync)
+	err = s.deleteRange(start, end)
 	if err != nil {
 		return err
 	}
@@ -457,7 +772,8 @@ func (s *Storage) ApplySnapshot(path string) error {
 		atomic.AddUint64(&s.stats.ReadBytes, n)
 		atomic.AddUint64(&s.stats.WrittenKeys, 1)
 		atomic.AddUint64(&s.stats.WrittenBytes, n)
-		err = s.db.Set(key, value, pebble.NoSync)
+		// the values of the old version are stored as is, and have no ttl
+		err = s.db.Set(key, encodeValue(value, 0), pebble.NoSync)
 		if err != nil {
 			return err
 		}
@@ -468,9 +784,16 @@ func (s *Storage) ApplySnapshot(path string) error {
 
 // Close close the storage
 func (s *Storage) Close() error {
+	close(s.stopC)
+	s.stopper.Wait()
 	return s.db.Close()
 }
 
+func exist(name string) bool {
+	_, err := os.Stat(name)
+	return err == nil
+}
+
 func clone(value []byte) []byte {
 	v := make([]byte, len(value))
 	copy(v, value)
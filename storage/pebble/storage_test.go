@@ -1,10 +1,13 @@
 package pebble
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/matrixorigin/matrixcube/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,3 +48,256 @@ func recreateTestTempDir(tmpDir string) {
 	os.RemoveAll(tmpDir)
 	os.MkdirAll(tmpDir, 0755)
 }
+
+func TestTTLWithTimestamp(t *testing.T) {
+	dir := tmpDir + "/ttl"
+	recreateTestTempDir(dir)
+	s, err := NewStorage(dir)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	k1 := []byte("k1")
+	k2 := []byte("k2")
+	v := []byte("v")
+
+	wb := util.NewWriteBatch()
+	wb.SetWithTTL(k1, v, 1)
+	wb.SetWithTTL(k2, v, 10)
+	wb.Timestamp = nowMS() - 2000
+	assert.NoError(t, s.Write(wb, false))
+
+	value, err := s.Get(k1)
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+
+	value, err = s.Get(k2)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+
+	key, value, err := s.Seek(k1)
+	assert.NoError(t, err)
+	assert.Equal(t, k2, key)
+	assert.Equal(t, v, value)
+}
+
+func TestSweepExpired(t *testing.T) {
+	dir := tmpDir + "/sweep"
+	recreateTestTempDir(dir)
+	s, err := NewStorage(dir)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	k1 := []byte("k1")
+	k2 := []byte("k2")
+	v := []byte("v")
+
+	assert.NoError(t, s.SetWithTTL(k1, v, 1))
+	assert.NoError(t, s.SetWithTTL(k2, v, 1))
+	// k2 is updated without ttl, so it should not be removed
+	assert.NoError(t, s.Set(k2, v))
+	assert.NoError(t, s.sweepExpired(nowMS()+2000))
+
+	_, closer, err := s.db.Get(k1)
+	assert.Equal(t, pebble.ErrNotFound, err)
+	if closer != nil {
+		closer.Close()
+	}
+
+	value, err := s.Get(k2)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: ttlIndexPrefix})
+	defer iter.Close()
+	assert.False(t, iter.First())
+}
+
+func TestSnapshotWithTTL(t *testing.T) {
+	dir := tmpDir + "/snap"
+	recreateTestTempDir(dir)
+	s1, err := NewStorage(dir + "/s1")
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := NewStorage(dir + "/s2")
+	assert.NoError(t, err)
+	defer s2.Close()
+
+	k1 := []byte("k1")
+	k2 := []byte("k2")
+	k3 := []byte("k3")
+	v := []byte("v")
+	assert.NoError(t, s1.Set(k1, v))
+	assert.NoError(t, s1.SetWithTTL(k2, v, 1))
+	assert.NoError(t, s2.Set(k3, v))
+
+	path := dir + "/snapshot"
+	assert.NoError(t, s1.CreateSnapshot(path, k1, k3))
+	assert.NoError(t, s2.ApplySnapshot(path))
+
+	value, err := s2.Get(k1)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+	value, err = s2.Get(k2)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+	value, err = s2.Get(k3)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+
+	// the ttl index is also applied
+	assert.NoError(t, s2.sweepExpired(nowMS()+2000))
+	_, closer, err := s2.db.Get(k2)
+	assert.Equal(t, pebble.ErrNotFound, err)
+	if closer != nil {
+		closer.Close()
+	}
+}
+
+func TestApplySnapshotRemovesTTLIndex(t *testing.T) {
+	dir := tmpDir + "/snapttl"
+	recreateTestTempDir(dir)
+	s1, err := NewStorage(dir + "/s1")
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := NewStorage(dir + "/s2")
+	assert.NoError(t, err)
+	defer s2.Close()
+
+	k1 := []byte("k1")
+	k3 := []byte("k3")
+	v := []byte("v")
+	assert.NoError(t, s1.Set(k1, v))
+	assert.NoError(t, s2.SetWithTTL(k1, v, 1))
+
+	path := dir + "/snapshot"
+	assert.NoError(t, s1.CreateSnapshot(path, k1, k3))
+	assert.NoError(t, s2.ApplySnapshot(path))
+
+	// the index of the replaced key is removed
+	iter := s2.db.NewIter(&pebble.IterOptions{LowerBound: ttlIndexPrefix})
+	defer iter.Close()
+	assert.False(t, iter.First())
+}
+
+func TestApplyLegacySnapshot(t *testing.T) {
+	dir := tmpDir + "/legacy"
+	recreateTestTempDir(dir)
+	s, err := NewStorage(dir + "/db")
+	assert.NoError(t, err)
+	defer s.Close()
+
+	k1 := []byte("k1")
+	k2 := []byte("k2")
+	v := []byte("v")
+	assert.NoError(t, s.Set(k2, v))
+
+	path := dir + "/snapshot"
+	assert.NoError(t, os.MkdirAll(path, 0755))
+	f, err := os.Create(path + "/" + snapshotLegacyDataFile)
+	assert.NoError(t, err)
+	// the legacy snapshot is written by the old version, and the values have no flag
+	for _, data := range [][]byte{k1, k2, k1, v} {
+		assert.NoError(t, writeBytes(f, data))
+	}
+	assert.NoError(t, f.Close())
+
+	assert.NoError(t, s.ApplySnapshot(path))
+	value, err := s.Get(k1)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+	value, err = s.Get(k2)
+	assert.NoError(t, err)
+	assert.Equal(t, v, value)
+}
+
+func TestMigrateLegacyFormat(t *testing.T) {
+	dir := tmpDir + "/format"
+	recreateTestTempDir(dir)
+
+	// the values written by the old version have no flag, and the migration of k1
+	// is finished before restart
+	db, err := pebble.Open(dir, &pebble.Options{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.Set([]byte("k1"), encodeValue([]byte("v1"), 0), pebble.Sync))
+	assert.NoError(t, db.Set([]byte("k2"), []byte("v2"), pebble.Sync))
+	assert.NoError(t, db.Set([]byte("k3"), []byte{}, pebble.Sync))
+	assert.NoError(t, db.Set(formatMigrateKey, []byte("k1"), pebble.Sync))
+	assert.NoError(t, db.Close())
+
+	for i := 0; i < 2; i++ {
+		s, err := NewStorage(dir)
+		assert.NoError(t, err)
+
+		version, ok, err := s.getFormatVersion()
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, currentFormat, version)
+		for k, v := range map[string]string{"k1": "v1", "k2": "v2", "k3": ""} {
+			value, err := s.Get([]byte(k))
+			assert.NoError(t, err)
+			assert.Equal(t, v, string(value))
+		}
+
+		var keys []string
+		assert.NoError(t, s.Scan(nil, nil, func(key, value []byte) (bool, error) {
+			keys = append(keys, string(key))
+			return true, nil
+		}, false))
+		assert.Equal(t, []string{"k1", "k2", "k3"}, keys)
+		assert.NoError(t, s.Close())
+	}
+}
+
+func TestComputeHashWithTTL(t *testing.T) {
+	dir := tmpDir + "/hash"
+	recreateTestTempDir(dir)
+	s1, err := NewStorage(dir + "/1")
+	assert.NoError(t, err)
+	defer s1.Close()
+	s2, err := NewStorage(dir + "/2")
+	assert.NoError(t, err)
+	defer s2.Close()
+
+	// the keys with TTL are expired by each replica independently
+	assert.NoError(t, s1.Set([]byte("k1"), []byte("v1")))
+	assert.NoError(t, s2.Set([]byte("k1"), []byte("v1")))
+	assert.NoError(t, s2.SetWithTTL([]byte("k2"), []byte("v2"), 10))
+
+	h1, err := s1.ComputeHash(nil, []byte{0xff, 0xff, 0xff, 0xff, 0xff})
+	assert.NoError(t, err)
+	h2, err := s2.ComputeHash(nil, []byte{0xff, 0xff, 0xff, 0xff, 0xff})
+	assert.NoError(t, err)
+	assert.Equal(t, h1, h2)
+}
+
+func TestApproximateSplitKey(t *testing.T) {
+	dir := tmpDir + "/approximate"
+	recreateTestTempDir(dir)
+	// keep the flushed sstables in L0
+	opts := &pebble.Options{L0CompactionThreshold: 100, L0StopWritesThreshold: 100}
+	s, err := NewStorageWithOptions(dir, opts)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	start, end := []byte("k"), []byte("l")
+	key, err := s.ApproximateSplitKey(start, end)
+	assert.NoError(t, err)
+	assert.Empty(t, key, "no sstables")
+
+	value := make([]byte, 100)
+	for i := 0; i < 1000; i++ {
+		assert.NoError(t, s.Set([]byte(fmt.Sprintf("k%03d", i)), value))
+		if i%100 == 99 {
+			assert.NoError(t, s.db.Flush())
+		}
+	}
+
+	key, err = s.ApproximateSplitKey(start, end)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Compare(key, []byte("k300")) > 0, "split key %s", key)
+	assert.True(t, bytes.Compare(key, []byte("k700")) < 0, "split key %s", key)
+
+	key, err = s.ApproximateSplitKey([]byte("k500"), []byte("k501"))
+	assert.NoError(t, err)
+	assert.Empty(t, key, "no candidates in range")
+}
@@ -0,0 +1,237 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// All values are stored as value + [expireAt(8 bytes)] + flag(1 byte) since the formatTTL. The expireAt is
+// only present if the flag is flagTTL, and it's the unix milliseconds of the expire time.
+// Every key with TTL has a index key ttlIndexPrefix + expireAt + key, used to find the
+// expired keys by the sweeper.
+const (
+	flagNoTTL byte = 0
+	flagTTL   byte = 1
+
+	expireAtSize   = 8
+	sweepBatchSize = 256
+)
+
+var (
+	ttlIndexPrefix = []byte{0xff, 0xff, 0xff, 0xff, 't', 't', 'l'}
+	// defaultSweepInterval the interval of removing the expired keys from the storage
+	defaultSweepInterval = time.Second * 10
+)
+
+func nowMS() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// expireAt returns the expire time of the ttl(seconds) based on the timestamp(unix milliseconds),
+// the current time is used if the timestamp is 0.
+func expireAt(timestamp int64, ttl int32) int64 {
+	if ttl <= 0 {
+		return 0
+	}
+
+	if timestamp == 0 {
+		timestamp = nowMS()
+	}
+	return timestamp + int64(ttl)*1000
+}
+
+func encodeValue(value []byte, expireAt int64) []byte {
+	if expireAt == 0 {
+		v := make([]byte, len(value)+1)
+		copy(v, value)
+		v[len(value)] = flagNoTTL
+		return v
+	}
+
+	v := make([]byte, len(value)+expireAtSize+1)
+	copy(v, value)
+	binary.BigEndian.PutUint64(v[len(value):], uint64(expireAt))
+	v[len(v)-1] = flagTTL
+	return v
+}
+
+// decodeValue returns the value and the expire time, the returned value shares the
+// same underlying array with the encoded value.
+func decodeValue(value []byte) ([]byte, int64) {
+	n := len(value)
+	if n == 0 {
+		return nil, 0
+	}
+
+	if value[n-1] != flagTTL {
+		return value[:n-1], 0
+	}
+
+	return value[:n-1-expireAtSize], int64(binary.BigEndian.Uint64(value[n-1-expireAtSize:]))
+}
+
+func isExpired(expireAt int64, now int64) bool {
+	return expireAt > 0 && expireAt <= now
+}
+
+func isTTLIndexKey(key []byte) bool {
+	return bytes.HasPrefix(key, ttlIndexPrefix)
+}
+
+func encodeTTLIndexKey(key []byte, expireAt int64) []byte {
+	v := make([]byte, len(ttlIndexPrefix)+expireAtSize+len(key))
+	copy(v, ttlIndexPrefix)
+	binary.BigEndian.PutUint64(v[len(ttlIndexPrefix):], uint64(expireAt))
+	copy(v[len(ttlIndexPrefix)+expireAtSize:], key)
+	return v
+}
+
+func decodeTTLIndexKey(indexKey []byte) ([]byte, int64) {
+	expireAt := int64(binary.BigEndian.Uint64(indexKey[len(ttlIndexPrefix):]))
+	return indexKey[len(ttlIndexPrefix)+expireAtSize:], expireAt
+}
+
+// set adds the key-value pair into the batch, and also adds the ttl index
+// if the expireAt is not 0.
+func set(b *pebble.Batch, key, value []byte, expireAt int64) error {
+	err := b.Set(key, encodeValue(value, expireAt), nil)
+	if err != nil {
+		return err
+	}
+
+	if expireAt > 0 {
+		return b.Set(encodeTTLIndexKey(key, expireAt), nil, nil)
+	}
+	return nil
+}
+
+// deleteRange removes the keys in [start, end) with their ttl index, otherwise the index
+// left behind expires the keys written into the range later.
+func (s *Storage) deleteRange(start, end []byte) error {
+	// prevent the keys from being updated between scan and delete
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	iter := s.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	for valid := iter.First(); valid; valid = iter.Next() {
+		if isInternalKey(iter.Key()) {
+			continue
+		}
+
+		if _, expireAt := decodeValue(iter.Value()); expireAt > 0 {
+			if err := b.Delete(encodeTTLIndexKey(iter.Key(), expireAt), nil); err != nil {
+				iter.Close()
+				return err
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if err := b.DeleteRange(start, end, nil); err != nil {
+		return err
+	}
+	return s.db.Apply(b, pebble.NoSync)
+}
+
+func (s *Storage) startSweeper(interval time.Duration) {
+	s.stopper.Add(1)
+	go func() {
+		defer s.stopper.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stopC:
+				return
+			case <-ticker.C:
+				err := s.sweepExpired(nowMS())
+				if err != nil {
+					logger.Errorf("remove expired keys failed with %+v", err)
+				}
+			}
+		}
+	}()
+}
+
+// sweepExpired removes all the keys which expired before now(unix milliseconds).
+func (s *Storage) sweepExpired(now int64) error {
+	upper := encodeTTLIndexKey(nil, now+1)
+	for {
+		var indexKeys [][]byte
+		iter := s.db.NewIter(&pebble.IterOptions{LowerBound: ttlIndexPrefix, UpperBound: upper})
+		iter.First()
+		for iter.Valid() && len(indexKeys) < sweepBatchSize {
+			indexKeys = append(indexKeys, clone(iter.Key()))
+			iter.Next()
+		}
+		err := iter.Close()
+		if err != nil {
+			return err
+		}
+
+		if len(indexKeys) == 0 {
+			return nil
+		}
+
+		err = s.removeExpired(indexKeys, now)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Storage) removeExpired(indexKeys [][]byte, now int64) error {
+	// prevent the keys from being updated between check and delete
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	for _, indexKey := range indexKeys {
+		key, _ := decodeTTLIndexKey(indexKey)
+		value, closer, err := s.db.Get(key)
+		if err != nil && err != pebble.ErrNotFound {
+			return err
+		}
+
+		if err == nil {
+			_, expireAt := decodeValue(value)
+			closer.Close()
+			if isExpired(expireAt, now) {
+				if err := b.Delete(key, nil); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := b.Delete(indexKey, nil); err != nil {
+			return err
+		}
+	}
+
+	return s.db.Apply(b, pebble.NoSync)
+}
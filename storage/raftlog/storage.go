@@ -0,0 +1,686 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raftlog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fagongzi/log"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+const (
+	recordEntry   = byte(1)
+	recordCompact = byte(2)
+	recordRemove  = byte(3)
+
+	// headerSize the size of the payload length and the crc32 of the payload
+	headerSize = 8
+	// metaSize the size of the type, shard id, index and term in the payload
+	metaSize = 1 + 8*3
+
+	segmentSuffix = ".log"
+
+	defaultSegmentSize     = 64 * 1024 * 1024
+	defaultRewriteSegments = 4
+	writeBufferSize        = 256 * 1024
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[raftlog]")
+
+	// ErrClosed the storage is closed
+	ErrClosed = errors.New("raft log storage closed")
+)
+
+// Options the options of the segment log storage
+type Options struct {
+	// SegmentSize a new segment file is created after the active segment exceeds the size
+	SegmentSize int64
+	// RewriteSegments the live entries in the segments older than the latest so many segments
+	// are rewritten to the active segment, so that the old segments can be dropped even if some
+	// shards are rarely compacted
+	RewriteSegments int
+	// DisableSync disables the fsync, only used in testing
+	DisableSync bool
+}
+
+func (opts *Options) adjust() {
+	if opts.SegmentSize == 0 {
+		opts.SegmentSize = defaultSegmentSize
+	}
+
+	if opts.RewriteSegments == 0 {
+		opts.RewriteSegments = defaultRewriteSegments
+	}
+}
+
+// position the position of the entry in the segment files
+type position struct {
+	segment uint64
+	offset  int64
+	size    uint32
+	term    uint64
+}
+
+// shardLog the positions of the continuous entries of a shard
+type shardLog struct {
+	// first is the index of the first entry, or the next expected index if there is no entries
+	first   uint64
+	entries []position
+}
+
+func (l *shardLog) next() uint64 {
+	return l.first + uint64(len(l.entries))
+}
+
+func (l *shardLog) get(index uint64) (position, bool) {
+	if index < l.first || index >= l.next() {
+		return position{}, false
+	}
+
+	return l.entries[index-l.first], true
+}
+
+// Storage the append-only raft log storage, the entries of all the shards are appended to the
+// active segment file, and a new segment file is created after the active segment is full. The
+// positions of the entries are kept in memory and rebuilt by replaying the segment files when it
+// opens. The compaction and the removal only update the positions and append a record, the old
+// segment files are dropped after all the entries in them are compacted.
+type Storage struct {
+	dir  string
+	opts Options
+
+	// syncMu serializes the fsync, the concurrent Sync calls wait for the running fsync and
+	// return directly if their appends are covered by it.
+	syncMu sync.Mutex
+	synced uint64
+
+	mu         sync.Mutex
+	closed     bool
+	shards     map[uint64]*shardLog
+	files      map[uint64]*os.File
+	segments   []uint64
+	activeID   uint64
+	activeSize int64
+	writer     *bufio.Writer
+	written    uint64
+	buf        []byte
+}
+
+// NewStorage returns a raft log storage with the default options
+func NewStorage(dir string) (*Storage, error) {
+	return NewStorageWithOptions(dir, Options{})
+}
+
+// NewStorageWithOptions returns a raft log storage, the existing segment files in the dir are
+// replayed.
+func NewStorageWithOptions(dir string, opts Options) (*Storage, error) {
+	opts.adjust()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &Storage{
+		dir:    dir,
+		opts:   opts,
+		shards: make(map[uint64]*shardLog),
+		files:  make(map[uint64]*os.File),
+	}
+
+	if err := s.replay(); err != nil {
+		s.closeFiles()
+		return nil, err
+	}
+
+	if len(s.segments) == 0 {
+		if err := s.createSegment(1); err != nil {
+			s.closeFiles()
+			return nil, err
+		}
+	} else {
+		s.activeID = s.segments[len(s.segments)-1]
+		s.writer = bufio.NewWriterSize(s.files[s.activeID], writeBufferSize)
+	}
+
+	return s, nil
+}
+
+// Append appends the entries of the shard, the entries are persisted after Sync returned.
+func (s *Storage) Append(shardID uint64, entries []raftpb.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	for idx := range entries {
+		e := &entries[idx]
+		data, err := e.Marshal()
+		if err != nil {
+			return err
+		}
+
+		pos, err := s.writeRecord(recordEntry, shardID, e.Index, e.Term, data)
+		if err != nil {
+			return err
+		}
+		s.applyEntry(shardID, e.Index, pos)
+	}
+
+	return s.maybeRollover()
+}
+
+// Sync persists all the appended entries
+func (s *Storage) Sync() error {
+	s.mu.Lock()
+	seq := s.written
+	s.mu.Unlock()
+
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	// synced by the concurrent call
+	if s.synced >= seq {
+		return nil
+	}
+
+	// the buffered records are flushed with the mu held, and the fsync is done without it,
+	// so the appends and the reads are not blocked by the fsync
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrClosed
+	}
+	target := s.written
+	id := s.activeID
+	f := s.files[id]
+	err := s.writer.Flush()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if !s.opts.DisableSync {
+		if err := f.Sync(); err != nil && !s.rolledOver(id) {
+			return err
+		}
+	}
+
+	s.synced = target
+	return nil
+}
+
+// rolledOver returns true if the segment is not active, it's synced before the rollover and it
+// may be closed by the purge.
+func (s *Storage) rolledOver(id uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return !s.closed && s.activeID != id
+}
+
+// Entries returns the entries of the shard in [low, high)
+func (s *Storage) Entries(shardID uint64, low, high, maxSize uint64) ([]raftpb.Entry, error) {
+	var ents []raftpb.Entry
+	if low == high {
+		return ents, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrClosed
+	}
+
+	l, ok := s.shards[shardID]
+	if !ok || low < l.first || high > l.next() {
+		return nil, raft.ErrUnavailable
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	var totalSize uint64
+	for index := low; index < high; index++ {
+		pos, _ := l.get(index)
+		totalSize += uint64(pos.size)
+		if totalSize > maxSize && len(ents) > 0 {
+			break
+		}
+
+		e, err := s.readEntry(pos)
+		if err != nil {
+			return nil, err
+		}
+		if e.Index != index {
+			return nil, fmt.Errorf("shard %d raft log index not match, logIndex %d expect %d",
+				shardID,
+				e.Index,
+				index)
+		}
+
+		ents = append(ents, e)
+	}
+
+	return ents, nil
+}
+
+// Term returns the term of the entry
+func (s *Storage) Term(shardID uint64, index uint64) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return 0, ErrClosed
+	}
+
+	if l, ok := s.shards[shardID]; ok {
+		if pos, ok := l.get(index); ok {
+			return pos.term, nil
+		}
+	}
+
+	return 0, raft.ErrUnavailable
+}
+
+// Compact removes the entries of the shard before the index
+func (s *Storage) Compact(shardID uint64, index uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if l, ok := s.shards[shardID]; !ok || index <= l.first {
+		return nil
+	}
+
+	if _, err := s.writeRecord(recordCompact, shardID, index, 0, nil); err != nil {
+		return err
+	}
+
+	s.applyCompact(shardID, index)
+	return nil
+}
+
+// Remove removes all the entries of the shard
+func (s *Storage) Remove(shardID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if _, ok := s.shards[shardID]; !ok {
+		return nil
+	}
+
+	if _, err := s.writeRecord(recordRemove, shardID, 0, 0, nil); err != nil {
+		return err
+	}
+
+	delete(s.shards, shardID)
+	return nil
+}
+
+// Close persists the appended entries and closes the segment files
+func (s *Storage) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+	err := s.syncActive()
+	s.closeFiles()
+	return err
+}
+
+func (s *Storage) writeRecord(recordType byte, shardID, index, term uint64, data []byte) (position, error) {
+	size := metaSize + len(data)
+	if cap(s.buf) < headerSize+size {
+		s.buf = make([]byte, headerSize+size)
+	}
+	buf := s.buf[:headerSize+size]
+
+	payload := buf[headerSize:]
+	payload[0] = recordType
+	binary.BigEndian.PutUint64(payload[1:], shardID)
+	binary.BigEndian.PutUint64(payload[9:], index)
+	binary.BigEndian.PutUint64(payload[17:], term)
+	copy(payload[metaSize:], data)
+
+	binary.BigEndian.PutUint32(buf, uint32(size))
+	binary.BigEndian.PutUint32(buf[4:], crc32.ChecksumIEEE(payload))
+
+	if _, err := s.writer.Write(buf); err != nil {
+		return position{}, err
+	}
+
+	pos := position{
+		segment: s.activeID,
+		offset:  s.activeSize + headerSize + metaSize,
+		size:    uint32(len(data)),
+		term:    term,
+	}
+	s.activeSize += int64(len(buf))
+	s.written++
+	return pos, nil
+}
+
+func (s *Storage) applyEntry(shardID uint64, index uint64, pos position) {
+	l, ok := s.shards[shardID]
+	if !ok {
+		l = &shardLog{first: index}
+		s.shards[shardID] = l
+	}
+
+	if len(l.entries) == 0 || index < l.first || index > l.next() {
+		// the first entry, or a new log after the snapshot
+		l.first = index
+		l.entries = l.entries[:0]
+	} else if index < l.next() {
+		// the conflict entries which never committed are replaced
+		l.entries = l.entries[:index-l.first]
+	}
+
+	l.entries = append(l.entries, pos)
+}
+
+func (s *Storage) applyCompact(shardID uint64, index uint64) {
+	l, ok := s.shards[shardID]
+	if !ok || index <= l.first {
+		return
+	}
+
+	if index >= l.next() {
+		l.entries = nil
+	} else {
+		l.entries = append([]position(nil), l.entries[index-l.first:]...)
+	}
+	l.first = index
+}
+
+func (s *Storage) readEntry(pos position) (raftpb.Entry, error) {
+	e := raftpb.Entry{}
+	f, ok := s.files[pos.segment]
+	if !ok {
+		return e, fmt.Errorf("segment %d not found", pos.segment)
+	}
+
+	if cap(s.buf) < int(pos.size) {
+		s.buf = make([]byte, pos.size)
+	}
+	data := s.buf[:pos.size]
+	if _, err := f.ReadAt(data, pos.offset); err != nil {
+		return e, err
+	}
+
+	err := e.Unmarshal(data)
+	return e, err
+}
+
+func (s *Storage) syncActive() error {
+	if err := s.writer.Flush(); err != nil {
+		return err
+	}
+
+	if s.opts.DisableSync {
+		return nil
+	}
+
+	return s.files[s.activeID].Sync()
+}
+
+func (s *Storage) maybeRollover() error {
+	if s.activeSize < s.opts.SegmentSize {
+		return nil
+	}
+
+	// the old segment is persisted before the new segment is created, so the records in the
+	// segments are persisted in order
+	if err := s.syncActive(); err != nil {
+		return err
+	}
+
+	if err := s.createSegment(s.activeID + 1); err != nil {
+		return err
+	}
+
+	return s.purge()
+}
+
+func (s *Storage) createSegment(id uint64) error {
+	f, err := os.OpenFile(s.segmentFile(id), os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	s.files[id] = f
+	s.segments = append(s.segments, id)
+	s.activeID = id
+	s.activeSize = 0
+	s.writer = bufio.NewWriterSize(f, writeBufferSize)
+	return nil
+}
+
+// purge rewrites the live entries in the old segments to the active segment, and drops the
+// segments which have no live entries.
+func (s *Storage) purge() error {
+	if s.activeID > uint64(s.opts.RewriteSegments) {
+		rewriteBefore := s.activeID - uint64(s.opts.RewriteSegments)
+		for shardID, l := range s.shards {
+			if len(l.entries) == 0 || l.entries[0].segment >= rewriteBefore {
+				continue
+			}
+
+			if err := s.rewrite(shardID, l); err != nil {
+				return err
+			}
+		}
+	}
+
+	minSegment := s.activeID
+	for _, l := range s.shards {
+		if len(l.entries) > 0 && l.entries[0].segment < minSegment {
+			minSegment = l.entries[0].segment
+		}
+	}
+
+	var segments []uint64
+	for _, id := range s.segments {
+		if id >= minSegment {
+			segments = append(segments, id)
+			continue
+		}
+
+		if err := s.files[id].Close(); err != nil {
+			return err
+		}
+		delete(s.files, id)
+		if err := os.Remove(s.segmentFile(id)); err != nil {
+			return err
+		}
+	}
+	s.segments = segments
+	return nil
+}
+
+func (s *Storage) rewrite(shardID uint64, l *shardLog) error {
+	if err := s.writer.Flush(); err != nil {
+		return err
+	}
+
+	first := l.first
+	entries := append([]position(nil), l.entries...)
+	for idx, pos := range entries {
+		e, err := s.readEntry(pos)
+		if err != nil {
+			return err
+		}
+
+		data, err := e.Marshal()
+		if err != nil {
+			return err
+		}
+
+		newPos, err := s.writeRecord(recordEntry, shardID, first+uint64(idx), pos.term, data)
+		if err != nil {
+			return err
+		}
+		s.applyEntry(shardID, first+uint64(idx), newPos)
+	}
+
+	logger.Debugf("shard %d rewrite %d entries to segment %d",
+		shardID,
+		len(entries),
+		s.activeID)
+	return nil
+}
+
+func (s *Storage) replay() error {
+	infos, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.segments = append(s.segments, id)
+	}
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i] < s.segments[j] })
+
+	for idx, id := range s.segments {
+		f, err := os.OpenFile(s.segmentFile(id), os.O_RDWR, 0644)
+		if err != nil {
+			return err
+		}
+		s.files[id] = f
+
+		size, err := s.replaySegment(id, f)
+		if err != nil {
+			if idx != len(s.segments)-1 {
+				return fmt.Errorf("segment %d is corrupted at %d: %+v", id, size, err)
+			}
+
+			// the records after the torn write at the tail are never synced
+			logger.Warningf("segment %d is truncated to %d, %+v", id, size, err)
+			if err := f.Truncate(size); err != nil {
+				return err
+			}
+		}
+
+		if _, err := f.Seek(size, io.SeekStart); err != nil {
+			return err
+		}
+		s.activeSize = size
+	}
+
+	return nil
+}
+
+// replaySegment applies the records in the segment, and returns the size of the valid records
+func (s *Storage) replaySegment(id uint64, f *os.File) (int64, error) {
+	s.activeID = id
+	r := bufio.NewReaderSize(f, writeBufferSize)
+	header := make([]byte, headerSize)
+	var offset int64
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				return offset, nil
+			}
+			return offset, err
+		}
+
+		size := binary.BigEndian.Uint32(header)
+		if size < metaSize {
+			return offset, fmt.Errorf("invalid record size %d", size)
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return offset, err
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			return offset, errors.New("checksum mismatch")
+		}
+
+		shardID := binary.BigEndian.Uint64(payload[1:])
+		index := binary.BigEndian.Uint64(payload[9:])
+		term := binary.BigEndian.Uint64(payload[17:])
+		switch payload[0] {
+		case recordEntry:
+			s.applyEntry(shardID, index, position{
+				segment: id,
+				offset:  offset + headerSize + metaSize,
+				size:    size - metaSize,
+				term:    term,
+			})
+		case recordCompact:
+			s.applyCompact(shardID, index)
+		case recordRemove:
+			delete(s.shards, shardID)
+		default:
+			return offset, fmt.Errorf("invalid record type %d", payload[0])
+		}
+
+		offset += int64(headerSize + size)
+	}
+}
+
+func (s *Storage) segmentFile(id uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", id, segmentSuffix))
+}
+
+func (s *Storage) closeFiles() {
+	for id, f := range s.files {
+		if err := f.Close(); err != nil {
+			logger.Errorf("close segment %d failed with %+v", id, err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package raftlog
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+var (
+	tmpDir = "/tmp/cube/storage/raftlog"
+)
+
+func TestAppendAndEntries(t *testing.T) {
+	s := newTestStorage(t, Options{})
+	defer s.Close()
+
+	assert.NoError(t, s.Append(1, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Append(2, newTestEntries(5, 6, 1)))
+	assert.NoError(t, s.Sync())
+
+	ents, err := s.Entries(1, 1, 11, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 10, 1), ents)
+
+	ents, err = s.Entries(1, 3, 5, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ents), "at least one entry is returned")
+
+	_, err = s.Entries(1, 0, 2, math.MaxUint64)
+	assert.Equal(t, raft.ErrUnavailable, err)
+	_, err = s.Entries(1, 10, 12, math.MaxUint64)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	term, err := s.Term(2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), term)
+	_, err = s.Term(2, 11)
+	assert.Equal(t, raft.ErrUnavailable, err)
+}
+
+func TestAppendConflictEntries(t *testing.T) {
+	s := newTestStorage(t, Options{})
+	defer s.Close()
+
+	assert.NoError(t, s.Append(1, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Append(1, newTestEntries(6, 2, 2)))
+
+	ents, err := s.Entries(1, 1, 8, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, append(newTestEntries(1, 5, 1), newTestEntries(6, 2, 2)...), ents)
+	_, err = s.Term(1, 8)
+	assert.Equal(t, raft.ErrUnavailable, err)
+}
+
+func TestCompactAndRemove(t *testing.T) {
+	s := newTestStorage(t, Options{})
+	defer s.Close()
+
+	assert.NoError(t, s.Append(1, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Append(2, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Compact(1, 6))
+
+	_, err := s.Term(1, 5)
+	assert.Equal(t, raft.ErrUnavailable, err)
+	ents, err := s.Entries(1, 6, 11, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(6, 5, 1), ents)
+
+	// a new log after the snapshot
+	assert.NoError(t, s.Compact(1, 21))
+	assert.NoError(t, s.Append(1, newTestEntries(21, 2, 2)))
+	ents, err = s.Entries(1, 21, 23, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(21, 2, 2), ents)
+
+	assert.NoError(t, s.Remove(2))
+	_, err = s.Term(2, 1)
+	assert.Equal(t, raft.ErrUnavailable, err)
+}
+
+func TestReplay(t *testing.T) {
+	s := newTestStorage(t, Options{})
+	assert.NoError(t, s.Append(1, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Append(1, newTestEntries(8, 2, 2)))
+	assert.NoError(t, s.Append(2, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Append(3, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Compact(2, 4))
+	assert.NoError(t, s.Remove(3))
+	assert.NoError(t, s.Close())
+
+	s, err := NewStorage(tmpDir)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	ents, err := s.Entries(1, 1, 10, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, append(newTestEntries(1, 7, 1), newTestEntries(8, 2, 2)...), ents)
+	_, err = s.Term(1, 10)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	_, err = s.Term(2, 3)
+	assert.Equal(t, raft.ErrUnavailable, err)
+	ents, err = s.Entries(2, 4, 11, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(4, 7, 1), ents)
+
+	_, err = s.Term(3, 1)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	// continue to append after replayed
+	assert.NoError(t, s.Append(1, newTestEntries(10, 1, 2)))
+	ents, err = s.Entries(1, 9, 11, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(9, 2, 2), ents)
+}
+
+func TestReplayWithTornWrite(t *testing.T) {
+	s := newTestStorage(t, Options{})
+	assert.NoError(t, s.Append(1, newTestEntries(1, 10, 1)))
+	assert.NoError(t, s.Close())
+
+	file := s.segmentFile(1)
+	info, err := os.Stat(file)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(file, info.Size()-1))
+
+	s, err = NewStorage(tmpDir)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	ents, err := s.Entries(1, 1, 10, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 9, 1), ents)
+	_, err = s.Term(1, 10)
+	assert.Equal(t, raft.ErrUnavailable, err)
+
+	assert.NoError(t, s.Append(1, newTestEntries(10, 1, 1)))
+	ents, err = s.Entries(1, 1, 11, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 10, 1), ents)
+}
+
+func TestDropSegments(t *testing.T) {
+	s := newTestStorage(t, Options{SegmentSize: 1024, RewriteSegments: 2})
+
+	// shard 2 is never compacted, its entries are rewritten to the new segments
+	assert.NoError(t, s.Append(2, newTestEntries(1, 2, 1)))
+	for i := uint64(0); i < 50; i++ {
+		assert.NoError(t, s.Append(1, newTestEntries(i*10+1, 10, 1)))
+		assert.NoError(t, s.Compact(1, i*10+1))
+	}
+	assert.True(t, s.activeID > 10, "active segment %d", s.activeID)
+	assert.True(t, len(s.segments) <= 4, "segments %+v", s.segments)
+
+	assert.NoError(t, s.Close())
+	s, err := NewStorage(tmpDir)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	ents, err := s.Entries(1, 491, 501, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(491, 10, 1), ents)
+	ents, err = s.Entries(2, 1, 3, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, newTestEntries(1, 2, 1), ents)
+}
+
+func newTestStorage(t *testing.T, opts Options) *Storage {
+	os.RemoveAll(tmpDir)
+	s, err := NewStorageWithOptions(tmpDir, opts)
+	assert.NoError(t, err)
+	return s
+}
+
+func newTestEntries(first uint64, n int, term uint64) []raftpb.Entry {
+	var ents []raftpb.Entry
+	for i := 0; i < n; i++ {
+		ents = append(ents, raftpb.Entry{
+			Index: first + uint64(i),
+			Term:  term,
+			Data:  []byte("data"),
+		})
+	}
+	return ents
+}
@@ -37,7 +37,9 @@ type KVStorage interface {
 
 	// Set put the key, value pair to the storage
 	Set(key []byte, value []byte) error
-	// SetWithTTL put the key, value pair to the storage with a ttl in seconds
+	// SetWithTTL put the key, value pair to the storage with a ttl in seconds, the expire time is
+	// based on the current time of the storage. The replicated writes should use the WriteBatch
+	// with the Timestamp, so that all the replicas have the same expire time.
 	SetWithTTL(key []byte, value []byte, ttl int32) error
 	// Get returns the value of the key
 	Get(key []byte) ([]byte, error)
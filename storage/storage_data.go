@@ -29,8 +29,18 @@ type DataStorage interface {
 	// SplitCheck Find a key from [start, end), so that the sum of bytes of the value of [start, key) <=size,
 	// returns the current bytes in [start,end), and the founded key
 	SplitCheck(start []byte, end []byte, size uint64) (currentSize uint64, currentKeys uint64, splitKeys [][]byte, err error)
+	// ApproximateSplitKey returns a key close to the middle of [start, end) by the size estimates of
+	// the storage without scanning the data, returns nil if the storage can not estimate it
+	ApproximateSplitKey(start []byte, end []byte) ([]byte, error)
 	// CreateSnapshot create a snapshot file under the giving path
 	CreateSnapshot(path string, start, end []byte) error
 	// ApplySnapshot apply a snapshort file from giving path
 	ApplySnapshot(path string) error
+	// ComputeHash returns the hash of all the key-value pairs in [start, end), which is used
+	// to check the consistency between the replicas of a shard
+	ComputeHash(start []byte, end []byte) ([]byte, error)
+	// PrepareComputeHash takes a snapshot of [start, end), and returns a function to compute the
+	// hash of the snapshot, which can be called in the other goroutine without blocking the
+	// following writes. The function must be called once to release the snapshot.
+	PrepareComputeHash(start []byte, end []byte) (func() ([]byte, error), error)
 }
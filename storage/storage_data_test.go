@@ -208,3 +208,60 @@ func TestCreateAndApply(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeHash(t *testing.T) {
+	for name, factory := range dataDactories {
+		t.Run(name, func(t *testing.T) {
+			s1 := factory(t)
+			kv1 := s1.(KVStorage)
+
+			s2 := factory(t)
+			kv2 := s2.(KVStorage)
+
+			start := []byte("k1")
+			end := []byte("k3")
+
+			assert.NoError(t, kv1.Set([]byte("k1"), []byte("v1")), "TestComputeHash failed")
+			assert.NoError(t, kv1.Set([]byte("k2"), []byte("v2")), "TestComputeHash failed")
+			assert.NoError(t, kv1.Set([]byte("k3"), []byte("v3")), "TestComputeHash failed")
+
+			assert.NoError(t, kv2.Set([]byte("k1"), []byte("v1")), "TestComputeHash failed")
+			assert.NoError(t, kv2.Set([]byte("k2"), []byte("v2")), "TestComputeHash failed")
+
+			h1, err := s1.ComputeHash(start, end)
+			assert.NoError(t, err, "TestComputeHash failed")
+			h2, err := s2.ComputeHash(start, end)
+			assert.NoError(t, err, "TestComputeHash failed")
+			assert.Equal(t, h1, h2, "TestComputeHash failed")
+
+			assert.NoError(t, kv2.Set([]byte("k2"), []byte("v22")), "TestComputeHash failed")
+			h2, err = s2.ComputeHash(start, end)
+			assert.NoError(t, err, "TestComputeHash failed")
+			assert.NotEqual(t, h1, h2, "TestComputeHash failed")
+		})
+	}
+}
+
+func TestPrepareComputeHash(t *testing.T) {
+	for name, factory := range dataDactories {
+		t.Run(name, func(t *testing.T) {
+			s := factory(t)
+			kv := s.(KVStorage)
+
+			start := []byte("k1")
+			end := []byte("k3")
+
+			assert.NoError(t, kv.Set([]byte("k1"), []byte("v1")), "TestPrepareComputeHash failed")
+			h1, err := s.ComputeHash(start, end)
+			assert.NoError(t, err, "TestPrepareComputeHash failed")
+
+			// the writes after prepared are not included
+			compute, err := s.PrepareComputeHash(start, end)
+			assert.NoError(t, err, "TestPrepareComputeHash failed")
+			assert.NoError(t, kv.Set([]byte("k2"), []byte("v2")), "TestPrepareComputeHash failed")
+			h2, err := compute()
+			assert.NoError(t, err, "TestPrepareComputeHash failed")
+			assert.Equal(t, h1, h2, "TestPrepareComputeHash failed")
+		})
+	}
+}
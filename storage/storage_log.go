@@ -0,0 +1,43 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+// LogStorage the storage to save the raft log entries of all the shards. The raft hard state
+// and the apply state are still saved in the MetadataStorage, the entries must be persisted
+// before the hard state which refers them.
+type LogStorage interface {
+	CloseableStorage
+
+	// Append appends the entries of the shard. The existing entries with the index greater than
+	// the last appended entry were never committed, they are removed. The entries are persisted
+	// after the Sync returned.
+	Append(shardID uint64, entries []raftpb.Entry) error
+	// Sync persists the appended entries of all the shards, the concurrent calls are merged into
+	// one fsync.
+	Sync() error
+	// Entries returns the entries of the shard in [low, high), the total size of the entries is
+	// limited by maxSize, but at least one entry is returned. It returns raft.ErrUnavailable if
+	// any entry in the range is missing.
+	Entries(shardID uint64, low, high, maxSize uint64) ([]raftpb.Entry, error)
+	// Term returns the term of the entry, it returns raft.ErrUnavailable if the entry is missing.
+	Term(shardID uint64, index uint64) (uint64, error)
+	// Compact removes the entries of the shard with the index less than the given index
+	Compact(shardID uint64, index uint64) error
+	// Remove removes all the entries of the shard
+	Remove(shardID uint64) error
+}
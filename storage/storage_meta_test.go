@@ -163,10 +163,6 @@ func TestSetAndMGet(t *testing.T) {
 func TestSetAndGetWithTTL(t *testing.T) {
 	for name, factory := range factories {
 		t.Run(name, func(t *testing.T) {
-			if name == "pebble" {
-				return
-			}
-
 			s := factory(t)
 			key1 := []byte("k1")
 			value1 := []byte("v1")
@@ -196,10 +192,6 @@ func TestSetAndGetWithTTL(t *testing.T) {
 func TestWritebatchWithTTL(t *testing.T) {
 	for name, factory := range factories {
 		t.Run(name, func(t *testing.T) {
-			if name == "pebble" {
-				return
-			}
-
 			s := factory(t)
 
 			key1 := []byte("k1")
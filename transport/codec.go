@@ -27,8 +27,22 @@ const (
 	typeRaft = 1
 	typeSnap = 2
 	typeAck  = 3
+	// typeHello the handshake of the new connection, the sender proposes the compression
+	// and the receiver replies with the accepted compression
+	typeHello = 4
+	// typeRaftBatch the compressed raft message batch
+	typeRaftBatch = 5
 )
 
+type hello struct {
+	compression Compression
+}
+
+type raftBatch struct {
+	compression Compression
+	data        []byte
+}
+
 type raftDecoder struct {
 }
 
@@ -62,6 +76,26 @@ func (decoder raftDecoder) Decode(in *buf.ByteBuf) (bool, interface{}, error) {
 		protoc.MustUnmarshal(msg, data)
 		in.MarkedBytesReaded()
 		return true, msg, nil
+	case typeHello:
+		in.MarkedBytesReaded()
+		if len(data) != 1 {
+			return false, nil, fmt.Errorf("invalid hello message with %d bytes", len(data))
+		}
+		return true, &hello{compression: Compression(data[0])}, nil
+	case typeRaftBatch:
+		in.MarkedBytesReaded()
+		if len(data) == 0 {
+			return false, nil, fmt.Errorf("missing compression of the raft message batch")
+		}
+		value, err := Compression(data[0]).decompress(data[1:])
+		if err != nil {
+			return false, nil, err
+		}
+		msg := &bhraftpb.RaftMessageBatch{}
+		if err := msg.Unmarshal(value); err != nil {
+			return false, nil, err
+		}
+		return true, msg, nil
 	}
 
 	return false, nil, fmt.Errorf("[matrixcube]: bug, not support msg type %d", t)
@@ -71,7 +105,16 @@ func (e raftEncoder) Encode(data interface{}, out *buf.ByteBuf) error {
 	t := typeRaft
 	var m protoc.PB
 
-	if v, ok := data.(*bhraftpb.RaftMessage); ok {
+	if v, ok := data.(*hello); ok {
+		out.WriteByte(byte(typeHello))
+		out.WriteByte(byte(v.compression))
+		return nil
+	} else if v, ok := data.(*raftBatch); ok {
+		out.WriteByte(byte(typeRaftBatch))
+		out.WriteByte(byte(v.compression))
+		out.Write(v.data)
+		return nil
+	} else if v, ok := data.(*bhraftpb.RaftMessage); ok {
 		t = typeRaft
 		m = v
 	} else if v, ok := data.(*bhraftpb.SnapshotMessage); ok {
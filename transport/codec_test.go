@@ -0,0 +1,81 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec/length"
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompression(t *testing.T) {
+	c, err := ParseCompression("")
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionNone, c)
+
+	c, err = ParseCompression("Snappy")
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionSnappy, c)
+	assert.Equal(t, "snappy", c.String())
+
+	_, err = ParseCompression("zip")
+	assert.Error(t, err)
+}
+
+func TestCodecWithCompression(t *testing.T) {
+	encoder, decoder := length.New(newRaftEncoder(), newRaftDecoder())
+	decode := func(value interface{}) (interface{}, error) {
+		out := buf.NewByteBuf(32)
+		assert.NoError(t, encoder.Encode(value, out))
+		complete, msg, err := decoder.Decode(out)
+		if err == nil {
+			assert.True(t, complete)
+		}
+		return msg, err
+	}
+
+	msg, err := decode(&hello{compression: CompressionSnappy})
+	assert.NoError(t, err)
+	assert.Equal(t, &hello{compression: CompressionSnappy}, msg)
+
+	batch := &bhraftpb.RaftMessageBatch{}
+	for i := uint64(1); i <= 10; i++ {
+		batch.Messages = append(batch.Messages, &bhraftpb.RaftMessage{ShardID: i,
+			Start: bytes.Repeat([]byte("a"), 1024)})
+	}
+	data := protoc.MustMarshal(batch)
+	for _, c := range []Compression{CompressionNone, CompressionSnappy} {
+		value := c.compress(data)
+		if c != CompressionNone {
+			assert.True(t, len(value) < len(data))
+		}
+		msg, err = decode(&raftBatch{compression: c, data: value})
+		assert.NoError(t, err)
+		assert.Equal(t, batch, msg)
+	}
+
+	// the raft message without compression
+	msg, err = decode(batch.Messages[0])
+	assert.NoError(t, err)
+	assert.Equal(t, batch.Messages[0].ShardID, msg.(*bhraftpb.RaftMessage).ShardID)
+
+	// corrupted data
+	_, err = decode(&raftBatch{compression: CompressionSnappy, data: data})
+	assert.Error(t, err)
+}
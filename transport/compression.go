@@ -0,0 +1,83 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/snappy"
+)
+
+// Compression the compression of the raft message batches
+type Compression byte
+
+const (
+	// CompressionNone the raft messages are not compressed
+	CompressionNone Compression = 0
+	// CompressionSnappy the raft message batches are compressed by snappy
+	CompressionSnappy Compression = 1
+)
+
+var (
+	compressionNames = map[Compression]string{
+		CompressionNone:   "none",
+		CompressionSnappy: "snappy",
+	}
+)
+
+// ParseCompression returns the compression by name, empty name means none
+func ParseCompression(name string) (Compression, error) {
+	name = strings.ToLower(name)
+	if name == "" {
+		return CompressionNone, nil
+	}
+
+	for c, value := range compressionNames {
+		if value == name {
+			return c, nil
+		}
+	}
+	return CompressionNone, fmt.Errorf("not support compression %s", name)
+}
+
+func (c Compression) String() string {
+	if name, ok := compressionNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", c)
+}
+
+func (c Compression) supported() bool {
+	_, ok := compressionNames[c]
+	return ok
+}
+
+func (c Compression) compress(data []byte) []byte {
+	switch c {
+	case CompressionSnappy:
+		return snappy.Encode(nil, data)
+	}
+	return data
+}
+
+func (c Compression) decompress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionSnappy:
+		return snappy.Decode(nil, data)
+	}
+	return nil, fmt.Errorf("not support compression %s", c)
+}
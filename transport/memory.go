@@ -0,0 +1,409 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/util/protoc"
+	"github.com/fagongzi/util/task"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/snapshot"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+var (
+	errPartitioned = errors.New("network partitioned")
+	errDropped     = errors.New("message dropped")
+)
+
+// MessageType the type of the messages sent by the transport
+type MessageType int
+
+const (
+	// MessageTypeRaft the raft messages except the snapshots
+	MessageTypeRaft MessageType = iota
+	// MessageTypeSnapshot the snapshot messages with the snapshot data
+	MessageTypeSnapshot
+)
+
+// Fault the fault injected to the messages of the MemoryNetwork
+type Fault struct {
+	// Type the type of the messages
+	Type MessageType
+	// From and To are the store ids of the messages, 0 matches all the stores
+	From, To uint64
+	// DropRate the probability that the message is dropped
+	DropRate float64
+	// DuplicateRate the probability that the message is delivered twice, only the raft message
+	// is duplicated for the snapshot, the snapshot data is sent once.
+	DuplicateRate float64
+	// DelayRate the probability that the message is delayed
+	DelayRate float64
+	// Delay how long the delayed message is delayed
+	Delay time.Duration
+}
+
+func (f Fault) match(msgType MessageType, from, to uint64) bool {
+	return f.Type == msgType &&
+		(f.From == 0 || f.From == from) &&
+		(f.To == 0 || f.To == to)
+}
+
+// MemoryNetwork the in-process network connects the stores with the memory transports, and injects
+// the partitions and the faults to the messages. The faults are decided by the random source with
+// the given seed, so that the same sequence of the messages meets the same faults.
+type MemoryNetwork struct {
+	sync.Mutex
+	rnd        *rand.Rand
+	transports map[uint64]*memoryTransport
+	partitions map[uint64]int
+	faults     []Fault
+}
+
+// NewMemoryNetwork returns a memory network with the seed of the fault injection
+func NewMemoryNetwork(seed int64) *MemoryNetwork {
+	return &MemoryNetwork{
+		rnd:        rand.New(rand.NewSource(seed)),
+		transports: make(map[uint64]*memoryTransport),
+		partitions: make(map[uint64]int),
+	}
+}
+
+// NewTransport returns a transport of the store connected to the network, only the error handler
+// option is used. The dropped messages are reported to the error handler if the store is partitioned
+// or the snapshot is dropped, the other dropped raft messages are lost silently.
+func (n *MemoryNetwork) NewTransport(storeID uint64, snapMgr snapshot.SnapshotManager, handler MessageHandler, opts ...Option) Transport {
+	t := &memoryTransport{
+		opts:     &options{},
+		network:  n,
+		storeID:  storeID,
+		snapMgr:  snapMgr,
+		handler:  handler,
+		raftMsgs: &task.Queue{},
+		snapMsgs: &task.Queue{},
+	}
+
+	for _, opt := range opts {
+		opt(t.opts)
+	}
+
+	return t
+}
+
+// Partition splits the stores into the groups, the stores in the different groups can not send
+// messages to each other. The stores not in any group are in the same group.
+func (n *MemoryNetwork) Partition(groups ...[]uint64) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.partitions = make(map[uint64]int)
+	for idx, stores := range groups {
+		for _, id := range stores {
+			n.partitions[id] = idx + 1
+		}
+	}
+}
+
+// AddFault adds a fault to the messages, all the matched faults are applied to each message
+func (n *MemoryNetwork) AddFault(fault Fault) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.faults = append(n.faults, fault)
+}
+
+// Heal removes all the partitions and the faults
+func (n *MemoryNetwork) Heal() {
+	n.Lock()
+	defer n.Unlock()
+
+	n.partitions = make(map[uint64]int)
+	n.faults = nil
+}
+
+// route returns the target transport of the message, the copies to deliver and the delay
+func (n *MemoryNetwork) route(msgType MessageType, from, to uint64) (*memoryTransport, int, time.Duration, error) {
+	n.Lock()
+	defer n.Unlock()
+
+	t, ok := n.transports[to]
+	if !ok || n.partitions[from] != n.partitions[to] {
+		return nil, 0, 0, errPartitioned
+	}
+
+	copies := 1
+	var delay time.Duration
+	for _, f := range n.faults {
+		if !f.match(msgType, from, to) {
+			continue
+		}
+
+		if n.rnd.Float64() < f.DropRate {
+			return nil, 0, 0, errDropped
+		}
+		if n.rnd.Float64() < f.DuplicateRate {
+			copies = 2
+		}
+		if n.rnd.Float64() < f.DelayRate && f.Delay > delay {
+			delay = f.Delay
+		}
+	}
+
+	return t, copies, delay, nil
+}
+
+func (n *MemoryNetwork) register(t *memoryTransport) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.transports[t.storeID] = t
+}
+
+func (n *MemoryNetwork) deregister(t *memoryTransport) {
+	n.Lock()
+	defer n.Unlock()
+
+	if n.transports[t.storeID] == t {
+		delete(n.transports, t.storeID)
+	}
+}
+
+type memoryTransport struct {
+	opts     *options
+	network  *MemoryNetwork
+	storeID  uint64
+	snapMgr  snapshot.SnapshotManager
+	handler  MessageHandler
+	raftMsgs *task.Queue
+	snapMsgs *task.Queue
+	stopped  uint32
+}
+
+func (t *memoryTransport) Start() {
+	go t.readyToSendRaft()
+	go t.readyToSendSnapshots()
+	t.network.register(t)
+}
+
+func (t *memoryTransport) Stop() {
+	atomic.StoreUint32(&t.stopped, 1)
+	t.network.deregister(t)
+	t.raftMsgs.Dispose()
+	t.snapMsgs.Dispose()
+	logger.Infof("memory transfer stopped")
+}
+
+func (t *memoryTransport) Send(msg *bhraftpb.RaftMessage) {
+	if msg.To.ContainerID == t.storeID {
+		t.handler(msg)
+		return
+	}
+
+	if msg.Message.Type == raftpb.MsgSnap {
+		t.snapMsgs.Put(msg)
+		return
+	}
+
+	t.raftMsgs.Put(msg)
+}
+
+func (t *memoryTransport) SendingSnapshotCount() uint64 {
+	return uint64(t.snapMsgs.Len())
+}
+
+func (t *memoryTransport) readyToSendRaft() {
+	items := make([]interface{}, 64)
+	for {
+		n, err := t.raftMsgs.Get(int64(len(items)), items)
+		if err != nil {
+			logger.Infof("memory send raft worker stopped")
+			return
+		}
+
+		for i := int64(0); i < n; i++ {
+			msg := items[i].(*bhraftpb.RaftMessage)
+			to, copies, delay, err := t.network.route(MessageTypeRaft, t.storeID, msg.To.ContainerID)
+			if err != nil {
+				if err == errPartitioned {
+					t.postSend(msg, err)
+				}
+				pb.ReleaseRaftMessage(msg)
+				continue
+			}
+
+			t.deliver(to, msg, copies, delay)
+			pb.ReleaseRaftMessage(msg)
+		}
+	}
+}
+
+func (t *memoryTransport) readyToSendSnapshots() {
+	items := make([]interface{}, 1)
+	for {
+		_, err := t.snapMsgs.Get(1, items)
+		if err != nil {
+			logger.Infof("memory send snapshot worker stopped")
+			return
+		}
+
+		msg := items[0].(*bhraftpb.RaftMessage)
+		to, copies, delay, err := t.network.route(MessageTypeSnapshot, t.storeID, msg.To.ContainerID)
+		if err == nil {
+			if delay > 0 {
+				time.Sleep(delay)
+			}
+			err = t.sendSnapshot(to, msg)
+		}
+		if err != nil {
+			t.postSend(msg, err)
+			pb.ReleaseRaftMessage(msg)
+			continue
+		}
+
+		// the snapshot data is received before the raft message
+		t.deliver(to, msg, copies, 0)
+		pb.ReleaseRaftMessage(msg)
+	}
+}
+
+func (t *memoryTransport) sendSnapshot(to *memoryTransport, msg *bhraftpb.RaftMessage) error {
+	snapMsg := &bhraftpb.SnapshotMessage{}
+	protoc.MustUnmarshal(snapMsg, msg.Message.Snapshot.Data)
+	snapMsg.Header.From = msg.From
+	snapMsg.Header.To = msg.To
+
+	if t.snapMgr.Register(snapMsg, snapshot.Sending) {
+		defer t.snapMgr.Deregister(snapMsg, snapshot.Sending)
+
+		_, err := t.snapMgr.WriteTo(snapMsg, &memorySession{to: to})
+		return err
+	}
+
+	return nil
+}
+
+// deliver sends the copies of the message to the target transport as sent through the wire
+func (t *memoryTransport) deliver(to *memoryTransport, msg *bhraftpb.RaftMessage, copies int, delay time.Duration) {
+	data := protoc.MustMarshal(msg)
+	for i := 0; i < copies; i++ {
+		m := pb.AcquireRaftMessage()
+		protoc.MustUnmarshal(m, data)
+		if delay > 0 {
+			time.AfterFunc(delay, func() { to.receive(m) })
+			continue
+		}
+		to.receive(m)
+	}
+}
+
+// receive handles the message received from the network, the message is lost if the transport
+// is stopped
+func (t *memoryTransport) receive(msg interface{}) {
+	if atomic.LoadUint32(&t.stopped) == 0 {
+		t.handler(msg)
+	}
+}
+
+func (t *memoryTransport) postSend(msg *bhraftpb.RaftMessage, err error) {
+	logger.Debugf("shard %d memory send msg from %d to %d failed with %+v",
+		msg.ShardID,
+		msg.From.ID,
+		msg.To.ID,
+		err)
+	if t.opts.errorHandlerFunc != nil {
+		t.opts.errorHandlerFunc(msg, err)
+	}
+}
+
+// memorySession the session used to write the snapshot data to the target transport
+type memorySession struct {
+	to    *memoryTransport
+	attrs sync.Map
+}
+
+func (s *memorySession) ID() uint64 {
+	return 0
+}
+
+func (s *memorySession) Connect(addr string, timeout time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (s *memorySession) Close() error {
+	return nil
+}
+
+func (s *memorySession) Connected() bool {
+	return true
+}
+
+func (s *memorySession) Read() (interface{}, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *memorySession) Flush() error {
+	return nil
+}
+
+func (s *memorySession) InBuf() *buf.ByteBuf {
+	return nil
+}
+
+func (s *memorySession) OutBuf() *buf.ByteBuf {
+	return nil
+}
+
+func (s *memorySession) SetAttr(key string, value interface{}) {
+	s.attrs.Store(key, value)
+}
+
+func (s *memorySession) RemoteAddr() string {
+	return ""
+}
+
+func (s *memorySession) RemoteIP() string {
+	return ""
+}
+
+func (s *memorySession) GetAttr(key string) interface{} {
+	v, _ := s.attrs.Load(key)
+	return v
+}
+
+func (s *memorySession) Write(msg interface{}) error {
+	return s.WriteAndFlush(msg)
+}
+
+// WriteAndFlush delivers a copy of the snapshot chunk, the buffer of the chunk is reused by the sender
+func (s *memorySession) WriteAndFlush(msg interface{}) error {
+	m, ok := msg.(*bhraftpb.SnapshotMessage)
+	if !ok {
+		return errors.New("not supported")
+	}
+
+	chunk := &bhraftpb.SnapshotMessage{}
+	protoc.MustUnmarshal(chunk, protoc.MustMarshal(m))
+	s.to.receive(chunk)
+	return nil
+}
+
+var _ goetty.IOSession = (*memorySession)(nil)
@@ -0,0 +1,108 @@
+// Copyright 2021 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/raft/raftpb"
+)
+
+func TestMemoryNetwork(t *testing.T) {
+	n := NewMemoryNetwork(1)
+	received := make(chan interface{}, 16)
+	// the failed message is released after handled
+	failed := make(chan uint64, 16)
+	t1 := n.NewTransport(1, nil, func(msg interface{}) {}, WithErrorHandler(func(msg *bhraftpb.RaftMessage, err error) {
+		failed <- msg.ShardID
+	}))
+	t2 := n.NewTransport(2, nil, func(msg interface{}) { received <- msg })
+	t1.Start()
+	defer t1.Stop()
+	t2.Start()
+	defer t2.Stop()
+
+	send := func(shardID uint64) {
+		msg := pb.AcquireRaftMessage()
+		msg.ShardID = shardID
+		msg.To.ContainerID = 2
+		msg.Message.Type = raftpb.MsgHeartbeat
+		t1.Send(msg)
+	}
+
+	send(1)
+	assert.Equal(t, uint64(1), waitMemoryMessage(t, received).ShardID)
+
+	// the partitioned messages are reported
+	n.Partition([]uint64{1}, []uint64{2})
+	send(2)
+	assert.Equal(t, uint64(2), <-failed)
+	n.Heal()
+	send(3)
+	assert.Equal(t, uint64(3), waitMemoryMessage(t, received).ShardID)
+
+	// the dropped raft messages are lost silently
+	n.AddFault(Fault{Type: MessageTypeRaft, DropRate: 1})
+	send(4)
+	n.Heal()
+	send(5)
+	assert.Equal(t, uint64(5), waitMemoryMessage(t, received).ShardID)
+	assert.Empty(t, failed)
+
+	n.AddFault(Fault{Type: MessageTypeRaft, DuplicateRate: 1})
+	send(6)
+	assert.Equal(t, uint64(6), waitMemoryMessage(t, received).ShardID)
+	assert.Equal(t, uint64(6), waitMemoryMessage(t, received).ShardID)
+	n.Heal()
+
+	n.AddFault(Fault{Type: MessageTypeRaft, To: 2, DelayRate: 1, Delay: time.Millisecond * 200})
+	start := time.Now()
+	send(7)
+	assert.Equal(t, uint64(7), waitMemoryMessage(t, received).ShardID)
+	assert.True(t, time.Since(start) >= time.Millisecond*200)
+}
+
+func TestMemoryNetworkWithSeed(t *testing.T) {
+	decisions := func(seed int64) []bool {
+		n := NewMemoryNetwork(seed)
+		tr := n.NewTransport(2, nil, nil)
+		tr.Start()
+		defer tr.Stop()
+		n.AddFault(Fault{Type: MessageTypeRaft, DropRate: 0.5})
+
+		var values []bool
+		for i := 0; i < 100; i++ {
+			_, _, _, err := n.route(MessageTypeRaft, 1, 2)
+			values = append(values, err == nil)
+		}
+		return values
+	}
+
+	assert.Equal(t, decisions(1), decisions(1))
+	assert.NotEqual(t, decisions(1), decisions(2))
+}
+
+func waitMemoryMessage(t *testing.T, c chan interface{}) *bhraftpb.RaftMessage {
+	select {
+	case msg := <-c:
+		return msg.(*bhraftpb.RaftMessage)
+	case <-time.After(time.Second * 5):
+		assert.FailNow(t, "timeout wait message")
+	}
+	return nil
+}
@@ -16,20 +16,27 @@ package transport
 import (
 	"time"
 
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/matrixorigin/matrixcube/util"
+	"golang.org/x/time/rate"
 )
 
 // Option transport option
 type Option func(*options)
 
 type options struct {
-	maxBodySize      int
-	readTimeout      time.Duration
-	writeTimeout     time.Duration
-	sendBatch        int64
-	raftWorkerCount  uint64
-	snapWorkerCount  uint64
-	errorHandlerFunc func(*bhraftpb.RaftMessage, error)
+	maxBodySize       int
+	readTimeout       time.Duration
+	writeTimeout      time.Duration
+	sendBatch         int64
+	raftWorkerCount   uint64
+	snapWorkerCount   uint64
+	errorHandlerFunc  func(*bhraftpb.RaftMessage, error)
+	security          tlsutil.Config
+	compression       Compression
+	compressThreshold int
+	receiveLimiter    *rate.Limiter
 }
 
 // WithTimeout set read and write timeout for rpc
@@ -68,3 +75,29 @@ func WithErrorHandler(value func(*bhraftpb.RaftMessage, error)) Option {
 		opts.errorHandlerFunc = value
 	}
 }
+
+// WithSecurity set the TLS config of the listener and the dialers
+func WithSecurity(value tlsutil.Config) Option {
+	return func(opts *options) {
+		opts.security = value
+	}
+}
+
+// WithCompression set the compression of the raft message batches, the batches smaller than
+// the threshold are not compressed. The compression is used only if the receiver accepts it
+// in the handshake.
+func WithCompression(value Compression, threshold int) Option {
+	return func(opts *options) {
+		opts.compression = value
+		opts.compressThreshold = threshold
+	}
+}
+
+// WithSnapshotReceiveLimit set the max bytes per second of the received snapshot chunks, 0 means
+// no limit. The connection is not read while throttled, so the sender is slowed down by the tcp
+// flow control.
+func WithSnapshotReceiveLimit(bytesPerSecond, chunkSize uint64) Option {
+	return func(opts *options) {
+		opts.receiveLimiter = util.NewBytesLimiter(bytesPerSecond, chunkSize)
+	}
+}
@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -14,10 +15,12 @@ import (
 	"github.com/fagongzi/util/protoc"
 	"github.com/fagongzi/util/task"
 	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
 	"github.com/matrixorigin/matrixcube/metric"
 	"github.com/matrixorigin/matrixcube/pb"
 	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
 	"github.com/matrixorigin/matrixcube/snapshot"
+	"github.com/matrixorigin/matrixcube/util"
 	"go.etcd.io/etcd/raft/raftpb"
 )
 
@@ -29,6 +32,12 @@ var (
 	errConnect = errors.New("not connected")
 )
 
+const (
+	attrCompression = "compression"
+	// helloRetryInterval the interval to retry the handshake with the store rejected the hello
+	helloRetryInterval = time.Minute * 10
+)
+
 // Transport raft transport
 type Transport interface {
 	// Start start the transport, receiving and sending messages
@@ -64,6 +73,8 @@ type defaultTransport struct {
 	raftMask    uint64
 	snapMsgs    []*task.Queue
 	snapMask    uint64
+	// noCompression store id -> the time of the rejected handshake
+	noCompression sync.Map
 }
 
 // NewDefaultTransport create  default transport
@@ -90,7 +101,7 @@ func NewDefaultTransport(
 	baseEncoder := newRaftEncoder()
 	baseDecoder := newRaftDecoder()
 	t.encoder, t.decoder = length.NewWithSize(baseEncoder, baseDecoder, 0, 0, 0, t.opts.maxBodySize)
-	app, err := goetty.NewTCPApplication(addr, t.onMessage,
+	app, err := tlsutil.NewTCPApplication(t.opts.security, addr, t.onMessage,
 		goetty.WithAppSessionOptions(goetty.WithCodec(t.encoder, t.decoder),
 			goetty.WithTimeout(t.opts.readTimeout, t.opts.writeTimeout),
 			goetty.WithLogger(logger),
@@ -175,10 +186,36 @@ func (t *defaultTransport) Send(msg *bhraftpb.RaftMessage) {
 }
 
 func (t *defaultTransport) onMessage(rs goetty.IOSession, msg interface{}, seq uint64) error {
+	switch m := msg.(type) {
+	case *hello:
+		return rs.WriteAndFlush(&hello{compression: t.acceptCompression(m.compression)})
+	case *bhraftpb.RaftMessageBatch:
+		for _, v := range m.Messages {
+			t.handler(v)
+		}
+		return nil
+	case *bhraftpb.SnapshotMessage:
+		// called in the read loop of the connection, the next chunk is not read while throttled
+		throttled, err := util.WaitBytes(context.Background(), t.opts.receiveLimiter, len(m.Data))
+		if err != nil {
+			return err
+		}
+		metric.AddSnapshotReceiveThrottled(throttled)
+	}
+
 	t.handler(msg)
 	return nil
 }
 
+// acceptCompression returns the compression accepted by the receiver, the compression is
+// accepted only if the receiver enables the compression too.
+func (t *defaultTransport) acceptCompression(value Compression) Compression {
+	if t.opts.compression == CompressionNone || !value.supported() {
+		return CompressionNone
+	}
+	return value
+}
+
 func (t *defaultTransport) readyToSendRaft(q *task.Queue) {
 	items := make([]interface{}, t.opts.sendBatch)
 	buffers := make(map[uint64][]*bhraftpb.RaftMessage)
@@ -319,6 +356,15 @@ func (t *defaultTransport) doSend(msgs []*bhraftpb.RaftMessage, to uint64) error
 }
 
 func (t *defaultTransport) doBatchWrite(msgs []*bhraftpb.RaftMessage, conn goetty.IOSession) error {
+	if batch := t.maybeCompress(msgs, conn); batch != nil {
+		err := conn.WriteAndFlush(batch)
+		if err != nil {
+			conn.Close()
+			return err
+		}
+		return nil
+	}
+
 	for _, m := range msgs {
 		err := conn.Write(m)
 		if err != nil {
@@ -336,6 +382,25 @@ func (t *defaultTransport) doBatchWrite(msgs []*bhraftpb.RaftMessage, conn goett
 	return nil
 }
 
+// maybeCompress returns the compressed batch of the messages, returns nil if the compression
+// is not negotiated on the connection or the messages are too small to compress.
+func (t *defaultTransport) maybeCompress(msgs []*bhraftpb.RaftMessage, conn goetty.IOSession) *raftBatch {
+	compression, _ := conn.GetAttr(attrCompression).(Compression)
+	if compression == CompressionNone {
+		return nil
+	}
+
+	batch := &bhraftpb.RaftMessageBatch{Messages: msgs}
+	size := batch.Size()
+	if size < t.opts.compressThreshold {
+		return nil
+	}
+
+	data := compression.compress(protoc.MustMarshal(batch))
+	metric.ObserveRaftMsgCompressionRatio(len(data), size)
+	return &raftBatch{compression: compression, data: data}
+}
+
 func (t *defaultTransport) putConn(id uint64, conn goetty.IOSession) {
 	if p, ok := t.conns.Load(id); ok {
 		p.(pool.IOSessionPool).Put(conn)
@@ -398,13 +463,68 @@ func (t *defaultTransport) checkConnect(id uint64, conn goetty.IOSession) bool {
 		return false
 	}
 
+	if ok {
+		if err := t.handshake(id, conn); err != nil {
+			// the old version receivers close the connection on the unknown hello message
+			logger.Warningf("handshake with store %d failed with %+v, reconnect without compression",
+				id,
+				err)
+			t.noCompression.Store(id, time.Now())
+			conn.Close()
+
+			ok, err = conn.Connect(addr, time.Second*10)
+			if err != nil {
+				logger.Errorf("connect to store %d failed with %+v",
+					id,
+					err)
+				return false
+			}
+			conn.SetAttr(attrCompression, CompressionNone)
+		}
+	}
+
 	logger.Infof("connected to store %d", id)
 	return ok
 }
 
+// handshake negotiates the compression of the raft message batches on the new connection. The
+// store which rejected the hello or not replied is connected without the handshake, and the
+// handshake is retried after the helloRetryInterval, the store maybe upgraded.
+func (t *defaultTransport) handshake(id uint64, conn goetty.IOSession) error {
+	conn.SetAttr(attrCompression, CompressionNone)
+	if t.opts.compression == CompressionNone {
+		return nil
+	}
+
+	if v, ok := t.noCompression.Load(id); ok {
+		if time.Since(v.(time.Time)) < helloRetryInterval {
+			return nil
+		}
+		t.noCompression.Delete(id)
+	}
+
+	err := conn.WriteAndFlush(&hello{compression: t.opts.compression})
+	if err != nil {
+		return err
+	}
+
+	msg, err := conn.Read()
+	if err != nil {
+		return err
+	}
+
+	v, ok := msg.(*hello)
+	if !ok {
+		return fmt.Errorf("unexpected handshake response %T", msg)
+	}
+
+	conn.SetAttr(attrCompression, v.compression)
+	return nil
+}
+
 func (t *defaultTransport) createConn() (goetty.IOSession, error) {
-	return goetty.NewIOSession(goetty.WithCodec(t.encoder, t.decoder),
-		goetty.WithTimeout(t.opts.readTimeout, t.opts.writeTimeout)), nil
+	return tlsutil.NewIOSession(t.opts.security, t.encoder, t.decoder,
+		tlsutil.WithTimeout(t.opts.readTimeout, t.opts.writeTimeout)), nil
 }
 
 func (t *defaultTransport) resolverStoreAddr(storeID uint64) (string, error) {
@@ -14,8 +14,72 @@
 package transport
 
 import (
+	"fmt"
 	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/goetty/codec/length"
+	"github.com/matrixorigin/matrixcube/components/prophet/metadata"
+	"github.com/matrixorigin/matrixcube/components/prophet/util/tlsutil"
+	"github.com/matrixorigin/matrixcube/pb/bhraftpb"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestStartTransport(t *testing.T) {
 }
+
+// legacyDecoder decodes the messages like the receivers without the handshake
+type legacyDecoder struct {
+	raftDecoder
+}
+
+func (d legacyDecoder) Decode(in *buf.ByteBuf) (bool, interface{}, error) {
+	if v, err := in.PeekN(0, 1); err == nil && v[0] == typeHello {
+		return false, nil, fmt.Errorf("not support msg type %d", v[0])
+	}
+	return d.raftDecoder.Decode(in)
+}
+
+func TestHandshakeWithLegacyReceiver(t *testing.T) {
+	addr := "127.0.0.1:52301"
+	received := make(chan interface{}, 1)
+	encoder, decoder := length.New(newRaftEncoder(), legacyDecoder{})
+	app, err := tlsutil.NewTCPApplication(tlsutil.Config{}, addr, func(rs goetty.IOSession, msg interface{}, seq uint64) error {
+		received <- msg
+		return nil
+	}, goetty.WithAppSessionOptions(goetty.WithCodec(encoder, decoder)))
+	assert.NoError(t, err)
+	assert.NoError(t, app.Start())
+	defer app.Stop()
+
+	tr := NewDefaultTransport(1, "127.0.0.1:52302", nil, func(interface{}) {},
+		func(id uint64) (metadata.Container, error) {
+			c := metadata.NewTestContainer(id)
+			c.SetAddrs(addr, addr)
+			return c, nil
+		},
+		WithCompression(CompressionSnappy, 0),
+		WithTimeout(time.Second, time.Second),
+		WithWorkerCount(1, 1),
+		WithSendBatch(16)).(*defaultTransport)
+	tr.Start()
+	defer tr.Stop()
+
+	// the receiver rejects the hello, the connection falls back to no compression
+	conn, err := tr.getConn(2)
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionNone, conn.GetAttr(attrCompression))
+	_, ok := tr.noCompression.Load(uint64(2))
+	assert.True(t, ok)
+
+	assert.NoError(t, tr.doBatchWrite([]*bhraftpb.RaftMessage{{ShardID: 1}}, conn))
+	tr.putConn(2, conn)
+	select {
+	case msg := <-received:
+		assert.Equal(t, uint64(1), msg.(*bhraftpb.RaftMessage).ShardID)
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "timeout")
+	}
+}
@@ -0,0 +1,451 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/command"
+	"github.com/matrixorigin/matrixcube/pb"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/matrixorigin/matrixcube/util"
+)
+
+const (
+	// gcBatchKeys the max count of the keys scanned by a GC request
+	gcBatchKeys = 1024
+)
+
+func write(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (uint64, int64, *raftcmdpb.Response) {
+	txnReq := &txnpb.TxnRequest{}
+	protoc.MustUnmarshal(txnReq, req.Cmd)
+
+	wb := ctx.WriteBatch()
+	c := &txnContext{
+		key: req.Key,
+		wb:  wb,
+		kv:  ctx.DataStorage().(storage.KVStorage),
+	}
+
+	// the propose time of the request, it's the same on all the replicas
+	now := wb.Timestamp
+	if now == 0 {
+		now = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+
+	rsp := &txnpb.TxnResponse{}
+	switch txnReq.Op {
+	case txnpb.TxnOp_Prewrite:
+		prewrite(c, txnReq, now, rsp)
+	case txnpb.TxnOp_Commit:
+		commit(c, txnReq, rsp)
+	case txnpb.TxnOp_Rollback:
+		rollback(c, txnReq, rsp)
+	case txnpb.TxnOp_CheckTxnStatus:
+		checkTxnStatus(c, txnReq, now, rsp)
+	case txnpb.TxnOp_GC:
+		gc(c, shard, txnReq, rsp)
+	default:
+		logger.Fatalf("invalid transaction write op %s", txnReq.Op.String())
+	}
+
+	resp := pb.AcquireResponse()
+	resp.Value = protoc.MustMarshal(rsp)
+	return c.written, c.diff, resp
+}
+
+func read(shard bhmetapb.Shard, req *raftcmdpb.Request, ctx command.Context) (*raftcmdpb.Response, uint64) {
+	txnReq := &txnpb.TxnRequest{}
+	protoc.MustUnmarshal(txnReq, req.Cmd)
+
+	c := &txnContext{
+		key: req.Key,
+		kv:  ctx.DataStorage().(storage.KVStorage),
+	}
+
+	rsp := &txnpb.TxnResponse{}
+	get(c, txnReq, rsp)
+
+	resp := pb.AcquireResponse()
+	resp.Value = protoc.MustMarshal(rsp)
+	return resp, uint64(len(rsp.Value))
+}
+
+// txnContext reads and writes the lock, write and data keys of the transaction key. The
+// reads see the writes of the previous requests in the same write batch.
+type txnContext struct {
+	key     []byte
+	wb      *util.WriteBatch
+	kv      storage.KVStorage
+	written uint64
+	diff    int64
+}
+
+func (c *txnContext) get(key []byte) []byte {
+	if c.wb != nil {
+		for i := len(c.wb.Keys) - 1; i >= 0; i-- {
+			if bytes.Equal(c.wb.Keys[i], key) {
+				if c.wb.Ops[i] == util.OpDelete {
+					return nil
+				}
+				return c.wb.Values[i]
+			}
+		}
+	}
+
+	value, err := c.kv.Get(key)
+	if err != nil {
+		logger.Fatalf("load transaction data of key %+v failed with %+v", key, err)
+	}
+	if len(value) == 0 {
+		return nil
+	}
+	return value
+}
+
+type pendingWrite struct {
+	key     []byte
+	value   []byte
+	deleted bool
+}
+
+// scan calls the fn with the key-value pairs in [start, end) in order, until the fn
+// returns false.
+func (c *txnContext) scan(start, end []byte, fn func(key, value []byte) bool) {
+	var pending []pendingWrite
+	if c.wb != nil {
+		indexes := make(map[string]int)
+		for i, key := range c.wb.Keys {
+			if bytes.Compare(key, start) < 0 || bytes.Compare(key, end) >= 0 {
+				continue
+			}
+
+			w := pendingWrite{key: key, value: c.wb.Values[i], deleted: c.wb.Ops[i] == util.OpDelete}
+			if idx, ok := indexes[string(key)]; ok {
+				pending[idx] = w
+				continue
+			}
+			indexes[string(key)] = len(pending)
+			pending = append(pending, w)
+		}
+		sort.Slice(pending, func(i, j int) bool {
+			return bytes.Compare(pending[i].key, pending[j].key) < 0
+		})
+	}
+
+	stopped := false
+	emit := func(w pendingWrite) bool {
+		if w.deleted || fn(w.key, w.value) {
+			return true
+		}
+		stopped = true
+		return false
+	}
+
+	err := c.kv.Scan(start, end, func(key, value []byte) (bool, error) {
+		for len(pending) > 0 && bytes.Compare(pending[0].key, key) < 0 {
+			w := pending[0]
+			pending = pending[1:]
+			if !emit(w) {
+				return false, nil
+			}
+		}
+
+		if len(pending) > 0 && bytes.Equal(pending[0].key, key) {
+			w := pending[0]
+			pending = pending[1:]
+			return emit(w), nil
+		}
+		return emit(pendingWrite{key: key, value: value}), nil
+	}, false)
+	if err != nil {
+		logger.Fatalf("scan transaction data in [%+v, %+v) failed with %+v", start, end, err)
+	}
+
+	for _, w := range pending {
+		if stopped || !emit(w) {
+			return
+		}
+	}
+}
+
+func (c *txnContext) set(key, value []byte) {
+	c.wb.Set(key, value)
+	c.written += uint64(len(key) + len(value))
+	c.diff += int64(len(key) + len(value))
+}
+
+func (c *txnContext) delete(key []byte, size int) {
+	c.wb.Delete(key)
+	c.written += uint64(len(key))
+	c.diff -= int64(len(key) + size)
+}
+
+// getLock returns the lock of the key and the size of the lock
+func (c *txnContext) getLock() (*txnpb.Lock, int) {
+	value := c.get(lockKey(c.key))
+	if value == nil {
+		return nil, 0
+	}
+
+	lock := &txnpb.Lock{}
+	protoc.MustUnmarshal(lock, value)
+	return lock, len(value)
+}
+
+// scanWrites calls the fn with the write records committed in [minTS, maxTS] in the
+// descending order of the commitTS, until the fn returns false.
+func (c *txnContext) scanWrites(maxTS, minTS uint64, fn func(commitTS uint64, w txnpb.Write) bool) {
+	end := writeKey(c.key, minTS-1)
+	if minTS == 0 {
+		end = versionKey(c.key, writeFlag+1, math.MaxUint64)
+	}
+
+	c.scan(writeKey(c.key, maxTS), end, func(key, value []byte) bool {
+		w := txnpb.Write{}
+		protoc.MustUnmarshal(&w, value)
+		return fn(decodeVersion(key), w)
+	})
+}
+
+// findWrite returns the write record of the transaction
+func (c *txnContext) findWrite(startTS uint64) (uint64, *txnpb.Write) {
+	var commitTS uint64
+	var found *txnpb.Write
+	c.scanWrites(math.MaxUint64, startTS, func(ts uint64, w txnpb.Write) bool {
+		if w.StartTS == startTS {
+			commitTS = ts
+			found = &w
+			return false
+		}
+		return true
+	})
+	return commitTS, found
+}
+
+func prewrite(c *txnContext, req *txnpb.TxnRequest, now int64, rsp *txnpb.TxnResponse) {
+	if lock, _ := c.getLock(); lock != nil {
+		if lock.StartTS != req.StartTS {
+			rsp.Error.Type = txnpb.TxnErrorType_KeyLocked
+			rsp.Error.Lock = lock
+		}
+		return
+	}
+
+	c.scanWrites(math.MaxUint64, req.StartTS, func(commitTS uint64, w txnpb.Write) bool {
+		if w.Rollback {
+			if w.StartTS == req.StartTS {
+				rsp.Error.Type = txnpb.TxnErrorType_TxnAborted
+				return false
+			}
+			return true
+		}
+
+		rsp.Error.Type = txnpb.TxnErrorType_WriteConflict
+		rsp.Error.CommitTS = commitTS
+		return false
+	})
+	if rsp.Error.Type != txnpb.TxnErrorType_NoError {
+		return
+	}
+
+	c.set(lockKey(c.key), protoc.MustMarshal(&txnpb.Lock{
+		Primary:      req.Primary,
+		PrimaryGroup: req.PrimaryGroup,
+		StartTS:      req.StartTS,
+		ExpireAt:     now + int64(req.TTL),
+		Delete:       req.Delete,
+	}))
+	if !req.Delete {
+		c.set(dataKey(c.key, req.StartTS), req.Value)
+	}
+}
+
+func commit(c *txnContext, req *txnpb.TxnRequest, rsp *txnpb.TxnResponse) {
+	if lock, size := c.getLock(); lock != nil && lock.StartTS == req.StartTS {
+		c.set(writeKey(c.key, req.CommitTS), protoc.MustMarshal(&txnpb.Write{
+			StartTS: req.StartTS,
+			Delete:  lock.Delete,
+		}))
+		c.delete(lockKey(c.key), size)
+		return
+	}
+
+	// committed already
+	if _, w := c.findWrite(req.StartTS); w != nil && !w.Rollback {
+		return
+	}
+
+	rsp.Error.Type = txnpb.TxnErrorType_TxnAborted
+}
+
+func rollback(c *txnContext, req *txnpb.TxnRequest, rsp *txnpb.TxnResponse) {
+	if commitTS, w := c.findWrite(req.StartTS); w != nil {
+		if !w.Rollback {
+			rsp.Error.Type = txnpb.TxnErrorType_AlreadyCommitted
+			rsp.Error.CommitTS = commitTS
+		}
+		return
+	}
+
+	if lock, size := c.getLock(); lock != nil && lock.StartTS == req.StartTS {
+		c.delete(lockKey(c.key), size)
+		if !lock.Delete {
+			key := dataKey(c.key, req.StartTS)
+			c.delete(key, len(c.get(key)))
+		}
+	}
+
+	// the rollback record prevents the delayed prewrite of the transaction
+	c.set(writeKey(c.key, req.StartTS), protoc.MustMarshal(&txnpb.Write{
+		StartTS:  req.StartTS,
+		Rollback: true,
+	}))
+}
+
+func checkTxnStatus(c *txnContext, req *txnpb.TxnRequest, now int64, rsp *txnpb.TxnResponse) {
+	if lock, _ := c.getLock(); lock != nil && lock.StartTS == req.StartTS {
+		if now < lock.ExpireAt {
+			rsp.Status = txnpb.TxnStatus_Locked
+			return
+		}
+
+		// the coordinator maybe crashed, rollback the expired primary lock
+		rsp.Status = txnpb.TxnStatus_RolledBack
+		rollback(c, req, rsp)
+		return
+	}
+
+	if commitTS, w := c.findWrite(req.StartTS); w != nil {
+		if w.Rollback {
+			rsp.Status = txnpb.TxnStatus_RolledBack
+		} else {
+			rsp.Status = txnpb.TxnStatus_Committed
+			rsp.CommitTS = commitTS
+		}
+		return
+	}
+
+	// the primary lock is not written yet, rollback it to prevent the delayed prewrite
+	rsp.Status = txnpb.TxnStatus_RolledBack
+	rollback(c, req, rsp)
+}
+
+func get(c *txnContext, req *txnpb.TxnRequest, rsp *txnpb.TxnResponse) {
+	if lock, _ := c.getLock(); lock != nil && lock.StartTS <= req.StartTS {
+		rsp.Error.Type = txnpb.TxnErrorType_KeyLocked
+		rsp.Error.Lock = lock
+		return
+	}
+
+	c.scanWrites(req.StartTS, 0, func(commitTS uint64, w txnpb.Write) bool {
+		if w.Rollback {
+			return true
+		}
+
+		if !w.Delete {
+			rsp.Value = append([]byte(nil), c.get(dataKey(c.key, w.StartTS))...)
+			rsp.Found = true
+		}
+		return false
+	})
+}
+
+// gc removes the versions of the transaction keys in [req.Key, shard.End) which are
+// invisible to the transactions started after the safe point. The latest committed
+// version before the safe point is kept, and the older versions, the deletions and the
+// rollback records before the safe point are removed. At most gcBatchKeys keys are
+// scanned, and the next key to continue is returned.
+func gc(c *txnContext, shard bhmetapb.Shard, req *txnpb.TxnRequest, rsp *txnpb.TxnResponse) {
+	end := raftstore.EncodeDataKey(shard.Group+1, nil)
+	if len(shard.End) > 0 {
+		end = raftstore.EncodeDataKey(shard.Group, shard.End)
+	}
+
+	var current []byte
+	kept := false
+	removed := make(map[uint64]struct{})
+	scanned := 0
+	completed := true
+	c.scan(c.key, end, func(key, value []byte) bool {
+		if len(key) < raftstore.DataPrefixSize {
+			return true
+		}
+
+		userKey := key[raftstore.DataPrefixSize:]
+		n, ok := encodedKeyLen(userKey)
+		if !ok || len(userKey) == n {
+			return true
+		}
+
+		txnKey := key[:raftstore.DataPrefixSize+n]
+		if !bytes.Equal(txnKey, current) {
+			if scanned >= gcBatchKeys {
+				rsp.NextKey = append([]byte(nil), userKey[:n]...)
+				completed = false
+				return false
+			}
+
+			current = append([]byte(nil), txnKey...)
+			kept = false
+			removed = make(map[uint64]struct{})
+			scanned++
+		}
+
+		flag := userKey[n]
+		if len(userKey) != n+9 || (flag != writeFlag && flag != dataFlag) {
+			return true
+		}
+
+		ts := decodeVersion(key)
+		if flag == dataFlag {
+			if _, ok := removed[ts]; ok {
+				c.delete(append([]byte(nil), key...), len(value))
+			}
+			return true
+		}
+
+		if ts > req.SafePoint {
+			return true
+		}
+
+		w := txnpb.Write{}
+		protoc.MustUnmarshal(&w, value)
+		switch {
+		case w.Rollback:
+		case !kept:
+			// the latest version before the safe point
+			kept = true
+			if !w.Delete {
+				return true
+			}
+		case !w.Delete:
+			removed[w.StartTS] = struct{}{}
+		}
+		c.delete(append([]byte(nil), key...), len(value))
+		return true
+	})
+
+	if completed {
+		rsp.NextKey = shard.End
+	}
+}
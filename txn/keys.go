@@ -0,0 +1,133 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+const (
+	encGroupSize = 8
+	encMarker    = byte(0xff)
+	encPad       = byte(0x0)
+
+	lockFlag  byte = 1
+	writeFlag byte = 2
+	dataFlag  byte = 3
+)
+
+// EncodeKey encodes the transaction key in the memcomparable format, the key is split into
+// the groups of 8 bytes, and every group is padded with 0 and followed by a marker of
+// 0xff - the count of the padding bytes. The encoded keys keep the order of the keys, and
+// an encoded key is never a prefix of the other one, so all the lock, write and data keys
+// of a transaction key are between it and the next transaction key.
+func EncodeKey(key []byte) []byte {
+	dLen := len(key)
+	encoded := make([]byte, 0, (dLen/encGroupSize+1)*(encGroupSize+1))
+	for idx := 0; idx <= dLen; idx += encGroupSize {
+		remain := dLen - idx
+		padCount := 0
+		if remain >= encGroupSize {
+			encoded = append(encoded, key[idx:idx+encGroupSize]...)
+		} else {
+			padCount = encGroupSize - remain
+			encoded = append(encoded, key[idx:]...)
+			for i := 0; i < padCount; i++ {
+				encoded = append(encoded, encPad)
+			}
+		}
+		encoded = append(encoded, encMarker-byte(padCount))
+	}
+	return encoded
+}
+
+// DecodeKey decodes the key encoded by EncodeKey, it returns false if the key is not a
+// valid encoded key.
+func DecodeKey(encoded []byte) ([]byte, bool) {
+	n, ok := encodedKeyLen(encoded)
+	if !ok || n != len(encoded) {
+		return nil, false
+	}
+
+	key := make([]byte, 0, n)
+	for idx := 0; idx < n; idx += encGroupSize + 1 {
+		padCount := int(encMarker - encoded[idx+encGroupSize])
+		key = append(key, encoded[idx:idx+encGroupSize-padCount]...)
+	}
+	return key, true
+}
+
+// AdjustSplitKey truncates the split key to the encoded transaction key at its beginning,
+// so the lock, write and data keys of a transaction key are never split into different
+// shards. The key is returned as is if it's not started with an encoded key.
+func AdjustSplitKey(key []byte) []byte {
+	if n, ok := encodedKeyLen(key); ok {
+		return key[:n]
+	}
+	return key
+}
+
+// encodedKeyLen returns the length of the encoded key at the beginning of the key
+func encodedKeyLen(key []byte) (int, bool) {
+	for idx := 0; idx+encGroupSize < len(key); idx += encGroupSize + 1 {
+		marker := key[idx+encGroupSize]
+		if marker == encMarker {
+			continue
+		}
+
+		padCount := int(encMarker - marker)
+		if padCount > encGroupSize {
+			return 0, false
+		}
+		for _, v := range key[idx+encGroupSize-padCount : idx+encGroupSize] {
+			if v != encPad {
+				return 0, false
+			}
+		}
+		return idx + encGroupSize + 1, true
+	}
+	return 0, false
+}
+
+// lockKey returns the key of the lock of the transaction key
+func lockKey(key []byte) []byte {
+	v := make([]byte, len(key)+1)
+	copy(v, key)
+	v[len(key)] = lockFlag
+	return v
+}
+
+// writeKey returns the key of the write record committed at the commitTS
+func writeKey(key []byte, commitTS uint64) []byte {
+	return versionKey(key, writeFlag, commitTS)
+}
+
+// dataKey returns the key of the value written by the transaction of the startTS
+func dataKey(key []byte, startTS uint64) []byte {
+	return versionKey(key, dataFlag, startTS)
+}
+
+// versionKey the versions are sorted by the timestamp in descending order
+func versionKey(key []byte, flag byte, ts uint64) []byte {
+	v := make([]byte, len(key)+9)
+	copy(v, key)
+	v[len(key)] = flag
+	binary.BigEndian.PutUint64(v[len(key)+1:], math.MaxUint64-ts)
+	return v
+}
+
+func decodeVersion(key []byte) uint64 {
+	return math.MaxUint64 - binary.BigEndian.Uint64(key[len(key)-8:])
+}
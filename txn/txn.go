@@ -0,0 +1,81 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txn implements the percolator style transaction commands on the raftstore.
+//
+// The transaction keys are encoded by EncodeKey before routed to the shards, and the data
+// of a transaction key is stored in the keys with the encoded key as the prefix:
+//
+//	lock key:  key + lockFlag                -> Lock of the uncommitted transaction
+//	write key: key + writeFlag + ^commitTS   -> Write of the committed or rolled back version
+//	data key:  key + dataFlag + ^startTS     -> value written by the transaction
+//
+// So the versions are sorted by the timestamp in descending order, and a GC request removes
+// the versions before the safe point. The split keys are adjusted by AdjustSplitKey to keep
+// all the keys of a transaction key in the same shard. The keys written by the transactions
+// must only be accessed by the transaction commands.
+package txn
+
+import (
+	"math"
+
+	"github.com/fagongzi/log"
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+)
+
+var (
+	logger = log.NewLoggerWithPrefix("[matrixcube-txn]")
+)
+
+const (
+	// WriteCMD the custom type of the transaction write commands, the store rejects
+	// the application handler of it if the transaction handlers are registered.
+	WriteCMD uint64 = math.MaxUint64 - 1
+	// ReadCMD the custom type of the transaction read commands, the store rejects
+	// the application handler of it if the transaction handlers are registered.
+	ReadCMD uint64 = math.MaxUint64 - 2
+)
+
+// Register registers the transaction command handlers and the split key adjust func to the store
+func Register(store raftstore.Store) {
+	store.RegisterWriteFunc(WriteCMD, write)
+	store.RegisterReadFunc(ReadCMD, read)
+	store.RegisterSplitKeyFunc(AdjustSplitKey)
+}
+
+// BuildRequest builds the raft request of the transaction command on the key
+func BuildRequest(req *raftcmdpb.Request, key []byte, txnReq *txnpb.TxnRequest) {
+	req.Key = EncodeKey(key)
+	req.Cmd = protoc.MustMarshal(txnReq)
+	if txnReq.Op == txnpb.TxnOp_Get {
+		req.CustemType = ReadCMD
+		req.Type = raftcmdpb.CMDType_Read
+		return
+	}
+
+	req.CustemType = WriteCMD
+	req.Type = raftcmdpb.CMDType_Write
+}
+
+// BuildGCRequest builds the raft request of the GC of the versions before the safe point in
+// the shard of the start key, the start key is the NextKey of the previous GC response or
+// empty for the first shard, it's not encoded by EncodeKey.
+func BuildGCRequest(req *raftcmdpb.Request, start []byte, safePoint uint64) {
+	req.Key = start
+	req.Cmd = protoc.MustMarshal(&txnpb.TxnRequest{Op: txnpb.TxnOp_GC, SafePoint: safePoint})
+	req.CustemType = WriteCMD
+	req.Type = raftcmdpb.CMDType_Write
+}
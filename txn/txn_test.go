@@ -0,0 +1,307 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixcube/pb/bhmetapb"
+	"github.com/matrixorigin/matrixcube/pb/raftcmdpb"
+	"github.com/matrixorigin/matrixcube/pb/txnpb"
+	"github.com/matrixorigin/matrixcube/raftstore"
+	"github.com/matrixorigin/matrixcube/storage"
+	"github.com/matrixorigin/matrixcube/storage/mem"
+	"github.com/matrixorigin/matrixcube/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type testContext struct {
+	wb *util.WriteBatch
+	ds storage.DataStorage
+}
+
+func newTestContext() *testContext {
+	return &testContext{wb: util.NewWriteBatch(), ds: mem.NewStorage()}
+}
+
+func (ctx *testContext) WriteBatch() *util.WriteBatch     { return ctx.wb }
+func (ctx *testContext) LogIndex() uint64                 { return 0 }
+func (ctx *testContext) Offset() int                      { return 0 }
+func (ctx *testContext) BatchSize() int                   { return 1 }
+func (ctx *testContext) Attrs() map[string]interface{}    { return nil }
+func (ctx *testContext) ByteBuf() *buf.ByteBuf            { return nil }
+func (ctx *testContext) DataStorage() storage.DataStorage { return ctx.ds }
+func (ctx *testContext) StoreID() uint64                  { return 0 }
+
+func (ctx *testContext) exec(t *testing.T, now int64, key string, txnReq *txnpb.TxnRequest) *txnpb.TxnResponse {
+	req := &raftcmdpb.Request{}
+	BuildRequest(req, []byte(key), txnReq)
+	return ctx.execRequest(t, now, req)
+}
+
+func (ctx *testContext) execRequest(t *testing.T, now int64, req *raftcmdpb.Request) *txnpb.TxnResponse {
+	// the key is encoded with the group prefix after proposed
+	req.Key = raftstore.EncodeDataKey(0, req.Key)
+
+	var resp *raftcmdpb.Response
+	if req.Type == raftcmdpb.CMDType_Read {
+		resp, _ = read(bhmetapb.Shard{}, req, ctx)
+	} else {
+		ctx.wb.Timestamp = now
+		_, _, resp = write(bhmetapb.Shard{}, req, ctx)
+		assert.NoError(t, ctx.ds.(storage.KVStorage).Write(ctx.wb, false))
+		ctx.wb.Reset()
+	}
+
+	rsp := &txnpb.TxnResponse{}
+	protoc.MustUnmarshal(rsp, resp.Value)
+	return rsp
+}
+
+func TestPrewriteAndCommit(t *testing.T) {
+	ctx := newTestContext()
+	prewrite := &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 10, Primary: []byte("k1"), TTL: 100, Value: []byte("v1")}
+	rsp := ctx.exec(t, 1000, "k1", prewrite)
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	// retry prewrite
+	rsp = ctx.exec(t, 1000, "k1", prewrite)
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	// locked by other transaction
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 11, Primary: []byte("k1")})
+	assert.Equal(t, txnpb.TxnErrorType_KeyLocked, rsp.Error.Type)
+	assert.Equal(t, uint64(10), rsp.Error.Lock.StartTS)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: 12})
+	assert.Equal(t, txnpb.TxnErrorType_KeyLocked, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: 9})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	assert.False(t, rsp.Found)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 10, CommitTS: 13})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	// retry commit
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 10, CommitTS: 13})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: 12})
+	assert.False(t, rsp.Found)
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: 14})
+	assert.True(t, rsp.Found)
+	assert.Equal(t, "v1", string(rsp.Value))
+
+	// committed after the start of the transaction
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 12, Primary: []byte("k1")})
+	assert.Equal(t, txnpb.TxnErrorType_WriteConflict, rsp.Error.Type)
+	assert.Equal(t, uint64(13), rsp.Error.CommitTS)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Rollback, StartTS: 10})
+	assert.Equal(t, txnpb.TxnErrorType_AlreadyCommitted, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 15, Primary: []byte("k1"), Delete: true})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 15, CommitTS: 16})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: 17})
+	assert.False(t, rsp.Found)
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: 14})
+	assert.True(t, rsp.Found)
+}
+
+func TestRollback(t *testing.T) {
+	ctx := newTestContext()
+	rsp := ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 10, Primary: []byte("k1"), Value: []byte("v1")})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Rollback, StartTS: 10})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 10, CommitTS: 11})
+	assert.Equal(t, txnpb.TxnErrorType_TxnAborted, rsp.Error.Type)
+
+	// delayed prewrite after rollback
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 10, Primary: []byte("k1"), Value: []byte("v1")})
+	assert.Equal(t, txnpb.TxnErrorType_TxnAborted, rsp.Error.Type)
+
+	// rollback of other transaction is not a conflict
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 9, Primary: []byte("k1"), Value: []byte("v1")})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+}
+
+func TestCheckTxnStatus(t *testing.T) {
+	ctx := newTestContext()
+	rsp := ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 10, Primary: []byte("k1"), TTL: 100})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1050, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_CheckTxnStatus, StartTS: 10})
+	assert.Equal(t, txnpb.TxnStatus_Locked, rsp.Status)
+
+	rsp = ctx.exec(t, 1100, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_CheckTxnStatus, StartTS: 10})
+	assert.Equal(t, txnpb.TxnStatus_RolledBack, rsp.Status)
+
+	rsp = ctx.exec(t, 1100, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 10, CommitTS: 11})
+	assert.Equal(t, txnpb.TxnErrorType_TxnAborted, rsp.Error.Type)
+
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 12, Primary: []byte("k1"), TTL: 100})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	rsp = ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 12, CommitTS: 13})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	rsp = ctx.exec(t, 2000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_CheckTxnStatus, StartTS: 12})
+	assert.Equal(t, txnpb.TxnStatus_Committed, rsp.Status)
+	assert.Equal(t, uint64(13), rsp.CommitTS)
+
+	// the primary is not prewritten
+	rsp = ctx.exec(t, 2000, "k2", &txnpb.TxnRequest{Op: txnpb.TxnOp_CheckTxnStatus, StartTS: 14})
+	assert.Equal(t, txnpb.TxnStatus_RolledBack, rsp.Status)
+	rsp = ctx.exec(t, 2000, "k2", &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 14, Primary: []byte("k2")})
+	assert.Equal(t, txnpb.TxnErrorType_TxnAborted, rsp.Error.Type)
+}
+
+func TestReadFromWriteBatch(t *testing.T) {
+	ctx := newTestContext()
+	req := &raftcmdpb.Request{}
+	BuildRequest(req, []byte("k1"), &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 10, Primary: []byte("k1"), Value: []byte("v1")})
+	req.Key = raftstore.EncodeDataKey(0, req.Key)
+	_, _, resp := write(bhmetapb.Shard{}, req, ctx)
+	rsp := &txnpb.TxnResponse{}
+	protoc.MustUnmarshal(rsp, resp.Value)
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	// the lock in the same write batch
+	BuildRequest(req, []byte("k1"), &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 11, Primary: []byte("k1")})
+	req.Key = raftstore.EncodeDataKey(0, req.Key)
+	_, _, resp = write(bhmetapb.Shard{}, req, ctx)
+	rsp = &txnpb.TxnResponse{}
+	protoc.MustUnmarshal(rsp, resp.Value)
+	assert.Equal(t, txnpb.TxnErrorType_KeyLocked, rsp.Error.Type)
+
+	// the write record in the same write batch
+	BuildRequest(req, []byte("k1"), &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: 10, CommitTS: 12})
+	req.Key = raftstore.EncodeDataKey(0, req.Key)
+	_, _, resp = write(bhmetapb.Shard{}, req, ctx)
+	rsp = &txnpb.TxnResponse{}
+	protoc.MustUnmarshal(rsp, resp.Value)
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+
+	BuildRequest(req, []byte("k1"), &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: 11, Primary: []byte("k1")})
+	req.Key = raftstore.EncodeDataKey(0, req.Key)
+	_, _, resp = write(bhmetapb.Shard{}, req, ctx)
+	rsp = &txnpb.TxnResponse{}
+	protoc.MustUnmarshal(rsp, resp.Value)
+	assert.Equal(t, txnpb.TxnErrorType_WriteConflict, rsp.Error.Type)
+	assert.Equal(t, uint64(12), rsp.Error.CommitTS)
+}
+
+func TestGC(t *testing.T) {
+	ctx := newTestContext()
+	put := func(key string, startTS, commitTS uint64, value string) {
+		rsp := ctx.exec(t, 1000, key, &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: startTS, Primary: []byte(key), Value: []byte(value), Delete: value == ""})
+		assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+		rsp = ctx.exec(t, 1000, key, &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: startTS, CommitTS: commitTS})
+		assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	}
+	assertGet := func(key string, startTS uint64, found bool, value string) {
+		rsp := ctx.exec(t, 1000, key, &txnpb.TxnRequest{Op: txnpb.TxnOp_Get, StartTS: startTS})
+		assert.Equal(t, found, rsp.Found)
+		assert.Equal(t, value, string(rsp.Value))
+	}
+	count := func() int {
+		n := 0
+		assert.NoError(t, ctx.ds.(storage.KVStorage).Scan(nil, []byte{0xff}, func(key, value []byte) (bool, error) {
+			n++
+			return true, nil
+		}, false))
+		return n
+	}
+
+	put("k1", 10, 20, "v1")
+	put("k1", 30, 40, "v2")
+	rsp := ctx.exec(t, 1000, "k1", &txnpb.TxnRequest{Op: txnpb.TxnOp_Rollback, StartTS: 45})
+	assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+	put("k1", 50, 60, "v3")
+	put("k2", 10, 20, "v1")
+	put("k2", 30, 40, "")
+	put("k3", 10, 20, "v1")
+	// 3 puts and a rollback of k1, a put and a delete of k2, a put of k3
+	assert.Equal(t, 3*2+1+3+2, count())
+
+	req := &raftcmdpb.Request{}
+	BuildGCRequest(req, nil, 55)
+	rsp = ctx.execRequest(t, 1000, req)
+	assert.Empty(t, rsp.NextKey)
+	// the latest version of k1 before the safe point and the version after it, a put of k3
+	assert.Equal(t, 2*2+2, count())
+	assertGet("k1", 55, true, "v2")
+	assertGet("k1", 70, true, "v3")
+	assertGet("k2", 55, false, "")
+	assertGet("k3", 55, true, "v1")
+
+	// nothing to GC
+	rsp = ctx.execRequest(t, 1000, req)
+	assert.Empty(t, rsp.NextKey)
+	assert.Equal(t, 2*2+2, count())
+}
+
+func TestGCInBatches(t *testing.T) {
+	ctx := newTestContext()
+	for i := 0; i < gcBatchKeys+1; i++ {
+		key := fmt.Sprintf("k%05d", i)
+		for _, ts := range []uint64{1, 3} {
+			rsp := ctx.exec(t, 1000, key, &txnpb.TxnRequest{Op: txnpb.TxnOp_Prewrite, StartTS: ts, Primary: []byte(key), Value: []byte("v")})
+			assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+			rsp = ctx.exec(t, 1000, key, &txnpb.TxnRequest{Op: txnpb.TxnOp_Commit, StartTS: ts, CommitTS: ts + 1})
+			assert.Equal(t, txnpb.TxnErrorType_NoError, rsp.Error.Type)
+		}
+	}
+
+	req := &raftcmdpb.Request{}
+	BuildGCRequest(req, nil, 10)
+	rsp := ctx.execRequest(t, 1000, req)
+	assert.Equal(t, EncodeKey([]byte(fmt.Sprintf("k%05d", gcBatchKeys))), rsp.NextKey)
+
+	BuildGCRequest(req, rsp.NextKey, 10)
+	rsp = ctx.execRequest(t, 1000, req)
+	assert.Empty(t, rsp.NextKey)
+}
+
+func TestEncodeKey(t *testing.T) {
+	keys := [][]byte{nil, []byte("a"), []byte("a\x00"), []byte("abcdefgh"), []byte("abcdefgh\x00"), []byte("abcdefghi"), []byte("b")}
+	for i, key := range keys {
+		encoded := EncodeKey(key)
+		decoded, ok := DecodeKey(encoded)
+		assert.True(t, ok)
+		assert.Equal(t, string(key), string(decoded))
+		assert.Equal(t, encoded, AdjustSplitKey(encoded))
+		assert.Equal(t, encoded, AdjustSplitKey(writeKey(encoded, 1)))
+
+		if i > 0 {
+			// the order is kept, and the keys of the previous key are less than the key
+			prev := EncodeKey(keys[i-1])
+			assert.True(t, bytes.Compare(prev, encoded) < 0)
+			assert.True(t, bytes.Compare(dataKey(prev, 0), encoded) < 0)
+			assert.False(t, bytes.HasPrefix(encoded, prev))
+		}
+	}
+
+	_, ok := DecodeKey([]byte("abc"))
+	assert.False(t, ok)
+	assert.Equal(t, []byte("abc"), AdjustSplitKey([]byte("abc")))
+}
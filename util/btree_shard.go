@@ -166,20 +166,26 @@ func (t *ShardTree) NextShard(start []byte) *bhmetapb.Shard {
 	return &value.Shard
 }
 
-// AscendRange asc iterator the tree in the range [start, end) until fn returns false
+// AscendRange asc iterator the tree in the range [start, end) until fn returns false,
+// empty end means positive infinity
 func (t *ShardTree) AscendRange(start, end []byte, fn func(Shard *bhmetapb.Shard) bool) {
 	startItem := &ShardItem{
 		Shard: bhmetapb.Shard{Start: start},
 	}
 
-	endItem := &ShardItem{
-		Shard: bhmetapb.Shard{Start: end},
+	iter := func(item btree.Item) bool {
+		return fn(&item.(*ShardItem).Shard)
 	}
 
 	t.RLock()
-	t.tree.DescendRange(startItem, endItem, func(item btree.Item) bool {
-		return fn(&item.(*ShardItem).Shard)
-	})
+	if len(end) == 0 {
+		t.tree.DescendLessOrEqual(startItem, iter)
+	} else {
+		endItem := &ShardItem{
+			Shard: bhmetapb.Shard{Start: end},
+		}
+		t.tree.DescendRange(startItem, endItem, iter)
+	}
 	t.RUnlock()
 }
 
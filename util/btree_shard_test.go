@@ -85,6 +85,16 @@ func TestTree(t *testing.T) {
 		t.Error("tree failed, asc range failed")
 	}
 
+	count = 0
+	tree.AscendRange([]byte{2}, nil, func(Shard *bhmetapb.Shard) bool {
+		count++
+		return true
+	})
+
+	if count != 2 {
+		t.Error("tree failed, asc range with empty end failed")
+	}
+
 	// it will replace with 0,1 Shard
 	tree.Update(bhmetapb.Shard{
 		ID:    10,
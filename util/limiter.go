@@ -0,0 +1,56 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// NewBytesLimiter returns a token bucket limiter of the bytes per second, returns nil if no
+// limit. The bucket holds the bytes of a second, and a chunk at least.
+func NewBytesLimiter(bytesPerSecond, chunkSize uint64) *rate.Limiter {
+	if bytesPerSecond == 0 {
+		return nil
+	}
+
+	burst := bytesPerSecond
+	if burst < chunkSize {
+		burst = chunkSize
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), int(burst))
+}
+
+// WaitBytes waits until the n bytes are allowed by the limiter, returns the throttled time
+func WaitBytes(ctx context.Context, limiter *rate.Limiter, n int) (time.Duration, error) {
+	if limiter == nil {
+		return 0, nil
+	}
+
+	start := time.Now()
+	for n > 0 {
+		value := n
+		if value > limiter.Burst() {
+			value = limiter.Burst()
+		}
+
+		if err := limiter.WaitN(ctx, value); err != nil {
+			return 0, err
+		}
+		n -= value
+	}
+	return time.Since(start), nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBytesLimiter(t *testing.T) {
+	assert.Nil(t, NewBytesLimiter(0, 1024))
+	throttled, err := WaitBytes(context.Background(), nil, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), throttled)
+
+	limiter := NewBytesLimiter(100, 200)
+	assert.Equal(t, 200, limiter.Burst(), "a chunk at least")
+
+	// the burst is allowed at once
+	throttled, err = WaitBytes(context.Background(), limiter, 200)
+	assert.NoError(t, err)
+	assert.True(t, throttled < time.Millisecond*100)
+
+	// the bytes more than the burst are throttled
+	throttled, err = WaitBytes(context.Background(), limiter, 250)
+	assert.NoError(t, err)
+	assert.True(t, throttled >= time.Second*2, "throttled %s", throttled)
+}
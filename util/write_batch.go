@@ -31,6 +31,9 @@ type WriteBatch struct {
 	Keys   [][]byte
 	Values [][]byte
 	TTLs   []int32
+	// Timestamp is the base time(unix milliseconds) used to compute the expire time
+	// of the keys with TTL, 0 means use the current time of the storage.
+	Timestamp int64
 }
 
 // Delete remove the key
@@ -70,4 +73,5 @@ func (wb *WriteBatch) Reset() {
 	wb.Keys = wb.Keys[:0]
 	wb.Values = wb.Values[:0]
 	wb.TTLs = wb.TTLs[:0]
+	wb.Timestamp = 0
 }